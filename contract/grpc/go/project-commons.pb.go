@@ -35,23 +35,73 @@ const (
 	Error_PROJECT_NOT_FOUND Error = 3
 	// Indicates the provided values for he operation were invalid
 	Error_BAD_REQUEST Error = 4
+	// Indicates the project was changed since the version the operation is based
+	// on
+	Error_CONFLICT Error = 5
+	// Indicates the project is archived and cannot be changed until it is
+	// unarchived
+	Error_PROJECT_ARCHIVED Error = 6
+	// Indicates the role of the user in the project does not allow the operation
+	Error_PERMISSION_DENIED Error = 7
+	// Indicates the organization still has projects and cannot be deleted
+	Error_ORGANIZATION_NOT_EMPTY Error = 8
+	// Indicates the organization does not exist
+	Error_ORGANIZATION_NOT_FOUND Error = 9
+	// Indicates the folder still has projects and cannot be deleted
+	Error_FOLDER_NOT_EMPTY Error = 10
+	// Indicates the folder does not exist
+	Error_FOLDER_NOT_FOUND Error = 11
+	// Indicates the item of an all-or-nothing batch was not applied because
+	// another item of the batch failed
+	Error_BATCH_ABORTED Error = 12
+	// Indicates the idempotency key was already used by a request with a
+	// different payload
+	Error_IDEMPOTENCY_KEY_REUSED Error = 13
+	// Indicates the changes after the resource version are no longer kept, so
+	// watching the projects cannot be resumed from it
+	Error_RESOURCE_VERSION_EXPIRED Error = 14
+	// Indicates the operation is not supported by the database the service is
+	// configured with
+	Error_UNIMPLEMENTED Error = 15
 )
 
 // Enum value maps for Error.
 var (
 	Error_name = map[int32]string{
-		0: "NO_ERROR",
-		1: "UNKNOWN",
-		2: "PROJECT_ALREADY_EXISTS",
-		3: "PROJECT_NOT_FOUND",
-		4: "BAD_REQUEST",
+		0:  "NO_ERROR",
+		1:  "UNKNOWN",
+		2:  "PROJECT_ALREADY_EXISTS",
+		3:  "PROJECT_NOT_FOUND",
+		4:  "BAD_REQUEST",
+		5:  "CONFLICT",
+		6:  "PROJECT_ARCHIVED",
+		7:  "PERMISSION_DENIED",
+		8:  "ORGANIZATION_NOT_EMPTY",
+		9:  "ORGANIZATION_NOT_FOUND",
+		10: "FOLDER_NOT_EMPTY",
+		11: "FOLDER_NOT_FOUND",
+		12: "BATCH_ABORTED",
+		13: "IDEMPOTENCY_KEY_REUSED",
+		14: "RESOURCE_VERSION_EXPIRED",
+		15: "UNIMPLEMENTED",
 	}
 	Error_value = map[string]int32{
-		"NO_ERROR":               0,
-		"UNKNOWN":                1,
-		"PROJECT_ALREADY_EXISTS": 2,
-		"PROJECT_NOT_FOUND":      3,
-		"BAD_REQUEST":            4,
+		"NO_ERROR":                 0,
+		"UNKNOWN":                  1,
+		"PROJECT_ALREADY_EXISTS":   2,
+		"PROJECT_NOT_FOUND":        3,
+		"BAD_REQUEST":              4,
+		"CONFLICT":                 5,
+		"PROJECT_ARCHIVED":         6,
+		"PERMISSION_DENIED":        7,
+		"ORGANIZATION_NOT_EMPTY":   8,
+		"ORGANIZATION_NOT_FOUND":   9,
+		"FOLDER_NOT_EMPTY":         10,
+		"FOLDER_NOT_FOUND":         11,
+		"BATCH_ABORTED":            12,
+		"IDEMPOTENCY_KEY_REUSED":   13,
+		"RESOURCE_VERSION_EXPIRED": 14,
+		"UNIMPLEMENTED":            15,
 	}
 )
 
@@ -87,13 +137,29 @@ var File_project_commons_proto protoreflect.FileDescriptor
 var file_project_commons_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x2a, 0x66, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f,
-	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x04, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x2a, 0xe5, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x08,
+	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
+	0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
+	0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52,
+	0x45, 0x55, 0x53, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x4d, 0x50, 0x4c, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0f, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
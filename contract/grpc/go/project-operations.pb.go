@@ -29,7 +29,7 @@ var file_project_operations_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x90, 0x03, 0x0a, 0x07,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xbc, 0x1c, 0x0a, 0x07,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
 	0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
@@ -50,43 +50,385 @@ var file_project_operations_proto_rawDesc = []byte{
 	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x12, 0x60, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09,
-	0x5a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x10, 0x55, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x55, 0x6e, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x55, 0x6e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a,
+	0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x84, 0x01, 0x0a, 0x1f, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_project_operations_proto_goTypes = []interface{}{
-	(*CreateProjectRequest)(nil),  // 0: project.CreateProjectRequest
-	(*ReadProjectRequest)(nil),    // 1: project.ReadProjectRequest
-	(*UpdateProjectRequest)(nil),  // 2: project.UpdateProjectRequest
-	(*DeleteProjectRequest)(nil),  // 3: project.DeleteProjectRequest
-	(*ListProjectsRequest)(nil),   // 4: project.ListProjectsRequest
-	(*CreateProjectResponse)(nil), // 5: project.CreateProjectResponse
-	(*ReadProjectResponse)(nil),   // 6: project.ReadProjectResponse
-	(*UpdateProjectResponse)(nil), // 7: project.UpdateProjectResponse
-	(*DeleteProjectResponse)(nil), // 8: project.DeleteProjectResponse
-	(*ListProjectsResponse)(nil),  // 9: project.ListProjectsResponse
+	(*CreateProjectRequest)(nil),                    // 0: project.CreateProjectRequest
+	(*ReadProjectRequest)(nil),                      // 1: project.ReadProjectRequest
+	(*UpdateProjectRequest)(nil),                    // 2: project.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),                    // 3: project.DeleteProjectRequest
+	(*BatchCreateProjectsRequest)(nil),              // 4: project.BatchCreateProjectsRequest
+	(*BatchUpdateProjectsRequest)(nil),              // 5: project.BatchUpdateProjectsRequest
+	(*BatchDeleteProjectsRequest)(nil),              // 6: project.BatchDeleteProjectsRequest
+	(*RestoreProjectRequest)(nil),                   // 7: project.RestoreProjectRequest
+	(*ArchiveProjectRequest)(nil),                   // 8: project.ArchiveProjectRequest
+	(*UnarchiveProjectRequest)(nil),                 // 9: project.UnarchiveProjectRequest
+	(*AddMemberRequest)(nil),                        // 10: project.AddMemberRequest
+	(*RemoveMemberRequest)(nil),                     // 11: project.RemoveMemberRequest
+	(*UpdateMemberRoleRequest)(nil),                 // 12: project.UpdateMemberRoleRequest
+	(*ListMembersRequest)(nil),                      // 13: project.ListMembersRequest
+	(*CreateInvitationRequest)(nil),                 // 14: project.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),                  // 15: project.ListInvitationsRequest
+	(*AcceptInvitationRequest)(nil),                 // 16: project.AcceptInvitationRequest
+	(*RevokeInvitationRequest)(nil),                 // 17: project.RevokeInvitationRequest
+	(*TransferProjectOwnershipRequest)(nil),         // 18: project.TransferProjectOwnershipRequest
+	(*AcceptProjectOwnershipTransferRequest)(nil),   // 19: project.AcceptProjectOwnershipTransferRequest
+	(*DeclineProjectOwnershipTransferRequest)(nil),  // 20: project.DeclineProjectOwnershipTransferRequest
+	(*ListProjectAuditEventsRequest)(nil),           // 21: project.ListProjectAuditEventsRequest
+	(*ListProjectsRequest)(nil),                     // 22: project.ListProjectsRequest
+	(*StreamProjectsRequest)(nil),                   // 23: project.StreamProjectsRequest
+	(*WatchProjectsRequest)(nil),                    // 24: project.WatchProjectsRequest
+	(*ListProjectSortFieldsRequest)(nil),            // 25: project.ListProjectSortFieldsRequest
+	(*SearchProjectsRequest)(nil),                   // 26: project.SearchProjectsRequest
+	(*ListDeletedProjectsRequest)(nil),              // 27: project.ListDeletedProjectsRequest
+	(*CreateOrganizationRequest)(nil),               // 28: project.CreateOrganizationRequest
+	(*ReadOrganizationRequest)(nil),                 // 29: project.ReadOrganizationRequest
+	(*UpdateOrganizationRequest)(nil),               // 30: project.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),               // 31: project.DeleteOrganizationRequest
+	(*ListOrganizationsRequest)(nil),                // 32: project.ListOrganizationsRequest
+	(*AddOrganizationMemberRequest)(nil),            // 33: project.AddOrganizationMemberRequest
+	(*RemoveOrganizationMemberRequest)(nil),         // 34: project.RemoveOrganizationMemberRequest
+	(*ListOrganizationMembersRequest)(nil),          // 35: project.ListOrganizationMembersRequest
+	(*CreateFolderRequest)(nil),                     // 36: project.CreateFolderRequest
+	(*ListFoldersRequest)(nil),                      // 37: project.ListFoldersRequest
+	(*DeleteFolderRequest)(nil),                     // 38: project.DeleteFolderRequest
+	(*MoveProjectRequest)(nil),                      // 39: project.MoveProjectRequest
+	(*CreateProjectResponse)(nil),                   // 40: project.CreateProjectResponse
+	(*ReadProjectResponse)(nil),                     // 41: project.ReadProjectResponse
+	(*UpdateProjectResponse)(nil),                   // 42: project.UpdateProjectResponse
+	(*DeleteProjectResponse)(nil),                   // 43: project.DeleteProjectResponse
+	(*BatchCreateProjectsResponse)(nil),             // 44: project.BatchCreateProjectsResponse
+	(*BatchUpdateProjectsResponse)(nil),             // 45: project.BatchUpdateProjectsResponse
+	(*BatchDeleteProjectsResponse)(nil),             // 46: project.BatchDeleteProjectsResponse
+	(*RestoreProjectResponse)(nil),                  // 47: project.RestoreProjectResponse
+	(*ArchiveProjectResponse)(nil),                  // 48: project.ArchiveProjectResponse
+	(*UnarchiveProjectResponse)(nil),                // 49: project.UnarchiveProjectResponse
+	(*AddMemberResponse)(nil),                       // 50: project.AddMemberResponse
+	(*RemoveMemberResponse)(nil),                    // 51: project.RemoveMemberResponse
+	(*UpdateMemberRoleResponse)(nil),                // 52: project.UpdateMemberRoleResponse
+	(*ListMembersResponse)(nil),                     // 53: project.ListMembersResponse
+	(*CreateInvitationResponse)(nil),                // 54: project.CreateInvitationResponse
+	(*ListInvitationsResponse)(nil),                 // 55: project.ListInvitationsResponse
+	(*AcceptInvitationResponse)(nil),                // 56: project.AcceptInvitationResponse
+	(*RevokeInvitationResponse)(nil),                // 57: project.RevokeInvitationResponse
+	(*TransferProjectOwnershipResponse)(nil),        // 58: project.TransferProjectOwnershipResponse
+	(*AcceptProjectOwnershipTransferResponse)(nil),  // 59: project.AcceptProjectOwnershipTransferResponse
+	(*DeclineProjectOwnershipTransferResponse)(nil), // 60: project.DeclineProjectOwnershipTransferResponse
+	(*ListProjectAuditEventsResponse)(nil),          // 61: project.ListProjectAuditEventsResponse
+	(*ListProjectsResponse)(nil),                    // 62: project.ListProjectsResponse
+	(*StreamProjectsResponse)(nil),                  // 63: project.StreamProjectsResponse
+	(*WatchProjectsResponse)(nil),                   // 64: project.WatchProjectsResponse
+	(*ListProjectSortFieldsResponse)(nil),           // 65: project.ListProjectSortFieldsResponse
+	(*SearchProjectsResponse)(nil),                  // 66: project.SearchProjectsResponse
+	(*ListDeletedProjectsResponse)(nil),             // 67: project.ListDeletedProjectsResponse
+	(*CreateOrganizationResponse)(nil),              // 68: project.CreateOrganizationResponse
+	(*ReadOrganizationResponse)(nil),                // 69: project.ReadOrganizationResponse
+	(*UpdateOrganizationResponse)(nil),              // 70: project.UpdateOrganizationResponse
+	(*DeleteOrganizationResponse)(nil),              // 71: project.DeleteOrganizationResponse
+	(*ListOrganizationsResponse)(nil),               // 72: project.ListOrganizationsResponse
+	(*AddOrganizationMemberResponse)(nil),           // 73: project.AddOrganizationMemberResponse
+	(*RemoveOrganizationMemberResponse)(nil),        // 74: project.RemoveOrganizationMemberResponse
+	(*ListOrganizationMembersResponse)(nil),         // 75: project.ListOrganizationMembersResponse
+	(*CreateFolderResponse)(nil),                    // 76: project.CreateFolderResponse
+	(*ListFoldersResponse)(nil),                     // 77: project.ListFoldersResponse
+	(*DeleteFolderResponse)(nil),                    // 78: project.DeleteFolderResponse
+	(*MoveProjectResponse)(nil),                     // 79: project.MoveProjectResponse
 }
 var file_project_operations_proto_depIdxs = []int32{
-	0, // 0: project.Service.CreateProject:input_type -> project.CreateProjectRequest
-	1, // 1: project.Service.ReadProject:input_type -> project.ReadProjectRequest
-	2, // 2: project.Service.UpdateProject:input_type -> project.UpdateProjectRequest
-	3, // 3: project.Service.DeleteProject:input_type -> project.DeleteProjectRequest
-	4, // 4: project.Service.ListProjects:input_type -> project.ListProjectsRequest
-	5, // 5: project.Service.CreateProject:output_type -> project.CreateProjectResponse
-	6, // 6: project.Service.ReadProject:output_type -> project.ReadProjectResponse
-	7, // 7: project.Service.UpdateProject:output_type -> project.UpdateProjectResponse
-	8, // 8: project.Service.DeleteProject:output_type -> project.DeleteProjectResponse
-	9, // 9: project.Service.ListProjects:output_type -> project.ListProjectsResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: project.Service.CreateProject:input_type -> project.CreateProjectRequest
+	1,  // 1: project.Service.ReadProject:input_type -> project.ReadProjectRequest
+	2,  // 2: project.Service.UpdateProject:input_type -> project.UpdateProjectRequest
+	3,  // 3: project.Service.DeleteProject:input_type -> project.DeleteProjectRequest
+	4,  // 4: project.Service.BatchCreateProjects:input_type -> project.BatchCreateProjectsRequest
+	5,  // 5: project.Service.BatchUpdateProjects:input_type -> project.BatchUpdateProjectsRequest
+	6,  // 6: project.Service.BatchDeleteProjects:input_type -> project.BatchDeleteProjectsRequest
+	7,  // 7: project.Service.RestoreProject:input_type -> project.RestoreProjectRequest
+	8,  // 8: project.Service.ArchiveProject:input_type -> project.ArchiveProjectRequest
+	9,  // 9: project.Service.UnarchiveProject:input_type -> project.UnarchiveProjectRequest
+	10, // 10: project.Service.AddMember:input_type -> project.AddMemberRequest
+	11, // 11: project.Service.RemoveMember:input_type -> project.RemoveMemberRequest
+	12, // 12: project.Service.UpdateMemberRole:input_type -> project.UpdateMemberRoleRequest
+	13, // 13: project.Service.ListMembers:input_type -> project.ListMembersRequest
+	14, // 14: project.Service.CreateInvitation:input_type -> project.CreateInvitationRequest
+	15, // 15: project.Service.ListInvitations:input_type -> project.ListInvitationsRequest
+	16, // 16: project.Service.AcceptInvitation:input_type -> project.AcceptInvitationRequest
+	17, // 17: project.Service.RevokeInvitation:input_type -> project.RevokeInvitationRequest
+	18, // 18: project.Service.TransferProjectOwnership:input_type -> project.TransferProjectOwnershipRequest
+	19, // 19: project.Service.AcceptProjectOwnershipTransfer:input_type -> project.AcceptProjectOwnershipTransferRequest
+	20, // 20: project.Service.DeclineProjectOwnershipTransfer:input_type -> project.DeclineProjectOwnershipTransferRequest
+	21, // 21: project.Service.ListProjectAuditEvents:input_type -> project.ListProjectAuditEventsRequest
+	22, // 22: project.Service.ListProjects:input_type -> project.ListProjectsRequest
+	23, // 23: project.Service.StreamProjects:input_type -> project.StreamProjectsRequest
+	24, // 24: project.Service.WatchProjects:input_type -> project.WatchProjectsRequest
+	25, // 25: project.Service.ListProjectSortFields:input_type -> project.ListProjectSortFieldsRequest
+	26, // 26: project.Service.SearchProjects:input_type -> project.SearchProjectsRequest
+	27, // 27: project.Service.ListDeletedProjects:input_type -> project.ListDeletedProjectsRequest
+	28, // 28: project.Service.CreateOrganization:input_type -> project.CreateOrganizationRequest
+	29, // 29: project.Service.ReadOrganization:input_type -> project.ReadOrganizationRequest
+	30, // 30: project.Service.UpdateOrganization:input_type -> project.UpdateOrganizationRequest
+	31, // 31: project.Service.DeleteOrganization:input_type -> project.DeleteOrganizationRequest
+	32, // 32: project.Service.ListOrganizations:input_type -> project.ListOrganizationsRequest
+	33, // 33: project.Service.AddOrganizationMember:input_type -> project.AddOrganizationMemberRequest
+	34, // 34: project.Service.RemoveOrganizationMember:input_type -> project.RemoveOrganizationMemberRequest
+	35, // 35: project.Service.ListOrganizationMembers:input_type -> project.ListOrganizationMembersRequest
+	36, // 36: project.Service.CreateFolder:input_type -> project.CreateFolderRequest
+	37, // 37: project.Service.ListFolders:input_type -> project.ListFoldersRequest
+	38, // 38: project.Service.DeleteFolder:input_type -> project.DeleteFolderRequest
+	39, // 39: project.Service.MoveProject:input_type -> project.MoveProjectRequest
+	40, // 40: project.Service.CreateProject:output_type -> project.CreateProjectResponse
+	41, // 41: project.Service.ReadProject:output_type -> project.ReadProjectResponse
+	42, // 42: project.Service.UpdateProject:output_type -> project.UpdateProjectResponse
+	43, // 43: project.Service.DeleteProject:output_type -> project.DeleteProjectResponse
+	44, // 44: project.Service.BatchCreateProjects:output_type -> project.BatchCreateProjectsResponse
+	45, // 45: project.Service.BatchUpdateProjects:output_type -> project.BatchUpdateProjectsResponse
+	46, // 46: project.Service.BatchDeleteProjects:output_type -> project.BatchDeleteProjectsResponse
+	47, // 47: project.Service.RestoreProject:output_type -> project.RestoreProjectResponse
+	48, // 48: project.Service.ArchiveProject:output_type -> project.ArchiveProjectResponse
+	49, // 49: project.Service.UnarchiveProject:output_type -> project.UnarchiveProjectResponse
+	50, // 50: project.Service.AddMember:output_type -> project.AddMemberResponse
+	51, // 51: project.Service.RemoveMember:output_type -> project.RemoveMemberResponse
+	52, // 52: project.Service.UpdateMemberRole:output_type -> project.UpdateMemberRoleResponse
+	53, // 53: project.Service.ListMembers:output_type -> project.ListMembersResponse
+	54, // 54: project.Service.CreateInvitation:output_type -> project.CreateInvitationResponse
+	55, // 55: project.Service.ListInvitations:output_type -> project.ListInvitationsResponse
+	56, // 56: project.Service.AcceptInvitation:output_type -> project.AcceptInvitationResponse
+	57, // 57: project.Service.RevokeInvitation:output_type -> project.RevokeInvitationResponse
+	58, // 58: project.Service.TransferProjectOwnership:output_type -> project.TransferProjectOwnershipResponse
+	59, // 59: project.Service.AcceptProjectOwnershipTransfer:output_type -> project.AcceptProjectOwnershipTransferResponse
+	60, // 60: project.Service.DeclineProjectOwnershipTransfer:output_type -> project.DeclineProjectOwnershipTransferResponse
+	61, // 61: project.Service.ListProjectAuditEvents:output_type -> project.ListProjectAuditEventsResponse
+	62, // 62: project.Service.ListProjects:output_type -> project.ListProjectsResponse
+	63, // 63: project.Service.StreamProjects:output_type -> project.StreamProjectsResponse
+	64, // 64: project.Service.WatchProjects:output_type -> project.WatchProjectsResponse
+	65, // 65: project.Service.ListProjectSortFields:output_type -> project.ListProjectSortFieldsResponse
+	66, // 66: project.Service.SearchProjects:output_type -> project.SearchProjectsResponse
+	67, // 67: project.Service.ListDeletedProjects:output_type -> project.ListDeletedProjectsResponse
+	68, // 68: project.Service.CreateOrganization:output_type -> project.CreateOrganizationResponse
+	69, // 69: project.Service.ReadOrganization:output_type -> project.ReadOrganizationResponse
+	70, // 70: project.Service.UpdateOrganization:output_type -> project.UpdateOrganizationResponse
+	71, // 71: project.Service.DeleteOrganization:output_type -> project.DeleteOrganizationResponse
+	72, // 72: project.Service.ListOrganizations:output_type -> project.ListOrganizationsResponse
+	73, // 73: project.Service.AddOrganizationMember:output_type -> project.AddOrganizationMemberResponse
+	74, // 74: project.Service.RemoveOrganizationMember:output_type -> project.RemoveOrganizationMemberResponse
+	75, // 75: project.Service.ListOrganizationMembers:output_type -> project.ListOrganizationMembersResponse
+	76, // 76: project.Service.CreateFolder:output_type -> project.CreateFolderResponse
+	77, // 77: project.Service.ListFolders:output_type -> project.ListFoldersResponse
+	78, // 78: project.Service.DeleteFolder:output_type -> project.DeleteFolderResponse
+	79, // 79: project.Service.MoveProject:output_type -> project.MoveProjectResponse
+	40, // [40:80] is the sub-list for method output_type
+	0,  // [0:40] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_project_operations_proto_init() }
@@ -138,14 +480,175 @@ type ServiceClient interface {
 	// request: The request to update an esiting project
 	// Returns the result of updateing an existing project
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
-	// DeleteProject deletes an exsiting project
+	// DeleteProject deletes an exsiting project. The project is kept as a
+	// deleted project until it is purged, and can be restored until then
 	// request: The request to delete an esiting project
 	// Returns the result of deleting an existing project
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
-	// ListProjects returns the list of projects that matched the criteria
+	// BatchCreateProjects creates new projects at once, up to the maximum batch
+	// size of the service
+	// request: The request to create new projects
+	// Returns the result of creating every project
+	BatchCreateProjects(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error)
+	// BatchUpdateProjects updates existing projects at once, up to the maximum
+	// batch size of the service
+	// request: The request to update existing projects
+	// Returns the result of updating every project
+	BatchUpdateProjects(ctx context.Context, in *BatchUpdateProjectsRequest, opts ...grpc.CallOption) (*BatchUpdateProjectsResponse, error)
+	// BatchDeleteProjects deletes existing projects at once, up to the maximum
+	// batch size of the service
+	// request: The request to delete existing projects
+	// Returns the result of deleting every project
+	BatchDeleteProjects(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*BatchDeleteProjectsResponse, error)
+	// RestoreProject restores a deleted project that is not purged yet
+	// request: The request to restore a deleted project
+	// Returns the result of restoring a deleted project
+	RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error)
+	// ArchiveProject archives an existing project. The archived project is
+	// read-only until it is unarchived
+	// request: The request to archive an existing project
+	// Returns the result of archiving an existing project
+	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error)
+	// UnarchiveProject unarchives an archived project
+	// request: The request to unarchive an archived project
+	// Returns the result of unarchiving an archived project
+	UnarchiveProject(ctx context.Context, in *UnarchiveProjectRequest, opts ...grpc.CallOption) (*UnarchiveProjectResponse, error)
+	// AddMember adds a new member to an existing project
+	// request: The request to add a new member to an existing project
+	// Returns the result of adding a new member to an existing project
+	AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error)
+	// RemoveMember removes an existing member from a project
+	// request: The request to remove an existing member from a project
+	// Returns the result of removing an existing member from a project
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// UpdateMemberRole changes the role of an existing member of a project
+	// request: The request to change the role of an existing member
+	// Returns the result of changing the role of an existing member
+	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error)
+	// ListMembers returns the members of an existing project
+	// request: The request to list the members of an existing project
+	// Returns the members of the project
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// CreateInvitation invites a user to join an existing project by email
+	// request: The request to invite a user to join an existing project
+	// Returns the result of inviting the user
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	// ListInvitations returns the pending invitations of an existing project
+	// request: The request to list the pending invitations of a project
+	// Returns the pending invitations of the project
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	// AcceptInvitation accepts an invitation to join a project
+	// request: The request to accept an invitation
+	// Returns the result of accepting the invitation
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	// RevokeInvitation revokes a pending invitation of an existing project
+	// request: The request to revoke a pending invitation
+	// Returns the result of revoking the invitation
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	// TransferProjectOwnership transfers the ownership of an existing project
+	// to another user, either immediately or once the new owner accepts it
+	// request: The request to transfer the ownership of an existing project
+	// Returns the result of transferring the ownership of an existing project
+	TransferProjectOwnership(ctx context.Context, in *TransferProjectOwnershipRequest, opts ...grpc.CallOption) (*TransferProjectOwnershipResponse, error)
+	// AcceptProjectOwnershipTransfer accepts the pending ownership transfer of
+	// a project
+	// request: The request to accept the pending ownership transfer
+	// Returns the result of accepting the pending ownership transfer
+	AcceptProjectOwnershipTransfer(ctx context.Context, in *AcceptProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptProjectOwnershipTransferResponse, error)
+	// DeclineProjectOwnershipTransfer declines the pending ownership transfer of
+	// a project
+	// request: The request to decline the pending ownership transfer
+	// Returns the result of declining the pending ownership transfer
+	DeclineProjectOwnershipTransfer(ctx context.Context, in *DeclineProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*DeclineProjectOwnershipTransferResponse, error)
+	// ListProjectAuditEvents returns the audit trail of an existing project
+	// request: The request to list the audit trail of an existing project
+	// Returns the audit events of the project
+	ListProjectAuditEvents(ctx context.Context, in *ListProjectAuditEventsRequest, opts ...grpc.CallOption) (*ListProjectAuditEventsResponse, error)
+	// ListProjects returns the list of projects the user is a member of, either
+	// directly or through their organizations, that matched the criteria
 	// request: The request contains the search criteria
 	// Returns the list of projects that matched the criteria
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	// StreamProjects streams all the projects the user is a member of, either
+	// directly or through their organizations, that matched the criteria. The
+	// projects are read from the database as the client receives them
+	// request: The request contains the search criteria
+	// Returns the stream of projects that matched the criteria
+	StreamProjects(ctx context.Context, in *StreamProjectsRequest, opts ...grpc.CallOption) (Service_StreamProjectsClient, error)
+	// WatchProjects streams the changes of the projects the user is a member of,
+	// either directly or through their organizations, as they are made. The
+	// stream can be resumed after the last change the client received. Returns
+	// UNIMPLEMENTED if the database the service is configured with cannot
+	// notify about the changes
+	// request: The request contains the resource version to resume watching from
+	// Returns the stream of changes of the projects
+	WatchProjects(ctx context.Context, in *WatchProjectsRequest, opts ...grpc.CallOption) (Service_WatchProjectsClient, error)
+	// ListProjectSortFields returns the fields the projects can be sorted on. The
+	// sorting options of ListProjects and ListDeletedProjects can only use these
+	// fields
+	// request: The request to list the fields the projects can be sorted on
+	// Returns the fields the projects can be sorted on
+	ListProjectSortFields(ctx context.Context, in *ListProjectSortFieldsRequest, opts ...grpc.CallOption) (*ListProjectSortFieldsResponse, error)
+	// SearchProjects returns the list of projects the user is a member of, either
+	// directly or through their organizations, that contain the words of the
+	// full-text query, the most relevant ones first
+	// request: The request contains the query
+	// Returns the list of projects that matched the query
+	SearchProjects(ctx context.Context, in *SearchProjectsRequest, opts ...grpc.CallOption) (*SearchProjectsResponse, error)
+	// ListDeletedProjects returns the list of deleted projects that are not
+	// purged yet and matched the criteria
+	// request: The request contains the search criteria
+	// Returns the list of deleted projects that matched the criteria
+	ListDeletedProjects(ctx context.Context, in *ListDeletedProjectsRequest, opts ...grpc.CallOption) (*ListDeletedProjectsResponse, error)
+	// CreateOrganization creates a new organization, the user who creates the
+	// organization becomes its owner
+	// request: The request to create a new organization
+	// Returns the result of creating the new organization
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// ReadOrganization reads an existing organization
+	// request: The request to read an existing organization
+	// Returns the result of reading the organization
+	ReadOrganization(ctx context.Context, in *ReadOrganizationRequest, opts ...grpc.CallOption) (*ReadOrganizationResponse, error)
+	// UpdateOrganization updates an existing organization
+	// request: The request to update an existing organization
+	// Returns the result of updating the organization
+	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
+	// DeleteOrganization deletes an existing organization that has no project
+	// request: The request to delete an existing organization
+	// Returns the result of deleting the organization
+	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
+	// ListOrganizations returns the organizations the user is a member of
+	// request: The request to list the organizations of the user
+	// Returns the organizations of the user
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// AddOrganizationMember adds a new member to an existing organization
+	// request: The request to add a new member to an existing organization
+	// Returns the result of adding the new member
+	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
+	// RemoveOrganizationMember removes an existing member from an organization
+	// request: The request to remove an existing member from an organization
+	// Returns the result of removing the member
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
+	// ListOrganizationMembers returns the members of an existing organization
+	// request: The request to list the members of an existing organization
+	// Returns the members of the organization
+	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
+	// CreateFolder creates a new folder to organize the projects of the user
+	// request: The request to create a new folder
+	// Returns the result of creating the new folder
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	// ListFolders returns the folders of the user
+	// request: The request to list the folders of the user
+	// Returns the folders of the user
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
+	// DeleteFolder deletes an existing folder together with its subfolders
+	// request: The request to delete an existing folder
+	// Returns the result of deleting the folder
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	// MoveProject moves an existing project into a folder or out of its folder
+	// request: The request to move an existing project
+	// Returns the result of moving the project
+	MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error)
 }
 
 type serviceClient struct {
@@ -156,185 +659,1448 @@ func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
 	return &serviceClient{cc}
 }
 
-func (c *serviceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
-	out := new(CreateProjectResponse)
-	err := c.cc.Invoke(ctx, "/project.Service/CreateProject", in, out, opts...)
-	if err != nil {
+func (c *serviceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/CreateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error) {
+	out := new(ReadProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ReadProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/UpdateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchCreateProjects(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error) {
+	out := new(BatchCreateProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/BatchCreateProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchUpdateProjects(ctx context.Context, in *BatchUpdateProjectsRequest, opts ...grpc.CallOption) (*BatchUpdateProjectsResponse, error) {
+	out := new(BatchUpdateProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/BatchUpdateProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BatchDeleteProjects(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*BatchDeleteProjectsResponse, error) {
+	out := new(BatchDeleteProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/BatchDeleteProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error) {
+	out := new(RestoreProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/RestoreProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error) {
+	out := new(ArchiveProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ArchiveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UnarchiveProject(ctx context.Context, in *UnarchiveProjectRequest, opts ...grpc.CallOption) (*UnarchiveProjectResponse, error) {
+	out := new(UnarchiveProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/UnarchiveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error) {
+	out := new(AddMemberResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/AddMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/RemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error) {
+	out := new(UpdateMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/UpdateMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TransferProjectOwnership(ctx context.Context, in *TransferProjectOwnershipRequest, opts ...grpc.CallOption) (*TransferProjectOwnershipResponse, error) {
+	out := new(TransferProjectOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/TransferProjectOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AcceptProjectOwnershipTransfer(ctx context.Context, in *AcceptProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*AcceptProjectOwnershipTransferResponse, error) {
+	out := new(AcceptProjectOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/AcceptProjectOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeclineProjectOwnershipTransfer(ctx context.Context, in *DeclineProjectOwnershipTransferRequest, opts ...grpc.CallOption) (*DeclineProjectOwnershipTransferResponse, error) {
+	out := new(DeclineProjectOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/DeclineProjectOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListProjectAuditEvents(ctx context.Context, in *ListProjectAuditEventsRequest, opts ...grpc.CallOption) (*ListProjectAuditEventsResponse, error) {
+	out := new(ListProjectAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListProjectAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StreamProjects(ctx context.Context, in *StreamProjectsRequest, opts ...grpc.CallOption) (Service_StreamProjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/project.Service/StreamProjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceStreamProjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_StreamProjectsClient interface {
+	Recv() (*StreamProjectsResponse, error)
+	grpc.ClientStream
+}
+
+type serviceStreamProjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceStreamProjectsClient) Recv() (*StreamProjectsResponse, error) {
+	m := new(StreamProjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) WatchProjects(ctx context.Context, in *WatchProjectsRequest, opts ...grpc.CallOption) (Service_WatchProjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[1], "/project.Service/WatchProjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceWatchProjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_WatchProjectsClient interface {
+	Recv() (*WatchProjectsResponse, error)
+	grpc.ClientStream
+}
+
+type serviceWatchProjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceWatchProjectsClient) Recv() (*WatchProjectsResponse, error) {
+	m := new(WatchProjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) ListProjectSortFields(ctx context.Context, in *ListProjectSortFieldsRequest, opts ...grpc.CallOption) (*ListProjectSortFieldsResponse, error) {
+	out := new(ListProjectSortFieldsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListProjectSortFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchProjects(ctx context.Context, in *SearchProjectsRequest, opts ...grpc.CallOption) (*SearchProjectsResponse, error) {
+	out := new(SearchProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/SearchProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListDeletedProjects(ctx context.Context, in *ListDeletedProjectsRequest, opts ...grpc.CallOption) (*ListDeletedProjectsResponse, error) {
+	out := new(ListDeletedProjectsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListDeletedProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/CreateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReadOrganization(ctx context.Context, in *ReadOrganizationRequest, opts ...grpc.CallOption) (*ReadOrganizationResponse, error) {
+	out := new(ReadOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ReadOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error) {
+	out := new(UpdateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/UpdateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error) {
+	out := new(DeleteOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/DeleteOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListOrganizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error) {
+	out := new(AddOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/AddOrganizationMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/RemoveOrganizationMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
+	out := new(ListOrganizationMembersResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListOrganizationMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error) {
+	out := new(ListFoldersResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/ListFolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error) {
+	out := new(MoveProjectResponse)
+	err := c.cc.Invoke(ctx, "/project.Service/MoveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// CreateProject creates a new project
+	// request: The request to create a new project
+	// Returns the result of creating new project
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// ReadProject reads an exsiting project
+	// request: The request to read an esiting project
+	// Returns the result of reading an existing project
+	ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error)
+	// UpdateProject updates an exsiting project
+	// request: The request to update an esiting project
+	// Returns the result of updateing an existing project
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	// DeleteProject deletes an exsiting project. The project is kept as a
+	// deleted project until it is purged, and can be restored until then
+	// request: The request to delete an esiting project
+	// Returns the result of deleting an existing project
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// BatchCreateProjects creates new projects at once, up to the maximum batch
+	// size of the service
+	// request: The request to create new projects
+	// Returns the result of creating every project
+	BatchCreateProjects(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+	// BatchUpdateProjects updates existing projects at once, up to the maximum
+	// batch size of the service
+	// request: The request to update existing projects
+	// Returns the result of updating every project
+	BatchUpdateProjects(context.Context, *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error)
+	// BatchDeleteProjects deletes existing projects at once, up to the maximum
+	// batch size of the service
+	// request: The request to delete existing projects
+	// Returns the result of deleting every project
+	BatchDeleteProjects(context.Context, *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error)
+	// RestoreProject restores a deleted project that is not purged yet
+	// request: The request to restore a deleted project
+	// Returns the result of restoring a deleted project
+	RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error)
+	// ArchiveProject archives an existing project. The archived project is
+	// read-only until it is unarchived
+	// request: The request to archive an existing project
+	// Returns the result of archiving an existing project
+	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	// UnarchiveProject unarchives an archived project
+	// request: The request to unarchive an archived project
+	// Returns the result of unarchiving an archived project
+	UnarchiveProject(context.Context, *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error)
+	// AddMember adds a new member to an existing project
+	// request: The request to add a new member to an existing project
+	// Returns the result of adding a new member to an existing project
+	AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error)
+	// RemoveMember removes an existing member from a project
+	// request: The request to remove an existing member from a project
+	// Returns the result of removing an existing member from a project
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// UpdateMemberRole changes the role of an existing member of a project
+	// request: The request to change the role of an existing member
+	// Returns the result of changing the role of an existing member
+	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
+	// ListMembers returns the members of an existing project
+	// request: The request to list the members of an existing project
+	// Returns the members of the project
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// CreateInvitation invites a user to join an existing project by email
+	// request: The request to invite a user to join an existing project
+	// Returns the result of inviting the user
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	// ListInvitations returns the pending invitations of an existing project
+	// request: The request to list the pending invitations of a project
+	// Returns the pending invitations of the project
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// AcceptInvitation accepts an invitation to join a project
+	// request: The request to accept an invitation
+	// Returns the result of accepting the invitation
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	// RevokeInvitation revokes a pending invitation of an existing project
+	// request: The request to revoke a pending invitation
+	// Returns the result of revoking the invitation
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	// TransferProjectOwnership transfers the ownership of an existing project
+	// to another user, either immediately or once the new owner accepts it
+	// request: The request to transfer the ownership of an existing project
+	// Returns the result of transferring the ownership of an existing project
+	TransferProjectOwnership(context.Context, *TransferProjectOwnershipRequest) (*TransferProjectOwnershipResponse, error)
+	// AcceptProjectOwnershipTransfer accepts the pending ownership transfer of
+	// a project
+	// request: The request to accept the pending ownership transfer
+	// Returns the result of accepting the pending ownership transfer
+	AcceptProjectOwnershipTransfer(context.Context, *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error)
+	// DeclineProjectOwnershipTransfer declines the pending ownership transfer of
+	// a project
+	// request: The request to decline the pending ownership transfer
+	// Returns the result of declining the pending ownership transfer
+	DeclineProjectOwnershipTransfer(context.Context, *DeclineProjectOwnershipTransferRequest) (*DeclineProjectOwnershipTransferResponse, error)
+	// ListProjectAuditEvents returns the audit trail of an existing project
+	// request: The request to list the audit trail of an existing project
+	// Returns the audit events of the project
+	ListProjectAuditEvents(context.Context, *ListProjectAuditEventsRequest) (*ListProjectAuditEventsResponse, error)
+	// ListProjects returns the list of projects the user is a member of, either
+	// directly or through their organizations, that matched the criteria
+	// request: The request contains the search criteria
+	// Returns the list of projects that matched the criteria
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	// StreamProjects streams all the projects the user is a member of, either
+	// directly or through their organizations, that matched the criteria. The
+	// projects are read from the database as the client receives them
+	// request: The request contains the search criteria
+	// Returns the stream of projects that matched the criteria
+	StreamProjects(*StreamProjectsRequest, Service_StreamProjectsServer) error
+	// WatchProjects streams the changes of the projects the user is a member of,
+	// either directly or through their organizations, as they are made. The
+	// stream can be resumed after the last change the client received. Returns
+	// UNIMPLEMENTED if the database the service is configured with cannot
+	// notify about the changes
+	// request: The request contains the resource version to resume watching from
+	// Returns the stream of changes of the projects
+	WatchProjects(*WatchProjectsRequest, Service_WatchProjectsServer) error
+	// ListProjectSortFields returns the fields the projects can be sorted on. The
+	// sorting options of ListProjects and ListDeletedProjects can only use these
+	// fields
+	// request: The request to list the fields the projects can be sorted on
+	// Returns the fields the projects can be sorted on
+	ListProjectSortFields(context.Context, *ListProjectSortFieldsRequest) (*ListProjectSortFieldsResponse, error)
+	// SearchProjects returns the list of projects the user is a member of, either
+	// directly or through their organizations, that contain the words of the
+	// full-text query, the most relevant ones first
+	// request: The request contains the query
+	// Returns the list of projects that matched the query
+	SearchProjects(context.Context, *SearchProjectsRequest) (*SearchProjectsResponse, error)
+	// ListDeletedProjects returns the list of deleted projects that are not
+	// purged yet and matched the criteria
+	// request: The request contains the search criteria
+	// Returns the list of deleted projects that matched the criteria
+	ListDeletedProjects(context.Context, *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error)
+	// CreateOrganization creates a new organization, the user who creates the
+	// organization becomes its owner
+	// request: The request to create a new organization
+	// Returns the result of creating the new organization
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// ReadOrganization reads an existing organization
+	// request: The request to read an existing organization
+	// Returns the result of reading the organization
+	ReadOrganization(context.Context, *ReadOrganizationRequest) (*ReadOrganizationResponse, error)
+	// UpdateOrganization updates an existing organization
+	// request: The request to update an existing organization
+	// Returns the result of updating the organization
+	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+	// DeleteOrganization deletes an existing organization that has no project
+	// request: The request to delete an existing organization
+	// Returns the result of deleting the organization
+	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+	// ListOrganizations returns the organizations the user is a member of
+	// request: The request to list the organizations of the user
+	// Returns the organizations of the user
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// AddOrganizationMember adds a new member to an existing organization
+	// request: The request to add a new member to an existing organization
+	// Returns the result of adding the new member
+	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
+	// RemoveOrganizationMember removes an existing member from an organization
+	// request: The request to remove an existing member from an organization
+	// Returns the result of removing the member
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	// ListOrganizationMembers returns the members of an existing organization
+	// request: The request to list the members of an existing organization
+	// Returns the members of the organization
+	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	// CreateFolder creates a new folder to organize the projects of the user
+	// request: The request to create a new folder
+	// Returns the result of creating the new folder
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	// ListFolders returns the folders of the user
+	// request: The request to list the folders of the user
+	// Returns the folders of the user
+	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
+	// DeleteFolder deletes an existing folder together with its subfolders
+	// request: The request to delete an existing folder
+	// Returns the result of deleting the folder
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	// MoveProject moves an existing project into a folder or out of its folder
+	// request: The request to move an existing project
+	// Returns the result of moving the project
+	MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (*UnimplementedServiceServer) ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadProject not implemented")
+}
+func (*UnimplementedServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (*UnimplementedServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (*UnimplementedServiceServer) BatchCreateProjects(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateProjects not implemented")
+}
+func (*UnimplementedServiceServer) BatchUpdateProjects(context.Context, *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateProjects not implemented")
+}
+func (*UnimplementedServiceServer) BatchDeleteProjects(context.Context, *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteProjects not implemented")
+}
+func (*UnimplementedServiceServer) RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (*UnimplementedServiceServer) ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProject not implemented")
+}
+func (*UnimplementedServiceServer) UnarchiveProject(context.Context, *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveProject not implemented")
+}
+func (*UnimplementedServiceServer) AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (*UnimplementedServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (*UnimplementedServiceServer) UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (*UnimplementedServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (*UnimplementedServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (*UnimplementedServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (*UnimplementedServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (*UnimplementedServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (*UnimplementedServiceServer) TransferProjectOwnership(context.Context, *TransferProjectOwnershipRequest) (*TransferProjectOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferProjectOwnership not implemented")
+}
+func (*UnimplementedServiceServer) AcceptProjectOwnershipTransfer(context.Context, *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptProjectOwnershipTransfer not implemented")
+}
+func (*UnimplementedServiceServer) DeclineProjectOwnershipTransfer(context.Context, *DeclineProjectOwnershipTransferRequest) (*DeclineProjectOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineProjectOwnershipTransfer not implemented")
+}
+func (*UnimplementedServiceServer) ListProjectAuditEvents(context.Context, *ListProjectAuditEventsRequest) (*ListProjectAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectAuditEvents not implemented")
+}
+func (*UnimplementedServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (*UnimplementedServiceServer) StreamProjects(*StreamProjectsRequest, Service_StreamProjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProjects not implemented")
+}
+func (*UnimplementedServiceServer) WatchProjects(*WatchProjectsRequest, Service_WatchProjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProjects not implemented")
+}
+func (*UnimplementedServiceServer) ListProjectSortFields(context.Context, *ListProjectSortFieldsRequest) (*ListProjectSortFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectSortFields not implemented")
+}
+func (*UnimplementedServiceServer) SearchProjects(context.Context, *SearchProjectsRequest) (*SearchProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProjects not implemented")
+}
+func (*UnimplementedServiceServer) ListDeletedProjects(context.Context, *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedProjects not implemented")
+}
+func (*UnimplementedServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (*UnimplementedServiceServer) ReadOrganization(context.Context, *ReadOrganizationRequest) (*ReadOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadOrganization not implemented")
+}
+func (*UnimplementedServiceServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
+}
+func (*UnimplementedServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
+}
+func (*UnimplementedServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (*UnimplementedServiceServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationMember not implemented")
+}
+func (*UnimplementedServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (*UnimplementedServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
+}
+func (*UnimplementedServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (*UnimplementedServiceServer) ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (*UnimplementedServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (*UnimplementedServiceServer) MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveProject not implemented")
+}
+
+func RegisterServiceServer(s *grpc.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ReadProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ReadProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ReadProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ReadProject(ctx, req.(*ReadProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/UpdateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchCreateProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchCreateProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/BatchCreateProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchCreateProjects(ctx, req.(*BatchCreateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchUpdateProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchUpdateProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/BatchUpdateProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchUpdateProjects(ctx, req.(*BatchUpdateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BatchDeleteProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BatchDeleteProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/BatchDeleteProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BatchDeleteProjects(ctx, req.(*BatchDeleteProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/RestoreProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RestoreProject(ctx, req.(*RestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ArchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ArchiveProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ArchiveProject(ctx, req.(*ArchiveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UnarchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UnarchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/UnarchiveProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UnarchiveProject(ctx, req.(*UnarchiveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/AddMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AddMember(ctx, req.(*AddMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/UpdateMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateMemberRole(ctx, req.(*UpdateMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TransferProjectOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferProjectOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TransferProjectOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/TransferProjectOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TransferProjectOwnership(ctx, req.(*TransferProjectOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcceptProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcceptProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/AcceptProjectOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcceptProjectOwnershipTransfer(ctx, req.(*AcceptProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeclineProjectOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineProjectOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeclineProjectOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/DeclineProjectOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeclineProjectOwnershipTransfer(ctx, req.(*DeclineProjectOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListProjectAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListProjectAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListProjectAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListProjectAuditEvents(ctx, req.(*ListProjectAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StreamProjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).StreamProjects(m, &serviceStreamProjectsServer{stream})
+}
+
+type Service_StreamProjectsServer interface {
+	Send(*StreamProjectsResponse) error
+	grpc.ServerStream
+}
+
+type serviceStreamProjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceStreamProjectsServer) Send(m *StreamProjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_WatchProjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).WatchProjects(m, &serviceWatchProjectsServer{stream})
+}
+
+type Service_WatchProjectsServer interface {
+	Send(*WatchProjectsResponse) error
+	grpc.ServerStream
+}
+
+type serviceWatchProjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceWatchProjectsServer) Send(m *WatchProjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_ListProjectSortFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectSortFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListProjectSortFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListProjectSortFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListProjectSortFields(ctx, req.(*ListProjectSortFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProjectsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/SearchProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchProjects(ctx, req.(*SearchProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serviceClient) ReadProject(ctx context.Context, in *ReadProjectRequest, opts ...grpc.CallOption) (*ReadProjectResponse, error) {
-	out := new(ReadProjectResponse)
-	err := c.cc.Invoke(ctx, "/project.Service/ReadProject", in, out, opts...)
-	if err != nil {
+func _Service_ListDeletedProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedProjectsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServiceServer).ListDeletedProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListDeletedProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListDeletedProjects(ctx, req.(*ListDeletedProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serviceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
-	out := new(UpdateProjectResponse)
-	err := c.cc.Invoke(ctx, "/project.Service/UpdateProject", in, out, opts...)
-	if err != nil {
+func _Service_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serviceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
-	out := new(DeleteProjectResponse)
-	err := c.cc.Invoke(ctx, "/project.Service/DeleteProject", in, out, opts...)
-	if err != nil {
+func _Service_ReadOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadOrganizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServiceServer).ReadOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ReadOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ReadOrganization(ctx, req.(*ReadOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serviceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
-	out := new(ListProjectsResponse)
-	err := c.cc.Invoke(ctx, "/project.Service/ListProjects", in, out, opts...)
-	if err != nil {
+func _Service_UpdateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/UpdateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateOrganization(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ServiceServer is the server API for Service service.
-type ServiceServer interface {
-	// CreateProject creates a new project
-	// request: The request to create a new project
-	// Returns the result of creating new project
-	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
-	// ReadProject reads an exsiting project
-	// request: The request to read an esiting project
-	// Returns the result of reading an existing project
-	ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error)
-	// UpdateProject updates an exsiting project
-	// request: The request to update an esiting project
-	// Returns the result of updateing an existing project
-	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
-	// DeleteProject deletes an exsiting project
-	// request: The request to delete an esiting project
-	// Returns the result of deleting an existing project
-	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
-	// ListProjects returns the list of projects that matched the criteria
-	// request: The request contains the search criteria
-	// Returns the list of projects that matched the criteria
-	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+func _Service_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/DeleteOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
+func _Service_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/ListOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
-}
-func (*UnimplementedServiceServer) ReadProject(context.Context, *ReadProjectRequest) (*ReadProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadProject not implemented")
-}
-func (*UnimplementedServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
-}
-func (*UnimplementedServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
-}
-func (*UnimplementedServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+func _Service_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AddOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/AddOrganizationMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterServiceServer(s *grpc.Server, srv ServiceServer) {
-	s.RegisterService(&_Service_serviceDesc, srv)
+func _Service_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.Service/RemoveOrganizationMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _Service_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).CreateProject(ctx, in)
+		return srv.(ServiceServer).ListOrganizationMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.Service/CreateProject",
+		FullMethod: "/project.Service/ListOrganizationMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(ServiceServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ReadProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadProjectRequest)
+func _Service_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ReadProject(ctx, in)
+		return srv.(ServiceServer).CreateFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.Service/ReadProject",
+		FullMethod: "/project.Service/CreateFolder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ReadProject(ctx, req.(*ReadProjectRequest))
+		return srv.(ServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _Service_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).UpdateProject(ctx, in)
+		return srv.(ServiceServer).ListFolders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.Service/UpdateProject",
+		FullMethod: "/project.Service/ListFolders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(ServiceServer).ListFolders(ctx, req.(*ListFoldersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _Service_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).DeleteProject(ctx, in)
+		return srv.(ServiceServer).DeleteFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.Service/DeleteProject",
+		FullMethod: "/project.Service/DeleteFolder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(ServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsRequest)
+func _Service_MoveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ListProjects(ctx, in)
+		return srv.(ServiceServer).MoveProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/project.Service/ListProjects",
+		FullMethod: "/project.Service/MoveProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+		return srv.(ServiceServer).MoveProject(ctx, req.(*MoveProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -359,11 +2125,154 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Service_DeleteProject_Handler,
 		},
+		{
+			MethodName: "BatchCreateProjects",
+			Handler:    _Service_BatchCreateProjects_Handler,
+		},
+		{
+			MethodName: "BatchUpdateProjects",
+			Handler:    _Service_BatchUpdateProjects_Handler,
+		},
+		{
+			MethodName: "BatchDeleteProjects",
+			Handler:    _Service_BatchDeleteProjects_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _Service_RestoreProject_Handler,
+		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _Service_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "UnarchiveProject",
+			Handler:    _Service_UnarchiveProject_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _Service_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _Service_RemoveMember_Handler,
+		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _Service_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _Service_ListMembers_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _Service_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _Service_ListInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _Service_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _Service_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "TransferProjectOwnership",
+			Handler:    _Service_TransferProjectOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptProjectOwnershipTransfer",
+			Handler:    _Service_AcceptProjectOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineProjectOwnershipTransfer",
+			Handler:    _Service_DeclineProjectOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "ListProjectAuditEvents",
+			Handler:    _Service_ListProjectAuditEvents_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _Service_ListProjects_Handler,
 		},
+		{
+			MethodName: "ListProjectSortFields",
+			Handler:    _Service_ListProjectSortFields_Handler,
+		},
+		{
+			MethodName: "SearchProjects",
+			Handler:    _Service_SearchProjects_Handler,
+		},
+		{
+			MethodName: "ListDeletedProjects",
+			Handler:    _Service_ListDeletedProjects_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _Service_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ReadOrganization",
+			Handler:    _Service_ReadOrganization_Handler,
+		},
+		{
+			MethodName: "UpdateOrganization",
+			Handler:    _Service_UpdateOrganization_Handler,
+		},
+		{
+			MethodName: "DeleteOrganization",
+			Handler:    _Service_DeleteOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _Service_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "AddOrganizationMember",
+			Handler:    _Service_AddOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _Service_RemoveOrganizationMember_Handler,
+		},
+		{
+			MethodName: "ListOrganizationMembers",
+			Handler:    _Service_ListOrganizationMembers_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Service_CreateFolder_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _Service_ListFolders_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _Service_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "MoveProject",
+			Handler:    _Service_MoveProject_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProjects",
+			Handler:       _Service_StreamProjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchProjects",
+			Handler:       _Service_WatchProjects_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "project-operations.proto",
 }
@@ -60,3 +60,10 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Create the name of the secret the cursor signing key is stored in
+*/}}
+{{- define "project.cursorSecretName" -}}
+{{- printf "%s-cursor" (include "project.fullname" .) | trunc 63 | trimSuffix "-" }}
+{{- end }}
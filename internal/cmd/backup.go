@@ -0,0 +1,34 @@
+// Package cmd implements different commands that can be executed against project service
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/decentralized-cloud/project/pkg/util"
+	gocoreUtil "github.com/micro-business/go-core/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func newBackupCommand() *cobra.Command {
+	var address, output string
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up the data file of a running Project service that uses the bolt database type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.BackupDatabase(address, output); err != nil {
+				return err
+			}
+
+			gocoreUtil.PrintInfo(fmt.Sprintf("Backup stored in %s\n", output))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&address, "address", "http://localhost:82", "The address of the admin HTTP endpoints of the running Project service")
+	cmd.Flags().StringVar(&output, "output", "", "The path to the file to store the backup in")
+	_ = cmd.MarkFlagRequired("output")
+
+	return cmd
+}
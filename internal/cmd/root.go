@@ -19,6 +19,7 @@ func NewRootCommand() *cobra.Command {
 	// Register all commands
 	cmd.AddCommand(
 		newStartCommand(),
+		newBackupCommand(),
 		newVersionCommand(),
 	)
 
@@ -0,0 +1,404 @@
+// Package models defines the different object models used in Project
+package models
+
+import "errors"
+
+// ConflictError indicates the project was changed since the version an operation is based on
+type ConflictError struct {
+	Err error
+}
+
+// Error returns message for the ConflictError error type
+// Returns the error message
+func (e ConflictError) Error() string {
+	if e.Err == nil {
+		return "Conflict."
+	}
+
+	return "Conflict. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewConflictErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// IsConflictError indicates whether the error is of type ConflictError
+// Returns true if the error is of type ConflictError, otherwise returns false
+func IsConflictError(err error) bool {
+	var conflictError ConflictError
+
+	return errors.As(err, &conflictError)
+}
+
+// NewConflictError creates a new ConflictError error
+// Returns the new error
+func NewConflictError() error {
+	return ConflictError{}
+}
+
+// NewConflictErrorWithError creates a new ConflictError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewConflictErrorWithError(err error) error {
+	return ConflictError{
+		Err: err,
+	}
+}
+
+// ProjectArchivedError indicates the project is archived and cannot be changed until it is unarchived
+type ProjectArchivedError struct {
+	Err error
+}
+
+// Error returns message for the ProjectArchivedError error type
+// Returns the error message
+func (e ProjectArchivedError) Error() string {
+	if e.Err == nil {
+		return "Project is archived."
+	}
+
+	return "Project is archived. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewProjectArchivedErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e ProjectArchivedError) Unwrap() error {
+	return e.Err
+}
+
+// IsProjectArchivedError indicates whether the error is of type ProjectArchivedError
+// Returns true if the error is of type ProjectArchivedError, otherwise returns false
+func IsProjectArchivedError(err error) bool {
+	var projectArchivedError ProjectArchivedError
+
+	return errors.As(err, &projectArchivedError)
+}
+
+// NewProjectArchivedError creates a new ProjectArchivedError error
+// Returns the new error
+func NewProjectArchivedError() error {
+	return ProjectArchivedError{}
+}
+
+// NewProjectArchivedErrorWithError creates a new ProjectArchivedError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewProjectArchivedErrorWithError(err error) error {
+	return ProjectArchivedError{
+		Err: err,
+	}
+}
+
+// PermissionDeniedError indicates the role of the user in the project does not allow the requested operation
+type PermissionDeniedError struct {
+	Err error
+}
+
+// Error returns message for the PermissionDeniedError error type
+// Returns the error message
+func (e PermissionDeniedError) Error() string {
+	if e.Err == nil {
+		return "Permission denied."
+	}
+
+	return "Permission denied. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewPermissionDeniedErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e PermissionDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// IsPermissionDeniedError indicates whether the error is of type PermissionDeniedError
+// Returns true if the error is of type PermissionDeniedError, otherwise returns false
+func IsPermissionDeniedError(err error) bool {
+	var permissionDeniedError PermissionDeniedError
+
+	return errors.As(err, &permissionDeniedError)
+}
+
+// NewPermissionDeniedError creates a new PermissionDeniedError error
+// Returns the new error
+func NewPermissionDeniedError() error {
+	return PermissionDeniedError{}
+}
+
+// NewPermissionDeniedErrorWithError creates a new PermissionDeniedError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewPermissionDeniedErrorWithError(err error) error {
+	return PermissionDeniedError{
+		Err: err,
+	}
+}
+
+// OrganizationNotEmptyError indicates the organization still has projects and cannot be deleted until they
+// are purged
+type OrganizationNotEmptyError struct {
+	Err error
+}
+
+// Error returns message for the OrganizationNotEmptyError error type
+// Returns the error message
+func (e OrganizationNotEmptyError) Error() string {
+	if e.Err == nil {
+		return "Organization is not empty."
+	}
+
+	return "Organization is not empty. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewOrganizationNotEmptyErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e OrganizationNotEmptyError) Unwrap() error {
+	return e.Err
+}
+
+// IsOrganizationNotEmptyError indicates whether the error is of type OrganizationNotEmptyError
+// Returns true if the error is of type OrganizationNotEmptyError, otherwise returns false
+func IsOrganizationNotEmptyError(err error) bool {
+	var organizationNotEmptyError OrganizationNotEmptyError
+
+	return errors.As(err, &organizationNotEmptyError)
+}
+
+// NewOrganizationNotEmptyError creates a new OrganizationNotEmptyError error
+// Returns the new error
+func NewOrganizationNotEmptyError() error {
+	return OrganizationNotEmptyError{}
+}
+
+// NewOrganizationNotEmptyErrorWithError creates a new OrganizationNotEmptyError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewOrganizationNotEmptyErrorWithError(err error) error {
+	return OrganizationNotEmptyError{
+		Err: err,
+	}
+}
+
+// FolderNotEmptyError indicates the folder or any of its subfolders still has projects and cannot be deleted
+// without deleting the projects too
+type FolderNotEmptyError struct {
+	Err error
+}
+
+// Error returns message for the FolderNotEmptyError error type
+// Returns the error message
+func (e FolderNotEmptyError) Error() string {
+	if e.Err == nil {
+		return "Folder is not empty."
+	}
+
+	return "Folder is not empty. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewFolderNotEmptyErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e FolderNotEmptyError) Unwrap() error {
+	return e.Err
+}
+
+// IsFolderNotEmptyError indicates whether the error is of type FolderNotEmptyError
+// Returns true if the error is of type FolderNotEmptyError, otherwise returns false
+func IsFolderNotEmptyError(err error) bool {
+	var folderNotEmptyError FolderNotEmptyError
+
+	return errors.As(err, &folderNotEmptyError)
+}
+
+// NewFolderNotEmptyError creates a new FolderNotEmptyError error
+// Returns the new error
+func NewFolderNotEmptyError() error {
+	return FolderNotEmptyError{}
+}
+
+// NewFolderNotEmptyErrorWithError creates a new FolderNotEmptyError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewFolderNotEmptyErrorWithError(err error) error {
+	return FolderNotEmptyError{
+		Err: err,
+	}
+}
+
+// BatchAbortedError indicates an item of an all-or-nothing batch was not applied because another item of the
+// batch failed
+type BatchAbortedError struct {
+	Err error
+}
+
+// Error returns message for the BatchAbortedError error type
+// Returns the error message
+func (e BatchAbortedError) Error() string {
+	if e.Err == nil {
+		return "Batch is aborted."
+	}
+
+	return "Batch is aborted. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewBatchAbortedErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e BatchAbortedError) Unwrap() error {
+	return e.Err
+}
+
+// IsBatchAbortedError indicates whether the error is of type BatchAbortedError
+// Returns true if the error is of type BatchAbortedError, otherwise returns false
+func IsBatchAbortedError(err error) bool {
+	var batchAbortedError BatchAbortedError
+
+	return errors.As(err, &batchAbortedError)
+}
+
+// NewBatchAbortedError creates a new BatchAbortedError error
+// Returns the new error
+func NewBatchAbortedError() error {
+	return BatchAbortedError{}
+}
+
+// NewBatchAbortedErrorWithError creates a new BatchAbortedError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewBatchAbortedErrorWithError(err error) error {
+	return BatchAbortedError{
+		Err: err,
+	}
+}
+
+// IdempotencyKeyReusedError indicates the idempotency key was already used by a request with a different payload
+type IdempotencyKeyReusedError struct {
+	Err error
+}
+
+// Error returns message for the IdempotencyKeyReusedError error type
+// Returns the error message
+func (e IdempotencyKeyReusedError) Error() string {
+	if e.Err == nil {
+		return "Idempotency key is already used by a different request."
+	}
+
+	return "Idempotency key is already used by a different request. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewIdempotencyKeyReusedErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e IdempotencyKeyReusedError) Unwrap() error {
+	return e.Err
+}
+
+// IsIdempotencyKeyReusedError indicates whether the error is of type IdempotencyKeyReusedError
+// Returns true if the error is of type IdempotencyKeyReusedError, otherwise returns false
+func IsIdempotencyKeyReusedError(err error) bool {
+	var idempotencyKeyReusedError IdempotencyKeyReusedError
+
+	return errors.As(err, &idempotencyKeyReusedError)
+}
+
+// NewIdempotencyKeyReusedError creates a new IdempotencyKeyReusedError error
+// Returns the new error
+func NewIdempotencyKeyReusedError() error {
+	return IdempotencyKeyReusedError{}
+}
+
+// NewIdempotencyKeyReusedErrorWithError creates a new IdempotencyKeyReusedError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewIdempotencyKeyReusedErrorWithError(err error) error {
+	return IdempotencyKeyReusedError{
+		Err: err,
+	}
+}
+
+// ResourceVersionExpiredError indicates the changes after the resource version are no longer kept, so watching the
+// projects cannot be resumed from it
+type ResourceVersionExpiredError struct {
+	Err error
+}
+
+// Error returns message for the ResourceVersionExpiredError error type
+// Returns the error message
+func (e ResourceVersionExpiredError) Error() string {
+	if e.Err == nil {
+		return "Resource version is expired."
+	}
+
+	return "Resource version is expired. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewResourceVersionExpiredErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e ResourceVersionExpiredError) Unwrap() error {
+	return e.Err
+}
+
+// IsResourceVersionExpiredError indicates whether the error is of type ResourceVersionExpiredError
+// Returns true if the error is of type ResourceVersionExpiredError, otherwise returns false
+func IsResourceVersionExpiredError(err error) bool {
+	var resourceVersionExpiredError ResourceVersionExpiredError
+
+	return errors.As(err, &resourceVersionExpiredError)
+}
+
+// NewResourceVersionExpiredError creates a new ResourceVersionExpiredError error
+// Returns the new error
+func NewResourceVersionExpiredError() error {
+	return ResourceVersionExpiredError{}
+}
+
+// NewResourceVersionExpiredErrorWithError creates a new ResourceVersionExpiredError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewResourceVersionExpiredErrorWithError(err error) error {
+	return ResourceVersionExpiredError{
+		Err: err,
+	}
+}
+
+// UnimplementedError indicates the operation is not supported by the configured repository service
+type UnimplementedError struct {
+	Err error
+}
+
+// Error returns message for the UnimplementedError error type
+// Returns the error message
+func (e UnimplementedError) Error() string {
+	if e.Err == nil {
+		return "Operation is not implemented."
+	}
+
+	return "Operation is not implemented. Error: " + e.Err.Error()
+}
+
+// Unwrap returns the err if provided through NewUnimplementedErrorWithError function, otherwise returns nil
+// Returns the wrapped error
+func (e UnimplementedError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnimplementedError indicates whether the error is of type UnimplementedError
+// Returns true if the error is of type UnimplementedError, otherwise returns false
+func IsUnimplementedError(err error) bool {
+	var unimplementedError UnimplementedError
+
+	return errors.As(err, &unimplementedError)
+}
+
+// NewUnimplementedError creates a new UnimplementedError error
+// Returns the new error
+func NewUnimplementedError() error {
+	return UnimplementedError{}
+}
+
+// NewUnimplementedErrorWithError creates a new UnimplementedError error that wraps the given error
+// err: Mandatory. The error to wrap
+// Returns the new error
+func NewUnimplementedErrorWithError(err error) error {
+	return UnimplementedError{
+		Err: err,
+	}
+}
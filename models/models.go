@@ -1,6 +1,8 @@
 // Package models defines the different object models used in Project
 package models
 
+import "time"
+
 type contextKey string
 
 func (c contextKey) String() string {
@@ -17,9 +19,323 @@ type ParsedToken struct {
 	Email string
 }
 
-// Project defines the project object
+// ProjectState defines the different states of a project
+type ProjectState int
+
+const (
+	// ProjectStateActive indicates the project is active and can be changed
+	ProjectStateActive ProjectState = iota
+
+	// ProjectStateArchived indicates the project is archived and is read-only until it is unarchived
+	ProjectStateArchived
+)
+
+// Project defines the project object. CreatedAt, UpdatedAt, CreatedBy, Version, DeletedAt and State are
+// managed by the repository services, the values provided by the users are ignored. DeletedAt is only set
+// for the deleted projects that are not purged yet. OrganizationID is the organization the project belongs
+// to, it is empty for the projects that do not belong to any organization and it can only be set when the
+// project is created. FolderID is the folder of the user who moved the project into it, it is empty for the
+// projects that are not in any folder and it can only be changed by moving the project.
 type Project struct {
-	Name string `bson:"name" json:"name"`
+	Name           string            `bson:"name" json:"name"`
+	Description    string            `bson:"description" json:"description"`
+	Labels         map[string]string `bson:"labels" json:"labels"`
+	Annotations    map[string]string `bson:"annotations" json:"annotations"`
+	CreatedAt      time.Time         `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time         `bson:"updatedAt" json:"updatedAt"`
+	CreatedBy      string            `bson:"createdBy" json:"createdBy"`
+	Version        int64             `bson:"version" json:"version"`
+	DeletedAt      time.Time         `bson:"deletedAt" json:"deletedAt"`
+	State          ProjectState      `bson:"state" json:"state"`
+	OrganizationID string            `bson:"organizationID,omitempty" json:"organizationID,omitempty"`
+	FolderID       string            `bson:"folderID,omitempty" json:"folderID,omitempty"`
+}
+
+// FilterOperator defines how the nested filters of a filter group are combined
+type FilterOperator int
+
+const (
+	// FilterOperatorAnd indicates a project must match all the nested filters
+	FilterOperatorAnd FilterOperator = iota
+
+	// FilterOperatorOr indicates a project must match at least one of the nested filters
+	FilterOperatorOr
+)
+
+// NameOperator defines how the project names are compared with the value of a name condition. The names are
+// compared case-sensitively.
+type NameOperator int
+
+const (
+	// NameOperatorEquals indicates the project name must be the same as the value
+	NameOperatorEquals NameOperator = iota
+
+	// NameOperatorPrefix indicates the project name must start with the value
+	NameOperatorPrefix
+
+	// NameOperatorContains indicates the project name must contain the value
+	NameOperatorContains
+)
+
+// LabelOperator defines how the labels of the projects are compared with a label selector
+type LabelOperator int
+
+const (
+	// LabelOperatorIn indicates the project must have the label with one of the values
+	LabelOperatorIn LabelOperator = iota
+
+	// LabelOperatorNotIn indicates the project must either not have the label or have it with a value that is
+	// not one of the values
+	LabelOperatorNotIn
+
+	// LabelOperatorExists indicates the project must have the label, regardless of its value
+	LabelOperatorExists
+
+	// LabelOperatorDoesNotExist indicates the project must not have the label
+	LabelOperatorDoesNotExist
+)
+
+// ProjectFilter defines a filter expression the projects are matched against. A filter is either a group
+// that combines its nested Filters with Operator, or a single condition, in which case exactly one of Name,
+// Label, CreatedAt, UpdatedAt and State is set.
+type ProjectFilter struct {
+	Operator  FilterOperator
+	Filters   []ProjectFilter
+	Name      *NameCondition
+	Label     *LabelSelector
+	CreatedAt *TimeRange
+	UpdatedAt *TimeRange
+	State     *ProjectState
+}
+
+// NameCondition defines a condition on the project names
+type NameCondition struct {
+	Operator NameOperator
+	Value    string
+}
+
+// LabelSelector defines a condition on the labels of the projects. Values must have at least one value for
+// the In and NotIn operators and no value for the Exists and DoesNotExist operators.
+type LabelSelector struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// TimeRange defines the range of times from From, inclusive, to To, exclusive. A zero bound leaves the range
+// open on that side.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Contains indicates whether the time is in the range
+// value: Mandatory. The time to check
+// Returns true if the time is in the range, otherwise returns false
+func (timeRange TimeRange) Contains(value time.Time) bool {
+	return (timeRange.From.IsZero() || !value.Before(timeRange.From)) &&
+		(timeRange.To.IsZero() || value.Before(timeRange.To))
+}
+
+// Organization defines the organization object that groups the projects of a team. CreatedAt, UpdatedAt and
+// CreatedBy are managed by the repository services, the values provided by the users are ignored.
+type Organization struct {
+	Name        string    `bson:"name" json:"name"`
+	Description string    `bson:"description" json:"description"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt" json:"updatedAt"`
+	CreatedBy   string    `bson:"createdBy" json:"createdBy"`
+}
+
+// OrganizationWithID implements the pair of the organization with its unique identifier
+type OrganizationWithID struct {
+	OrganizationID string
+	Organization   Organization
+}
+
+// Folder defines the folder object that groups the projects of a user. Folders are private to the user who
+// creates them and can be nested, ParentFolderID is empty for the folders at the root. CreatedAt is managed by
+// the repository services, the value provided by the users is ignored.
+type Folder struct {
+	Name           string    `bson:"name" json:"name"`
+	ParentFolderID string    `bson:"parentFolderID,omitempty" json:"parentFolderID,omitempty"`
+	CreatedAt      time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+// FolderWithID implements the pair of the folder with its unique identifier
+type FolderWithID struct {
+	FolderID string
+	Folder   Folder
+}
+
+// MemberRole defines the roles the members of a project or an organization can have. The roles are ordered,
+// every role is granted the permissions of the roles that come before it. The members of an organization are
+// granted their role in all the projects of the organization, on top of their own role in the projects.
+type MemberRole int
+
+const (
+	// MemberRoleViewer indicates the member can read the project
+	MemberRoleViewer MemberRole = iota
+
+	// MemberRoleEditor indicates the member can also update, archive and unarchive the project
+	MemberRoleEditor
+
+	// MemberRoleOwner indicates the member can also delete and restore the project and manage its members.
+	// Every project has exactly one owner, and every organization has exactly one owner, the user who
+	// created it.
+	MemberRoleOwner
+)
+
+// Member defines a user who has access to a project or an organization and the role of the user in it
+type Member struct {
+	UserEmail string     `bson:"userEmail" json:"userEmail"`
+	Role      MemberRole `bson:"role" json:"role"`
+}
+
+// Invitation defines a pending invitation of a user to join a project with the provided role. The invitation
+// is accepted with the token that is delivered to the invited user, only the hash of the token is stored.
+// The invitation cannot be accepted once it is expired.
+type Invitation struct {
+	ProjectID string     `bson:"projectID" json:"projectID"`
+	Email     string     `bson:"email" json:"email"`
+	Role      MemberRole `bson:"role" json:"role"`
+	InvitedBy string     `bson:"invitedBy" json:"invitedBy"`
+	CreatedAt time.Time  `bson:"createdAt" json:"createdAt"`
+	ExpiresAt time.Time  `bson:"expiresAt" json:"expiresAt"`
+}
+
+// IsExpired indicates whether the invitation is expired at the provided time
+// now: Mandatory. The time to check the expiry of the invitation at
+// Returns true if the invitation is expired, otherwise returns false
+func (invitation Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(invitation.ExpiresAt)
+}
+
+// OwnershipTransfer defines a pending transfer of the ownership of a project that waits for the new owner
+// to accept or decline it. The transfer cannot be accepted once it is expired.
+type OwnershipTransfer struct {
+	FromUserEmail string    `bson:"fromUserEmail" json:"fromUserEmail"`
+	ToUserEmail   string    `bson:"toUserEmail" json:"toUserEmail"`
+	RequestedAt   time.Time `bson:"requestedAt" json:"requestedAt"`
+	ExpiresAt     time.Time `bson:"expiresAt" json:"expiresAt"`
+}
+
+// IsExpired indicates whether the transfer is expired at the provided time
+// now: Mandatory. The time to check the expiry of the transfer at
+// Returns true if the transfer is expired, otherwise returns false
+func (transfer OwnershipTransfer) IsExpired(now time.Time) bool {
+	return !now.Before(transfer.ExpiresAt)
+}
+
+// AuditAction defines the different actions recorded in the audit trail of a project
+type AuditAction int
+
+const (
+	// AuditActionOwnershipTransferRequested indicates the owner requested to transfer the ownership of the project
+	AuditActionOwnershipTransferRequested AuditAction = iota
+
+	// AuditActionOwnershipTransferAccepted indicates the new owner accepted the ownership transfer
+	AuditActionOwnershipTransferAccepted
+
+	// AuditActionOwnershipTransferDeclined indicates the pending ownership transfer was declined by the new owner
+	// or cancelled by the owner
+	AuditActionOwnershipTransferDeclined
+
+	// AuditActionOwnershipTransferred indicates the owner transferred the ownership of the project without
+	// waiting for the new owner to accept it
+	AuditActionOwnershipTransferred
+)
+
+// AuditEvent defines an action recorded in the audit trail of a project. UserEmail is the user who took the
+// action and TargetUserEmail is the user the action was taken on, if any.
+type AuditEvent struct {
+	Action          AuditAction `bson:"action" json:"action"`
+	UserEmail       string      `bson:"userEmail" json:"userEmail"`
+	TargetUserEmail string      `bson:"targetUserEmail" json:"targetUserEmail"`
+	OccurredAt      time.Time   `bson:"occurredAt" json:"occurredAt"`
+}
+
+// ProjectSortField defines a field the projects can be sorted on
+type ProjectSortField struct {
+	Name        string
+	Description string
+}
+
+// ProjectSortFields is the catalogue of the fields the projects can be sorted on. Every repository service maps
+// each of them to a field of its storage, the projects cannot be sorted on any other field.
+var ProjectSortFields = []ProjectSortField{
+	{Name: "_id", Description: "The unique project identifier, which follows the order the projects were created in"},
+	{Name: "name", Description: "The project name"},
+	{Name: "createdAt", Description: "The time the project was created"},
+	{Name: "updatedAt", Description: "The time the project was last updated"},
+}
+
+// IsProjectSortField indicates whether the projects can be sorted on the field
+// name: Mandatory. The name of the field
+// Returns true if the field is in the catalogue of the sortable fields, otherwise returns false
+func IsProjectSortField(name string) bool {
+	for _, field := range ProjectSortFields {
+		if field.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	// ProjectNamePath is the path of the project name in the update masks
+	ProjectNamePath = "name"
+
+	// ProjectDescriptionPath is the path of the project description in the update masks
+	ProjectDescriptionPath = "description"
+
+	// ProjectLabelsPath is the path of the project labels in the update masks
+	ProjectLabelsPath = "labels"
+
+	// ProjectAnnotationsPath is the path of the project annotations in the update masks
+	ProjectAnnotationsPath = "annotations"
+)
+
+// ProjectUpdatePaths is the catalogue of the paths of the project fields the users can update. An update mask
+// can only list these paths, the other fields are managed by the service.
+var ProjectUpdatePaths = []string{
+	ProjectNamePath,
+	ProjectDescriptionPath,
+	ProjectLabelsPath,
+	ProjectAnnotationsPath,
+}
+
+// IsProjectUpdatePath indicates whether the users can update the project field the path refers to
+// path: Mandatory. The path of the field
+// Returns true if the path is in the catalogue of the updatable fields, otherwise returns false
+func IsProjectUpdatePath(path string) bool {
+	for _, updatePath := range ProjectUpdatePaths {
+		if updatePath == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpdateMaskContains indicates whether the update mask applies the field the path refers to. An empty update
+// mask applies all the fields of the ProjectUpdatePaths catalogue.
+// updateMask: Optional. The paths of the fields to update
+// path: Mandatory. The path of the field
+// Returns true if the field must be updated, otherwise returns false
+func UpdateMaskContains(updateMask []string, path string) bool {
+	if len(updateMask) == 0 {
+		return true
+	}
+
+	for _, maskPath := range updateMask {
+		if maskPath == path {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ProjectWithCursor implements the pair of the project with a cursor that determines the
@@ -29,3 +345,97 @@ type ProjectWithCursor struct {
 	Project   Project
 	Cursor    string
 }
+
+// TextRange defines a range of characters in a text, Start is inclusive and End is exclusive. The positions
+// are counted in characters (runes), not in bytes.
+type TextRange struct {
+	Start int
+	End   int
+}
+
+// Highlight defines the ranges of a field of the project that matched the search terms. Field is either
+// name or description.
+type Highlight struct {
+	Field  string
+	Ranges []TextRange
+}
+
+// ProjectSearchResult implements the project that matched a search query together with a cursor that
+// determines its location in the search results, its relevance score and the parts of it that matched
+type ProjectSearchResult struct {
+	ProjectID  string
+	Project    Project
+	Cursor     string
+	Score      float64
+	Highlights []Highlight
+}
+
+// ProjectEventType defines the different changes of the projects the watchers are notified about. The types are
+// relative to the watcher, e.g. a project the watcher is given access to is added for the watcher, even though
+// the project already existed.
+type ProjectEventType int
+
+const (
+	// ProjectEventTypeAdded indicates the project became visible to the user, it was either created, restored
+	// or shared with the user
+	ProjectEventTypeAdded ProjectEventType = iota
+
+	// ProjectEventTypeModified indicates the project the user can see was changed
+	ProjectEventTypeModified
+
+	// ProjectEventTypeDeleted indicates the project is no longer visible to the user, it was either deleted or
+	// the user lost access to it
+	ProjectEventTypeDeleted
+)
+
+// ProjectEvent defines a change of a project the user can see. Project is the project after the change, or the
+// last version the user could see if the project is deleted. ResourceVersion identifies the position of the
+// event in the changes of the projects, watching the projects can be resumed after the event from it.
+type ProjectEvent struct {
+	Type            ProjectEventType
+	ProjectID       string
+	Project         Project
+	ResourceVersion string
+}
+
+// DomainEventType defines the different changes of the projects that are published to the downstream services
+type DomainEventType string
+
+const (
+	// DomainEventTypeProjectCreated indicates a new project was created
+	DomainEventTypeProjectCreated DomainEventType = "ProjectCreated"
+
+	// DomainEventTypeProjectUpdated indicates an existing project was updated
+	DomainEventTypeProjectUpdated DomainEventType = "ProjectUpdated"
+
+	// DomainEventTypeProjectDeleted indicates an existing project was deleted
+	DomainEventTypeProjectDeleted DomainEventType = "ProjectDeleted"
+
+	// DomainEventTypeProjectRestored indicates a deleted project was restored
+	DomainEventTypeProjectRestored DomainEventType = "ProjectRestored"
+
+	// DomainEventTypeProjectArchived indicates an existing project was archived
+	DomainEventTypeProjectArchived DomainEventType = "ProjectArchived"
+
+	// DomainEventTypeProjectUnarchived indicates an archived project was made active again
+	DomainEventTypeProjectUnarchived DomainEventType = "ProjectUnarchived"
+
+	// DomainEventTypeProjectMoved indicates an existing project was moved into a folder or out of its folder
+	DomainEventTypeProjectMoved DomainEventType = "ProjectMoved"
+
+	// DomainEventTypeProjectOwnershipTransferred indicates the ownership of an existing project was transferred
+	// to another user
+	DomainEventTypeProjectOwnershipTransferred DomainEventType = "ProjectOwnershipTransferred"
+)
+
+// DomainEvent defines a change of a project that is written to the outbox in the same write as the change
+// itself, and published to the downstream services once the change is stored. The events are delivered at
+// least once, the downstream services use EventID to drop the duplicates. ProjectID is managed by the
+// repository services, the value provided by the users is ignored.
+type DomainEvent struct {
+	EventID    string          `bson:"eventID" json:"eventID"`
+	Type       DomainEventType `bson:"type" json:"type"`
+	ProjectID  string          `bson:"projectID" json:"projectID"`
+	UserEmail  string          `bson:"userEmail" json:"userEmail"`
+	OccurredAt time.Time       `bson:"occurredAt" json:"occurredAt"`
+}
@@ -2,14 +2,307 @@
 package models
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+
 	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/micro-business/go-core/common"
+)
+
+const (
+	maxDescriptionLength   = 1024
+	maxLabelKeyLength      = 63
+	maxLabelValueLength    = 63
+	maxAnnotationKeyLength = 253
+	maxAnnotationsSize     = 256 * 1024
+	maxFilterDepth         = 5
+	maxFilterConditions    = 50
+	maxLabelSelectorValues = 50
 )
 
+// labelPattern matches the label keys and the non-empty label values. They must start and end with an
+// alphanumeric character and can only contain alphanumeric characters, '-', '_' and '.' in between.
+var labelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`)
+
 // Validate validates the Project and return error if the validation failes
 // Returns error if validation failes
 func (val Project) Validate() error {
+	return val.ValidatePaths(nil)
+}
+
+// ValidatePaths validates the fields of the Project the update mask applies and return error if the validation
+// failes. The fields the update mask does not list are left untouched by the update, so they are not validated.
+// updateMask: Optional. The paths of the fields to validate, all the fields are validated if it is empty
+// Returns error if validation failes
+func (val Project) ValidatePaths(updateMask []string) error {
+	fields := []*validation.FieldRules{}
+
+	if UpdateMaskContains(updateMask, ProjectNamePath) {
+		// Name cannot be empty
+		fields = append(fields, validation.Field(&val.Name, validation.Required))
+	}
+
+	if UpdateMaskContains(updateMask, ProjectDescriptionPath) {
+		// Description is optional, but cannot be too long
+		fields = append(fields, validation.Field(&val.Description, validation.RuneLength(0, maxDescriptionLength)))
+	}
+
+	if UpdateMaskContains(updateMask, ProjectLabelsPath) {
+		// Labels keys and values must be short and only contain the allowed characters
+		fields = append(fields, validation.Field(&val.Labels, validation.By(validateLabels)))
+	}
+
+	if UpdateMaskContains(updateMask, ProjectAnnotationsPath) {
+		// Annotations keys cannot be empty or too long, and the annotations cannot be too big
+		fields = append(fields, validation.Field(&val.Annotations, validation.By(validateAnnotations)))
+	}
+
+	return validation.ValidateStruct(&val, fields...)
+}
+
+// Validate validates the Organization and return error if the validation failes
+// Returns error if validation failes
+func (val Organization) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Name cannot be empty
+		validation.Field(&val.Name, validation.Required),
+		// Description is optional, but cannot be too long
+		validation.Field(&val.Description, validation.RuneLength(0, maxDescriptionLength)),
+	)
+}
+
+// Validate validates the Folder and return error if the validation failes
+// Returns error if validation failes
+func (val Folder) Validate() error {
 	return validation.ValidateStruct(&val,
 		// Name cannot be empty
 		validation.Field(&val.Name, validation.Required),
 	)
 }
+
+// Validate validates the ProjectFilter and return error if the validation failes. The filters cannot be nested
+// too deep or have too many conditions, so a single request cannot make the repository services evaluate an
+// arbitrarily large expression.
+// Returns error if validation failes
+func (val ProjectFilter) Validate() error {
+	conditions := 0
+
+	return validateFilter(val, 1, &conditions)
+}
+
+// Validate validates the NameCondition and return error if the validation failes
+// Returns error if validation failes
+func (val NameCondition) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Operator must be one of the supported operators
+		validation.Field(&val.Operator, validation.In(NameOperatorEquals, NameOperatorPrefix, NameOperatorContains)),
+		// Value cannot be empty
+		validation.Field(&val.Value, validation.Required),
+	)
+}
+
+// Validate validates the LabelSelector and return error if the validation failes
+// Returns error if validation failes
+func (val LabelSelector) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Key must be a valid label key
+		validation.Field(&val.Key, validation.Required, validation.By(validateLabelKey)),
+		// Operator must be one of the supported operators
+		validation.Field(
+			&val.Operator,
+			validation.In(LabelOperatorIn, LabelOperatorNotIn, LabelOperatorExists, LabelOperatorDoesNotExist)),
+		// Values must match the operator
+		validation.Field(&val.Values, validation.By(func(value interface{}) error {
+			return validateLabelSelectorValues(val.Operator, val.Values)
+		})),
+	)
+}
+
+// Validate validates the TimeRange and return error if the validation failes
+// Returns error if validation failes
+func (val TimeRange) Validate() error {
+	if val.From.IsZero() && val.To.IsZero() {
+		return errors.New("time range must have at least one bound")
+	}
+
+	if !val.From.IsZero() && !val.To.IsZero() && !val.From.Before(val.To) {
+		return errors.New("time range must start before it ends")
+	}
+
+	return nil
+}
+
+// ValidateSortingOptions validates the options the projects are sorted by and return error if the validation
+// failes. Every option must sort on a field of the ProjectSortFields catalogue, and a field cannot be used twice.
+// value: Mandatory. The []common.SortingOptionPair to validate
+// Returns error if validation failes
+func ValidateSortingOptions(value interface{}) error {
+	sortingOptions, _ := value.([]common.SortingOptionPair)
+	fields := map[string]bool{}
+
+	for _, sortingOption := range sortingOptions {
+		if !IsProjectSortField(sortingOption.Name) {
+			return fmt.Errorf("projects cannot be sorted on %q", sortingOption.Name)
+		}
+
+		if fields[sortingOption.Name] {
+			return fmt.Errorf("projects cannot be sorted on %q more than once", sortingOption.Name)
+		}
+
+		if sortingOption.Direction != common.Ascending && sortingOption.Direction != common.Descending {
+			return fmt.Errorf("sorting direction of %q must be either ascending or descending", sortingOption.Name)
+		}
+
+		fields[sortingOption.Name] = true
+	}
+
+	return nil
+}
+
+// ValidateUpdateMask validates the paths of the project fields to update and return error if the validation
+// failes. Every path must be in the ProjectUpdatePaths catalogue, and a path cannot be listed twice.
+// value: Mandatory. The []string to validate
+// Returns error if validation failes
+func ValidateUpdateMask(value interface{}) error {
+	updateMask, _ := value.([]string)
+	paths := map[string]bool{}
+
+	for _, path := range updateMask {
+		if !IsProjectUpdatePath(path) {
+			return fmt.Errorf("project field %q cannot be updated", path)
+		}
+
+		if paths[path] {
+			return fmt.Errorf("project field %q cannot be updated more than once", path)
+		}
+
+		paths[path] = true
+	}
+
+	return nil
+}
+
+func validateFilter(filter ProjectFilter, depth int, conditions *int) error {
+	if depth > maxFilterDepth {
+		return fmt.Errorf("filters cannot be nested more than %d levels deep", maxFilterDepth)
+	}
+
+	count := 0
+	for _, isSet := range []bool{
+		filter.Name != nil,
+		filter.Label != nil,
+		filter.CreatedAt != nil,
+		filter.UpdatedAt != nil,
+		filter.State != nil,
+	} {
+		if isSet {
+			count++
+		}
+	}
+
+	if len(filter.Filters) > 0 {
+		if count > 0 {
+			return errors.New("filter cannot have both nested filters and a condition")
+		}
+
+		if err := validation.Validate(filter.Operator, validation.In(FilterOperatorAnd, FilterOperatorOr)); err != nil {
+			return fmt.Errorf("filter operator: %w", err)
+		}
+
+		for _, nestedFilter := range filter.Filters {
+			if err := validateFilter(nestedFilter, depth+1, conditions); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if count != 1 {
+		return errors.New("filter must have either nested filters or exactly one condition")
+	}
+
+	if *conditions++; *conditions > maxFilterConditions {
+		return fmt.Errorf("filter cannot have more than %d conditions", maxFilterConditions)
+	}
+
+	return validation.ValidateStruct(&filter,
+		// Validate the conditions using their own validation rules
+		validation.Field(&filter.Name),
+		validation.Field(&filter.Label),
+		validation.Field(&filter.CreatedAt),
+		validation.Field(&filter.UpdatedAt),
+		// State must be one of the project states
+		validation.Field(&filter.State, validation.In(ProjectStateActive, ProjectStateArchived)),
+	)
+}
+
+func validateLabelSelectorValues(operator LabelOperator, values []string) error {
+	switch operator {
+	case LabelOperatorIn, LabelOperatorNotIn:
+		if len(values) == 0 || len(values) > maxLabelSelectorValues {
+			return fmt.Errorf("must have between 1 and %d values", maxLabelSelectorValues)
+		}
+	default:
+		if len(values) > 0 {
+			return errors.New("must be empty for the existence checks")
+		}
+	}
+
+	for _, value := range values {
+		if value != "" && (len(value) > maxLabelValueLength || !labelPattern.MatchString(value)) {
+			return fmt.Errorf("value %q is not a valid label value", value)
+		}
+	}
+
+	return nil
+}
+
+func validateLabelKey(value interface{}) error {
+	key, _ := value.(string)
+	if len(key) > maxLabelKeyLength || !labelPattern.MatchString(key) {
+		return fmt.Errorf("label key %q must be at most %d characters, start and end with an alphanumeric character and only contain alphanumeric characters, '-', '_' and '.'", key, maxLabelKeyLength)
+	}
+
+	return nil
+}
+
+func validateLabels(value interface{}) error {
+	labels, _ := value.(map[string]string)
+
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+
+		if value == "" {
+			continue
+		}
+
+		if len(value) > maxLabelValueLength || !labelPattern.MatchString(value) {
+			return fmt.Errorf("value of the label %q must be at most %d characters, start and end with an alphanumeric character and only contain alphanumeric characters, '-', '_' and '.'", key, maxLabelValueLength)
+		}
+	}
+
+	return nil
+}
+
+func validateAnnotations(value interface{}) error {
+	annotations, _ := value.(map[string]string)
+	size := 0
+
+	for key, value := range annotations {
+		if key == "" || len(key) > maxAnnotationKeyLength {
+			return fmt.Errorf("annotation key %q must be between 1 and %d characters", key, maxAnnotationKeyLength)
+		}
+
+		size += len(key) + len(value)
+	}
+
+	if size > maxAnnotationsSize {
+		return fmt.Errorf("annotations must be at most %d bytes in total", maxAnnotationsSize)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// BackupDatabase downloads a consistent snapshot of the data file from a running project service and
+// stores it in the output file. The snapshot is verified before it replaces the output file, so a failed
+// backup never overwrites a previous good one.
+// address: Mandatory. The address of the admin HTTP endpoints of the running project service, e.g. http://localhost:82
+// outputFilePath: Mandatory. The path to the file to store the backup in
+// Returns error if something goes wrong
+func BackupDatabase(address, outputFilePath string) error {
+	if strings.Trim(address, " ") == "" {
+		return commonErrors.NewArgumentNilError("address", "address is required")
+	}
+
+	if strings.Trim(outputFilePath, " ") == "" {
+		return commonErrors.NewArgumentNilError("outputFilePath", "outputFilePath is required")
+	}
+
+	response, err := http.Get(strings.TrimRight(address, "/") + "/backup")
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to request the backup", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode == http.StatusNotFound {
+		return commonErrors.NewUnknownError("the repository service does not support backups, only the bolt database type does")
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return commonErrors.NewUnknownError(fmt.Sprintf("failed to back up the data file, the service responded with %s", response.Status))
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(outputFilePath), filepath.Base(outputFilePath)+".*.tmp")
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the temporary backup file", err)
+	}
+
+	defer func() {
+		_ = os.Remove(tempFile.Name())
+	}()
+
+	if _, err = io.Copy(tempFile, response.Body); err != nil {
+		_ = tempFile.Close()
+
+		return commonErrors.NewUnknownErrorWithError("failed to download the backup", err)
+	}
+
+	if err = tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+
+		return commonErrors.NewUnknownErrorWithError("failed to flush the backup to the disk", err)
+	}
+
+	if err = tempFile.Close(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the backup file", err)
+	}
+
+	if err = verifyDataFile(tempFile.Name()); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tempFile.Name(), outputFilePath); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to move the backup to the output file", err)
+	}
+
+	return nil
+}
+
+// verifyDataFile makes sure the file is a complete and consistent bbolt data file
+func verifyDataFile(filePath string) error {
+	db, err := bbolt.Open(filePath, 0600, &bbolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("the backup is not a valid data file", err)
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	return db.View(func(tx *bbolt.Tx) error {
+		for err := range tx.Check() {
+			return commonErrors.NewUnknownErrorWithError("the backup is corrupted", err)
+		}
+
+		return nil
+	})
+}
@@ -2,6 +2,8 @@
 package util
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -9,14 +11,32 @@ import (
 	"github.com/decentralized-cloud/project/services/business"
 	"github.com/decentralized-cloud/project/services/configuration"
 	"github.com/decentralized-cloud/project/services/endpoint"
+	"github.com/decentralized-cloud/project/services/notifier"
+	fileNotifier "github.com/decentralized-cloud/project/services/notifier/file"
+	logNotifier "github.com/decentralized-cloud/project/services/notifier/log"
+	"github.com/decentralized-cloud/project/services/publisher"
+	kafkaPublisher "github.com/decentralized-cloud/project/services/publisher/kafka"
+	memoryPublisher "github.com/decentralized-cloud/project/services/publisher/memory"
+	natsPublisher "github.com/decentralized-cloud/project/services/publisher/nats"
+	"github.com/decentralized-cloud/project/services/purger"
+	"github.com/decentralized-cloud/project/services/relay"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/bolt"
+	"github.com/decentralized-cloud/project/services/repository/memory"
 	"github.com/decentralized-cloud/project/services/repository/mongodb"
+	"github.com/decentralized-cloud/project/services/repository/postgres"
 	"github.com/decentralized-cloud/project/services/transport/grpc"
 	"github.com/decentralized-cloud/project/services/transport/https"
 	"github.com/micro-business/go-core/gokit/middleware"
+	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 )
 
 var configurationService configuration.ConfigurationContract
+var repositoryService repository.RepositoryContract
+var notifierService notifier.NotifierContract
+var eventPublisherService publisher.EventPublisherContract
+var businessService business.BusinessContract
 var endpointCreatorService endpoint.EndpointCreatorContract
 var middlewareProviderService middleware.MiddlewareProviderContract
 
@@ -47,11 +67,29 @@ func StartService() {
 
 	httpsTansportService, err := https.NewTransportService(
 		logger,
-		configurationService)
+		configurationService,
+		repositoryService)
 	if err != nil {
 		logger.Fatal("failed to create HTTPS transport service", zap.Error(err))
 	}
 
+	purgerService, err := purger.NewPurgerService(
+		logger,
+		configurationService,
+		businessService)
+	if err != nil {
+		logger.Fatal("failed to create purger service", zap.Error(err))
+	}
+
+	relayService, err := relay.NewRelayService(
+		logger,
+		configurationService,
+		repositoryService,
+		eventPublisherService)
+	if err != nil {
+		logger.Fatal("failed to create relay service", zap.Error(err))
+	}
+
 	signalChan := make(chan os.Signal, 1)
 	cleanupDone := make(chan struct{})
 	signal.Notify(signalChan, os.Interrupt)
@@ -68,6 +106,18 @@ func StartService() {
 		}
 	}()
 
+	go func() {
+		if serviceErr := purgerService.Start(); serviceErr != nil {
+			logger.Fatal("failed to start purger service", zap.Error(serviceErr))
+		}
+	}()
+
+	go func() {
+		if serviceErr := relayService.Start(); serviceErr != nil {
+			logger.Fatal("failed to start relay service", zap.Error(serviceErr))
+		}
+	}()
+
 	go func() {
 		<-signalChan
 		logger.Info("Received an interrupt, stopping services...")
@@ -80,6 +130,24 @@ func StartService() {
 			logger.Error("failed to stop HTTPS transport service", zap.Error(err))
 		}
 
+		// The purger and the relay services are stopped before the publisher and the repository services are
+		// closed, as stopping them waits for their calls in progress to return
+		if err := purgerService.Stop(); err != nil {
+			logger.Error("failed to stop purger service", zap.Error(err))
+		}
+
+		if err := relayService.Stop(); err != nil {
+			logger.Error("failed to stop relay service", zap.Error(err))
+		}
+
+		if err := eventPublisherService.Close(); err != nil {
+			logger.Error("failed to close event publisher service", zap.Error(err))
+		}
+
+		if err := repositoryService.Close(); err != nil {
+			logger.Error("failed to close repository service", zap.Error(err))
+		}
+
 		close(cleanupDone)
 	}()
 	<-cleanupDone
@@ -94,14 +162,27 @@ func setupDependencies(logger *zap.Logger) (err error) {
 		return
 	}
 
-	repositoryService, err := mongodb.NewMongodbRepositoryService(configurationService)
-	if err != nil {
+	if repositoryService, err = setupRepositoryService(); err != nil {
 		return
 	}
 
-	businessService, err := business.NewBusinessService(repositoryService)
-	if err != nil {
-		return err
+	// The migrations run at startup, so they are not bound to the deadline of the first request
+	if migrationService, ok := repositoryService.(repository.MigrationContract); ok {
+		if err = migrationService.Migrate(context.Background()); err != nil {
+			return
+		}
+	}
+
+	if notifierService, err = setupNotifierService(logger); err != nil {
+		return
+	}
+
+	if eventPublisherService, err = setupEventPublisherService(); err != nil {
+		return
+	}
+
+	if businessService, err = business.NewBusinessService(repositoryService, configurationService, notifierService); err != nil {
+		return
 	}
 
 	if endpointCreatorService, err = endpoint.NewEndpointCreatorService(businessService); err != nil {
@@ -110,3 +191,82 @@ func setupDependencies(logger *zap.Logger) (err error) {
 
 	return
 }
+
+func setupRepositoryService() (repository.RepositoryContract, error) {
+	databaseType, err := configurationService.GetDatabaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	switch databaseType {
+	case configuration.MongoDB:
+		return mongodb.NewMongodbRepositoryService(configurationService)
+	case configuration.Postgres:
+		return postgres.NewPostgresRepositoryService(configurationService)
+	case configuration.Bolt:
+		return bolt.NewBoltRepositoryService(configurationService)
+	case configuration.Memory:
+		return memory.NewMemoryRepositoryService()
+	default:
+		return nil, commonErrors.NewUnknownError(fmt.Sprintf("database type %s is not supported", databaseType))
+	}
+}
+
+func setupNotifierService(logger *zap.Logger) (notifier.NotifierContract, error) {
+	notifierType, err := configurationService.GetNotifierType()
+	if err != nil {
+		return nil, err
+	}
+
+	switch notifierType {
+	case configuration.LogNotifier:
+		return logNotifier.NewLogNotifierService(logger)
+	case configuration.FileNotifier:
+		filePath, err := configurationService.GetNotifierFilePath()
+		if err != nil {
+			return nil, err
+		}
+
+		return fileNotifier.NewFileNotifierService(filePath)
+	default:
+		return nil, commonErrors.NewUnknownError(fmt.Sprintf("notifier type %s is not supported", notifierType))
+	}
+}
+
+func setupEventPublisherService() (publisher.EventPublisherContract, error) {
+	eventPublisherType, err := configurationService.GetEventPublisherType()
+	if err != nil {
+		return nil, err
+	}
+
+	switch eventPublisherType {
+	case configuration.NatsEventPublisher:
+		natsURL, err := configurationService.GetNatsURL()
+		if err != nil {
+			return nil, err
+		}
+
+		subject, err := configurationService.GetNatsSubject()
+		if err != nil {
+			return nil, err
+		}
+
+		return natsPublisher.NewNatsEventPublisherService(natsURL, subject)
+	case configuration.KafkaEventPublisher:
+		brokers, err := configurationService.GetKafkaBrokers()
+		if err != nil {
+			return nil, err
+		}
+
+		topic, err := configurationService.GetKafkaTopic()
+		if err != nil {
+			return nil, err
+		}
+
+		return kafkaPublisher.NewKafkaEventPublisherService(brokers, topic)
+	case configuration.MemoryEventPublisher:
+		return memoryPublisher.NewMemoryEventPublisherService()
+	default:
+		return nil, commonErrors.NewUnknownError(fmt.Sprintf("event publisher type %s is not supported", eventPublisherType))
+	}
+}
@@ -24,3 +24,5 @@ docker cp extract-mock-builder:/src/services/transport/mock/mock-contract.go ./s
 docker cp extract-mock-builder:/src/services/business/mock/mock-contract.go ./services/business/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/configuration/mock/mock-contract.go ./services/configuration/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/endpoint/mock/mock-contract.go ./services/endpoint/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/notifier/mock/mock-contract.go ./services/notifier/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/publisher/mock/mock-contract.go ./services/publisher/mock/mock-contract.go
@@ -0,0 +1,75 @@
+package business
+
+import (
+	"fmt"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	validation "github.com/go-ozzo/ozzo-validation"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// verifyBatchSize verifies the batch does not contain more projects than the configured maximum
+// size: Mandatory. The number of the projects of the batch
+// Returns ArgumentError if the batch is too big
+func (service *businessService) verifyBatchSize(size int) error {
+	if size > service.maxBatchSize {
+		return commonErrors.NewArgumentError(
+			"projects",
+			fmt.Sprintf("batch cannot contain more than %d projects", service.maxBatchSize))
+	}
+
+	return nil
+}
+
+// validateBatchItem validates one of the projects of a batch, so the invalid projects get their own results
+// rather than failing the whole batch
+// idx: Mandatory. The position of the project in the batch
+// request: Mandatory. The request the project is applied with
+// Returns ArgumentError if the validation failes
+func validateBatchItem(idx int, request validation.Validatable) error {
+	if err := request.Validate(); err != nil {
+		return commonErrors.NewArgumentErrorWithError(fmt.Sprintf("projects[%d]", idx), "", err)
+	}
+
+	return nil
+}
+
+// abortBatch marks the projects of an all-or-nothing batch that did not fail as aborted, if any of the
+// projects failed
+// errs: Mandatory. The errors of the projects of the batch, nil for the projects that did not fail
+// Returns true if the batch is aborted
+func abortBatch(errs []error) bool {
+	aborted := false
+	for _, err := range errs {
+		aborted = aborted || err != nil
+	}
+
+	if aborted {
+		for idx := range errs {
+			if errs[idx] == nil {
+				errs[idx] = models.NewBatchAbortedError()
+			}
+		}
+	}
+
+	return aborted
+}
+
+// abortBatchItem returns the errors of the projects of an all-or-nothing batch the repository service rolled
+// back because one of the projects failed
+// count: Mandatory. The number of the projects of the batch
+// err: Mandatory. The error returned by the repository service
+// Returns the errors of the projects or nil if the batch failed as a whole
+func abortBatchItem(count int, err error) []error {
+	batchItemError, ok := repository.AsBatchItemError(err)
+	if !ok || batchItemError.Index < 0 || batchItemError.Index >= count {
+		return nil
+	}
+
+	errs := make([]error, count)
+	errs[batchItemError.Index] = batchItemError.Err
+	abortBatch(errs)
+
+	return errs
+}
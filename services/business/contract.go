@@ -22,7 +22,7 @@ type BusinessContract interface {
 		ctx context.Context,
 		request *ReadProjectRequest) (*ReadProjectResponse, error)
 
-	// UpdateProject update an existing project
+	// UpdateProject update an existing project. The archived projects cannot be updated.
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request to update an existing project
 	// Returns either the result of updateing an existing project or error if something goes wrong.
@@ -30,7 +30,8 @@ type BusinessContract interface {
 		ctx context.Context,
 		request *UpdateProjectRequest) (*UpdateProjectResponse, error)
 
-	// DeleteProject delete an existing project
+	// DeleteProject delete an existing project. The project is only marked as deleted and can be restored
+	// until it is purged.
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request to delete an existing project
 	// Returns either the result of deleting an existing project or error if something goes wrong.
@@ -38,6 +39,66 @@ type BusinessContract interface {
 		ctx context.Context,
 		request *DeleteProjectRequest) (*DeleteProjectResponse, error)
 
+	// BatchCreateProjects creates new projects at once. Every project gets its own result, and if AllOrNothing
+	// is requested, either all the projects are created or none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create new projects
+	// Returns either the results of creating the projects or error if something goes wrong.
+	BatchCreateProjects(
+		ctx context.Context,
+		request *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+
+	// BatchUpdateProjects updates existing projects at once. Every project gets its own result, and if
+	// AllOrNothing is requested, either all the projects are updated or none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to update existing projects
+	// Returns either the results of updating the projects or error if something goes wrong.
+	BatchUpdateProjects(
+		ctx context.Context,
+		request *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error)
+
+	// BatchDeleteProjects deletes existing projects at once. Every project gets its own result, and if
+	// AllOrNothing is requested, either all the projects are deleted or none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete existing projects
+	// Returns either the results of deleting the projects or error if something goes wrong.
+	BatchDeleteProjects(
+		ctx context.Context,
+		request *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error)
+
+	// ArchiveProject archives an existing project. The archived project is read-only until it is unarchived.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to archive an existing project
+	// Returns either the result of archiving an existing project or error if something goes wrong.
+	ArchiveProject(
+		ctx context.Context,
+		request *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+
+	// UnarchiveProject unarchives an archived project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to unarchive an archived project
+	// Returns either the result of unarchiving an archived project or error if something goes wrong.
+	UnarchiveProject(
+		ctx context.Context,
+		request *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error)
+
+	// RestoreProject restores a deleted project that is not purged yet
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to restore a deleted project
+	// Returns either the result of restoring a deleted project or error if something goes wrong.
+	RestoreProject(
+		ctx context.Context,
+		request *RestoreProjectRequest) (*RestoreProjectResponse, error)
+
+	// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+	// time provided in the request
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the time the projects must have been deleted before
+	// Returns either the result of purging the deleted projects or error if something goes wrong.
+	PurgeDeletedProjects(
+		ctx context.Context,
+		request *PurgeDeletedProjectsRequest) (*PurgeDeletedProjectsResponse, error)
+
 	// ListProjects returns the list of projects that matched the criteria
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request contains the search criteria
@@ -45,4 +106,238 @@ type BusinessContract interface {
 	ListProjects(
 		ctx context.Context,
 		request *ListProjectsRequest) (*ListProjectsResponse, error)
+
+	// ListProjectSortFields returns the fields the projects can be sorted on
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the fields the projects can be sorted on
+	// Returns the fields the projects can be sorted on
+	ListProjectSortFields(
+		ctx context.Context,
+		request *ListProjectSortFieldsRequest) (*ListProjectSortFieldsResponse, error)
+
+	// StreamProjects returns an iterator over all the projects that matched the criteria
+	// ctx: Mandatory The reference to the context, cancelling it stops reading the projects
+	// request: Mandatory. The request contains the search criteria
+	// Returns the iterator over the projects that matched the criteria
+	StreamProjects(
+		ctx context.Context,
+		request *StreamProjectsRequest) (*StreamProjectsResponse, error)
+
+	// WatchProjects watches the changes of the projects the user can see. The repository services that cannot
+	// notify about the changes of the projects, such as the Postgres one, return UnimplementedError.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the resource version to resume watching from
+	// Returns either the watcher over the changes of the projects or error if something goes wrong.
+	WatchProjects(
+		ctx context.Context,
+		request *WatchProjectsRequest) (*WatchProjectsResponse, error)
+
+	// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the query
+	// Returns the list of projects that matched the query
+	SearchProjects(
+		ctx context.Context,
+		request *SearchProjectsRequest) (*SearchProjectsResponse, error)
+
+	// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the search criteria
+	// Returns the list of deleted projects that matched the criteria
+	ListDeletedProjects(
+		ctx context.Context,
+		request *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error)
+
+	// AddMember adds a new member to an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a new member to an existing project
+	// Returns either the result of adding a new member or error if something goes wrong.
+	AddMember(
+		ctx context.Context,
+		request *AddMemberRequest) (*AddMemberResponse, error)
+
+	// RemoveMember removes an existing member from a project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove an existing member from a project
+	// Returns either the result of removing an existing member or error if something goes wrong.
+	RemoveMember(
+		ctx context.Context,
+		request *RemoveMemberRequest) (*RemoveMemberResponse, error)
+
+	// UpdateMemberRole changes the role of an existing member of a project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to change the role of an existing member
+	// Returns either the result of changing the role of an existing member or error if something goes wrong.
+	UpdateMemberRole(
+		ctx context.Context,
+		request *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
+
+	// ListMembers returns the members of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the members of an existing project
+	// Returns either the members of the project or error if something goes wrong.
+	ListMembers(
+		ctx context.Context,
+		request *ListMembersRequest) (*ListMembersResponse, error)
+
+	// TransferProjectOwnership transfers the ownership of an existing project to another user, either
+	// immediately or once the new owner accepts the transfer
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to transfer the ownership of an existing project
+	// Returns either the result of transferring the ownership or error if something goes wrong.
+	TransferProjectOwnership(
+		ctx context.Context,
+		request *TransferProjectOwnershipRequest) (*TransferProjectOwnershipResponse, error)
+
+	// AcceptProjectOwnershipTransfer accepts the pending ownership transfer of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to accept the pending ownership transfer
+	// Returns either the result of accepting the pending ownership transfer or error if something goes wrong.
+	AcceptProjectOwnershipTransfer(
+		ctx context.Context,
+		request *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error)
+
+	// DeclineProjectOwnershipTransfer declines the pending ownership transfer of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to decline the pending ownership transfer
+	// Returns either the result of declining the pending ownership transfer or error if something goes wrong.
+	DeclineProjectOwnershipTransfer(
+		ctx context.Context,
+		request *DeclineProjectOwnershipTransferRequest) (*DeclineProjectOwnershipTransferResponse, error)
+
+	// ListProjectAuditEvents returns the audit trail of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the audit trail of an existing project
+	// Returns either the audit events of the project or error if something goes wrong.
+	ListProjectAuditEvents(
+		ctx context.Context,
+		request *ListProjectAuditEventsRequest) (*ListProjectAuditEventsResponse, error)
+
+	// CreateInvitation invites a user to join an existing project and delivers the invitation to the user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to invite a user to join an existing project
+	// Returns either the result of inviting the user or error if something goes wrong.
+	CreateInvitation(
+		ctx context.Context,
+		request *CreateInvitationRequest) (*CreateInvitationResponse, error)
+
+	// ListInvitations returns the pending invitations of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the pending invitations of an existing project
+	// Returns either the pending invitations of the project or error if something goes wrong.
+	ListInvitations(
+		ctx context.Context,
+		request *ListInvitationsRequest) (*ListInvitationsResponse, error)
+
+	// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to accept a pending invitation
+	// Returns either the result of accepting the invitation or error if something goes wrong.
+	AcceptInvitation(
+		ctx context.Context,
+		request *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+
+	// RevokeInvitation revokes a pending invitation of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to revoke a pending invitation
+	// Returns either the result of revoking the invitation or error if something goes wrong.
+	RevokeInvitation(
+		ctx context.Context,
+		request *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+
+	// CreateOrganization creates a new organization and makes the user who creates it its owner
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create a new organization
+	// Returns either the result of creating the new organization or error if something goes wrong.
+	CreateOrganization(
+		ctx context.Context,
+		request *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+
+	// ReadOrganization reads an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read an existing organization
+	// Returns either the result of reading the organization or error if something goes wrong.
+	ReadOrganization(
+		ctx context.Context,
+		request *ReadOrganizationRequest) (*ReadOrganizationResponse, error)
+
+	// UpdateOrganization updates an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to update an existing organization
+	// Returns either the result of updating the organization or error if something goes wrong.
+	UpdateOrganization(
+		ctx context.Context,
+		request *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+
+	// DeleteOrganization deletes an existing organization that has no project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete an existing organization
+	// Returns either the result of deleting the organization or error if something goes wrong.
+	DeleteOrganization(
+		ctx context.Context,
+		request *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+
+	// ListOrganizations returns the organizations the user is a member of
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the organizations of the user
+	// Returns either the organizations of the user or error if something goes wrong.
+	ListOrganizations(
+		ctx context.Context,
+		request *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+
+	// AddOrganizationMember adds a new member to an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a new member to an existing organization
+	// Returns either the result of adding the new member or error if something goes wrong.
+	AddOrganizationMember(
+		ctx context.Context,
+		request *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
+
+	// RemoveOrganizationMember removes an existing member from an organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove an existing member from an organization
+	// Returns either the result of removing the member or error if something goes wrong.
+	RemoveOrganizationMember(
+		ctx context.Context,
+		request *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+
+	// ListOrganizationMembers returns the members of an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the members of an existing organization
+	// Returns either the members of the organization or error if something goes wrong.
+	ListOrganizationMembers(
+		ctx context.Context,
+		request *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+
+	// CreateFolder creates a new folder to organize the projects of the user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create a new folder
+	// Returns either the result of creating the new folder or error if something goes wrong.
+	CreateFolder(
+		ctx context.Context,
+		request *CreateFolderRequest) (*CreateFolderResponse, error)
+
+	// ListFolders returns the folders of the user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the folders of the user
+	// Returns either the folders of the user or error if something goes wrong.
+	ListFolders(
+		ctx context.Context,
+		request *ListFoldersRequest) (*ListFoldersResponse, error)
+
+	// DeleteFolder deletes an existing folder together with its subfolders
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete an existing folder
+	// Returns either the result of deleting the folder or error if something goes wrong.
+	DeleteFolder(
+		ctx context.Context,
+		request *DeleteFolderRequest) (*DeleteFolderResponse, error)
+
+	// MoveProject moves an existing project into a folder of the user or out of its folder
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to move an existing project
+	// Returns either the result of moving the project or error if something goes wrong.
+	MoveProject(
+		ctx context.Context,
+		request *MoveProjectRequest) (*MoveProjectResponse, error)
 }
@@ -0,0 +1,109 @@
+package business
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// signCursor signs the cursor returned by the repository service, so the users cannot forge the cursors to page
+// through the projects in ways the repository service did not hand out
+// cursor: Mandatory. The cursor returned by the repository service
+// Returns the signed cursor
+func (service *businessService) signCursor(cursor string) string {
+	return cursor + "." + base64.RawURLEncoding.EncodeToString(service.cursorMAC(cursor))
+}
+
+// verifyCursor verifies the signature of the cursor returned by signCursor
+// signedCursor: Mandatory. The cursor returned by signCursor
+// Returns the cursor returned by the repository service or ArgumentError if the signature is not valid
+func (service *businessService) verifyCursor(signedCursor string) (string, error) {
+	separatorIndex := strings.LastIndex(signedCursor, ".")
+	if separatorIndex < 0 {
+		return "", commonErrors.NewArgumentError("cursor", "cursor is not signed")
+	}
+
+	cursor := signedCursor[:separatorIndex]
+	signature, err := base64.RawURLEncoding.DecodeString(signedCursor[separatorIndex+1:])
+	if err != nil || !hmac.Equal(signature, service.cursorMAC(cursor)) {
+		return "", commonErrors.NewArgumentError("cursor", "cursor signature is not valid")
+	}
+
+	return cursor, nil
+}
+
+// verifyPagination verifies the signatures of the After and Before cursors of the pagination
+// pagination: Mandatory. The pagination received from the user
+// Returns the pagination with the cursors returned by the repository service or ArgumentError if any of the
+// signatures is not valid
+func (service *businessService) verifyPagination(pagination common.Pagination) (common.Pagination, error) {
+	if pagination.After != nil {
+		after, err := service.verifyCursor(*pagination.After)
+		if err != nil {
+			return common.Pagination{}, commonErrors.NewArgumentErrorWithError("pagination.after", "", err)
+		}
+
+		pagination.After = &after
+	}
+
+	if pagination.Before != nil {
+		before, err := service.verifyCursor(*pagination.Before)
+		if err != nil {
+			return common.Pagination{}, commonErrors.NewArgumentErrorWithError("pagination.before", "", err)
+		}
+
+		pagination.Before = &before
+	}
+
+	return pagination, nil
+}
+
+// signProjectCursors signs the cursors of the projects returned by the repository service
+func (service *businessService) signProjectCursors(projects []models.ProjectWithCursor) []models.ProjectWithCursor {
+	signedProjects := make([]models.ProjectWithCursor, 0, len(projects))
+	for _, project := range projects {
+		project.Cursor = service.signCursor(project.Cursor)
+		signedProjects = append(signedProjects, project)
+	}
+
+	return signedProjects
+}
+
+// signSearchResultCursors signs the cursors of the search results returned by the repository service
+func (service *businessService) signSearchResultCursors(results []models.ProjectSearchResult) []models.ProjectSearchResult {
+	signedResults := make([]models.ProjectSearchResult, 0, len(results))
+	for _, result := range results {
+		result.Cursor = service.signCursor(result.Cursor)
+		signedResults = append(signedResults, result)
+	}
+
+	return signedResults
+}
+
+// signingProjectIterator signs the cursors of the projects returned by the iterator of the repository service
+type signingProjectIterator struct {
+	repository.ProjectIterator
+	service *businessService
+}
+
+// Project returns the project the iterator is at with its signed cursor
+// Returns the project the last call to Next advanced the iterator to
+func (iterator *signingProjectIterator) Project() models.ProjectWithCursor {
+	project := iterator.ProjectIterator.Project()
+	project.Cursor = iterator.service.signCursor(project.Cursor)
+
+	return project
+}
+
+func (service *businessService) cursorMAC(cursor string) []byte {
+	mac := hmac.New(sha256.New, service.cursorSigningKey)
+	_, _ = mac.Write([]byte(cursor))
+
+	return mac.Sum(nil)
+}
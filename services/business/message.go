@@ -2,7 +2,10 @@
 package business
 
 import (
+	"time"
+
 	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
 	"github.com/micro-business/go-core/common"
 )
 
@@ -10,6 +13,8 @@ import (
 type CreateProjectRequest struct {
 	UserEmail string
 	Project   models.Project
+	// IdempotencyKey is optional, the retries of the request with the same key create the project only once
+	IdempotencyKey string
 }
 
 // CreateProjectResponse contains the result of creating a new project
@@ -32,11 +37,15 @@ type ReadProjectResponse struct {
 	Project models.Project
 }
 
-// UpdateProjectRequest contains the request to update an existing project
+// UpdateProjectRequest contains the request to update an existing project. If ExpectedVersion is not
+// zero, the project is only updated if its current version matches ExpectedVersion. UpdateMask lists the
+// paths of the fields to update, all the fields of models.ProjectUpdatePaths are updated if it is empty.
 type UpdateProjectRequest struct {
-	UserEmail string
-	ProjectID string
-	Project   models.Project
+	UserEmail       string
+	ProjectID       string
+	Project         models.Project
+	ExpectedVersion int64
+	UpdateMask      []string
 }
 
 // UpdateProjectResponse contains the result of updating an existing project
@@ -46,10 +55,12 @@ type UpdateProjectResponse struct {
 	Cursor  string
 }
 
-// DeleteProjectRequest contains the request to delete an existing project
+// DeleteProjectRequest contains the request to delete an existing project. If ExpectedVersion is not
+// zero, the project is only deleted if its current version matches ExpectedVersion.
 type DeleteProjectRequest struct {
-	UserEmail string
-	ProjectID string
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
 }
 
 // DeleteProjectResponse contains the result of deleting an existing project
@@ -57,19 +68,561 @@ type DeleteProjectResponse struct {
 	Err error
 }
 
-// ListProjectsRequest contains the filter criteria to look for existing projects
+// BatchCreateProjectsRequest contains the request to create new projects at once. If AllOrNothing is true,
+// either all the projects are created or none of them is, otherwise every project is created on its own.
+type BatchCreateProjectsRequest struct {
+	UserEmail    string
+	Projects     []models.Project
+	AllOrNothing bool
+}
+
+// BatchCreateProjectResult contains the result of creating one of the projects of a batch
+type BatchCreateProjectResult struct {
+	Err       error
+	ProjectID string
+	Project   models.Project
+	Cursor    string
+}
+
+// BatchCreateProjectsResponse contains the results of creating new projects, in the order of the projects.
+// Err is only set if the batch failed as a whole.
+type BatchCreateProjectsResponse struct {
+	Err     error
+	Results []BatchCreateProjectResult
+}
+
+// BatchUpdateProjectItem contains the details to update one of the projects of a batch, which have the same
+// meaning as the fields of UpdateProjectRequest
+type BatchUpdateProjectItem struct {
+	ProjectID       string
+	Project         models.Project
+	ExpectedVersion int64
+	UpdateMask      []string
+}
+
+// BatchUpdateProjectsRequest contains the request to update existing projects at once. If AllOrNothing is
+// true, either all the projects are updated or none of them is, otherwise every project is updated on its own.
+type BatchUpdateProjectsRequest struct {
+	UserEmail    string
+	Projects     []BatchUpdateProjectItem
+	AllOrNothing bool
+}
+
+// BatchUpdateProjectResult contains the result of updating one of the projects of a batch
+type BatchUpdateProjectResult struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
+
+// BatchUpdateProjectsResponse contains the results of updating existing projects, in the order of the
+// projects. Err is only set if the batch failed as a whole.
+type BatchUpdateProjectsResponse struct {
+	Err     error
+	Results []BatchUpdateProjectResult
+}
+
+// BatchDeleteProjectItem contains the details to delete one of the projects of a batch, which have the same
+// meaning as the fields of DeleteProjectRequest
+type BatchDeleteProjectItem struct {
+	ProjectID       string
+	ExpectedVersion int64
+}
+
+// BatchDeleteProjectsRequest contains the request to delete existing projects at once. If AllOrNothing is
+// true, either all the projects are deleted or none of them is, otherwise every project is deleted on its own.
+type BatchDeleteProjectsRequest struct {
+	UserEmail    string
+	Projects     []BatchDeleteProjectItem
+	AllOrNothing bool
+}
+
+// BatchDeleteProjectResult contains the result of deleting one of the projects of a batch
+type BatchDeleteProjectResult struct {
+	Err error
+}
+
+// BatchDeleteProjectsResponse contains the results of deleting existing projects, in the order of the
+// projects. Err is only set if the batch failed as a whole.
+type BatchDeleteProjectsResponse struct {
+	Err     error
+	Results []BatchDeleteProjectResult
+}
+
+// ArchiveProjectRequest contains the request to archive an existing project. If ExpectedVersion is not
+// zero, the project is only archived if its current version matches ExpectedVersion.
+type ArchiveProjectRequest struct {
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
+}
+
+// ArchiveProjectResponse contains the result of archiving an existing project
+type ArchiveProjectResponse struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
+
+// UnarchiveProjectRequest contains the request to unarchive an archived project. If ExpectedVersion is not
+// zero, the project is only unarchived if its current version matches ExpectedVersion.
+type UnarchiveProjectRequest struct {
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
+}
+
+// UnarchiveProjectResponse contains the result of unarchiving an archived project
+type UnarchiveProjectResponse struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
+
+// RestoreProjectRequest contains the request to restore a deleted project. If ExpectedVersion is not
+// zero, the project is only restored if its current version matches ExpectedVersion.
+type RestoreProjectRequest struct {
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
+}
+
+// RestoreProjectResponse contains the result of restoring a deleted project
+type RestoreProjectResponse struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
+
+// PurgeDeletedProjectsRequest contains the request to permanently remove the projects of all the users
+// that were deleted before DeletedBefore
+type PurgeDeletedProjectsRequest struct {
+	DeletedBefore time.Time
+}
+
+// PurgeDeletedProjectsResponse contains the result of purging the deleted projects
+type PurgeDeletedProjectsResponse struct {
+	Err         error
+	PurgedCount int64
+}
+
+// ListProjectsRequest contains the filter criteria to look for existing projects. The archived projects
+// are only returned if IncludeArchived is true, and only the projects that match Filter are returned if it is
+// provided.
 type ListProjectsRequest struct {
+	UserEmail         string
+	Pagination        common.Pagination
+	SortingOptions    []common.SortingOptionPair
+	ProjectIDs        []string
+	IncludeArchived   bool
+	OrganizationID    string
+	FolderID          string
+	IncludeSubfolders bool
+	Filter            *models.ProjectFilter
+}
+
+// ListProjectsResponse contains the list of the projects that matched the result
+type ListProjectsResponse struct {
+	Err             error
+	HasPreviousPage bool
+	HasNextPage     bool
+	TotalCount      int64
+	Projects        []models.ProjectWithCursor
+}
+
+// StreamProjectsRequest contains the filter criteria to look for all the existing projects, with the same meaning
+// as the criteria of ListProjectsRequest. The projects are not paginated.
+type StreamProjectsRequest struct {
+	UserEmail         string
+	SortingOptions    []common.SortingOptionPair
+	ProjectIDs        []string
+	IncludeArchived   bool
+	OrganizationID    string
+	FolderID          string
+	IncludeSubfolders bool
+	Filter            *models.ProjectFilter
+}
+
+// StreamProjectsResponse contains the iterator over the projects that matched the criteria. The iterator must be
+// closed once it is no longer used.
+type StreamProjectsResponse struct {
+	Err      error
+	Projects repository.ProjectIterator
+}
+
+// WatchProjectsRequest contains the user to watch the changes of the visible projects for. ResourceVersion is the
+// resource version of the last event the user received, the changes after it are returned first. The changes are
+// watched from now on if ResourceVersion is empty.
+type WatchProjectsRequest struct {
+	UserEmail       string
+	ResourceVersion string
+}
+
+// WatchProjectsResponse contains the watcher that returns the changes of the projects the user can see. The
+// watcher must be closed once it is no longer used.
+type WatchProjectsResponse struct {
+	Err     error
+	Watcher repository.ProjectWatcher
+}
+
+// ListProjectSortFieldsRequest contains the request to list the fields the projects can be sorted on
+type ListProjectSortFieldsRequest struct {
+}
+
+// ListProjectSortFieldsResponse contains the fields the projects can be sorted on
+type ListProjectSortFieldsResponse struct {
+	Err    error
+	Fields []models.ProjectSortField
+}
+
+// SearchProjectsRequest contains the full-text query to look for the projects that contain its words. The
+// archived projects are only returned if IncludeArchived is true.
+type SearchProjectsRequest struct {
+	UserEmail       string
+	Query           string
+	Pagination      common.Pagination
+	IncludeArchived bool
+}
+
+// SearchProjectsResponse contains the list of the projects that matched the query, the most relevant ones first
+type SearchProjectsResponse struct {
+	Err             error
+	HasPreviousPage bool
+	HasNextPage     bool
+	TotalCount      int64
+	Projects        []models.ProjectSearchResult
+}
+
+// ListDeletedProjectsRequest contains the filter criteria to look for the deleted projects that are not purged yet
+type ListDeletedProjectsRequest struct {
 	UserEmail      string
 	Pagination     common.Pagination
 	SortingOptions []common.SortingOptionPair
 	ProjectIDs     []string
 }
 
-// ListProjectsResponse contains the list of the projects that matched the result
-type ListProjectsResponse struct {
+// ListDeletedProjectsResponse contains the list of the deleted projects that matched the result
+type ListDeletedProjectsResponse struct {
 	Err             error
 	HasPreviousPage bool
 	HasNextPage     bool
 	TotalCount      int64
 	Projects        []models.ProjectWithCursor
 }
+
+// AddMemberRequest contains the request to add a new member to an existing project. Only the owner of the
+// project can add new members, and the new members can only be viewers or editors.
+type AddMemberRequest struct {
+	UserEmail   string
+	ProjectID   string
+	MemberEmail string
+	Role        models.MemberRole
+}
+
+// AddMemberResponse contains the result of adding a new member to an existing project
+type AddMemberResponse struct {
+	Err    error
+	Member models.Member
+}
+
+// RemoveMemberRequest contains the request to remove an existing member from a project. Only the owner of
+// the project can remove the other members, but every member can remove themselves.
+type RemoveMemberRequest struct {
+	UserEmail   string
+	ProjectID   string
+	MemberEmail string
+}
+
+// RemoveMemberResponse contains the result of removing an existing member from a project
+type RemoveMemberResponse struct {
+	Err error
+}
+
+// UpdateMemberRoleRequest contains the request to change the role of an existing member of a project. Only
+// the owner of the project can change the roles, and the role of the owner cannot be changed.
+type UpdateMemberRoleRequest struct {
+	UserEmail   string
+	ProjectID   string
+	MemberEmail string
+	Role        models.MemberRole
+}
+
+// UpdateMemberRoleResponse contains the result of changing the role of an existing member of a project
+type UpdateMemberRoleResponse struct {
+	Err    error
+	Member models.Member
+}
+
+// ListMembersRequest contains the request to list the members of an existing project
+type ListMembersRequest struct {
+	UserEmail string
+	ProjectID string
+}
+
+// ListMembersResponse contains the members of an existing project, ordered by their emails
+type ListMembersResponse struct {
+	Err     error
+	Members []models.Member
+}
+
+// TransferProjectOwnershipRequest contains the request to transfer the ownership of an existing project to
+// another user. Only the owner of the project can transfer its ownership. If RequireAcceptance is set, the
+// ownership is only transferred once the new owner accepts the transfer, otherwise it is transferred immediately.
+type TransferProjectOwnershipRequest struct {
+	UserEmail         string
+	ProjectID         string
+	NewOwnerEmail     string
+	RequireAcceptance bool
+}
+
+// TransferProjectOwnershipResponse contains the result of transferring the ownership of an existing project.
+// PendingTransfer is only set if the transfer waits for the new owner to accept it, otherwise Project contains
+// the project after its ownership is transferred.
+type TransferProjectOwnershipResponse struct {
+	Err             error
+	Project         models.Project
+	Cursor          string
+	PendingTransfer *models.OwnershipTransfer
+}
+
+// AcceptProjectOwnershipTransferRequest contains the request of the new owner to accept the pending ownership
+// transfer of an existing project
+type AcceptProjectOwnershipTransferRequest struct {
+	UserEmail string
+	ProjectID string
+}
+
+// AcceptProjectOwnershipTransferResponse contains the result of accepting the pending ownership transfer
+type AcceptProjectOwnershipTransferResponse struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
+
+// DeclineProjectOwnershipTransferRequest contains the request to decline the pending ownership transfer of an
+// existing project. The transfer can be declined by the new owner or cancelled by the current owner.
+type DeclineProjectOwnershipTransferRequest struct {
+	UserEmail string
+	ProjectID string
+}
+
+// DeclineProjectOwnershipTransferResponse contains the result of declining the pending ownership transfer
+type DeclineProjectOwnershipTransferResponse struct {
+	Err error
+}
+
+// ListProjectAuditEventsRequest contains the request to list the audit trail of an existing project
+type ListProjectAuditEventsRequest struct {
+	UserEmail string
+	ProjectID string
+}
+
+// ListProjectAuditEventsResponse contains the audit events of an existing project, ordered by the time they occurred
+type ListProjectAuditEventsResponse struct {
+	Err         error
+	AuditEvents []models.AuditEvent
+}
+
+// CreateInvitationRequest contains the request to invite a user to join an existing project with the provided role
+type CreateInvitationRequest struct {
+	UserEmail string
+	ProjectID string
+	Email     string
+	Role      models.MemberRole
+}
+
+// CreateInvitationResponse contains the result of inviting a user to join an existing project
+type CreateInvitationResponse struct {
+	Err        error
+	Invitation models.Invitation
+}
+
+// ListInvitationsRequest contains the request to list the pending invitations of an existing project
+type ListInvitationsRequest struct {
+	UserEmail string
+	ProjectID string
+}
+
+// ListInvitationsResponse contains the pending invitations of an existing project, ordered by their emails
+type ListInvitationsResponse struct {
+	Err         error
+	Invitations []models.Invitation
+}
+
+// AcceptInvitationRequest contains the request of the invited user to accept a pending invitation with the
+// token delivered to the user
+type AcceptInvitationRequest struct {
+	UserEmail string
+	Token     string
+}
+
+// AcceptInvitationResponse contains the result of accepting a pending invitation
+type AcceptInvitationResponse struct {
+	Err       error
+	ProjectID string
+	Member    models.Member
+}
+
+// RevokeInvitationRequest contains the request to revoke the pending invitation of a user to an existing project
+type RevokeInvitationRequest struct {
+	UserEmail string
+	ProjectID string
+	Email     string
+}
+
+// RevokeInvitationResponse contains the result of revoking a pending invitation
+type RevokeInvitationResponse struct {
+	Err error
+}
+
+// CreateOrganizationRequest contains the request to create a new organization
+type CreateOrganizationRequest struct {
+	UserEmail    string
+	Organization models.Organization
+}
+
+// CreateOrganizationResponse contains the result of creating a new organization
+type CreateOrganizationResponse struct {
+	Err            error
+	OrganizationID string
+	Organization   models.Organization
+}
+
+// ReadOrganizationRequest contains the request to read an existing organization
+type ReadOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+}
+
+// ReadOrganizationResponse contains the result of reading an existing organization
+type ReadOrganizationResponse struct {
+	Err          error
+	Organization models.Organization
+}
+
+// UpdateOrganizationRequest contains the request to update an existing organization
+type UpdateOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+	Organization   models.Organization
+}
+
+// UpdateOrganizationResponse contains the result of updating an existing organization
+type UpdateOrganizationResponse struct {
+	Err          error
+	Organization models.Organization
+}
+
+// DeleteOrganizationRequest contains the request to delete an existing organization
+type DeleteOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+}
+
+// DeleteOrganizationResponse contains the result of deleting an existing organization
+type DeleteOrganizationResponse struct {
+	Err error
+}
+
+// ListOrganizationsRequest contains the request to list the organizations the user is a member of
+type ListOrganizationsRequest struct {
+	UserEmail string
+}
+
+// ListOrganizationsResponse contains the organizations the user is a member of, ordered by their names
+type ListOrganizationsResponse struct {
+	Err           error
+	Organizations []models.OrganizationWithID
+}
+
+// AddOrganizationMemberRequest contains the request to add a new member to an existing organization with the
+// provided role
+type AddOrganizationMemberRequest struct {
+	UserEmail      string
+	OrganizationID string
+	MemberEmail    string
+	Role           models.MemberRole
+}
+
+// AddOrganizationMemberResponse contains the result of adding a new member to an existing organization
+type AddOrganizationMemberResponse struct {
+	Err    error
+	Member models.Member
+}
+
+// RemoveOrganizationMemberRequest contains the request to remove an existing member from an organization
+type RemoveOrganizationMemberRequest struct {
+	UserEmail      string
+	OrganizationID string
+	MemberEmail    string
+}
+
+// RemoveOrganizationMemberResponse contains the result of removing an existing member from an organization
+type RemoveOrganizationMemberResponse struct {
+	Err error
+}
+
+// ListOrganizationMembersRequest contains the request to list the members of an existing organization
+type ListOrganizationMembersRequest struct {
+	UserEmail      string
+	OrganizationID string
+}
+
+// ListOrganizationMembersResponse contains the members of an existing organization, ordered by their emails
+type ListOrganizationMembersResponse struct {
+	Err     error
+	Members []models.Member
+}
+
+// CreateFolderRequest contains the request to create a new folder
+type CreateFolderRequest struct {
+	UserEmail string
+	Folder    models.Folder
+}
+
+// CreateFolderResponse contains the result of creating a new folder
+type CreateFolderResponse struct {
+	Err      error
+	FolderID string
+	Folder   models.Folder
+}
+
+// ListFoldersRequest contains the request to list the folders of the user
+type ListFoldersRequest struct {
+	UserEmail string
+}
+
+// ListFoldersResponse contains the folders of the user, ordered by their names
+type ListFoldersResponse struct {
+	Err     error
+	Folders []models.FolderWithID
+}
+
+// DeleteFolderRequest contains the request to delete an existing folder together with its subfolders. If
+// Cascade is true, the projects in the deleted folders are deleted too, otherwise the folder can only be
+// deleted if it has no project.
+type DeleteFolderRequest struct {
+	UserEmail string
+	FolderID  string
+	Cascade   bool
+}
+
+// DeleteFolderResponse contains the result of deleting an existing folder
+type DeleteFolderResponse struct {
+	Err error
+}
+
+// MoveProjectRequest contains the request to move an existing project into a folder of the user. The project
+// is moved out of its folder if FolderID is empty.
+type MoveProjectRequest struct {
+	UserEmail string
+	ProjectID string
+	FolderID  string
+}
+
+// MoveProjectResponse contains the result of moving an existing project
+type MoveProjectResponse struct {
+	Err     error
+	Project models.Project
+	Cursor  string
+}
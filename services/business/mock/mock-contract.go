@@ -35,6 +35,171 @@ func (m *MockBusinessContract) EXPECT() *MockBusinessContractMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockBusinessContract) AcceptInvitation(ctx context.Context, request *business.AcceptInvitationRequest) (*business.AcceptInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, request)
+	ret0, _ := ret[0].(*business.AcceptInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockBusinessContractMockRecorder) AcceptInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockBusinessContract)(nil).AcceptInvitation), ctx, request)
+}
+
+// AcceptProjectOwnershipTransfer mocks base method.
+func (m *MockBusinessContract) AcceptProjectOwnershipTransfer(ctx context.Context, request *business.AcceptProjectOwnershipTransferRequest) (*business.AcceptProjectOwnershipTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptProjectOwnershipTransfer", ctx, request)
+	ret0, _ := ret[0].(*business.AcceptProjectOwnershipTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptProjectOwnershipTransfer indicates an expected call of AcceptProjectOwnershipTransfer.
+func (mr *MockBusinessContractMockRecorder) AcceptProjectOwnershipTransfer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptProjectOwnershipTransfer", reflect.TypeOf((*MockBusinessContract)(nil).AcceptProjectOwnershipTransfer), ctx, request)
+}
+
+// AddMember mocks base method.
+func (m *MockBusinessContract) AddMember(ctx context.Context, request *business.AddMemberRequest) (*business.AddMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, request)
+	ret0, _ := ret[0].(*business.AddMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockBusinessContractMockRecorder) AddMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockBusinessContract)(nil).AddMember), ctx, request)
+}
+
+// AddOrganizationMember mocks base method.
+func (m *MockBusinessContract) AddOrganizationMember(ctx context.Context, request *business.AddOrganizationMemberRequest) (*business.AddOrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMember", ctx, request)
+	ret0, _ := ret[0].(*business.AddOrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrganizationMember indicates an expected call of AddOrganizationMember.
+func (mr *MockBusinessContractMockRecorder) AddOrganizationMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMember", reflect.TypeOf((*MockBusinessContract)(nil).AddOrganizationMember), ctx, request)
+}
+
+// ArchiveProject mocks base method.
+func (m *MockBusinessContract) ArchiveProject(ctx context.Context, request *business.ArchiveProjectRequest) (*business.ArchiveProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProject", ctx, request)
+	ret0, _ := ret[0].(*business.ArchiveProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveProject indicates an expected call of ArchiveProject.
+func (mr *MockBusinessContractMockRecorder) ArchiveProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProject", reflect.TypeOf((*MockBusinessContract)(nil).ArchiveProject), ctx, request)
+}
+
+// BatchCreateProjects mocks base method.
+func (m *MockBusinessContract) BatchCreateProjects(ctx context.Context, request *business.BatchCreateProjectsRequest) (*business.BatchCreateProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateProjects", ctx, request)
+	ret0, _ := ret[0].(*business.BatchCreateProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreateProjects indicates an expected call of BatchCreateProjects.
+func (mr *MockBusinessContractMockRecorder) BatchCreateProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateProjects", reflect.TypeOf((*MockBusinessContract)(nil).BatchCreateProjects), ctx, request)
+}
+
+// BatchDeleteProjects mocks base method.
+func (m *MockBusinessContract) BatchDeleteProjects(ctx context.Context, request *business.BatchDeleteProjectsRequest) (*business.BatchDeleteProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteProjects", ctx, request)
+	ret0, _ := ret[0].(*business.BatchDeleteProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteProjects indicates an expected call of BatchDeleteProjects.
+func (mr *MockBusinessContractMockRecorder) BatchDeleteProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteProjects", reflect.TypeOf((*MockBusinessContract)(nil).BatchDeleteProjects), ctx, request)
+}
+
+// BatchUpdateProjects mocks base method.
+func (m *MockBusinessContract) BatchUpdateProjects(ctx context.Context, request *business.BatchUpdateProjectsRequest) (*business.BatchUpdateProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateProjects", ctx, request)
+	ret0, _ := ret[0].(*business.BatchUpdateProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateProjects indicates an expected call of BatchUpdateProjects.
+func (mr *MockBusinessContractMockRecorder) BatchUpdateProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateProjects", reflect.TypeOf((*MockBusinessContract)(nil).BatchUpdateProjects), ctx, request)
+}
+
+// CreateFolder mocks base method.
+func (m *MockBusinessContract) CreateFolder(ctx context.Context, request *business.CreateFolderRequest) (*business.CreateFolderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, request)
+	ret0, _ := ret[0].(*business.CreateFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockBusinessContractMockRecorder) CreateFolder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockBusinessContract)(nil).CreateFolder), ctx, request)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockBusinessContract) CreateInvitation(ctx context.Context, request *business.CreateInvitationRequest) (*business.CreateInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, request)
+	ret0, _ := ret[0].(*business.CreateInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockBusinessContractMockRecorder) CreateInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockBusinessContract)(nil).CreateInvitation), ctx, request)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockBusinessContract) CreateOrganization(ctx context.Context, request *business.CreateOrganizationRequest) (*business.CreateOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, request)
+	ret0, _ := ret[0].(*business.CreateOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockBusinessContractMockRecorder) CreateOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockBusinessContract)(nil).CreateOrganization), ctx, request)
+}
+
 // CreateProject mocks base method.
 func (m *MockBusinessContract) CreateProject(ctx context.Context, request *business.CreateProjectRequest) (*business.CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +215,51 @@ func (mr *MockBusinessContractMockRecorder) CreateProject(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockBusinessContract)(nil).CreateProject), ctx, request)
 }
 
+// DeclineProjectOwnershipTransfer mocks base method.
+func (m *MockBusinessContract) DeclineProjectOwnershipTransfer(ctx context.Context, request *business.DeclineProjectOwnershipTransferRequest) (*business.DeclineProjectOwnershipTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineProjectOwnershipTransfer", ctx, request)
+	ret0, _ := ret[0].(*business.DeclineProjectOwnershipTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineProjectOwnershipTransfer indicates an expected call of DeclineProjectOwnershipTransfer.
+func (mr *MockBusinessContractMockRecorder) DeclineProjectOwnershipTransfer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineProjectOwnershipTransfer", reflect.TypeOf((*MockBusinessContract)(nil).DeclineProjectOwnershipTransfer), ctx, request)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockBusinessContract) DeleteFolder(ctx context.Context, request *business.DeleteFolderRequest) (*business.DeleteFolderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", ctx, request)
+	ret0, _ := ret[0].(*business.DeleteFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockBusinessContractMockRecorder) DeleteFolder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockBusinessContract)(nil).DeleteFolder), ctx, request)
+}
+
+// DeleteOrganization mocks base method.
+func (m *MockBusinessContract) DeleteOrganization(ctx context.Context, request *business.DeleteOrganizationRequest) (*business.DeleteOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, request)
+	ret0, _ := ret[0].(*business.DeleteOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *MockBusinessContractMockRecorder) DeleteOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*MockBusinessContract)(nil).DeleteOrganization), ctx, request)
+}
+
 // DeleteProject mocks base method.
 func (m *MockBusinessContract) DeleteProject(ctx context.Context, request *business.DeleteProjectRequest) (*business.DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +275,126 @@ func (mr *MockBusinessContractMockRecorder) DeleteProject(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockBusinessContract)(nil).DeleteProject), ctx, request)
 }
 
+// ListDeletedProjects mocks base method.
+func (m *MockBusinessContract) ListDeletedProjects(ctx context.Context, request *business.ListDeletedProjectsRequest) (*business.ListDeletedProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedProjects", ctx, request)
+	ret0, _ := ret[0].(*business.ListDeletedProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedProjects indicates an expected call of ListDeletedProjects.
+func (mr *MockBusinessContractMockRecorder) ListDeletedProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedProjects", reflect.TypeOf((*MockBusinessContract)(nil).ListDeletedProjects), ctx, request)
+}
+
+// ListFolders mocks base method.
+func (m *MockBusinessContract) ListFolders(ctx context.Context, request *business.ListFoldersRequest) (*business.ListFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", ctx, request)
+	ret0, _ := ret[0].(*business.ListFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockBusinessContractMockRecorder) ListFolders(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockBusinessContract)(nil).ListFolders), ctx, request)
+}
+
+// ListInvitations mocks base method.
+func (m *MockBusinessContract) ListInvitations(ctx context.Context, request *business.ListInvitationsRequest) (*business.ListInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, request)
+	ret0, _ := ret[0].(*business.ListInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockBusinessContractMockRecorder) ListInvitations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockBusinessContract)(nil).ListInvitations), ctx, request)
+}
+
+// ListMembers mocks base method.
+func (m *MockBusinessContract) ListMembers(ctx context.Context, request *business.ListMembersRequest) (*business.ListMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, request)
+	ret0, _ := ret[0].(*business.ListMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockBusinessContractMockRecorder) ListMembers(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockBusinessContract)(nil).ListMembers), ctx, request)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockBusinessContract) ListOrganizationMembers(ctx context.Context, request *business.ListOrganizationMembersRequest) (*business.ListOrganizationMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", ctx, request)
+	ret0, _ := ret[0].(*business.ListOrganizationMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockBusinessContractMockRecorder) ListOrganizationMembers(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockBusinessContract)(nil).ListOrganizationMembers), ctx, request)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockBusinessContract) ListOrganizations(ctx context.Context, request *business.ListOrganizationsRequest) (*business.ListOrganizationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx, request)
+	ret0, _ := ret[0].(*business.ListOrganizationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockBusinessContractMockRecorder) ListOrganizations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockBusinessContract)(nil).ListOrganizations), ctx, request)
+}
+
+// ListProjectAuditEvents mocks base method.
+func (m *MockBusinessContract) ListProjectAuditEvents(ctx context.Context, request *business.ListProjectAuditEventsRequest) (*business.ListProjectAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectAuditEvents", ctx, request)
+	ret0, _ := ret[0].(*business.ListProjectAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAuditEvents indicates an expected call of ListProjectAuditEvents.
+func (mr *MockBusinessContractMockRecorder) ListProjectAuditEvents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAuditEvents", reflect.TypeOf((*MockBusinessContract)(nil).ListProjectAuditEvents), ctx, request)
+}
+
+// ListProjectSortFields mocks base method.
+func (m *MockBusinessContract) ListProjectSortFields(ctx context.Context, request *business.ListProjectSortFieldsRequest) (*business.ListProjectSortFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSortFields", ctx, request)
+	ret0, _ := ret[0].(*business.ListProjectSortFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSortFields indicates an expected call of ListProjectSortFields.
+func (mr *MockBusinessContractMockRecorder) ListProjectSortFields(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSortFields", reflect.TypeOf((*MockBusinessContract)(nil).ListProjectSortFields), ctx, request)
+}
+
 // ListProjects mocks base method.
 func (m *MockBusinessContract) ListProjects(ctx context.Context, request *business.ListProjectsRequest) (*business.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +410,51 @@ func (mr *MockBusinessContractMockRecorder) ListProjects(ctx, request interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockBusinessContract)(nil).ListProjects), ctx, request)
 }
 
+// MoveProject mocks base method.
+func (m *MockBusinessContract) MoveProject(ctx context.Context, request *business.MoveProjectRequest) (*business.MoveProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProject", ctx, request)
+	ret0, _ := ret[0].(*business.MoveProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProject indicates an expected call of MoveProject.
+func (mr *MockBusinessContractMockRecorder) MoveProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProject", reflect.TypeOf((*MockBusinessContract)(nil).MoveProject), ctx, request)
+}
+
+// PurgeDeletedProjects mocks base method.
+func (m *MockBusinessContract) PurgeDeletedProjects(ctx context.Context, request *business.PurgeDeletedProjectsRequest) (*business.PurgeDeletedProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedProjects", ctx, request)
+	ret0, _ := ret[0].(*business.PurgeDeletedProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedProjects indicates an expected call of PurgeDeletedProjects.
+func (mr *MockBusinessContractMockRecorder) PurgeDeletedProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedProjects", reflect.TypeOf((*MockBusinessContract)(nil).PurgeDeletedProjects), ctx, request)
+}
+
+// ReadOrganization mocks base method.
+func (m *MockBusinessContract) ReadOrganization(ctx context.Context, request *business.ReadOrganizationRequest) (*business.ReadOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOrganization", ctx, request)
+	ret0, _ := ret[0].(*business.ReadOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOrganization indicates an expected call of ReadOrganization.
+func (mr *MockBusinessContractMockRecorder) ReadOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOrganization", reflect.TypeOf((*MockBusinessContract)(nil).ReadOrganization), ctx, request)
+}
+
 // ReadProject mocks base method.
 func (m *MockBusinessContract) ReadProject(ctx context.Context, request *business.ReadProjectRequest) (*business.ReadProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +470,156 @@ func (mr *MockBusinessContractMockRecorder) ReadProject(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadProject", reflect.TypeOf((*MockBusinessContract)(nil).ReadProject), ctx, request)
 }
 
+// RemoveMember mocks base method.
+func (m *MockBusinessContract) RemoveMember(ctx context.Context, request *business.RemoveMemberRequest) (*business.RemoveMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, request)
+	ret0, _ := ret[0].(*business.RemoveMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockBusinessContractMockRecorder) RemoveMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockBusinessContract)(nil).RemoveMember), ctx, request)
+}
+
+// RemoveOrganizationMember mocks base method.
+func (m *MockBusinessContract) RemoveOrganizationMember(ctx context.Context, request *business.RemoveOrganizationMemberRequest) (*business.RemoveOrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMember", ctx, request)
+	ret0, _ := ret[0].(*business.RemoveOrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrganizationMember indicates an expected call of RemoveOrganizationMember.
+func (mr *MockBusinessContractMockRecorder) RemoveOrganizationMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMember", reflect.TypeOf((*MockBusinessContract)(nil).RemoveOrganizationMember), ctx, request)
+}
+
+// RestoreProject mocks base method.
+func (m *MockBusinessContract) RestoreProject(ctx context.Context, request *business.RestoreProjectRequest) (*business.RestoreProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, request)
+	ret0, _ := ret[0].(*business.RestoreProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockBusinessContractMockRecorder) RestoreProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockBusinessContract)(nil).RestoreProject), ctx, request)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockBusinessContract) RevokeInvitation(ctx context.Context, request *business.RevokeInvitationRequest) (*business.RevokeInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", ctx, request)
+	ret0, _ := ret[0].(*business.RevokeInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockBusinessContractMockRecorder) RevokeInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockBusinessContract)(nil).RevokeInvitation), ctx, request)
+}
+
+// SearchProjects mocks base method.
+func (m *MockBusinessContract) SearchProjects(ctx context.Context, request *business.SearchProjectsRequest) (*business.SearchProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProjects", ctx, request)
+	ret0, _ := ret[0].(*business.SearchProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProjects indicates an expected call of SearchProjects.
+func (mr *MockBusinessContractMockRecorder) SearchProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProjects", reflect.TypeOf((*MockBusinessContract)(nil).SearchProjects), ctx, request)
+}
+
+// StreamProjects mocks base method.
+func (m *MockBusinessContract) StreamProjects(ctx context.Context, request *business.StreamProjectsRequest) (*business.StreamProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamProjects", ctx, request)
+	ret0, _ := ret[0].(*business.StreamProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamProjects indicates an expected call of StreamProjects.
+func (mr *MockBusinessContractMockRecorder) StreamProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProjects", reflect.TypeOf((*MockBusinessContract)(nil).StreamProjects), ctx, request)
+}
+
+// TransferProjectOwnership mocks base method.
+func (m *MockBusinessContract) TransferProjectOwnership(ctx context.Context, request *business.TransferProjectOwnershipRequest) (*business.TransferProjectOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferProjectOwnership", ctx, request)
+	ret0, _ := ret[0].(*business.TransferProjectOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferProjectOwnership indicates an expected call of TransferProjectOwnership.
+func (mr *MockBusinessContractMockRecorder) TransferProjectOwnership(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferProjectOwnership", reflect.TypeOf((*MockBusinessContract)(nil).TransferProjectOwnership), ctx, request)
+}
+
+// UnarchiveProject mocks base method.
+func (m *MockBusinessContract) UnarchiveProject(ctx context.Context, request *business.UnarchiveProjectRequest) (*business.UnarchiveProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveProject", ctx, request)
+	ret0, _ := ret[0].(*business.UnarchiveProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveProject indicates an expected call of UnarchiveProject.
+func (mr *MockBusinessContractMockRecorder) UnarchiveProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveProject", reflect.TypeOf((*MockBusinessContract)(nil).UnarchiveProject), ctx, request)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockBusinessContract) UpdateMemberRole(ctx context.Context, request *business.UpdateMemberRoleRequest) (*business.UpdateMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, request)
+	ret0, _ := ret[0].(*business.UpdateMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockBusinessContractMockRecorder) UpdateMemberRole(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockBusinessContract)(nil).UpdateMemberRole), ctx, request)
+}
+
+// UpdateOrganization mocks base method.
+func (m *MockBusinessContract) UpdateOrganization(ctx context.Context, request *business.UpdateOrganizationRequest) (*business.UpdateOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, request)
+	ret0, _ := ret[0].(*business.UpdateOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganization indicates an expected call of UpdateOrganization.
+func (mr *MockBusinessContractMockRecorder) UpdateOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockBusinessContract)(nil).UpdateOrganization), ctx, request)
+}
+
 // UpdateProject mocks base method.
 func (m *MockBusinessContract) UpdateProject(ctx context.Context, request *business.UpdateProjectRequest) (*business.UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +634,18 @@ func (mr *MockBusinessContractMockRecorder) UpdateProject(ctx, request interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockBusinessContract)(nil).UpdateProject), ctx, request)
 }
+
+// WatchProjects mocks base method.
+func (m *MockBusinessContract) WatchProjects(ctx context.Context, request *business.WatchProjectsRequest) (*business.WatchProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProjects", ctx, request)
+	ret0, _ := ret[0].(*business.WatchProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchProjects indicates an expected call of WatchProjects.
+func (mr *MockBusinessContractMockRecorder) WatchProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProjects", reflect.TypeOf((*MockBusinessContract)(nil).WatchProjects), ctx, request)
+}
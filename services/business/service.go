@@ -3,40 +3,128 @@ package business
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"time"
 
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/configuration"
+	"github.com/decentralized-cloud/project/services/notifier"
 	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/lucsky/cuid"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 )
 
+// invitationTokenSize is the number of random bytes the invitation tokens are generated from
+const invitationTokenSize = 32
+
 type businessService struct {
-	repositoryService repository.RepositoryContract
+	repositoryService       repository.RepositoryContract
+	notifierService         notifier.NotifierContract
+	ownershipTransferExpiry time.Duration
+	invitationExpiry        time.Duration
+	cursorSigningKey        []byte
+	maxBatchSize            int
+	idempotencyKeyTTL       time.Duration
 }
 
 // NewBusinessService creates new instance of the BusinessService, setting up all dependencies and returns the instance
 // repositoryService: Mandatory. Reference to the repository service that can persist the project related data
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// notifierService: Mandatory. Reference to the notifier service that delivers the invitations
 // Returns the new service or error if something goes wrong
 func NewBusinessService(
-	repositoryService repository.RepositoryContract) (BusinessContract, error) {
+	repositoryService repository.RepositoryContract,
+	configurationService configuration.ConfigurationContract,
+	notifierService notifier.NotifierContract) (BusinessContract, error) {
 	if repositoryService == nil {
 		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
 	}
 
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if notifierService == nil {
+		return nil, commonErrors.NewArgumentNilError("notifierService", "notifierService is required")
+	}
+
+	ownershipTransferExpiry, err := configurationService.GetOwnershipTransferExpiry()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the ownership transfer expiry", err)
+	}
+
+	invitationExpiry, err := configurationService.GetInvitationExpiry()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the invitation expiry", err)
+	}
+
+	cursorSigningKey, err := configurationService.GetCursorSigningKey()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the cursor signing key", err)
+	}
+
+	maxBatchSize, err := configurationService.GetMaxBatchSize()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the max batch size", err)
+	}
+
+	idempotencyKeyTTL, err := configurationService.GetIdempotencyKeyTTL()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the idempotency key TTL", err)
+	}
+
 	return &businessService{
-		repositoryService: repositoryService,
+		repositoryService:       repositoryService,
+		notifierService:         notifierService,
+		ownershipTransferExpiry: ownershipTransferExpiry,
+		invitationExpiry:        invitationExpiry,
+		cursorSigningKey:        []byte(cursorSigningKey),
+		maxBatchSize:            maxBatchSize,
+		idempotencyKeyTTL:       idempotencyKeyTTL,
 	}, nil
 }
 
-// CreateProject creates a new project.
+// CreateProject creates a new project. Only the owner and the editors of an organization can create projects
+// in the organization. The retries of the request with the same idempotency key return the project created by
+// the original request until the key expires.
 // ctx: Mandatory The reference to the context
 // request: Mandatory. The request to create a new project
 // Returns either the result of creating new project or error if something goes wrong.
 func (service *businessService) CreateProject(
 	ctx context.Context,
 	request *CreateProjectRequest) (*CreateProjectResponse, error) {
-	response, err := service.repositoryService.CreateProject(ctx, &repository.CreateProjectRequest{
+	if err := service.checkCreateProject(ctx, request); err != nil {
+		return &CreateProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	repositoryRequest := repository.CreateProjectRequest{
 		UserEmail: request.UserEmail,
 		Project:   request.Project,
-	})
+		Event:     newDomainEvent(models.DomainEventTypeProjectCreated, request.UserEmail),
+	}
+
+	if request.IdempotencyKey != "" {
+		payloadHash, err := hashCreateProjectPayload(request.Project)
+		if err != nil {
+			return &CreateProjectResponse{
+				Err: err,
+			}, nil
+		}
+
+		repositoryRequest.IdempotencyKey = &repository.IdempotencyKey{
+			Key:         request.IdempotencyKey,
+			PayloadHash: payloadHash,
+			ExpiresAt:   time.Now().UTC().Add(service.idempotencyKeyTTL),
+		}
+	}
+
+	response, err := service.repositoryService.CreateProject(ctx, &repositoryRequest)
 
 	if err != nil {
 		return &CreateProjectResponse{
@@ -47,7 +135,7 @@ func (service *businessService) CreateProject(
 	return &CreateProjectResponse{
 		ProjectID: response.ProjectID,
 		Project:   response.Project,
-		Cursor:    response.Cursor,
+		Cursor:    service.signCursor(response.Cursor),
 	}, nil
 }
 
@@ -74,17 +162,27 @@ func (service *businessService) ReadProject(
 	}, nil
 }
 
-// UpdateProject update an existing project
+// UpdateProject update an existing project. Only the owner and the editors of the project can update it,
+// and the archived projects cannot be updated.
 // ctx: Mandatory The reference to the context
 // request: Mandatory. The request to update an existing project
 // Returns either the result of updateing an existing project or error if something goes wrong.
 func (service *businessService) UpdateProject(
 	ctx context.Context,
 	request *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	if err := service.checkUpdateProject(ctx, request); err != nil {
+		return &UpdateProjectResponse{
+			Err: err,
+		}, nil
+	}
+
 	response, err := service.repositoryService.UpdateProject(ctx, &repository.UpdateProjectRequest{
-		UserEmail: request.UserEmail,
-		ProjectID: request.ProjectID,
-		Project:   request.Project,
+		UserEmail:       request.UserEmail,
+		ProjectID:       request.ProjectID,
+		Project:         request.Project,
+		ExpectedVersion: request.ExpectedVersion,
+		UpdateMask:      request.UpdateMask,
+		Event:           newDomainEvent(models.DomainEventTypeProjectUpdated, request.UserEmail),
 	})
 
 	if err != nil {
@@ -95,20 +193,29 @@ func (service *businessService) UpdateProject(
 
 	return &UpdateProjectResponse{
 		Project: response.Project,
-		Cursor:  response.Cursor,
+		Cursor:  service.signCursor(response.Cursor),
 	}, nil
 }
 
-// DeleteProject delete an existing project
+// DeleteProject delete an existing project. The project is only marked as deleted and can be restored
+// until it is purged. Only the owner of the project can delete it.
 // ctx: Mandatory The reference to the context
 // request: Mandatory. The request to delete an existing project
 // Returns either the result of deleting an existing project or error if something goes wrong.
 func (service *businessService) DeleteProject(
 	ctx context.Context,
 	request *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	if err := service.checkDeleteProject(ctx, request); err != nil {
+		return &DeleteProjectResponse{
+			Err: err,
+		}, nil
+	}
+
 	_, err := service.repositoryService.DeleteProject(ctx, &repository.DeleteProjectRequest{
-		UserEmail: request.UserEmail,
-		ProjectID: request.ProjectID,
+		UserEmail:       request.UserEmail,
+		ProjectID:       request.ProjectID,
+		ExpectedVersion: request.ExpectedVersion,
+		Event:           newDomainEvent(models.DomainEventTypeProjectDeleted, request.UserEmail),
 	})
 
 	if err != nil {
@@ -120,6 +227,406 @@ func (service *businessService) DeleteProject(
 	return &DeleteProjectResponse{}, nil
 }
 
+// BatchCreateProjects creates new projects at once. Every project is authorized as if it was created on its
+// own, and if AllOrNothing is requested, the projects are only created if all of them can be created.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns either the results of creating the projects or error if something goes wrong.
+func (service *businessService) BatchCreateProjects(
+	ctx context.Context,
+	request *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+	if err := service.verifyBatchSize(len(request.Projects)); err != nil {
+		return &BatchCreateProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	requests := make([]CreateProjectRequest, 0, len(request.Projects))
+	for _, project := range request.Projects {
+		requests = append(requests, CreateProjectRequest{
+			UserEmail: request.UserEmail,
+			Project:   project,
+		})
+	}
+
+	results := make([]BatchCreateProjectResult, len(requests))
+
+	if !request.AllOrNothing {
+		for idx := range requests {
+			if err := validateBatchItem(idx, requests[idx]); err != nil {
+				results[idx].Err = err
+
+				continue
+			}
+
+			response, _ := service.CreateProject(ctx, &requests[idx])
+			results[idx] = BatchCreateProjectResult{
+				Err:       response.Err,
+				ProjectID: response.ProjectID,
+				Project:   response.Project,
+				Cursor:    response.Cursor,
+			}
+		}
+
+		return &BatchCreateProjectsResponse{
+			Results: results,
+		}, nil
+	}
+
+	errs := make([]error, len(requests))
+	repositoryRequests := make([]repository.CreateProjectRequest, 0, len(requests))
+	for idx := range requests {
+		if errs[idx] = validateBatchItem(idx, requests[idx]); errs[idx] == nil {
+			errs[idx] = service.checkCreateProject(ctx, &requests[idx])
+		}
+
+		repositoryRequests = append(repositoryRequests, repository.CreateProjectRequest{
+			UserEmail: requests[idx].UserEmail,
+			Project:   requests[idx].Project,
+			Event:     newDomainEvent(models.DomainEventTypeProjectCreated, requests[idx].UserEmail),
+		})
+	}
+
+	if !abortBatch(errs) {
+		response, err := service.repositoryService.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{
+			Requests: repositoryRequests,
+		})
+
+		if err == nil {
+			for idx, createResponse := range response.Responses {
+				results[idx] = BatchCreateProjectResult{
+					ProjectID: createResponse.ProjectID,
+					Project:   createResponse.Project,
+					Cursor:    service.signCursor(createResponse.Cursor),
+				}
+			}
+
+			return &BatchCreateProjectsResponse{
+				Results: results,
+			}, nil
+		}
+
+		if errs = abortBatchItem(len(requests), err); errs == nil {
+			return &BatchCreateProjectsResponse{
+				Err: err,
+			}, nil
+		}
+	}
+
+	for idx, err := range errs {
+		results[idx].Err = err
+	}
+
+	return &BatchCreateProjectsResponse{
+		Results: results,
+	}, nil
+}
+
+// BatchUpdateProjects updates existing projects at once. Every project is authorized as if it was updated on
+// its own, and if AllOrNothing is requested, the projects are only updated if all of them can be updated.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns either the results of updating the projects or error if something goes wrong.
+func (service *businessService) BatchUpdateProjects(
+	ctx context.Context,
+	request *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error) {
+	if err := service.verifyBatchSize(len(request.Projects)); err != nil {
+		return &BatchUpdateProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	requests := make([]UpdateProjectRequest, 0, len(request.Projects))
+	for _, item := range request.Projects {
+		requests = append(requests, UpdateProjectRequest{
+			UserEmail:       request.UserEmail,
+			ProjectID:       item.ProjectID,
+			Project:         item.Project,
+			ExpectedVersion: item.ExpectedVersion,
+			UpdateMask:      item.UpdateMask,
+		})
+	}
+
+	results := make([]BatchUpdateProjectResult, len(requests))
+
+	if !request.AllOrNothing {
+		for idx := range requests {
+			if err := validateBatchItem(idx, requests[idx]); err != nil {
+				results[idx].Err = err
+
+				continue
+			}
+
+			response, _ := service.UpdateProject(ctx, &requests[idx])
+			results[idx] = BatchUpdateProjectResult{
+				Err:     response.Err,
+				Project: response.Project,
+				Cursor:  response.Cursor,
+			}
+		}
+
+		return &BatchUpdateProjectsResponse{
+			Results: results,
+		}, nil
+	}
+
+	errs := make([]error, len(requests))
+	repositoryRequests := make([]repository.UpdateProjectRequest, 0, len(requests))
+	for idx := range requests {
+		if errs[idx] = validateBatchItem(idx, requests[idx]); errs[idx] == nil {
+			errs[idx] = service.checkUpdateProject(ctx, &requests[idx])
+		}
+
+		repositoryRequests = append(repositoryRequests, repository.UpdateProjectRequest{
+			UserEmail:       requests[idx].UserEmail,
+			ProjectID:       requests[idx].ProjectID,
+			Project:         requests[idx].Project,
+			ExpectedVersion: requests[idx].ExpectedVersion,
+			UpdateMask:      requests[idx].UpdateMask,
+			Event:           newDomainEvent(models.DomainEventTypeProjectUpdated, requests[idx].UserEmail),
+		})
+	}
+
+	if !abortBatch(errs) {
+		response, err := service.repositoryService.BatchUpdateProjects(ctx, &repository.BatchUpdateProjectsRequest{
+			Requests: repositoryRequests,
+		})
+
+		if err == nil {
+			for idx, updateResponse := range response.Responses {
+				results[idx] = BatchUpdateProjectResult{
+					Project: updateResponse.Project,
+					Cursor:  service.signCursor(updateResponse.Cursor),
+				}
+			}
+
+			return &BatchUpdateProjectsResponse{
+				Results: results,
+			}, nil
+		}
+
+		if errs = abortBatchItem(len(requests), err); errs == nil {
+			return &BatchUpdateProjectsResponse{
+				Err: err,
+			}, nil
+		}
+	}
+
+	for idx, err := range errs {
+		results[idx].Err = err
+	}
+
+	return &BatchUpdateProjectsResponse{
+		Results: results,
+	}, nil
+}
+
+// BatchDeleteProjects deletes existing projects at once. Every project is authorized as if it was deleted on
+// its own, and if AllOrNothing is requested, the projects are only deleted if all of them can be deleted.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns either the results of deleting the projects or error if something goes wrong.
+func (service *businessService) BatchDeleteProjects(
+	ctx context.Context,
+	request *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error) {
+	if err := service.verifyBatchSize(len(request.Projects)); err != nil {
+		return &BatchDeleteProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	requests := make([]DeleteProjectRequest, 0, len(request.Projects))
+	for _, item := range request.Projects {
+		requests = append(requests, DeleteProjectRequest{
+			UserEmail:       request.UserEmail,
+			ProjectID:       item.ProjectID,
+			ExpectedVersion: item.ExpectedVersion,
+		})
+	}
+
+	results := make([]BatchDeleteProjectResult, len(requests))
+
+	if !request.AllOrNothing {
+		for idx := range requests {
+			if err := validateBatchItem(idx, requests[idx]); err != nil {
+				results[idx].Err = err
+
+				continue
+			}
+
+			response, _ := service.DeleteProject(ctx, &requests[idx])
+			results[idx].Err = response.Err
+		}
+
+		return &BatchDeleteProjectsResponse{
+			Results: results,
+		}, nil
+	}
+
+	errs := make([]error, len(requests))
+	repositoryRequests := make([]repository.DeleteProjectRequest, 0, len(requests))
+	for idx := range requests {
+		if errs[idx] = validateBatchItem(idx, requests[idx]); errs[idx] == nil {
+			errs[idx] = service.checkDeleteProject(ctx, &requests[idx])
+		}
+
+		repositoryRequests = append(repositoryRequests, repository.DeleteProjectRequest{
+			UserEmail:       requests[idx].UserEmail,
+			ProjectID:       requests[idx].ProjectID,
+			ExpectedVersion: requests[idx].ExpectedVersion,
+			Event:           newDomainEvent(models.DomainEventTypeProjectDeleted, requests[idx].UserEmail),
+		})
+	}
+
+	if !abortBatch(errs) {
+		_, err := service.repositoryService.BatchDeleteProjects(ctx, &repository.BatchDeleteProjectsRequest{
+			Requests: repositoryRequests,
+		})
+
+		if err == nil {
+			return &BatchDeleteProjectsResponse{
+				Results: results,
+			}, nil
+		}
+
+		if errs = abortBatchItem(len(requests), err); errs == nil {
+			return &BatchDeleteProjectsResponse{
+				Err: err,
+			}, nil
+		}
+	}
+
+	for idx, err := range errs {
+		results[idx].Err = err
+	}
+
+	return &BatchDeleteProjectsResponse{
+		Results: results,
+	}, nil
+}
+
+// ArchiveProject archives an existing project. The archived project is read-only until it is unarchived.
+// Only the owner and the editors of the project can archive it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to archive an existing project
+// Returns either the result of archiving an existing project or error if something goes wrong.
+func (service *businessService) ArchiveProject(
+	ctx context.Context,
+	request *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleEditor); err != nil {
+		return &ArchiveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+		UserEmail:       request.UserEmail,
+		ProjectID:       request.ProjectID,
+		State:           models.ProjectStateArchived,
+		ExpectedVersion: request.ExpectedVersion,
+		Event:           newDomainEvent(models.DomainEventTypeProjectArchived, request.UserEmail),
+	})
+
+	if err != nil {
+		return &ArchiveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ArchiveProjectResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// UnarchiveProject unarchives an archived project. Only the owner and the editors of the project can unarchive it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to unarchive an archived project
+// Returns either the result of unarchiving an archived project or error if something goes wrong.
+func (service *businessService) UnarchiveProject(
+	ctx context.Context,
+	request *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleEditor); err != nil {
+		return &UnarchiveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+		UserEmail:       request.UserEmail,
+		ProjectID:       request.ProjectID,
+		State:           models.ProjectStateActive,
+		ExpectedVersion: request.ExpectedVersion,
+		Event:           newDomainEvent(models.DomainEventTypeProjectUnarchived, request.UserEmail),
+	})
+
+	if err != nil {
+		return &UnarchiveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UnarchiveProjectResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet. Only the owner of the project can restore it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns either the result of restoring a deleted project or error if something goes wrong.
+func (service *businessService) RestoreProject(
+	ctx context.Context,
+	request *RestoreProjectRequest) (*RestoreProjectResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &RestoreProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.RestoreProject(ctx, &repository.RestoreProjectRequest{
+		UserEmail:       request.UserEmail,
+		ProjectID:       request.ProjectID,
+		ExpectedVersion: request.ExpectedVersion,
+		Event:           newDomainEvent(models.DomainEventTypeProjectRestored, request.UserEmail),
+	})
+
+	if err != nil {
+		return &RestoreProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RestoreProjectResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+// time provided in the request
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the time the projects must have been deleted before
+// Returns either the result of purging the deleted projects or error if something goes wrong.
+func (service *businessService) PurgeDeletedProjects(
+	ctx context.Context,
+	request *PurgeDeletedProjectsRequest) (*PurgeDeletedProjectsResponse, error) {
+	response, err := service.repositoryService.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{
+		DeletedBefore: request.DeletedBefore,
+	})
+
+	if err != nil {
+		return &PurgeDeletedProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &PurgeDeletedProjectsResponse{
+		PurgedCount: response.PurgedCount,
+	}, nil
+}
+
 // ListProjects returns the list of projects that matched the criteria
 // ctx: Mandatory The reference to the context
 // request: Mandatory. The request contains the search criteria
@@ -127,11 +634,23 @@ func (service *businessService) DeleteProject(
 func (service *businessService) ListProjects(
 	ctx context.Context,
 	request *ListProjectsRequest) (*ListProjectsResponse, error) {
+	pagination, err := service.verifyPagination(request.Pagination)
+	if err != nil {
+		return &ListProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
 	result, err := service.repositoryService.ListProjects(ctx, &repository.ListProjectsRequest{
-		UserEmail:      request.UserEmail,
-		Pagination:     request.Pagination,
-		SortingOptions: request.SortingOptions,
-		ProjectIDs:     request.ProjectIDs,
+		UserEmail:         request.UserEmail,
+		Pagination:        pagination,
+		SortingOptions:    request.SortingOptions,
+		ProjectIDs:        request.ProjectIDs,
+		IncludeArchived:   request.IncludeArchived,
+		OrganizationID:    request.OrganizationID,
+		FolderID:          request.FolderID,
+		IncludeSubfolders: request.IncludeSubfolders,
+		Filter:            request.Filter,
 	})
 
 	if err != nil {
@@ -144,6 +663,1198 @@ func (service *businessService) ListProjects(
 		HasPreviousPage: result.HasPreviousPage,
 		HasNextPage:     result.HasNextPage,
 		TotalCount:      result.TotalCount,
-		Projects:        result.Projects,
+		Projects:        service.signProjectCursors(result.Projects),
+	}, nil
+}
+
+// StreamProjects returns an iterator over all the projects that matched the criteria
+// ctx: Mandatory The reference to the context, cancelling it stops reading the projects
+// request: Mandatory. The request contains the search criteria
+// Returns the iterator over the projects that matched the criteria
+func (service *businessService) StreamProjects(
+	ctx context.Context,
+	request *StreamProjectsRequest) (*StreamProjectsResponse, error) {
+	result, err := service.repositoryService.StreamProjects(ctx, &repository.StreamProjectsRequest{
+		UserEmail:         request.UserEmail,
+		SortingOptions:    request.SortingOptions,
+		ProjectIDs:        request.ProjectIDs,
+		IncludeArchived:   request.IncludeArchived,
+		OrganizationID:    request.OrganizationID,
+		FolderID:          request.FolderID,
+		IncludeSubfolders: request.IncludeSubfolders,
+		Filter:            request.Filter,
+	})
+
+	if err != nil {
+		return &StreamProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &StreamProjectsResponse{
+		Projects: &signingProjectIterator{
+			ProjectIterator: result.Projects,
+			service:         service,
+		},
+	}, nil
+}
+
+// WatchProjects watches the changes of the projects the user can see, if the repository service can notify about
+// them. Otherwise UnimplementedError is returned.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the resource version to resume watching from
+// Returns either the watcher over the changes of the projects or error if something goes wrong.
+func (service *businessService) WatchProjects(
+	ctx context.Context,
+	request *WatchProjectsRequest) (*WatchProjectsResponse, error) {
+	watchService, ok := service.repositoryService.(repository.WatchContract)
+	if !ok {
+		return &WatchProjectsResponse{
+			Err: models.NewUnimplementedError(),
+		}, nil
+	}
+
+	result, err := watchService.WatchProjects(ctx, &repository.WatchProjectsRequest{
+		UserEmail:       request.UserEmail,
+		ResourceVersion: request.ResourceVersion,
+	})
+
+	if err != nil {
+		return &WatchProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &WatchProjectsResponse{
+		Watcher: result.Watcher,
+	}, nil
+}
+
+// ListProjectSortFields returns the fields the projects can be sorted on
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the fields the projects can be sorted on
+// Returns the fields the projects can be sorted on
+func (service *businessService) ListProjectSortFields(
+	ctx context.Context,
+	request *ListProjectSortFieldsRequest) (*ListProjectSortFieldsResponse, error) {
+	return &ListProjectSortFieldsResponse{
+		Fields: append([]models.ProjectSortField{}, models.ProjectSortFields...),
+	}, nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *businessService) SearchProjects(
+	ctx context.Context,
+	request *SearchProjectsRequest) (*SearchProjectsResponse, error) {
+	pagination, err := service.verifyPagination(request.Pagination)
+	if err != nil {
+		return &SearchProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	result, err := service.repositoryService.SearchProjects(ctx, &repository.SearchProjectsRequest{
+		UserEmail:       request.UserEmail,
+		Query:           request.Query,
+		Pagination:      pagination,
+		IncludeArchived: request.IncludeArchived,
+	})
+
+	if err != nil {
+		return &SearchProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &SearchProjectsResponse{
+		HasPreviousPage: result.HasPreviousPage,
+		HasNextPage:     result.HasNextPage,
+		TotalCount:      result.TotalCount,
+		Projects:        service.signSearchResultCursors(result.Projects),
 	}, nil
 }
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of deleted projects that matched the criteria
+func (service *businessService) ListDeletedProjects(
+	ctx context.Context,
+	request *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error) {
+	pagination, err := service.verifyPagination(request.Pagination)
+	if err != nil {
+		return &ListDeletedProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	result, err := service.repositoryService.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{
+		UserEmail:      request.UserEmail,
+		Pagination:     pagination,
+		SortingOptions: request.SortingOptions,
+		ProjectIDs:     request.ProjectIDs,
+	})
+
+	if err != nil {
+		return &ListDeletedProjectsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListDeletedProjectsResponse{
+		HasPreviousPage: result.HasPreviousPage,
+		HasNextPage:     result.HasNextPage,
+		TotalCount:      result.TotalCount,
+		Projects:        service.signProjectCursors(result.Projects),
+	}, nil
+}
+
+// AddMember adds a new member to an existing project. Only the owner of the project can add new members.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns either the result of adding a new member or error if something goes wrong.
+func (service *businessService) AddMember(
+	ctx context.Context,
+	request *AddMemberRequest) (*AddMemberResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &AddMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.AddMember(ctx, &repository.AddMemberRequest{
+		ProjectID: request.ProjectID,
+		Member: models.Member{
+			UserEmail: request.MemberEmail,
+			Role:      request.Role,
+		},
+	})
+
+	if err != nil {
+		return &AddMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &AddMemberResponse{
+		Member: response.Member,
+	}, nil
+}
+
+// RemoveMember removes an existing member from a project. Only the owner of the project can remove the
+// other members, but every member can leave the project. The owner cannot be removed from the project.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns either the result of removing an existing member or error if something goes wrong.
+func (service *businessService) RemoveMember(
+	ctx context.Context,
+	request *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	requiredRole := models.MemberRoleOwner
+	if request.MemberEmail == request.UserEmail {
+		requiredRole = models.MemberRoleViewer
+	}
+
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, requiredRole); err != nil {
+		return &RemoveMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err := service.ensureNotOwner(ctx, request.ProjectID, request.MemberEmail, "the owner cannot be removed from the project"); err != nil {
+		return &RemoveMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.repositoryService.RemoveMember(ctx, &repository.RemoveMemberRequest{
+		ProjectID: request.ProjectID,
+		UserEmail: request.MemberEmail,
+	}); err != nil {
+		return &RemoveMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RemoveMemberResponse{}, nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project. Only the owner of the project can
+// change the roles, and the role of the owner cannot be changed.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns either the result of changing the role of an existing member or error if something goes wrong.
+func (service *businessService) UpdateMemberRole(
+	ctx context.Context,
+	request *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &UpdateMemberRoleResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err := service.ensureNotOwner(ctx, request.ProjectID, request.MemberEmail, "the role of the owner cannot be changed"); err != nil {
+		return &UpdateMemberRoleResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.UpdateMemberRole(ctx, &repository.UpdateMemberRoleRequest{
+		ProjectID: request.ProjectID,
+		UserEmail: request.MemberEmail,
+		Role:      request.Role,
+	})
+
+	if err != nil {
+		return &UpdateMemberRoleResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UpdateMemberRoleResponse{
+		Member: response.Member,
+	}, nil
+}
+
+// ListMembers returns the members of an existing project. Every member of the project can list its members.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns either the members of the project or error if something goes wrong.
+func (service *businessService) ListMembers(
+	ctx context.Context,
+	request *ListMembersRequest) (*ListMembersResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleViewer); err != nil {
+		return &ListMembersResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.ListMembers(ctx, &repository.ListMembersRequest{
+		ProjectID: request.ProjectID,
+	})
+
+	if err != nil {
+		return &ListMembersResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListMembersResponse{
+		Members: response.Members,
+	}, nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user. Only the owner of
+// the project can transfer its ownership, the owners of its organization cannot. The ownership is either
+// transferred immediately, or a pending transfer is recorded that the new owner has to accept before it
+// expires. The previous owner stays an editor of the project.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns either the result of transferring the ownership or error if something goes wrong.
+func (service *businessService) TransferProjectOwnership(
+	ctx context.Context,
+	request *TransferProjectOwnershipRequest) (*TransferProjectOwnershipResponse, error) {
+	if err := service.authorizeProjectOwner(ctx, request.ProjectID, request.UserEmail); err != nil {
+		return &TransferProjectOwnershipResponse{
+			Err: err,
+		}, nil
+	}
+
+	if request.NewOwnerEmail == request.UserEmail {
+		return &TransferProjectOwnershipResponse{
+			Err: commonErrors.NewArgumentError("newOwnerEmail", "the user already owns the project"),
+		}, nil
+	}
+
+	now := time.Now().UTC()
+
+	if request.RequireAcceptance {
+		response, err := service.repositoryService.RequestOwnershipTransfer(ctx, &repository.RequestOwnershipTransferRequest{
+			ProjectID: request.ProjectID,
+			Transfer: models.OwnershipTransfer{
+				FromUserEmail: request.UserEmail,
+				ToUserEmail:   request.NewOwnerEmail,
+				RequestedAt:   now,
+				ExpiresAt:     now.Add(service.ownershipTransferExpiry),
+			},
+			AuditEvent: models.AuditEvent{
+				Action:          models.AuditActionOwnershipTransferRequested,
+				UserEmail:       request.UserEmail,
+				TargetUserEmail: request.NewOwnerEmail,
+				OccurredAt:      now,
+			},
+		})
+
+		if err != nil {
+			return &TransferProjectOwnershipResponse{
+				Err: err,
+			}, nil
+		}
+
+		return &TransferProjectOwnershipResponse{
+			PendingTransfer: &response.Transfer,
+		}, nil
+	}
+
+	response, err := service.repositoryService.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+		ProjectID:     request.ProjectID,
+		FromUserEmail: request.UserEmail,
+		ToUserEmail:   request.NewOwnerEmail,
+		AuditEvent: models.AuditEvent{
+			Action:          models.AuditActionOwnershipTransferred,
+			UserEmail:       request.UserEmail,
+			TargetUserEmail: request.NewOwnerEmail,
+			OccurredAt:      now,
+		},
+		Event: newDomainEvent(models.DomainEventTypeProjectOwnershipTransferred, request.UserEmail),
+	})
+
+	if err != nil {
+		return &TransferProjectOwnershipResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &TransferProjectOwnershipResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// AcceptProjectOwnershipTransfer accepts the pending ownership transfer of an existing project. Only the user
+// the project is being transferred to can accept the transfer. The users who cannot accept the transfer and the
+// expired transfers get the not found error.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to accept the pending ownership transfer
+// Returns either the result of accepting the pending ownership transfer or error if something goes wrong.
+func (service *businessService) AcceptProjectOwnershipTransfer(
+	ctx context.Context,
+	request *AcceptProjectOwnershipTransferRequest) (*AcceptProjectOwnershipTransferResponse, error) {
+	readResponse, err := service.repositoryService.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{
+		ProjectID: request.ProjectID,
+	})
+
+	if err != nil {
+		return &AcceptProjectOwnershipTransferResponse{
+			Err: err,
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	transfer := readResponse.Transfer
+
+	if transfer.ToUserEmail != request.UserEmail || transfer.IsExpired(now) {
+		return &AcceptProjectOwnershipTransferResponse{
+			Err: commonErrors.NewNotFoundError(),
+		}, nil
+	}
+
+	response, err := service.repositoryService.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+		ProjectID:     request.ProjectID,
+		FromUserEmail: transfer.FromUserEmail,
+		ToUserEmail:   transfer.ToUserEmail,
+		AuditEvent: models.AuditEvent{
+			Action:          models.AuditActionOwnershipTransferAccepted,
+			UserEmail:       request.UserEmail,
+			TargetUserEmail: transfer.FromUserEmail,
+			OccurredAt:      now,
+		},
+		Event: newDomainEvent(models.DomainEventTypeProjectOwnershipTransferred, request.UserEmail),
+	})
+
+	if err != nil {
+		return &AcceptProjectOwnershipTransferResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &AcceptProjectOwnershipTransferResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// DeclineProjectOwnershipTransfer declines the pending ownership transfer of an existing project. The transfer
+// can be declined by the user the project is being transferred to, or cancelled by the owner who requested it.
+// The other users get the not found error.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to decline the pending ownership transfer
+// Returns either the result of declining the pending ownership transfer or error if something goes wrong.
+func (service *businessService) DeclineProjectOwnershipTransfer(
+	ctx context.Context,
+	request *DeclineProjectOwnershipTransferRequest) (*DeclineProjectOwnershipTransferResponse, error) {
+	readResponse, err := service.repositoryService.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{
+		ProjectID: request.ProjectID,
+	})
+
+	if err != nil {
+		return &DeclineProjectOwnershipTransferResponse{
+			Err: err,
+		}, nil
+	}
+
+	transfer := readResponse.Transfer
+	if transfer.ToUserEmail != request.UserEmail && transfer.FromUserEmail != request.UserEmail {
+		return &DeclineProjectOwnershipTransferResponse{
+			Err: commonErrors.NewNotFoundError(),
+		}, nil
+	}
+
+	if _, err := service.repositoryService.DeleteOwnershipTransfer(ctx, &repository.DeleteOwnershipTransferRequest{
+		ProjectID: request.ProjectID,
+		AuditEvent: models.AuditEvent{
+			Action:          models.AuditActionOwnershipTransferDeclined,
+			UserEmail:       request.UserEmail,
+			TargetUserEmail: transfer.ToUserEmail,
+			OccurredAt:      time.Now().UTC(),
+		},
+	}); err != nil {
+		return &DeclineProjectOwnershipTransferResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &DeclineProjectOwnershipTransferResponse{}, nil
+}
+
+// ListProjectAuditEvents returns the audit trail of an existing project. Every member of the project can list
+// its audit trail.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns either the audit events of the project or error if something goes wrong.
+func (service *businessService) ListProjectAuditEvents(
+	ctx context.Context,
+	request *ListProjectAuditEventsRequest) (*ListProjectAuditEventsResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleViewer); err != nil {
+		return &ListProjectAuditEventsResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{
+		ProjectID: request.ProjectID,
+	})
+
+	if err != nil {
+		return &ListProjectAuditEventsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListProjectAuditEventsResponse{
+		AuditEvents: response.AuditEvents,
+	}, nil
+}
+
+// CreateInvitation invites a user to join an existing project and delivers the invitation to the user. Only the
+// owner of the project can invite the users, and the existing members cannot be invited. Inviting the same user
+// again replaces the pending invitation, so the previously delivered token can no longer be used.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to invite a user to join an existing project
+// Returns either the result of inviting the user or error if something goes wrong.
+func (service *businessService) CreateInvitation(
+	ctx context.Context,
+	request *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &CreateInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.repositoryService.ReadMember(ctx, &repository.ReadMemberRequest{
+		ProjectID: request.ProjectID,
+		UserEmail: request.Email,
+	}); err == nil {
+		return &CreateInvitationResponse{
+			Err: commonErrors.NewAlreadyExistsError(),
+		}, nil
+	} else if !commonErrors.IsNotFoundError(err) {
+		return &CreateInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	token, err := newInvitationToken()
+	if err != nil {
+		return &CreateInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	createdAt := time.Now().UTC()
+	response, err := service.repositoryService.CreateInvitation(ctx, &repository.CreateInvitationRequest{
+		Invitation: models.Invitation{
+			ProjectID: request.ProjectID,
+			Email:     request.Email,
+			Role:      request.Role,
+			InvitedBy: request.UserEmail,
+			CreatedAt: createdAt,
+			ExpiresAt: createdAt.Add(service.invitationExpiry),
+		},
+		TokenHash: hashInvitationToken(token),
+	})
+
+	if err != nil {
+		return &CreateInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err = service.notifierService.SendInvitation(ctx, &notifier.SendInvitationRequest{
+		Invitation: response.Invitation,
+		Token:      token,
+	}); err != nil {
+		return &CreateInvitationResponse{
+			Err: commonErrors.NewUnknownErrorWithError("failed to deliver the invitation", err),
+		}, nil
+	}
+
+	return &CreateInvitationResponse{
+		Invitation: response.Invitation,
+	}, nil
+}
+
+// ListInvitations returns the pending invitations of an existing project. Only the owner of the project can
+// list its pending invitations.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns either the pending invitations of the project or error if something goes wrong.
+func (service *businessService) ListInvitations(
+	ctx context.Context,
+	request *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &ListInvitationsResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.ListInvitations(ctx, &repository.ListInvitationsRequest{
+		ProjectID: request.ProjectID,
+	})
+
+	if err != nil {
+		return &ListInvitationsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListInvitationsResponse{
+		Invitations: response.Invitations,
+	}, nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project. The
+// invitation can only be accepted by the user it was delivered to, the other users get the permission denied
+// error. The expired invitations are treated as if they do not exist.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns either the result of accepting the invitation or error if something goes wrong.
+func (service *businessService) AcceptInvitation(
+	ctx context.Context,
+	request *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	readResponse, err := service.repositoryService.ReadInvitation(ctx, &repository.ReadInvitationRequest{
+		TokenHash: hashInvitationToken(request.Token),
+	})
+
+	if err != nil {
+		return &AcceptInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	invitation := readResponse.Invitation
+	if invitation.Email != request.UserEmail {
+		return &AcceptInvitationResponse{
+			Err: models.NewPermissionDeniedError(),
+		}, nil
+	}
+
+	if invitation.IsExpired(time.Now()) {
+		return &AcceptInvitationResponse{
+			Err: commonErrors.NewNotFoundError(),
+		}, nil
+	}
+
+	response, err := service.repositoryService.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+		ProjectID: invitation.ProjectID,
+		Email:     invitation.Email,
+	})
+
+	if err != nil {
+		return &AcceptInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &AcceptInvitationResponse{
+		ProjectID: invitation.ProjectID,
+		Member:    response.Member,
+	}, nil
+}
+
+// RevokeInvitation revokes a pending invitation of an existing project. Only the owner of the project can
+// revoke its pending invitations.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to revoke a pending invitation
+// Returns either the result of revoking the invitation or error if something goes wrong.
+func (service *businessService) RevokeInvitation(
+	ctx context.Context,
+	request *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &RevokeInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.repositoryService.DeleteInvitation(ctx, &repository.DeleteInvitationRequest{
+		ProjectID: request.ProjectID,
+		Email:     request.Email,
+	}); err != nil {
+		return &RevokeInvitationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RevokeInvitationResponse{}, nil
+}
+
+// CreateOrganization creates a new organization and makes the user who creates it its owner
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns either the result of creating the new organization or error if something goes wrong.
+func (service *businessService) CreateOrganization(
+	ctx context.Context,
+	request *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	response, err := service.repositoryService.CreateOrganization(ctx, &repository.CreateOrganizationRequest{
+		UserEmail:    request.UserEmail,
+		Organization: request.Organization,
+	})
+
+	if err != nil {
+		return &CreateOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &CreateOrganizationResponse{
+		OrganizationID: response.OrganizationID,
+		Organization:   response.Organization,
+	}, nil
+}
+
+// ReadOrganization reads an existing organization. Every member of the organization can read it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns either the result of reading the organization or error if something goes wrong.
+func (service *businessService) ReadOrganization(
+	ctx context.Context,
+	request *ReadOrganizationRequest) (*ReadOrganizationResponse, error) {
+	response, err := service.repositoryService.ReadOrganization(ctx, &repository.ReadOrganizationRequest{
+		UserEmail:      request.UserEmail,
+		OrganizationID: request.OrganizationID,
+	})
+
+	if err != nil {
+		return &ReadOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ReadOrganizationResponse{
+		Organization: response.Organization,
+	}, nil
+}
+
+// UpdateOrganization updates an existing organization. Only the owner of the organization can update it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns either the result of updating the organization or error if something goes wrong.
+func (service *businessService) UpdateOrganization(
+	ctx context.Context,
+	request *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
+	if err := service.authorizeOrganization(ctx, request.OrganizationID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &UpdateOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.UpdateOrganization(ctx, &repository.UpdateOrganizationRequest{
+		UserEmail:      request.UserEmail,
+		OrganizationID: request.OrganizationID,
+		Organization:   request.Organization,
+	})
+
+	if err != nil {
+		return &UpdateOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UpdateOrganizationResponse{
+		Organization: response.Organization,
+	}, nil
+}
+
+// DeleteOrganization deletes an existing organization. Only the owner of the organization can delete it, and
+// the organizations that still have projects, including the deleted projects that are not purged yet, cannot
+// be deleted.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns either the result of deleting the organization or error if something goes wrong.
+func (service *businessService) DeleteOrganization(
+	ctx context.Context,
+	request *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+	if err := service.authorizeOrganization(ctx, request.OrganizationID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &DeleteOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.repositoryService.DeleteOrganization(ctx, &repository.DeleteOrganizationRequest{
+		UserEmail:      request.UserEmail,
+		OrganizationID: request.OrganizationID,
+	}); err != nil {
+		return &DeleteOrganizationResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &DeleteOrganizationResponse{}, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the organizations of the user
+// Returns either the organizations of the user or error if something goes wrong.
+func (service *businessService) ListOrganizations(
+	ctx context.Context,
+	request *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	response, err := service.repositoryService.ListOrganizations(ctx, &repository.ListOrganizationsRequest{
+		UserEmail: request.UserEmail,
+	})
+
+	if err != nil {
+		return &ListOrganizationsResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListOrganizationsResponse{
+		Organizations: response.Organizations,
+	}, nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization. Only the owner of the organization can
+// add new members.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns either the result of adding the new member or error if something goes wrong.
+func (service *businessService) AddOrganizationMember(
+	ctx context.Context,
+	request *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error) {
+	if err := service.authorizeOrganization(ctx, request.OrganizationID, request.UserEmail, models.MemberRoleOwner); err != nil {
+		return &AddOrganizationMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+		OrganizationID: request.OrganizationID,
+		Member: models.Member{
+			UserEmail: request.MemberEmail,
+			Role:      request.Role,
+		},
+	})
+
+	if err != nil {
+		return &AddOrganizationMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &AddOrganizationMemberResponse{
+		Member: response.Member,
+	}, nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization. Only the owner of the organization
+// can remove the other members, but every member can leave the organization. The owner cannot be removed from
+// the organization.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns either the result of removing the member or error if something goes wrong.
+func (service *businessService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	requiredRole := models.MemberRoleOwner
+	if request.MemberEmail == request.UserEmail {
+		requiredRole = models.MemberRoleViewer
+	}
+
+	if err := service.authorizeOrganization(ctx, request.OrganizationID, request.UserEmail, requiredRole); err != nil {
+		return &RemoveOrganizationMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	memberResponse, err := service.repositoryService.ReadOrganizationMember(ctx, &repository.ReadOrganizationMemberRequest{
+		OrganizationID: request.OrganizationID,
+		UserEmail:      request.MemberEmail,
+	})
+
+	if err != nil {
+		return &RemoveOrganizationMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	if memberResponse.Member.Role == models.MemberRoleOwner {
+		return &RemoveOrganizationMemberResponse{
+			Err: commonErrors.NewArgumentError("memberEmail", "the owner cannot be removed from the organization"),
+		}, nil
+	}
+
+	if _, err := service.repositoryService.RemoveOrganizationMember(ctx, &repository.RemoveOrganizationMemberRequest{
+		OrganizationID: request.OrganizationID,
+		UserEmail:      request.MemberEmail,
+	}); err != nil {
+		return &RemoveOrganizationMemberResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RemoveOrganizationMemberResponse{}, nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization. Every member of the organization can
+// list its members.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns either the members of the organization or error if something goes wrong.
+func (service *businessService) ListOrganizationMembers(
+	ctx context.Context,
+	request *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	if err := service.authorizeOrganization(ctx, request.OrganizationID, request.UserEmail, models.MemberRoleViewer); err != nil {
+		return &ListOrganizationMembersResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.ListOrganizationMembers(ctx, &repository.ListOrganizationMembersRequest{
+		OrganizationID: request.OrganizationID,
+	})
+
+	if err != nil {
+		return &ListOrganizationMembersResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListOrganizationMembersResponse{
+		Members: response.Members,
+	}, nil
+}
+
+// CreateFolder creates a new folder to organize the projects of the user. The folders are private to the user
+// who created them, so only the folders of the user can be used as the parent folder.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns either the result of creating the new folder or error if something goes wrong.
+func (service *businessService) CreateFolder(
+	ctx context.Context,
+	request *CreateFolderRequest) (*CreateFolderResponse, error) {
+	response, err := service.repositoryService.CreateFolder(ctx, &repository.CreateFolderRequest{
+		UserEmail: request.UserEmail,
+		Folder:    request.Folder,
+	})
+
+	if err != nil {
+		return &CreateFolderResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &CreateFolderResponse{
+		FolderID: response.FolderID,
+		Folder:   response.Folder,
+	}, nil
+}
+
+// ListFolders returns the folders of the user
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the folders of the user
+// Returns either the folders of the user or error if something goes wrong.
+func (service *businessService) ListFolders(
+	ctx context.Context,
+	request *ListFoldersRequest) (*ListFoldersResponse, error) {
+	response, err := service.repositoryService.ListFolders(ctx, &repository.ListFoldersRequest{
+		UserEmail: request.UserEmail,
+	})
+
+	if err != nil {
+		return &ListFoldersResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListFoldersResponse{
+		Folders: response.Folders,
+	}, nil
+}
+
+// DeleteFolder deletes an existing folder of the user together with its subfolders. The projects in the
+// deleted folders are deleted too if cascade is requested, in which case the user must be the owner of all
+// of them, as if they were deleted one by one.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns either the result of deleting the folder or error if something goes wrong.
+func (service *businessService) DeleteFolder(
+	ctx context.Context,
+	request *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	if request.Cascade {
+		listResponse, err := service.repositoryService.ListProjects(ctx, &repository.ListProjectsRequest{
+			UserEmail:         request.UserEmail,
+			IncludeArchived:   true,
+			FolderID:          request.FolderID,
+			IncludeSubfolders: true,
+		})
+
+		if err != nil {
+			return &DeleteFolderResponse{
+				Err: err,
+			}, nil
+		}
+
+		for _, project := range listResponse.Projects {
+			if err = service.authorize(ctx, project.ProjectID, request.UserEmail, models.MemberRoleOwner); err != nil {
+				return &DeleteFolderResponse{
+					Err: err,
+				}, nil
+			}
+		}
+	}
+
+	if _, err := service.repositoryService.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+		UserEmail: request.UserEmail,
+		FolderID:  request.FolderID,
+		Cascade:   request.Cascade,
+		Event:     newDomainEvent(models.DomainEventTypeProjectDeleted, request.UserEmail),
+	}); err != nil {
+		return &DeleteFolderResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &DeleteFolderResponse{}, nil
+}
+
+// MoveProject moves an existing project into a folder of the user or out of its folder. Only the owner and the
+// editors of the project can move it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns either the result of moving the project or error if something goes wrong.
+func (service *businessService) MoveProject(
+	ctx context.Context,
+	request *MoveProjectRequest) (*MoveProjectResponse, error) {
+	if err := service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleEditor); err != nil {
+		return &MoveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	response, err := service.repositoryService.MoveProject(ctx, &repository.MoveProjectRequest{
+		UserEmail: request.UserEmail,
+		ProjectID: request.ProjectID,
+		FolderID:  request.FolderID,
+		Event:     newDomainEvent(models.DomainEventTypeProjectMoved, request.UserEmail),
+	})
+
+	if err != nil {
+		return &MoveProjectResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &MoveProjectResponse{
+		Project: response.Project,
+		Cursor:  service.signCursor(response.Cursor),
+	}, nil
+}
+
+// checkCreateProject checks whether the user can create the project. Only the owner and the editors of an
+// organization can create projects in the organization.
+func (service *businessService) checkCreateProject(ctx context.Context, request *CreateProjectRequest) error {
+	if request.Project.OrganizationID == "" {
+		return nil
+	}
+
+	return service.authorizeOrganization(ctx, request.Project.OrganizationID, request.UserEmail, models.MemberRoleEditor)
+}
+
+// checkUpdateProject checks whether the user can update the project. Only the owner and the editors of the
+// project can update it. The archived projects are rejected by the repository as part of the update itself, so
+// a project archived concurrently cannot be updated.
+func (service *businessService) checkUpdateProject(ctx context.Context, request *UpdateProjectRequest) error {
+	return service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleEditor)
+}
+
+// checkDeleteProject checks whether the user can delete the project. Only the owner of the project can delete it.
+func (service *businessService) checkDeleteProject(ctx context.Context, request *DeleteProjectRequest) error {
+	return service.authorize(ctx, request.ProjectID, request.UserEmail, models.MemberRoleOwner)
+}
+
+// authorize checks whether the user has the required role or a higher one in the project, either as a member
+// of the project or as a member of the organization of the project, whichever role is higher.
+// The users who are not members of the project get the not found error, so they cannot find out whether the
+// project exists.
+// ctx: Mandatory The reference to the context
+// projectID: Mandatory. The unique project ID
+// userEmail: Mandatory. The email of the user
+// requiredRole: Mandatory. The minimum role the user must have
+// Returns error if the user is not authorized or something goes wrong
+func (service *businessService) authorize(
+	ctx context.Context,
+	projectID string,
+	userEmail string,
+	requiredRole models.MemberRole) error {
+	response, err := service.repositoryService.ReadEffectiveRole(ctx, &repository.ReadEffectiveRoleRequest{
+		ProjectID: projectID,
+		UserEmail: userEmail,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if response.Role < requiredRole {
+		return models.NewPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// authorizeProjectOwner checks whether the user is the owner of the project as a member of the project. The owners
+// of the organization of the project have the owner role in the project too, but they do not own the project.
+// ctx: Mandatory The reference to the context
+// projectID: Mandatory. The unique project ID
+// userEmail: Mandatory. The email of the user
+// Returns error if the user is not the owner of the project or something goes wrong
+func (service *businessService) authorizeProjectOwner(
+	ctx context.Context,
+	projectID string,
+	userEmail string) error {
+	if err := service.authorize(ctx, projectID, userEmail, models.MemberRoleOwner); err != nil {
+		return err
+	}
+
+	response, err := service.repositoryService.ReadMember(ctx, &repository.ReadMemberRequest{
+		ProjectID: projectID,
+		UserEmail: userEmail,
+	})
+
+	if commonErrors.IsNotFoundError(err) {
+		return models.NewPermissionDeniedError()
+	} else if err != nil {
+		return err
+	}
+
+	if response.Member.Role != models.MemberRoleOwner {
+		return models.NewPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// authorizeOrganization checks whether the user is a member of the organization with the required role or a
+// higher one. The users who are not members of the organization get the not found error, so they cannot find
+// out whether the organization exists.
+// ctx: Mandatory The reference to the context
+// organizationID: Mandatory. The unique organization ID
+// userEmail: Mandatory. The email of the user
+// requiredRole: Mandatory. The minimum role the user must have
+// Returns error if the user is not authorized or something goes wrong
+func (service *businessService) authorizeOrganization(
+	ctx context.Context,
+	organizationID string,
+	userEmail string,
+	requiredRole models.MemberRole) error {
+	response, err := service.repositoryService.ReadOrganizationMember(ctx, &repository.ReadOrganizationMemberRequest{
+		OrganizationID: organizationID,
+		UserEmail:      userEmail,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if response.Member.Role < requiredRole {
+		return models.NewPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// ensureNotOwner returns an argument error with the provided message if the member is the owner of the project
+func (service *businessService) ensureNotOwner(
+	ctx context.Context,
+	projectID string,
+	memberEmail string,
+	message string) error {
+	response, err := service.repositoryService.ReadMember(ctx, &repository.ReadMemberRequest{
+		ProjectID: projectID,
+		UserEmail: memberEmail,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if response.Member.Role == models.MemberRoleOwner {
+		return commonErrors.NewArgumentError("memberEmail", message)
+	}
+
+	return nil
+}
+
+// newDomainEvent returns a new event of the change of a project made by the user. The repository writes the
+// event to the outbox together with the change and sets the identifier of the project.
+func newDomainEvent(eventType models.DomainEventType, userEmail string) *models.DomainEvent {
+	return &models.DomainEvent{
+		EventID:    cuid.New(),
+		Type:       eventType,
+		UserEmail:  userEmail,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// newInvitationToken generates a new random invitation token that is safe to be used in URLs
+func newInvitationToken() (string, error) {
+	token := make([]byte, invitationTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", commonErrors.NewUnknownErrorWithError("failed to generate the invitation token", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// hashInvitationToken returns the hash of the invitation token, which is the only form of the token that is stored
+func hashInvitationToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// hashCreateProjectPayload returns the hash of the project to create, which is stored with the idempotency key
+// of the request to detect the requests that reuse the key with a different project
+func hashCreateProjectPayload(project models.Project) (string, error) {
+	payload, err := json.Marshal(project)
+	if err != nil {
+		return "", commonErrors.NewUnknownErrorWithError("failed to hash the project", err)
+	}
+
+	hash := sha256.Sum256(payload)
+
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -2,6 +2,10 @@ package business_test
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"math/rand"
 	"strings"
@@ -10,6 +14,9 @@ import (
 
 	"github.com/decentralized-cloud/project/models"
 	"github.com/decentralized-cloud/project/services/business"
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/notifier"
+	notifierMock "github.com/decentralized-cloud/project/services/notifier/mock"
 	repository "github.com/decentralized-cloud/project/services/repository"
 	repsoitoryMock "github.com/decentralized-cloud/project/services/repository/mock"
 	"github.com/golang/mock/gomock"
@@ -30,17 +37,57 @@ func TestBusinessService(t *testing.T) {
 
 var _ = Describe("Business Service Tests", func() {
 	var (
-		mockCtrl              *gomock.Controller
-		sut                   business.BusinessContract
-		mockRepositoryService *repsoitoryMock.MockRepositoryContract
-		ctx                   context.Context
+		mockCtrl                 *gomock.Controller
+		sut                      business.BusinessContract
+		mockRepositoryService    *repsoitoryMock.MockRepositoryContract
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockNotifierService      *notifierMock.MockNotifierContract
+		ownershipTransferExpiry  time.Duration
+		invitationExpiry         time.Duration
+		cursorSigningKey         string
+		maxBatchSize             int
+		idempotencyKeyTTL        time.Duration
+		ctx                      context.Context
 	)
 
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 
 		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
-		sut, _ = business.NewBusinessService(mockRepositoryService)
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		ownershipTransferExpiry = time.Duration(rand.Intn(100)+1) * time.Hour
+		mockConfigurationService.
+			EXPECT().
+			GetOwnershipTransferExpiry().
+			Return(ownershipTransferExpiry, nil).
+			AnyTimes()
+		invitationExpiry = time.Duration(rand.Intn(100)+1) * time.Hour
+		mockConfigurationService.
+			EXPECT().
+			GetInvitationExpiry().
+			Return(invitationExpiry, nil).
+			AnyTimes()
+		cursorSigningKey = cuid.New()
+		mockConfigurationService.
+			EXPECT().
+			GetCursorSigningKey().
+			Return(cursorSigningKey, nil).
+			AnyTimes()
+		maxBatchSize = rand.Intn(10) + 3
+		mockConfigurationService.
+			EXPECT().
+			GetMaxBatchSize().
+			Return(maxBatchSize, nil).
+			AnyTimes()
+		idempotencyKeyTTL = time.Duration(rand.Intn(100)+1) * time.Hour
+		mockConfigurationService.
+			EXPECT().
+			GetIdempotencyKeyTTL().
+			Return(idempotencyKeyTTL, nil).
+			AnyTimes()
+		mockNotifierService = notifierMock.NewMockNotifierContract(mockCtrl)
+
+		sut, _ = business.NewBusinessService(mockRepositoryService, mockConfigurationService, mockNotifierService)
 		ctx = context.Background()
 	})
 
@@ -51,15 +98,141 @@ var _ = Describe("Business Service Tests", func() {
 	Context("user tries to instantiate BusinessService", func() {
 		When("project repository service is not provided and NewBusinessService is called", func() {
 			It("should return ArgumentNilError", func() {
-				service, err := business.NewBusinessService(nil)
+				service, err := business.NewBusinessService(nil, mockConfigurationService, mockNotifierService)
 				Ω(service).Should(BeNil())
 				assertArgumentNilError("repositoryService", "", err)
 			})
 		})
 
+		When("configuration service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(mockRepositoryService, nil, mockNotifierService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("configuration service fails to return the ownership transfer expiry", func() {
+			It("should return UnknownError", func() {
+				failingConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				failingConfigurationService.
+					EXPECT().
+					GetOwnershipTransferExpiry().
+					Return(time.Duration(0), errors.New(cuid.New()))
+
+				service, err := business.NewBusinessService(mockRepositoryService, failingConfigurationService, mockNotifierService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service fails to return the invitation expiry", func() {
+			It("should return UnknownError", func() {
+				failingConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				failingConfigurationService.
+					EXPECT().
+					GetOwnershipTransferExpiry().
+					Return(ownershipTransferExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetInvitationExpiry().
+					Return(time.Duration(0), errors.New(cuid.New()))
+
+				service, err := business.NewBusinessService(mockRepositoryService, failingConfigurationService, mockNotifierService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service fails to return the cursor signing key", func() {
+			It("should return UnknownError", func() {
+				failingConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				failingConfigurationService.
+					EXPECT().
+					GetOwnershipTransferExpiry().
+					Return(ownershipTransferExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetInvitationExpiry().
+					Return(invitationExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetCursorSigningKey().
+					Return("", errors.New(cuid.New()))
+
+				service, err := business.NewBusinessService(mockRepositoryService, failingConfigurationService, mockNotifierService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service fails to return the max batch size", func() {
+			It("should return UnknownError", func() {
+				failingConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				failingConfigurationService.
+					EXPECT().
+					GetOwnershipTransferExpiry().
+					Return(ownershipTransferExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetInvitationExpiry().
+					Return(invitationExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetCursorSigningKey().
+					Return(cursorSigningKey, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetMaxBatchSize().
+					Return(0, errors.New(cuid.New()))
+
+				service, err := business.NewBusinessService(mockRepositoryService, failingConfigurationService, mockNotifierService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service fails to return the idempotency key TTL", func() {
+			It("should return UnknownError", func() {
+				failingConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				failingConfigurationService.
+					EXPECT().
+					GetOwnershipTransferExpiry().
+					Return(ownershipTransferExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetInvitationExpiry().
+					Return(invitationExpiry, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetCursorSigningKey().
+					Return(cursorSigningKey, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetMaxBatchSize().
+					Return(maxBatchSize, nil)
+				failingConfigurationService.
+					EXPECT().
+					GetIdempotencyKeyTTL().
+					Return(time.Duration(0), errors.New(cuid.New()))
+
+				service, err := business.NewBusinessService(mockRepositoryService, failingConfigurationService, mockNotifierService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+
+		When("notifier service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(mockRepositoryService, mockConfigurationService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("notifierService", "", err)
+			})
+		})
+
 		When("all dependencies are resolved and NewBusinessService is called", func() {
 			It("should instantiate the new BusinessService", func() {
-				service, err := business.NewBusinessService(mockRepositoryService)
+				service, err := business.NewBusinessService(mockRepositoryService, mockConfigurationService, mockNotifierService)
 				Ω(err).Should(BeNil())
 				Ω(service).ShouldNot(BeNil())
 			})
@@ -86,6 +259,8 @@ var _ = Describe("Business Service Tests", func() {
 						CreateProject(ctx, gomock.Any()).
 						Do(func(_ context.Context, mappedRequest *repository.CreateProjectRequest) {
 							Ω(mappedRequest.Project).Should(Equal(request.Project))
+							Ω(mappedRequest.IdempotencyKey).Should(BeNil())
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectCreated, request.UserEmail)
 						}).
 						Return(&repository.CreateProjectResponse{}, nil)
 
@@ -94,6 +269,55 @@ var _ = Describe("Business Service Tests", func() {
 					Ω(response.Err).Should(BeNil())
 				})
 
+				When("the request has an idempotency key", func() {
+					BeforeEach(func() {
+						request.IdempotencyKey = cuid.New()
+					})
+
+					It("should pass the idempotency key to project repository CreateProject method", func() {
+						var payloadHashes []string
+
+						before := time.Now()
+						mockRepositoryService.
+							EXPECT().
+							CreateProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *repository.CreateProjectRequest) {
+								Ω(mappedRequest.IdempotencyKey).ShouldNot(BeNil())
+								Ω(mappedRequest.IdempotencyKey.Key).Should(Equal(request.IdempotencyKey))
+								Ω(mappedRequest.IdempotencyKey.PayloadHash).ShouldNot(BeEmpty())
+								Ω(mappedRequest.IdempotencyKey.ExpiresAt).Should(BeTemporally(">=", before.Add(idempotencyKeyTTL)))
+								Ω(mappedRequest.IdempotencyKey.ExpiresAt).Should(BeTemporally("<=", time.Now().Add(idempotencyKeyTTL)))
+								payloadHashes = append(payloadHashes, mappedRequest.IdempotencyKey.PayloadHash)
+							}).
+							Return(&repository.CreateProjectResponse{}, nil).
+							Times(3)
+
+						_, err := sut.CreateProject(ctx, &request)
+						Ω(err).Should(BeNil())
+
+						_, err = sut.CreateProject(ctx, &request)
+						Ω(err).Should(BeNil())
+
+						request.Project.Description = cuid.New()
+						_, err = sut.CreateProject(ctx, &request)
+						Ω(err).Should(BeNil())
+
+						Ω(payloadHashes[1]).Should(Equal(payloadHashes[0]))
+						Ω(payloadHashes[2]).ShouldNot(Equal(payloadHashes[0]))
+					})
+
+					It("should return IdempotencyKeyReusedError if project repository CreateProject returns it", func() {
+						mockRepositoryService.
+							EXPECT().
+							CreateProject(gomock.Any(), gomock.Any()).
+							Return(nil, models.NewIdempotencyKeyReusedError())
+
+						response, err := sut.CreateProject(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(models.IsIdempotencyKeyReusedError(response.Err)).Should(BeTrue())
+					})
+				})
+
 				When("And project repository CreateProject returns error", func() {
 					It("should return the same error", func() {
 						expectedError := errors.New(cuid.New())
@@ -129,10 +353,65 @@ var _ = Describe("Business Service Tests", func() {
 						Ω(response.ProjectID).ShouldNot(BeNil())
 						Ω(response.ProjectID).Should(Equal(expectedResponse.ProjectID))
 						Ω(response.Project).Should(Equal(expectedResponse.Project))
+						Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
 					})
 				})
 			})
 		})
+
+		Context("project is created in an organization", func() {
+			BeforeEach(func() {
+				request.UserEmail = cuid.New() + "@test.com"
+				request.Project.OrganizationID = cuid.New()
+			})
+
+			When("the user is an editor of the organization", func() {
+				It("should call project repository CreateProject method", func() {
+					mockRepositoryService.
+						EXPECT().
+						ReadOrganizationMember(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ReadOrganizationMemberRequest) {
+							Ω(mappedRequest.OrganizationID).Should(Equal(request.Project.OrganizationID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						}).
+						Return(&repository.ReadOrganizationMemberResponse{Member: models.Member{Role: models.MemberRoleEditor}}, nil)
+					mockRepositoryService.
+						EXPECT().
+						CreateProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.CreateProjectRequest) {
+							Ω(mappedRequest.Project.OrganizationID).Should(Equal(request.Project.OrganizationID))
+						}).
+						Return(&repository.CreateProjectResponse{}, nil)
+
+					response, err := sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the user is a viewer of the organization", func() {
+				It("should return PermissionDeniedError without creating the project", func() {
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the user is not a member of the organization", func() {
+				It("should return NotFoundError without creating the project", func() {
+					mockRepositoryService.
+						EXPECT().
+						ReadOrganizationMember(gomock.Any(), gomock.Any()).
+						Return(nil, commonErrors.NewNotFoundError())
+
+					response, err := sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
 	})
 
 	Describe("ReadProject", func() {
@@ -204,12 +483,18 @@ var _ = Describe("Business Service Tests", func() {
 
 		BeforeEach(func() {
 			request = business.UpdateProjectRequest{
-				ProjectID: cuid.New(),
-				Project:   models.Project{Name: cuid.New()},
+				ProjectID:       cuid.New(),
+				Project:         models.Project{Name: cuid.New()},
+				ExpectedVersion: rand.Int63n(100) + 1,
+				UpdateMask:      []string{models.ProjectNamePath},
 			}
 		})
 
 		Context("project service is instantiated", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+			})
+
 			When("UpdateProject is called", func() {
 				It("should call project repository UpdateProject method", func() {
 					mockRepositoryService.
@@ -218,6 +503,9 @@ var _ = Describe("Business Service Tests", func() {
 						Do(func(_ context.Context, mappedRequest *repository.UpdateProjectRequest) {
 							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
 							Ω(mappedRequest.Project.Name).Should(Equal(request.Project.Name))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.ExpectedVersion))
+							Ω(mappedRequest.UpdateMask).Should(Equal(request.UpdateMask))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectUpdated, request.UserEmail)
 						}).
 						Return(&repository.UpdateProjectResponse{}, nil)
 
@@ -227,6 +515,19 @@ var _ = Describe("Business Service Tests", func() {
 				})
 			})
 
+			When("And project repository UpdateProject returns ProjectArchivedError", func() {
+				It("should return ProjectArchivedError", func() {
+					mockRepositoryService.
+						EXPECT().
+						UpdateProject(gomock.Any(), gomock.Any()).
+						Return(nil, models.NewProjectArchivedError())
+
+					response, err := sut.UpdateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsProjectArchivedError(response.Err)).Should(BeTrue())
+				})
+			})
+
 			When("And project repository UpdateProject returns error", func() {
 				It("should return the same error", func() {
 					expectedError := errors.New(cuid.New())
@@ -261,6 +562,36 @@ var _ = Describe("Business Service Tests", func() {
 				})
 			})
 		})
+
+		Context("user is not allowed to update the project", func() {
+			When("the user is not a member of the project", func() {
+				It("should return the same error without updating the project", func() {
+					expectedError := commonErrors.NewNotFoundError()
+					mockRepositoryService.
+						EXPECT().
+						ReadEffectiveRole(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ReadEffectiveRoleRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						}).
+						Return(nil, expectedError)
+
+					response, err := sut.UpdateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("the user is a viewer of the project", func() {
+				It("should return PermissionDeniedError without updating the project", func() {
+					expectRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.UpdateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
 	})
 
 	Describe("DeleteProject is called", func() {
@@ -270,11 +601,16 @@ var _ = Describe("Business Service Tests", func() {
 
 		BeforeEach(func() {
 			request = business.DeleteProjectRequest{
-				ProjectID: cuid.New(),
+				ProjectID:       cuid.New(),
+				ExpectedVersion: rand.Int63n(100) + 1,
 			}
 		})
 
 		Context("project service is instantiated", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
 			When("DeleteProject is called", func() {
 				It("should call project repository DeleteProject method", func() {
 					mockRepositoryService.
@@ -282,6 +618,8 @@ var _ = Describe("Business Service Tests", func() {
 						DeleteProject(ctx, gomock.Any()).
 						Do(func(_ context.Context, mappedRequest *repository.DeleteProjectRequest) {
 							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.ExpectedVersion))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectDeleted, request.UserEmail)
 						}).
 						Return(&repository.DeleteProjectResponse{}, nil)
 
@@ -318,132 +656,3087 @@ var _ = Describe("Business Service Tests", func() {
 				})
 			})
 		})
+
+		Context("user is not allowed to delete the project", func() {
+			When("the user is an editor of the project", func() {
+				It("should return PermissionDeniedError without deleting the project", func() {
+					expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+					response, err := sut.DeleteProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
 	})
 
-	Describe("ListProjects is called", func() {
+	Describe("BatchCreateProjects is called", func() {
 		var (
-			request    business.ListProjectsRequest
-			projectIDs []string
+			request business.BatchCreateProjectsRequest
 		)
 
 		BeforeEach(func() {
-			projectIDs = []string{}
-			for idx := 0; idx < rand.Intn(20)+1; idx++ {
-				projectIDs = append(projectIDs, cuid.New())
-			}
-
-			request = business.ListProjectsRequest{
-				Pagination: common.Pagination{
-					After:  convertStringToPointer(cuid.New()),
-					First:  convertIntToPointer(rand.Intn(1000)),
-					Before: convertStringToPointer(cuid.New()),
-					Last:   convertIntToPointer(rand.Intn(1000)),
-				},
-				SortingOptions: []common.SortingOptionPair{
-					common.SortingOptionPair{
-						Name:      cuid.New(),
-						Direction: common.Ascending,
-					},
-					common.SortingOptionPair{
-						Name:      cuid.New(),
-						Direction: common.Descending,
-					},
+			request = business.BatchCreateProjectsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				Projects: []models.Project{
+					{Name: cuid.New()},
+					{Name: cuid.New()},
 				},
-				ProjectIDs: projectIDs,
 			}
 		})
 
-		Context("project service is instantiated", func() {
-			When("ListProjects is called", func() {
-				It("should call project repository ListProjects method", func() {
+		When("the batch contains more projects than the max batch size", func() {
+			It("should return ArgumentError without creating the projects", func() {
+				request.Projects = make([]models.Project, maxBatchSize+1)
+
+				response, err := sut.BatchCreateProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				assertArgumentError("projects", "", response.Err)
+			})
+		})
+
+		Context("all-or-nothing is not requested", func() {
+			It("should create the projects one by one and return the result of every project", func() {
+				expectedError := errors.New(cuid.New())
+				expectedResponse := repository.CreateProjectResponse{
+					ProjectID: cuid.New(),
+					Project:   request.Projects[1],
+					Cursor:    cuid.New(),
+				}
+				request.Projects = append(request.Projects, models.Project{})
+
+				gomock.InOrder(
 					mockRepositoryService.
 						EXPECT().
-						ListProjects(ctx, gomock.Any()).
-						Do(func(_ context.Context, mappedRequest *repository.ListProjectsRequest) {
-							Ω(mappedRequest.Pagination).Should(Equal(request.Pagination))
-							Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
-							Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+						CreateProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.CreateProjectRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.Project).Should(Equal(request.Projects[0]))
 						}).
-						Return(&repository.ListProjectsResponse{}, nil)
+						Return(nil, expectedError),
+					mockRepositoryService.
+						EXPECT().
+						CreateProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.CreateProjectRequest) {
+							Ω(mappedRequest.Project).Should(Equal(request.Projects[1]))
+						}).
+						Return(&expectedResponse, nil),
+				)
 
-					response, err := sut.ListProjects(ctx, &request)
+				response, err := sut.BatchCreateProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Results).Should(HaveLen(3))
+				Ω(response.Results[0].Err).Should(Equal(expectedError))
+				Ω(response.Results[1].Err).Should(BeNil())
+				Ω(response.Results[1].ProjectID).Should(Equal(expectedResponse.ProjectID))
+				Ω(response.Results[1].Project).Should(Equal(expectedResponse.Project))
+				Ω(response.Results[1].Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				assertArgumentError("projects[2]", "", response.Results[2].Err)
+			})
+		})
+
+		Context("all-or-nothing is requested", func() {
+			BeforeEach(func() {
+				request.AllOrNothing = true
+			})
+
+			When("one of the projects is invalid", func() {
+				It("should abort the other projects without creating any project", func() {
+					request.Projects[1].Name = ""
+
+					response, err := sut.BatchCreateProjects(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(BeNil())
+					Ω(models.IsBatchAbortedError(response.Results[0].Err)).Should(BeTrue())
+					assertArgumentError("projects[1]", "", response.Results[1].Err)
 				})
 			})
 
-			When("project repository ListProjects returns error", func() {
+			When("the user is not allowed to create one of the projects", func() {
+				It("should abort the other projects without creating any project", func() {
+					request.Projects[0].OrganizationID = cuid.New()
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.BatchCreateProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Results[0].Err)).Should(BeTrue())
+					Ω(models.IsBatchAbortedError(response.Results[1].Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository BatchCreateProjects fails to create one of the projects", func() {
+				It("should return the error of the project and abort the other projects", func() {
+					expectedError := commonErrors.NewAlreadyExistsError()
+					mockRepositoryService.
+						EXPECT().
+						BatchCreateProjects(ctx, gomock.Any()).
+						Return(nil, repository.NewBatchItemError(1, expectedError))
+
+					response, err := sut.BatchCreateProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(models.IsBatchAbortedError(response.Results[0].Err)).Should(BeTrue())
+					Ω(response.Results[1].Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository BatchCreateProjects returns error", func() {
 				It("should return the same error", func() {
 					expectedError := errors.New(cuid.New())
 					mockRepositoryService.
 						EXPECT().
-						ListProjects(gomock.Any(), gomock.Any()).
+						BatchCreateProjects(gomock.Any(), gomock.Any()).
 						Return(nil, expectedError)
 
-					response, err := sut.ListProjects(ctx, &request)
+					response, err := sut.BatchCreateProjects(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(Equal(expectedError))
 				})
 			})
 
-			When("project repository ListProjects completes successfully", func() {
-				It("should return the list of matched projectIDs", func() {
-					projects := []models.ProjectWithCursor{}
-
-					for idx := 0; idx < rand.Intn(20)+1; idx++ {
-						projects = append(projects, models.ProjectWithCursor{
-							ProjectID: cuid.New(),
-							Project: models.Project{
-								Name: cuid.New(),
-							},
-							Cursor: cuid.New(),
-						})
-					}
-
-					expectedResponse := repository.ListProjectsResponse{
-						HasPreviousPage: (rand.Intn(10) % 2) == 0,
-						HasNextPage:     (rand.Intn(10) % 2) == 0,
-						TotalCount:      rand.Int63n(1000),
-						Projects:        projects,
+			When("project repository BatchCreateProjects return no error", func() {
+				It("should return the created projects", func() {
+					expectedResponse := repository.BatchCreateProjectsResponse{
+						Responses: []repository.CreateProjectResponse{
+							{ProjectID: cuid.New(), Project: request.Projects[0], Cursor: cuid.New()},
+							{ProjectID: cuid.New(), Project: request.Projects[1], Cursor: cuid.New()},
+						},
 					}
-
 					mockRepositoryService.
 						EXPECT().
-						ListProjects(gomock.Any(), gomock.Any()).
+						BatchCreateProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.BatchCreateProjectsRequest) {
+							Ω(mappedRequest.Requests).Should(HaveLen(len(request.Projects)))
+							for idx, createRequest := range mappedRequest.Requests {
+								Ω(createRequest.UserEmail).Should(Equal(request.UserEmail))
+								Ω(createRequest.Project).Should(Equal(request.Projects[idx]))
+								assertDomainEvent(createRequest.Event, models.DomainEventTypeProjectCreated, request.UserEmail)
+							}
+						}).
 						Return(&expectedResponse, nil)
 
-					response, err := sut.ListProjects(ctx, &request)
+					response, err := sut.BatchCreateProjects(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(BeNil())
-					Ω(response.HasPreviousPage).Should(Equal(expectedResponse.HasPreviousPage))
-					Ω(response.HasNextPage).Should(Equal(expectedResponse.HasNextPage))
-					Ω(response.TotalCount).Should(Equal(expectedResponse.TotalCount))
-					Ω(response.Projects).Should(Equal(expectedResponse.Projects))
+					Ω(response.Results).Should(HaveLen(len(expectedResponse.Responses)))
+					for idx, result := range response.Results {
+						Ω(result.Err).Should(BeNil())
+						Ω(result.ProjectID).Should(Equal(expectedResponse.Responses[idx].ProjectID))
+						Ω(result.Project).Should(Equal(expectedResponse.Responses[idx].Project))
+						Ω(result.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Responses[idx].Cursor)))
+					}
 				})
 			})
 		})
 	})
-})
 
-func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
-	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+	Describe("BatchUpdateProjects is called", func() {
+		var (
+			request business.BatchUpdateProjectsRequest
+		)
 
-	var argumentNilErr commonErrors.ArgumentNilError
-	_ = errors.As(err, &argumentNilErr)
+		BeforeEach(func() {
+			request = business.BatchUpdateProjectsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				Projects: []business.BatchUpdateProjectItem{
+					{ProjectID: cuid.New(), Project: models.Project{Name: cuid.New()}, ExpectedVersion: rand.Int63n(100) + 1},
+					{ProjectID: cuid.New(), Project: models.Project{Description: cuid.New()}, UpdateMask: []string{models.ProjectDescriptionPath}},
+				},
+			}
+		})
 
-	if expectedArgumentName != "" {
-		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
-	}
+		When("the batch contains more projects than the max batch size", func() {
+			It("should return ArgumentError without updating the projects", func() {
+				request.Projects = make([]business.BatchUpdateProjectItem, maxBatchSize+1)
 
-	if expectedMessage != "" {
-		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
-	}
-}
+				response, err := sut.BatchUpdateProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				assertArgumentError("projects", "", response.Err)
+			})
+		})
 
-func convertStringToPointer(str string) *string {
-	return &str
-}
+		Context("all-or-nothing is not requested", func() {
+			It("should update the projects one by one and return the result of every project", func() {
+				expectedError := errors.New(cuid.New())
+				expectedResponse := repository.UpdateProjectResponse{
+					Project: models.Project{Description: request.Projects[1].Project.Description},
+					Cursor:  cuid.New(),
+				}
+				request.Projects = append(request.Projects, business.BatchUpdateProjectItem{})
 
-func convertIntToPointer(i int) *int {
-	return &i
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						UpdateProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.UpdateProjectRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ProjectID).Should(Equal(request.Projects[0].ProjectID))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.Projects[0].ExpectedVersion))
+						}).
+						Return(nil, expectedError),
+					mockRepositoryService.
+						EXPECT().
+						UpdateProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.UpdateProjectRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.Projects[1].ProjectID))
+							Ω(mappedRequest.UpdateMask).Should(Equal(request.Projects[1].UpdateMask))
+						}).
+						Return(&expectedResponse, nil),
+				)
+
+				response, err := sut.BatchUpdateProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Results).Should(HaveLen(3))
+				Ω(response.Results[0].Err).Should(Equal(expectedError))
+				Ω(response.Results[1].Err).Should(BeNil())
+				Ω(response.Results[1].Project).Should(Equal(expectedResponse.Project))
+				Ω(response.Results[1].Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				assertArgumentError("projects[2]", "", response.Results[2].Err)
+			})
+		})
+
+		Context("all-or-nothing is requested", func() {
+			BeforeEach(func() {
+				request.AllOrNothing = true
+			})
+
+			Context("the user is allowed to update all the projects", func() {
+				BeforeEach(func() {
+					expectRole(mockRepositoryService, models.MemberRoleEditor)
+					expectRole(mockRepositoryService, models.MemberRoleEditor)
+				})
+
+				When("one of the projects is archived", func() {
+					It("should return ProjectArchivedError for the project and abort the other projects", func() {
+						mockRepositoryService.
+							EXPECT().
+							BatchUpdateProjects(ctx, gomock.Any()).
+							Return(nil, repository.NewBatchItemError(1, models.NewProjectArchivedError()))
+
+						response, err := sut.BatchUpdateProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+						Ω(models.IsBatchAbortedError(response.Results[0].Err)).Should(BeTrue())
+						Ω(models.IsProjectArchivedError(response.Results[1].Err)).Should(BeTrue())
+					})
+				})
+
+				When("project repository BatchUpdateProjects fails to update one of the projects", func() {
+					It("should return the error of the project and abort the other projects", func() {
+						expectedError := models.NewConflictError()
+						mockRepositoryService.
+							EXPECT().
+							BatchUpdateProjects(ctx, gomock.Any()).
+							Return(nil, repository.NewBatchItemError(0, expectedError))
+
+						response, err := sut.BatchUpdateProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+						Ω(response.Results[0].Err).Should(Equal(expectedError))
+						Ω(models.IsBatchAbortedError(response.Results[1].Err)).Should(BeTrue())
+					})
+				})
+
+				When("project repository BatchUpdateProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedError := errors.New(cuid.New())
+						mockRepositoryService.
+							EXPECT().
+							BatchUpdateProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedError)
+
+						response, err := sut.BatchUpdateProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(Equal(expectedError))
+					})
+				})
+
+				When("project repository BatchUpdateProjects return no error", func() {
+					It("should return the updated projects", func() {
+						expectedResponse := repository.BatchUpdateProjectsResponse{
+							Responses: []repository.UpdateProjectResponse{
+								{Project: request.Projects[0].Project, Cursor: cuid.New()},
+								{Project: request.Projects[1].Project, Cursor: cuid.New()},
+							},
+						}
+						mockRepositoryService.
+							EXPECT().
+							BatchUpdateProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *repository.BatchUpdateProjectsRequest) {
+								Ω(mappedRequest.Requests).Should(HaveLen(len(request.Projects)))
+								for idx, updateRequest := range mappedRequest.Requests {
+									Ω(updateRequest.UserEmail).Should(Equal(request.UserEmail))
+									Ω(updateRequest.ProjectID).Should(Equal(request.Projects[idx].ProjectID))
+									Ω(updateRequest.Project).Should(Equal(request.Projects[idx].Project))
+									Ω(updateRequest.ExpectedVersion).Should(Equal(request.Projects[idx].ExpectedVersion))
+									Ω(updateRequest.UpdateMask).Should(Equal(request.Projects[idx].UpdateMask))
+									assertDomainEvent(updateRequest.Event, models.DomainEventTypeProjectUpdated, request.UserEmail)
+								}
+							}).
+							Return(&expectedResponse, nil)
+
+						response, err := sut.BatchUpdateProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+						Ω(response.Results).Should(HaveLen(len(expectedResponse.Responses)))
+						for idx, result := range response.Results {
+							Ω(result.Err).Should(BeNil())
+							Ω(result.Project).Should(Equal(expectedResponse.Responses[idx].Project))
+							Ω(result.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Responses[idx].Cursor)))
+						}
+					})
+				})
+			})
+		})
+	})
+
+	Describe("BatchDeleteProjects is called", func() {
+		var (
+			request business.BatchDeleteProjectsRequest
+		)
+
+		BeforeEach(func() {
+			request = business.BatchDeleteProjectsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				Projects: []business.BatchDeleteProjectItem{
+					{ProjectID: cuid.New(), ExpectedVersion: rand.Int63n(100) + 1},
+					{ProjectID: cuid.New()},
+				},
+			}
+		})
+
+		When("the batch contains more projects than the max batch size", func() {
+			It("should return ArgumentError without deleting the projects", func() {
+				request.Projects = make([]business.BatchDeleteProjectItem, maxBatchSize+1)
+
+				response, err := sut.BatchDeleteProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				assertArgumentError("projects", "", response.Err)
+			})
+		})
+
+		Context("all-or-nothing is not requested", func() {
+			It("should delete the projects one by one and return the result of every project", func() {
+				request.Projects = append(request.Projects, business.BatchDeleteProjectItem{})
+
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						ReadEffectiveRole(gomock.Any(), gomock.Any()).
+						Return(&repository.ReadEffectiveRoleResponse{Role: models.MemberRoleEditor}, nil),
+					mockRepositoryService.
+						EXPECT().
+						ReadEffectiveRole(gomock.Any(), gomock.Any()).
+						Return(&repository.ReadEffectiveRoleResponse{Role: models.MemberRoleOwner}, nil),
+				)
+				mockRepositoryService.
+					EXPECT().
+					DeleteProject(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.DeleteProjectRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.ProjectID).Should(Equal(request.Projects[1].ProjectID))
+						Ω(mappedRequest.ExpectedVersion).Should(Equal(request.Projects[1].ExpectedVersion))
+					}).
+					Return(&repository.DeleteProjectResponse{}, nil)
+
+				response, err := sut.BatchDeleteProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Results).Should(HaveLen(3))
+				Ω(models.IsPermissionDeniedError(response.Results[0].Err)).Should(BeTrue())
+				Ω(response.Results[1].Err).Should(BeNil())
+				assertArgumentError("projects[2]", "", response.Results[2].Err)
+			})
+		})
+
+		Context("all-or-nothing is requested", func() {
+			BeforeEach(func() {
+				request.AllOrNothing = true
+			})
+
+			When("the user is not allowed to delete one of the projects", func() {
+				It("should abort the other projects without deleting any project", func() {
+					gomock.InOrder(
+						mockRepositoryService.
+							EXPECT().
+							ReadEffectiveRole(gomock.Any(), gomock.Any()).
+							Return(&repository.ReadEffectiveRoleResponse{Role: models.MemberRoleOwner}, nil),
+						mockRepositoryService.
+							EXPECT().
+							ReadEffectiveRole(gomock.Any(), gomock.Any()).
+							Return(&repository.ReadEffectiveRoleResponse{Role: models.MemberRoleViewer}, nil),
+					)
+
+					response, err := sut.BatchDeleteProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(models.IsBatchAbortedError(response.Results[0].Err)).Should(BeTrue())
+					Ω(models.IsPermissionDeniedError(response.Results[1].Err)).Should(BeTrue())
+				})
+			})
+
+			Context("the user is the owner of all the projects", func() {
+				BeforeEach(func() {
+					expectRole(mockRepositoryService, models.MemberRoleOwner)
+					expectRole(mockRepositoryService, models.MemberRoleOwner)
+				})
+
+				When("project repository BatchDeleteProjects fails to delete one of the projects", func() {
+					It("should return the error of the project and abort the other projects", func() {
+						expectedError := commonErrors.NewNotFoundError()
+						mockRepositoryService.
+							EXPECT().
+							BatchDeleteProjects(ctx, gomock.Any()).
+							Return(nil, repository.NewBatchItemError(1, expectedError))
+
+						response, err := sut.BatchDeleteProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+						Ω(models.IsBatchAbortedError(response.Results[0].Err)).Should(BeTrue())
+						Ω(response.Results[1].Err).Should(Equal(expectedError))
+					})
+				})
+
+				When("project repository BatchDeleteProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedError := errors.New(cuid.New())
+						mockRepositoryService.
+							EXPECT().
+							BatchDeleteProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedError)
+
+						response, err := sut.BatchDeleteProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(Equal(expectedError))
+					})
+				})
+
+				When("project repository BatchDeleteProjects return no error", func() {
+					It("should call project repository BatchDeleteProjects method", func() {
+						mockRepositoryService.
+							EXPECT().
+							BatchDeleteProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *repository.BatchDeleteProjectsRequest) {
+								Ω(mappedRequest.Requests).Should(HaveLen(len(request.Projects)))
+								for idx, deleteRequest := range mappedRequest.Requests {
+									Ω(deleteRequest.UserEmail).Should(Equal(request.UserEmail))
+									Ω(deleteRequest.ProjectID).Should(Equal(request.Projects[idx].ProjectID))
+									Ω(deleteRequest.ExpectedVersion).Should(Equal(request.Projects[idx].ExpectedVersion))
+									assertDomainEvent(deleteRequest.Event, models.DomainEventTypeProjectDeleted, request.UserEmail)
+								}
+							}).
+							Return(&repository.BatchDeleteProjectsResponse{}, nil)
+
+						response, err := sut.BatchDeleteProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+						Ω(response.Results).Should(HaveLen(len(request.Projects)))
+						for _, result := range response.Results {
+							Ω(result.Err).Should(BeNil())
+						}
+					})
+				})
+			})
+		})
+	})
+
+	Describe("ListProjects is called", func() {
+		var (
+			request              business.ListProjectsRequest
+			repositoryPagination common.Pagination
+			projectIDs           []string
+		)
+
+		BeforeEach(func() {
+			projectIDs = []string{}
+			for idx := 0; idx < rand.Intn(20)+1; idx++ {
+				projectIDs = append(projectIDs, cuid.New())
+			}
+
+			repositoryPagination = common.Pagination{
+				After:  convertStringToPointer(cuid.New()),
+				First:  convertIntToPointer(rand.Intn(1000)),
+				Before: convertStringToPointer(cuid.New()),
+				Last:   convertIntToPointer(rand.Intn(1000)),
+			}
+
+			request = business.ListProjectsRequest{
+				Pagination: signPagination(cursorSigningKey, repositoryPagination),
+				SortingOptions: []common.SortingOptionPair{
+					common.SortingOptionPair{
+						Name:      cuid.New(),
+						Direction: common.Ascending,
+					},
+					common.SortingOptionPair{
+						Name:      cuid.New(),
+						Direction: common.Descending,
+					},
+				},
+				ProjectIDs:        projectIDs,
+				IncludeArchived:   (rand.Intn(10) % 2) == 0,
+				OrganizationID:    cuid.New(),
+				FolderID:          cuid.New(),
+				IncludeSubfolders: (rand.Intn(10) % 2) == 0,
+				Filter: &models.ProjectFilter{
+					Name: &models.NameCondition{Operator: models.NameOperatorPrefix, Value: cuid.New()},
+				},
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			When("ListProjects is called", func() {
+				It("should call project repository ListProjects method", func() {
+					mockRepositoryService.
+						EXPECT().
+						ListProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ListProjectsRequest) {
+							Ω(mappedRequest.Pagination).Should(Equal(repositoryPagination))
+							Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+							Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+							Ω(mappedRequest.IncludeArchived).Should(Equal(request.IncludeArchived))
+							Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+							Ω(mappedRequest.IncludeSubfolders).Should(Equal(request.IncludeSubfolders))
+							Ω(mappedRequest.Filter).Should(Equal(request.Filter))
+						}).
+						Return(&repository.ListProjectsResponse{}, nil)
+
+					response, err := sut.ListProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the signature of a cursor is not valid", func() {
+				It("should return ArgumentError without calling project repository ListProjects method", func() {
+					for _, pagination := range []common.Pagination{
+						{After: repositoryPagination.After, First: repositoryPagination.First},
+						{Before: convertStringToPointer(signCursor(cuid.New(), *repositoryPagination.Before))},
+						{After: convertStringToPointer(signCursor(cursorSigningKey, cuid.New()) + "!")},
+					} {
+						request.Pagination = pagination
+
+						response, err := sut.ListProjects(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+					}
+				})
+			})
+
+			When("project repository ListProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						ListProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.ListProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository ListProjects completes successfully", func() {
+				It("should return the list of matched projectIDs", func() {
+					projects := []models.ProjectWithCursor{}
+
+					for idx := 0; idx < rand.Intn(20)+1; idx++ {
+						projects = append(projects, models.ProjectWithCursor{
+							ProjectID: cuid.New(),
+							Project: models.Project{
+								Name: cuid.New(),
+							},
+							Cursor: cuid.New(),
+						})
+					}
+
+					expectedResponse := repository.ListProjectsResponse{
+						HasPreviousPage: (rand.Intn(10) % 2) == 0,
+						HasNextPage:     (rand.Intn(10) % 2) == 0,
+						TotalCount:      rand.Int63n(1000),
+						Projects:        projects,
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						ListProjects(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.ListProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.HasPreviousPage).Should(Equal(expectedResponse.HasPreviousPage))
+					Ω(response.HasNextPage).Should(Equal(expectedResponse.HasNextPage))
+					Ω(response.TotalCount).Should(Equal(expectedResponse.TotalCount))
+					assertSignedProjects(cursorSigningKey, response.Projects, expectedResponse.Projects)
+				})
+			})
+		})
+	})
+
+	Describe("StreamProjects is called", func() {
+		var request business.StreamProjectsRequest
+
+		BeforeEach(func() {
+			request = business.StreamProjectsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				SortingOptions: []common.SortingOptionPair{
+					{
+						Name:      "name",
+						Direction: common.Descending,
+					},
+				},
+				ProjectIDs:        []string{cuid.New(), cuid.New()},
+				IncludeArchived:   (rand.Intn(10) % 2) == 0,
+				OrganizationID:    cuid.New(),
+				FolderID:          cuid.New(),
+				IncludeSubfolders: (rand.Intn(10) % 2) == 0,
+				Filter: &models.ProjectFilter{
+					Name: &models.NameCondition{Operator: models.NameOperatorPrefix, Value: cuid.New()},
+				},
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			When("StreamProjects is called", func() {
+				It("should call project repository StreamProjects method", func() {
+					mockRepositoryService.
+						EXPECT().
+						StreamProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.StreamProjectsRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+							Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+							Ω(mappedRequest.IncludeArchived).Should(Equal(request.IncludeArchived))
+							Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+							Ω(mappedRequest.IncludeSubfolders).Should(Equal(request.IncludeSubfolders))
+							Ω(mappedRequest.Filter).Should(Equal(request.Filter))
+						}).
+						Return(&repository.StreamProjectsResponse{}, nil)
+
+					response, err := sut.StreamProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository StreamProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						StreamProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.StreamProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository StreamProjects completes successfully", func() {
+				It("should return the projects with their signed cursors", func() {
+					project := models.ProjectWithCursor{
+						ProjectID: cuid.New(),
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					}
+
+					mockProjectIterator := repsoitoryMock.NewMockProjectIterator(mockCtrl)
+					gomock.InOrder(
+						mockProjectIterator.EXPECT().Next(ctx).Return(true),
+						mockProjectIterator.EXPECT().Project().Return(project),
+						mockProjectIterator.EXPECT().Next(ctx).Return(false),
+						mockProjectIterator.EXPECT().Err().Return(nil),
+						mockProjectIterator.EXPECT().Close().Return(nil),
+					)
+
+					mockRepositoryService.
+						EXPECT().
+						StreamProjects(gomock.Any(), gomock.Any()).
+						Return(&repository.StreamProjectsResponse{Projects: mockProjectIterator}, nil)
+
+					response, err := sut.StreamProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+
+					Ω(response.Projects.Next(ctx)).Should(BeTrue())
+					streamedProject := response.Projects.Project()
+					Ω(streamedProject.ProjectID).Should(Equal(project.ProjectID))
+					Ω(streamedProject.Project).Should(Equal(project.Project))
+					Ω(streamedProject.Cursor).Should(Equal(signCursor(cursorSigningKey, project.Cursor)))
+					Ω(response.Projects.Next(ctx)).Should(BeFalse())
+					Ω(response.Projects.Err()).Should(BeNil())
+					Ω(response.Projects.Close()).Should(Succeed())
+				})
+			})
+		})
+	})
+
+	Describe("WatchProjects is called", func() {
+		var request business.WatchProjectsRequest
+
+		BeforeEach(func() {
+			request = business.WatchProjectsRequest{
+				UserEmail:       cuid.New() + "@test.com",
+				ResourceVersion: cuid.New(),
+			}
+		})
+
+		Context("project service is instantiated with a repository service that cannot watch the projects", func() {
+			When("WatchProjects is called", func() {
+				It("should return UnimplementedError", func() {
+					response, err := sut.WatchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsUnimplementedError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("project service is instantiated with a repository service that can watch the projects", func() {
+			var mockWatchService *repsoitoryMock.MockWatchContract
+
+			BeforeEach(func() {
+				mockWatchService = repsoitoryMock.NewMockWatchContract(mockCtrl)
+				sut, _ = business.NewBusinessService(
+					watchRepositoryService{mockRepositoryService, mockWatchService},
+					mockConfigurationService,
+					mockNotifierService)
+			})
+
+			When("WatchProjects is called", func() {
+				It("should call project repository WatchProjects method", func() {
+					mockWatchService.
+						EXPECT().
+						WatchProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.WatchProjectsRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ResourceVersion).Should(Equal(request.ResourceVersion))
+						}).
+						Return(&repository.WatchProjectsResponse{}, nil)
+
+					response, err := sut.WatchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository WatchProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := models.NewResourceVersionExpiredError()
+					mockWatchService.
+						EXPECT().
+						WatchProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.WatchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository WatchProjects completes successfully", func() {
+				It("should return the watcher", func() {
+					mockProjectWatcher := repsoitoryMock.NewMockProjectWatcher(mockCtrl)
+					mockWatchService.
+						EXPECT().
+						WatchProjects(gomock.Any(), gomock.Any()).
+						Return(&repository.WatchProjectsResponse{Watcher: mockProjectWatcher}, nil)
+
+					response, err := sut.WatchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Watcher).Should(Equal(mockProjectWatcher))
+				})
+			})
+		})
+	})
+
+	Describe("ListProjectSortFields is called", func() {
+		Context("project service is instantiated", func() {
+			When("ListProjectSortFields is called", func() {
+				It("should return the catalogue of the fields the projects can be sorted on", func() {
+					response, err := sut.ListProjectSortFields(ctx, &business.ListProjectSortFieldsRequest{})
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Fields).Should(Equal(models.ProjectSortFields))
+				})
+			})
+		})
+	})
+
+	Describe("SearchProjects is called", func() {
+		var (
+			request              business.SearchProjectsRequest
+			repositoryPagination common.Pagination
+		)
+
+		BeforeEach(func() {
+			repositoryPagination = common.Pagination{
+				After: convertStringToPointer(cuid.New()),
+				First: convertIntToPointer(rand.Intn(1000)),
+			}
+
+			request = business.SearchProjectsRequest{
+				UserEmail:       cuid.New() + "@test.com",
+				Query:           cuid.New(),
+				Pagination:      signPagination(cursorSigningKey, repositoryPagination),
+				IncludeArchived: (rand.Intn(10) % 2) == 0,
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			When("SearchProjects is called", func() {
+				It("should call project repository SearchProjects method", func() {
+					mockRepositoryService.
+						EXPECT().
+						SearchProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.SearchProjectsRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.Query).Should(Equal(request.Query))
+							Ω(mappedRequest.Pagination).Should(Equal(repositoryPagination))
+							Ω(mappedRequest.IncludeArchived).Should(Equal(request.IncludeArchived))
+						}).
+						Return(&repository.SearchProjectsResponse{}, nil)
+
+					response, err := sut.SearchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the signature of a cursor is not valid", func() {
+				It("should return ArgumentError without calling project repository SearchProjects method", func() {
+					request.Pagination.After = repositoryPagination.After
+
+					response, err := sut.SearchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository SearchProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						SearchProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.SearchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository SearchProjects completes successfully", func() {
+				It("should return the matched projects", func() {
+					projects := []models.ProjectSearchResult{}
+
+					for idx := 0; idx < rand.Intn(20)+1; idx++ {
+						projects = append(projects, models.ProjectSearchResult{
+							ProjectID: cuid.New(),
+							Project: models.Project{
+								Name: cuid.New(),
+							},
+							Cursor: cuid.New(),
+							Score:  rand.Float64(),
+							Highlights: []models.Highlight{
+								{Field: "name", Ranges: []models.TextRange{{Start: 0, End: rand.Intn(10) + 1}}},
+							},
+						})
+					}
+
+					expectedResponse := repository.SearchProjectsResponse{
+						HasPreviousPage: (rand.Intn(10) % 2) == 0,
+						HasNextPage:     (rand.Intn(10) % 2) == 0,
+						TotalCount:      rand.Int63n(1000),
+						Projects:        projects,
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						SearchProjects(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.SearchProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.HasPreviousPage).Should(Equal(expectedResponse.HasPreviousPage))
+					Ω(response.HasNextPage).Should(Equal(expectedResponse.HasNextPage))
+					Ω(response.TotalCount).Should(Equal(expectedResponse.TotalCount))
+					Ω(response.Projects).Should(HaveLen(len(expectedResponse.Projects)))
+					for idx, project := range response.Projects {
+						Ω(project.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Projects[idx].Cursor)))
+						project.Cursor = expectedResponse.Projects[idx].Cursor
+						Ω(project).Should(Equal(expectedResponse.Projects[idx]))
+					}
+				})
+			})
+		})
+	})
+
+	Describe("ArchiveProject is called", func() {
+		var (
+			request business.ArchiveProjectRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ArchiveProjectRequest{
+				UserEmail:       cuid.New() + "@test.com",
+				ProjectID:       cuid.New(),
+				ExpectedVersion: rand.Int63n(100) + 1,
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+			})
+
+			When("ArchiveProject is called", func() {
+				It("should call project repository UpdateProjectState method", func() {
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.UpdateProjectStateRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.State).Should(Equal(models.ProjectStateArchived))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.ExpectedVersion))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectArchived, request.UserEmail)
+						}).
+						Return(&repository.UpdateProjectStateResponse{}, nil)
+
+					response, err := sut.ArchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository UpdateProjectState returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.ArchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository UpdateProjectState completes successfully", func() {
+				It("should return expected details", func() {
+					expectedResponse := repository.UpdateProjectStateResponse{
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					}
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.ArchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Project).Should(Equal(expectedResponse.Project))
+					Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				})
+			})
+		})
+	})
+
+	Describe("UnarchiveProject is called", func() {
+		var (
+			request business.UnarchiveProjectRequest
+		)
+
+		BeforeEach(func() {
+			request = business.UnarchiveProjectRequest{
+				UserEmail:       cuid.New() + "@test.com",
+				ProjectID:       cuid.New(),
+				ExpectedVersion: rand.Int63n(100) + 1,
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+			})
+
+			When("UnarchiveProject is called", func() {
+				It("should call project repository UpdateProjectState method", func() {
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.UpdateProjectStateRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.State).Should(Equal(models.ProjectStateActive))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.ExpectedVersion))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectUnarchived, request.UserEmail)
+						}).
+						Return(&repository.UpdateProjectStateResponse{}, nil)
+
+					response, err := sut.UnarchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository UpdateProjectState returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.UnarchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository UpdateProjectState completes successfully", func() {
+				It("should return expected details", func() {
+					expectedResponse := repository.UpdateProjectStateResponse{
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					}
+					mockRepositoryService.
+						EXPECT().
+						UpdateProjectState(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.UnarchiveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Project).Should(Equal(expectedResponse.Project))
+					Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				})
+			})
+		})
+	})
+
+	Describe("RestoreProject is called", func() {
+		var (
+			request business.RestoreProjectRequest
+		)
+
+		BeforeEach(func() {
+			request = business.RestoreProjectRequest{
+				UserEmail:       cuid.New() + "@test.com",
+				ProjectID:       cuid.New(),
+				ExpectedVersion: rand.Int63n(100) + 1,
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("RestoreProject is called", func() {
+				It("should call project repository RestoreProject method", func() {
+					mockRepositoryService.
+						EXPECT().
+						RestoreProject(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.RestoreProjectRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.ExpectedVersion).Should(Equal(request.ExpectedVersion))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectRestored, request.UserEmail)
+						}).
+						Return(&repository.RestoreProjectResponse{}, nil)
+
+					response, err := sut.RestoreProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository RestoreProject returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						RestoreProject(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.RestoreProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository RestoreProject completes successfully", func() {
+				It("should return expected details", func() {
+					expectedResponse := repository.RestoreProjectResponse{
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					}
+					mockRepositoryService.
+						EXPECT().
+						RestoreProject(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.RestoreProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Project).Should(Equal(expectedResponse.Project))
+					Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				})
+			})
+		})
+	})
+
+	Describe("PurgeDeletedProjects is called", func() {
+		var (
+			request business.PurgeDeletedProjectsRequest
+		)
+
+		BeforeEach(func() {
+			request = business.PurgeDeletedProjectsRequest{
+				DeletedBefore: time.Now().Add(-time.Duration(rand.Intn(1000)+1) * time.Hour),
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			When("PurgeDeletedProjects is called", func() {
+				It("should call project repository PurgeDeletedProjects method", func() {
+					mockRepositoryService.
+						EXPECT().
+						PurgeDeletedProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.PurgeDeletedProjectsRequest) {
+							Ω(mappedRequest.DeletedBefore).Should(Equal(request.DeletedBefore))
+						}).
+						Return(&repository.PurgeDeletedProjectsResponse{}, nil)
+
+					response, err := sut.PurgeDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository PurgeDeletedProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						PurgeDeletedProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.PurgeDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository PurgeDeletedProjects completes successfully", func() {
+				It("should return the number of purged projects", func() {
+					expectedResponse := repository.PurgeDeletedProjectsResponse{
+						PurgedCount: rand.Int63n(1000),
+					}
+					mockRepositoryService.
+						EXPECT().
+						PurgeDeletedProjects(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.PurgeDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.PurgedCount).Should(Equal(expectedResponse.PurgedCount))
+				})
+			})
+		})
+	})
+
+	Describe("ListDeletedProjects is called", func() {
+		var (
+			request              business.ListDeletedProjectsRequest
+			repositoryPagination common.Pagination
+		)
+
+		BeforeEach(func() {
+			repositoryPagination = common.Pagination{
+				After: convertStringToPointer(cuid.New()),
+				First: convertIntToPointer(rand.Intn(1000)),
+			}
+
+			request = business.ListDeletedProjectsRequest{
+				UserEmail:  cuid.New() + "@test.com",
+				Pagination: signPagination(cursorSigningKey, repositoryPagination),
+				SortingOptions: []common.SortingOptionPair{
+					common.SortingOptionPair{
+						Name:      cuid.New(),
+						Direction: common.Ascending,
+					},
+				},
+				ProjectIDs: []string{cuid.New(), cuid.New()},
+			}
+		})
+
+		Context("project service is instantiated", func() {
+			When("ListDeletedProjects is called", func() {
+				It("should call project repository ListDeletedProjects method", func() {
+					mockRepositoryService.
+						EXPECT().
+						ListDeletedProjects(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ListDeletedProjectsRequest) {
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.Pagination).Should(Equal(repositoryPagination))
+							Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+							Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+						}).
+						Return(&repository.ListDeletedProjectsResponse{}, nil)
+
+					response, err := sut.ListDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the signature of a cursor is not valid", func() {
+				It("should return ArgumentError without calling project repository ListDeletedProjects method", func() {
+					request.Pagination.After = repositoryPagination.After
+
+					response, err := sut.ListDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository ListDeletedProjects returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						ListDeletedProjects(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.ListDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository ListDeletedProjects completes successfully", func() {
+				It("should return the list of matched deleted projects", func() {
+					expectedResponse := repository.ListDeletedProjectsResponse{
+						HasPreviousPage: (rand.Intn(10) % 2) == 0,
+						HasNextPage:     (rand.Intn(10) % 2) == 0,
+						TotalCount:      rand.Int63n(1000),
+						Projects: []models.ProjectWithCursor{
+							{
+								ProjectID: cuid.New(),
+								Project: models.Project{
+									Name:      cuid.New(),
+									DeletedAt: time.Now(),
+								},
+								Cursor: cuid.New(),
+							},
+						},
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						ListDeletedProjects(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.ListDeletedProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.HasPreviousPage).Should(Equal(expectedResponse.HasPreviousPage))
+					Ω(response.HasNextPage).Should(Equal(expectedResponse.HasNextPage))
+					Ω(response.TotalCount).Should(Equal(expectedResponse.TotalCount))
+					assertSignedProjects(cursorSigningKey, response.Projects, expectedResponse.Projects)
+				})
+			})
+		})
+	})
+
+	Describe("AddMember is called", func() {
+		var (
+			request business.AddMemberRequest
+		)
+
+		BeforeEach(func() {
+			request = business.AddMemberRequest{
+				UserEmail:   cuid.New() + "@test.com",
+				ProjectID:   cuid.New(),
+				MemberEmail: cuid.New() + "@test.com",
+				Role:        models.MemberRoleEditor,
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("AddMember is called", func() {
+				It("should call project repository AddMember method", func() {
+					mockRepositoryService.
+						EXPECT().
+						AddMember(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.AddMemberRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.Member.UserEmail).Should(Equal(request.MemberEmail))
+							Ω(mappedRequest.Member.Role).Should(Equal(request.Role))
+						}).
+						Return(&repository.AddMemberResponse{}, nil)
+
+					response, err := sut.AddMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository AddMember returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						AddMember(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.AddMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("project repository AddMember completes successfully", func() {
+				It("should return the added member", func() {
+					expectedResponse := repository.AddMemberResponse{
+						Member: models.Member{UserEmail: request.MemberEmail, Role: request.Role},
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						AddMember(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.AddMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Member).Should(Equal(expectedResponse.Member))
+				})
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError without adding the member", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.AddMember(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("RemoveMember is called", func() {
+		var (
+			request business.RemoveMemberRequest
+		)
+
+		BeforeEach(func() {
+			request = business.RemoveMemberRequest{
+				UserEmail:   cuid.New() + "@test.com",
+				ProjectID:   cuid.New(),
+				MemberEmail: cuid.New() + "@test.com",
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("RemoveMember is called", func() {
+				It("should call project repository RemoveMember method", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleViewer)
+					mockRepositoryService.
+						EXPECT().
+						RemoveMember(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.RemoveMemberRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.MemberEmail))
+						}).
+						Return(&repository.RemoveMemberResponse{}, nil)
+
+					response, err := sut.RemoveMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the member to remove is the owner", func() {
+				It("should return ArgumentError without removing the member", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleOwner)
+
+					response, err := sut.RemoveMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository RemoveMember returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					expectMemberRole(mockRepositoryService, models.MemberRoleEditor)
+					mockRepositoryService.
+						EXPECT().
+						RemoveMember(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.RemoveMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+		})
+
+		Context("user is a viewer of the project", func() {
+			When("the user removes another member", func() {
+				It("should return PermissionDeniedError without removing the member", func() {
+					expectRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.RemoveMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the user removes themselves", func() {
+				It("should call project repository RemoveMember method", func() {
+					request.MemberEmail = request.UserEmail
+					expectRole(mockRepositoryService, models.MemberRoleViewer)
+					expectMemberRole(mockRepositoryService, models.MemberRoleViewer)
+					mockRepositoryService.
+						EXPECT().
+						RemoveMember(ctx, gomock.Any()).
+						Return(&repository.RemoveMemberResponse{}, nil)
+
+					response, err := sut.RemoveMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("UpdateMemberRole is called", func() {
+		var (
+			request business.UpdateMemberRoleRequest
+		)
+
+		BeforeEach(func() {
+			request = business.UpdateMemberRoleRequest{
+				UserEmail:   cuid.New() + "@test.com",
+				ProjectID:   cuid.New(),
+				MemberEmail: cuid.New() + "@test.com",
+				Role:        models.MemberRoleViewer,
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("UpdateMemberRole is called", func() {
+				It("should call project repository UpdateMemberRole method", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleEditor)
+					mockRepositoryService.
+						EXPECT().
+						UpdateMemberRole(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.UpdateMemberRoleRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.MemberEmail))
+							Ω(mappedRequest.Role).Should(Equal(request.Role))
+						}).
+						Return(&repository.UpdateMemberRoleResponse{}, nil)
+
+					response, err := sut.UpdateMemberRole(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the member is the owner", func() {
+				It("should return ArgumentError without changing the role", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleOwner)
+
+					response, err := sut.UpdateMemberRole(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository UpdateMemberRole completes successfully", func() {
+				It("should return the updated member", func() {
+					expectedResponse := repository.UpdateMemberRoleResponse{
+						Member: models.Member{UserEmail: request.MemberEmail, Role: request.Role},
+					}
+
+					expectMemberRole(mockRepositoryService, models.MemberRoleEditor)
+					mockRepositoryService.
+						EXPECT().
+						UpdateMemberRole(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.UpdateMemberRole(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Member).Should(Equal(expectedResponse.Member))
+				})
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError without changing the role", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.UpdateMemberRole(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("ListMembers is called", func() {
+		var (
+			request business.ListMembersRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListMembersRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+			}
+		})
+
+		Context("user is a member of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleViewer)
+			})
+
+			When("ListMembers is called", func() {
+				It("should call project repository ListMembers method", func() {
+					mockRepositoryService.
+						EXPECT().
+						ListMembers(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ListMembersRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						}).
+						Return(&repository.ListMembersResponse{}, nil)
+
+					response, err := sut.ListMembers(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("project repository ListMembers completes successfully", func() {
+				It("should return the members of the project", func() {
+					expectedResponse := repository.ListMembersResponse{
+						Members: []models.Member{
+							{UserEmail: cuid.New() + "@test.com", Role: models.MemberRoleOwner},
+							{UserEmail: request.UserEmail, Role: models.MemberRoleViewer},
+						},
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						ListMembers(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.ListMembers(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Members).Should(Equal(expectedResponse.Members))
+				})
+			})
+		})
+	})
+
+	Describe("TransferProjectOwnership is called", func() {
+		var (
+			request business.TransferProjectOwnershipRequest
+		)
+
+		BeforeEach(func() {
+			request = business.TransferProjectOwnershipRequest{
+				UserEmail:     cuid.New() + "@test.com",
+				ProjectID:     cuid.New(),
+				NewOwnerEmail: cuid.New() + "@test.com",
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+				expectMemberRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("the acceptance of the new owner is not required", func() {
+				It("should call project repository TransferProjectOwnership method", func() {
+					mockRepositoryService.
+						EXPECT().
+						TransferProjectOwnership(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.TransferProjectOwnershipRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.FromUserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.ToUserEmail).Should(Equal(request.NewOwnerEmail))
+							Ω(mappedRequest.AuditEvent.Action).Should(Equal(models.AuditActionOwnershipTransferred))
+							Ω(mappedRequest.AuditEvent.UserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.AuditEvent.TargetUserEmail).Should(Equal(request.NewOwnerEmail))
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectOwnershipTransferred, request.UserEmail)
+						}).
+						Return(&repository.TransferProjectOwnershipResponse{}, nil)
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.PendingTransfer).Should(BeNil())
+				})
+
+				It("should return the project after its ownership is transferred", func() {
+					expectedResponse := repository.TransferProjectOwnershipResponse{
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						TransferProjectOwnership(gomock.Any(), gomock.Any()).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Project).Should(Equal(expectedResponse.Project))
+					Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+				})
+			})
+
+			When("the acceptance of the new owner is required", func() {
+				It("should call project repository RequestOwnershipTransfer method with the configured expiry", func() {
+					request.RequireAcceptance = true
+					mockRepositoryService.
+						EXPECT().
+						RequestOwnershipTransfer(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.RequestOwnershipTransferRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.Transfer.FromUserEmail).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.Transfer.ToUserEmail).Should(Equal(request.NewOwnerEmail))
+							Ω(mappedRequest.Transfer.ExpiresAt.Sub(mappedRequest.Transfer.RequestedAt)).Should(Equal(ownershipTransferExpiry))
+							Ω(mappedRequest.AuditEvent.Action).Should(Equal(models.AuditActionOwnershipTransferRequested))
+						}).
+						DoAndReturn(func(_ context.Context, mappedRequest *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+							return &repository.RequestOwnershipTransferResponse{Transfer: mappedRequest.Transfer}, nil
+						})
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.PendingTransfer).ShouldNot(BeNil())
+					Ω(response.PendingTransfer.ToUserEmail).Should(Equal(request.NewOwnerEmail))
+				})
+			})
+
+			When("the new owner is the user", func() {
+				It("should return ArgumentError without transferring the ownership", func() {
+					request.NewOwnerEmail = request.UserEmail
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository TransferProjectOwnership returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						TransferProjectOwnership(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError without transferring the ownership", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.TransferProjectOwnership(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		Context("user is an owner of the organization of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("the user is not a member of the project", func() {
+				It("should return PermissionDeniedError without transferring the ownership", func() {
+					mockRepositoryService.
+						EXPECT().
+						ReadMember(gomock.Any(), gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ReadMemberRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						}).
+						Return(nil, commonErrors.NewNotFoundError())
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the user is an editor of the project", func() {
+				It("should return PermissionDeniedError without transferring the ownership", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleEditor)
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("project repository ReadMember returns error", func() {
+				It("should return the same error", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						ReadMember(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.TransferProjectOwnership(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+		})
+	})
+
+	Describe("AcceptProjectOwnershipTransfer is called", func() {
+		var (
+			request  business.AcceptProjectOwnershipTransferRequest
+			transfer models.OwnershipTransfer
+		)
+
+		BeforeEach(func() {
+			request = business.AcceptProjectOwnershipTransferRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+			}
+
+			transfer = models.OwnershipTransfer{
+				FromUserEmail: cuid.New() + "@test.com",
+				ToUserEmail:   request.UserEmail,
+				RequestedAt:   time.Now().UTC().Add(-time.Hour),
+				ExpiresAt:     time.Now().UTC().Add(time.Hour),
+			}
+		})
+
+		When("the user is the new owner of a pending transfer", func() {
+			It("should call project repository TransferProjectOwnership method", func() {
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+				mockRepositoryService.
+					EXPECT().
+					TransferProjectOwnership(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.TransferProjectOwnershipRequest) {
+						Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						Ω(mappedRequest.FromUserEmail).Should(Equal(transfer.FromUserEmail))
+						Ω(mappedRequest.ToUserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.AuditEvent.Action).Should(Equal(models.AuditActionOwnershipTransferAccepted))
+						assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectOwnershipTransferred, request.UserEmail)
+					}).
+					Return(&repository.TransferProjectOwnershipResponse{Cursor: request.ProjectID}, nil)
+
+				response, err := sut.AcceptProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, request.ProjectID)))
+			})
+		})
+
+		When("the user is not the new owner", func() {
+			It("should return NotFoundError without transferring the ownership", func() {
+				transfer.ToUserEmail = cuid.New() + "@test.com"
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+
+				response, err := sut.AcceptProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("the transfer is expired", func() {
+			It("should return NotFoundError without transferring the ownership", func() {
+				transfer.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+
+				response, err := sut.AcceptProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("project repository ReadOwnershipTransfer returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					ReadOwnershipTransfer(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.AcceptProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("DeclineProjectOwnershipTransfer is called", func() {
+		var (
+			request  business.DeclineProjectOwnershipTransferRequest
+			transfer models.OwnershipTransfer
+		)
+
+		BeforeEach(func() {
+			request = business.DeclineProjectOwnershipTransferRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+			}
+
+			transfer = models.OwnershipTransfer{
+				FromUserEmail: cuid.New() + "@test.com",
+				ToUserEmail:   cuid.New() + "@test.com",
+				RequestedAt:   time.Now().UTC(),
+				ExpiresAt:     time.Now().UTC().Add(time.Hour),
+			}
+		})
+
+		When("the user is the new owner", func() {
+			It("should call project repository DeleteOwnershipTransfer method", func() {
+				transfer.ToUserEmail = request.UserEmail
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+				mockRepositoryService.
+					EXPECT().
+					DeleteOwnershipTransfer(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.DeleteOwnershipTransferRequest) {
+						Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						Ω(mappedRequest.AuditEvent.Action).Should(Equal(models.AuditActionOwnershipTransferDeclined))
+						Ω(mappedRequest.AuditEvent.UserEmail).Should(Equal(request.UserEmail))
+					}).
+					Return(&repository.DeleteOwnershipTransferResponse{}, nil)
+
+				response, err := sut.DeclineProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+		})
+
+		When("the user is the owner who requested the transfer", func() {
+			It("should call project repository DeleteOwnershipTransfer method", func() {
+				transfer.FromUserEmail = request.UserEmail
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+				mockRepositoryService.
+					EXPECT().
+					DeleteOwnershipTransfer(ctx, gomock.Any()).
+					Return(&repository.DeleteOwnershipTransferResponse{}, nil)
+
+				response, err := sut.DeclineProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+		})
+
+		When("the user is neither the owner nor the new owner", func() {
+			It("should return NotFoundError without declining the transfer", func() {
+				expectOwnershipTransfer(mockRepositoryService, transfer)
+
+				response, err := sut.DeclineProjectOwnershipTransfer(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("ListProjectAuditEvents is called", func() {
+		var (
+			request business.ListProjectAuditEventsRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListProjectAuditEventsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+			}
+		})
+
+		Context("user is a member of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleViewer)
+			})
+
+			When("project repository ListAuditEvents completes successfully", func() {
+				It("should return the audit events of the project", func() {
+					expectedResponse := repository.ListAuditEventsResponse{
+						AuditEvents: []models.AuditEvent{
+							{
+								Action:          models.AuditActionOwnershipTransferred,
+								UserEmail:       cuid.New() + "@test.com",
+								TargetUserEmail: request.UserEmail,
+								OccurredAt:      time.Now().UTC(),
+							},
+						},
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						ListAuditEvents(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.ListAuditEventsRequest) {
+							Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						}).
+						Return(&expectedResponse, nil)
+
+					response, err := sut.ListProjectAuditEvents(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.AuditEvents).Should(Equal(expectedResponse.AuditEvents))
+				})
+			})
+		})
+
+		Context("user is not a member of the project", func() {
+			It("should return NotFoundError", func() {
+				mockRepositoryService.
+					EXPECT().
+					ReadEffectiveRole(gomock.Any(), gomock.Any()).
+					Return(nil, commonErrors.NewNotFoundError())
+
+				response, err := sut.ListProjectAuditEvents(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("CreateInvitation is called", func() {
+		var (
+			request business.CreateInvitationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.CreateInvitationRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+				Email:     cuid.New() + "@test.com",
+				Role:      models.MemberRoleEditor,
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("the invited user is not a member of the project", func() {
+				BeforeEach(func() {
+					mockRepositoryService.
+						EXPECT().
+						ReadMember(gomock.Any(), gomock.Any()).
+						Return(nil, commonErrors.NewNotFoundError())
+				})
+
+				It("should store the hash of the token and deliver the token with the notifier", func() {
+					var tokenHash string
+					mockRepositoryService.
+						EXPECT().
+						CreateInvitation(ctx, gomock.Any()).
+						DoAndReturn(func(_ context.Context, mappedRequest *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+							Ω(mappedRequest.Invitation.ProjectID).Should(Equal(request.ProjectID))
+							Ω(mappedRequest.Invitation.Email).Should(Equal(request.Email))
+							Ω(mappedRequest.Invitation.Role).Should(Equal(request.Role))
+							Ω(mappedRequest.Invitation.InvitedBy).Should(Equal(request.UserEmail))
+							Ω(mappedRequest.Invitation.ExpiresAt.Sub(mappedRequest.Invitation.CreatedAt)).Should(Equal(invitationExpiry))
+							tokenHash = mappedRequest.TokenHash
+
+							return &repository.CreateInvitationResponse{Invitation: mappedRequest.Invitation}, nil
+						})
+
+					mockNotifierService.
+						EXPECT().
+						SendInvitation(ctx, gomock.Any()).
+						Do(func(_ context.Context, notification *notifier.SendInvitationRequest) {
+							Ω(notification.Invitation.Email).Should(Equal(request.Email))
+							Ω(notification.Token).ShouldNot(BeEmpty())
+							Ω(notification.Token).ShouldNot(Equal(tokenHash))
+
+							hash := sha256.Sum256([]byte(notification.Token))
+							Ω(hex.EncodeToString(hash[:])).Should(Equal(tokenHash))
+						}).
+						Return(nil)
+
+					response, err := sut.CreateInvitation(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+					Ω(response.Invitation.Email).Should(Equal(request.Email))
+				})
+
+				It("should return UnknownError if the notifier fails to deliver the invitation", func() {
+					mockRepositoryService.
+						EXPECT().
+						CreateInvitation(gomock.Any(), gomock.Any()).
+						Return(&repository.CreateInvitationResponse{}, nil)
+
+					mockNotifierService.
+						EXPECT().
+						SendInvitation(gomock.Any(), gomock.Any()).
+						Return(errors.New(cuid.New()))
+
+					response, err := sut.CreateInvitation(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsUnknownError(response.Err)).Should(BeTrue())
+				})
+
+				It("should return the error without delivering the invitation if project repository CreateInvitation fails", func() {
+					expectedError := errors.New(cuid.New())
+					mockRepositoryService.
+						EXPECT().
+						CreateInvitation(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
+
+					response, err := sut.CreateInvitation(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
+				})
+			})
+
+			When("the invited user is already a member of the project", func() {
+				It("should return AlreadyExistsError", func() {
+					expectMemberRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.CreateInvitation(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsAlreadyExistsError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.CreateInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("ListInvitations is called", func() {
+		var (
+			request business.ListInvitationsRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListInvitationsRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			It("should return the pending invitations of the project", func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+				expectedResponse := repository.ListInvitationsResponse{
+					Invitations: []models.Invitation{
+						{
+							ProjectID: request.ProjectID,
+							Email:     cuid.New() + "@test.com",
+							Role:      models.MemberRoleViewer,
+							InvitedBy: request.UserEmail,
+						},
+					},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ListInvitations(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ListInvitationsRequest) {
+						Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.ListInvitations(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Invitations).Should(Equal(expectedResponse.Invitations))
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.ListInvitations(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("AcceptInvitation is called", func() {
+		var (
+			request    business.AcceptInvitationRequest
+			invitation models.Invitation
+		)
+
+		BeforeEach(func() {
+			request = business.AcceptInvitationRequest{
+				UserEmail: cuid.New() + "@test.com",
+				Token:     cuid.New(),
+			}
+
+			invitation = models.Invitation{
+				ProjectID: cuid.New(),
+				Email:     request.UserEmail,
+				Role:      models.MemberRoleEditor,
+				InvitedBy: cuid.New() + "@test.com",
+				CreatedAt: time.Now().UTC().Add(-time.Hour),
+				ExpiresAt: time.Now().UTC().Add(time.Hour),
+			}
+		})
+
+		When("the user is the invited user of a pending invitation", func() {
+			It("should call project repository AcceptInvitation method", func() {
+				hash := sha256.Sum256([]byte(request.Token))
+				expectInvitation(mockRepositoryService, hex.EncodeToString(hash[:]), invitation)
+				mockRepositoryService.
+					EXPECT().
+					AcceptInvitation(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.AcceptInvitationRequest) {
+						Ω(mappedRequest.ProjectID).Should(Equal(invitation.ProjectID))
+						Ω(mappedRequest.Email).Should(Equal(request.UserEmail))
+					}).
+					Return(&repository.AcceptInvitationResponse{Member: models.Member{UserEmail: request.UserEmail, Role: invitation.Role}}, nil)
+
+				response, err := sut.AcceptInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.ProjectID).Should(Equal(invitation.ProjectID))
+				Ω(response.Member.Role).Should(Equal(invitation.Role))
+			})
+		})
+
+		When("the invitation belongs to another user", func() {
+			It("should return PermissionDeniedError without accepting the invitation", func() {
+				invitation.Email = cuid.New() + "@test.com"
+				expectInvitation(mockRepositoryService, "", invitation)
+
+				response, err := sut.AcceptInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("the invitation is expired", func() {
+			It("should return NotFoundError without accepting the invitation", func() {
+				invitation.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+				expectInvitation(mockRepositoryService, "", invitation)
+
+				response, err := sut.AcceptInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("project repository ReadInvitation returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					ReadInvitation(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.AcceptInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("RevokeInvitation is called", func() {
+		var (
+			request business.RevokeInvitationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.RevokeInvitationRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+				Email:     cuid.New() + "@test.com",
+			}
+		})
+
+		Context("user is the owner of the project", func() {
+			It("should call project repository DeleteInvitation method", func() {
+				expectRole(mockRepositoryService, models.MemberRoleOwner)
+				mockRepositoryService.
+					EXPECT().
+					DeleteInvitation(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.DeleteInvitationRequest) {
+						Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						Ω(mappedRequest.Email).Should(Equal(request.Email))
+					}).
+					Return(&repository.DeleteInvitationResponse{}, nil)
+
+				response, err := sut.RevokeInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+		})
+
+		Context("user is an editor of the project", func() {
+			It("should return PermissionDeniedError", func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.RevokeInvitation(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("CreateOrganization is called", func() {
+		var (
+			request business.CreateOrganizationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.CreateOrganizationRequest{
+				UserEmail:    cuid.New() + "@test.com",
+				Organization: models.Organization{Name: cuid.New()},
+			}
+		})
+
+		When("project repository CreateOrganization completes successfully", func() {
+			It("should return the created organization", func() {
+				expectedResponse := repository.CreateOrganizationResponse{
+					OrganizationID: cuid.New(),
+					Organization:   models.Organization{Name: request.Organization.Name},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					CreateOrganization(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.CreateOrganizationRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.Organization).Should(Equal(request.Organization))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.CreateOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.OrganizationID).Should(Equal(expectedResponse.OrganizationID))
+				Ω(response.Organization).Should(Equal(expectedResponse.Organization))
+			})
+		})
+
+		When("project repository CreateOrganization returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					CreateOrganization(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.CreateOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("ReadOrganization is called", func() {
+		var (
+			request business.ReadOrganizationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ReadOrganizationRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+			}
+		})
+
+		When("project repository ReadOrganization completes successfully", func() {
+			It("should return the organization", func() {
+				expectedResponse := repository.ReadOrganizationResponse{
+					Organization: models.Organization{Name: cuid.New()},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ReadOrganization(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ReadOrganizationRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.ReadOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Organization).Should(Equal(expectedResponse.Organization))
+			})
+		})
+
+		When("project repository ReadOrganization returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					ReadOrganization(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.ReadOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("UpdateOrganization is called", func() {
+		var (
+			request business.UpdateOrganizationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.UpdateOrganizationRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+				Organization:   models.Organization{Name: cuid.New()},
+			}
+		})
+
+		Context("user is the owner of the organization", func() {
+			It("should call project repository UpdateOrganization method", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleOwner)
+				mockRepositoryService.
+					EXPECT().
+					UpdateOrganization(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.UpdateOrganizationRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+						Ω(mappedRequest.Organization).Should(Equal(request.Organization))
+					}).
+					Return(&repository.UpdateOrganizationResponse{Organization: request.Organization}, nil)
+
+				response, err := sut.UpdateOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Organization).Should(Equal(request.Organization))
+			})
+		})
+
+		Context("user is an editor of the organization", func() {
+			It("should return PermissionDeniedError without updating the organization", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.UpdateOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("DeleteOrganization is called", func() {
+		var (
+			request business.DeleteOrganizationRequest
+		)
+
+		BeforeEach(func() {
+			request = business.DeleteOrganizationRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+			}
+		})
+
+		Context("user is the owner of the organization", func() {
+			BeforeEach(func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			It("should call project repository DeleteOrganization method", func() {
+				mockRepositoryService.
+					EXPECT().
+					DeleteOrganization(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.DeleteOrganizationRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+					}).
+					Return(&repository.DeleteOrganizationResponse{}, nil)
+
+				response, err := sut.DeleteOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+
+			When("the organization still has projects", func() {
+				It("should return OrganizationNotEmptyError", func() {
+					mockRepositoryService.
+						EXPECT().
+						DeleteOrganization(gomock.Any(), gomock.Any()).
+						Return(nil, models.NewOrganizationNotEmptyError())
+
+					response, err := sut.DeleteOrganization(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsOrganizationNotEmptyError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("user is an editor of the organization", func() {
+			It("should return PermissionDeniedError without deleting the organization", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.DeleteOrganization(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("ListOrganizations is called", func() {
+		var (
+			request business.ListOrganizationsRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListOrganizationsRequest{
+				UserEmail: cuid.New() + "@test.com",
+			}
+		})
+
+		When("project repository ListOrganizations completes successfully", func() {
+			It("should return the organizations", func() {
+				expectedResponse := repository.ListOrganizationsResponse{
+					Organizations: []models.OrganizationWithID{
+						{OrganizationID: cuid.New(), Organization: models.Organization{Name: cuid.New()}},
+					},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ListOrganizations(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ListOrganizationsRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.ListOrganizations(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Organizations).Should(Equal(expectedResponse.Organizations))
+			})
+		})
+	})
+
+	Describe("AddOrganizationMember is called", func() {
+		var (
+			request business.AddOrganizationMemberRequest
+		)
+
+		BeforeEach(func() {
+			request = business.AddOrganizationMemberRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+				MemberEmail:    cuid.New() + "@test.com",
+				Role:           models.MemberRoleEditor,
+			}
+		})
+
+		Context("user is the owner of the organization", func() {
+			It("should call project repository AddOrganizationMember method", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleOwner)
+				mockRepositoryService.
+					EXPECT().
+					AddOrganizationMember(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.AddOrganizationMemberRequest) {
+						Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+						Ω(mappedRequest.Member.UserEmail).Should(Equal(request.MemberEmail))
+						Ω(mappedRequest.Member.Role).Should(Equal(request.Role))
+					}).
+					Return(&repository.AddOrganizationMemberResponse{}, nil)
+
+				response, err := sut.AddOrganizationMember(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+		})
+
+		Context("user is an editor of the organization", func() {
+			It("should return PermissionDeniedError without adding the member", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleEditor)
+
+				response, err := sut.AddOrganizationMember(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("RemoveOrganizationMember is called", func() {
+		var (
+			request business.RemoveOrganizationMemberRequest
+		)
+
+		BeforeEach(func() {
+			request = business.RemoveOrganizationMemberRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+				MemberEmail:    cuid.New() + "@test.com",
+			}
+		})
+
+		Context("user is the owner of the organization", func() {
+			BeforeEach(func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleOwner)
+			})
+
+			When("the member to remove is not the owner", func() {
+				It("should call project repository RemoveOrganizationMember method", func() {
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleEditor)
+					mockRepositoryService.
+						EXPECT().
+						RemoveOrganizationMember(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.RemoveOrganizationMemberRequest) {
+							Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							Ω(mappedRequest.UserEmail).Should(Equal(request.MemberEmail))
+						}).
+						Return(&repository.RemoveOrganizationMemberResponse{}, nil)
+
+					response, err := sut.RemoveOrganizationMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("the member to remove is the owner", func() {
+				It("should return ArgumentError without removing the member", func() {
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleOwner)
+
+					response, err := sut.RemoveOrganizationMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("user is a viewer of the organization", func() {
+			When("the user removes another member", func() {
+				It("should return PermissionDeniedError without removing the member", func() {
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+
+					response, err := sut.RemoveOrganizationMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the user removes themselves", func() {
+				It("should call project repository RemoveOrganizationMember method", func() {
+					request.MemberEmail = request.UserEmail
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+					expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+					mockRepositoryService.
+						EXPECT().
+						RemoveOrganizationMember(ctx, gomock.Any()).
+						Return(&repository.RemoveOrganizationMemberResponse{}, nil)
+
+					response, err := sut.RemoveOrganizationMember(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("ListOrganizationMembers is called", func() {
+		var (
+			request business.ListOrganizationMembersRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListOrganizationMembersRequest{
+				UserEmail:      cuid.New() + "@test.com",
+				OrganizationID: cuid.New(),
+			}
+		})
+
+		Context("user is a viewer of the organization", func() {
+			It("should return the members of the organization", func() {
+				expectOrganizationRole(mockRepositoryService, models.MemberRoleViewer)
+				expectedResponse := repository.ListOrganizationMembersResponse{
+					Members: []models.Member{{UserEmail: request.UserEmail, Role: models.MemberRoleViewer}},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ListOrganizationMembers(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ListOrganizationMembersRequest) {
+						Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.ListOrganizationMembers(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Members).Should(Equal(expectedResponse.Members))
+			})
+		})
+
+		Context("user is not a member of the organization", func() {
+			It("should return NotFoundError", func() {
+				mockRepositoryService.
+					EXPECT().
+					ReadOrganizationMember(gomock.Any(), gomock.Any()).
+					Return(nil, commonErrors.NewNotFoundError())
+
+				response, err := sut.ListOrganizationMembers(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("CreateFolder is called", func() {
+		var (
+			request business.CreateFolderRequest
+		)
+
+		BeforeEach(func() {
+			request = business.CreateFolderRequest{
+				UserEmail: cuid.New() + "@test.com",
+				Folder: models.Folder{
+					Name:           cuid.New(),
+					ParentFolderID: cuid.New(),
+				},
+			}
+		})
+
+		When("project repository CreateFolder completes successfully", func() {
+			It("should return the created folder", func() {
+				expectedResponse := repository.CreateFolderResponse{
+					FolderID: cuid.New(),
+					Folder:   request.Folder,
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					CreateFolder(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.CreateFolderRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.Folder).Should(Equal(request.Folder))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.CreateFolder(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.FolderID).Should(Equal(expectedResponse.FolderID))
+				Ω(response.Folder).Should(Equal(expectedResponse.Folder))
+			})
+		})
+
+		When("project repository CreateFolder returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					CreateFolder(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.CreateFolder(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("ListFolders is called", func() {
+		var (
+			request business.ListFoldersRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListFoldersRequest{
+				UserEmail: cuid.New() + "@test.com",
+			}
+		})
+
+		When("project repository ListFolders completes successfully", func() {
+			It("should return the folders", func() {
+				expectedResponse := repository.ListFoldersResponse{
+					Folders: []models.FolderWithID{
+						{FolderID: cuid.New(), Folder: models.Folder{Name: cuid.New()}},
+					},
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ListFolders(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ListFoldersRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.ListFolders(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Folders).Should(Equal(expectedResponse.Folders))
+			})
+		})
+	})
+
+	Describe("DeleteFolder is called", func() {
+		var (
+			request business.DeleteFolderRequest
+		)
+
+		BeforeEach(func() {
+			request = business.DeleteFolderRequest{
+				UserEmail: cuid.New() + "@test.com",
+				FolderID:  cuid.New(),
+			}
+		})
+
+		Context("cascade is not requested", func() {
+			It("should call project repository DeleteFolder method", func() {
+				mockRepositoryService.
+					EXPECT().
+					DeleteFolder(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.DeleteFolderRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+						Ω(mappedRequest.Cascade).Should(BeFalse())
+					}).
+					Return(&repository.DeleteFolderResponse{}, nil)
+
+				response, err := sut.DeleteFolder(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+			})
+
+			When("the folder still has projects", func() {
+				It("should return FolderNotEmptyError", func() {
+					mockRepositoryService.
+						EXPECT().
+						DeleteFolder(gomock.Any(), gomock.Any()).
+						Return(nil, models.NewFolderNotEmptyError())
+
+					response, err := sut.DeleteFolder(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsFolderNotEmptyError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("cascade is requested", func() {
+			var (
+				projectIDs []string
+			)
+
+			BeforeEach(func() {
+				request.Cascade = true
+				projectIDs = []string{cuid.New(), cuid.New()}
+
+				mockRepositoryService.
+					EXPECT().
+					ListProjects(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.ListProjectsRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+						Ω(mappedRequest.IncludeSubfolders).Should(BeTrue())
+						Ω(mappedRequest.IncludeArchived).Should(BeTrue())
+					}).
+					Return(&repository.ListProjectsResponse{
+						Projects: []models.ProjectWithCursor{
+							{ProjectID: projectIDs[0]},
+							{ProjectID: projectIDs[1]},
+						},
+					}, nil)
+			})
+
+			When("user is the owner of all the projects in the folder", func() {
+				It("should call project repository DeleteFolder method", func() {
+					expectRole(mockRepositoryService, models.MemberRoleOwner)
+					expectRole(mockRepositoryService, models.MemberRoleOwner)
+					mockRepositoryService.
+						EXPECT().
+						DeleteFolder(ctx, gomock.Any()).
+						Do(func(_ context.Context, mappedRequest *repository.DeleteFolderRequest) {
+							Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+							Ω(mappedRequest.Cascade).Should(BeTrue())
+							assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectDeleted, request.UserEmail)
+						}).
+						Return(&repository.DeleteFolderResponse{}, nil)
+
+					response, err := sut.DeleteFolder(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
+			When("user is an editor of a project in the folder", func() {
+				It("should return PermissionDeniedError without deleting the folder", func() {
+					expectRole(mockRepositoryService, models.MemberRoleOwner)
+					expectRole(mockRepositoryService, models.MemberRoleEditor)
+
+					response, err := sut.DeleteFolder(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("MoveProject is called", func() {
+		var (
+			request business.MoveProjectRequest
+		)
+
+		BeforeEach(func() {
+			request = business.MoveProjectRequest{
+				UserEmail: cuid.New() + "@test.com",
+				ProjectID: cuid.New(),
+				FolderID:  cuid.New(),
+			}
+		})
+
+		Context("user is an editor of the project", func() {
+			BeforeEach(func() {
+				expectRole(mockRepositoryService, models.MemberRoleEditor)
+			})
+
+			It("should call project repository MoveProject method", func() {
+				expectedResponse := repository.MoveProjectResponse{
+					Project: models.Project{
+						Name:     cuid.New(),
+						FolderID: request.FolderID,
+					},
+					Cursor: cuid.New(),
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					MoveProject(ctx, gomock.Any()).
+					Do(func(_ context.Context, mappedRequest *repository.MoveProjectRequest) {
+						Ω(mappedRequest.UserEmail).Should(Equal(request.UserEmail))
+						Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+						Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+						assertDomainEvent(mappedRequest.Event, models.DomainEventTypeProjectMoved, request.UserEmail)
+					}).
+					Return(&expectedResponse, nil)
+
+				response, err := sut.MoveProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Project).Should(Equal(expectedResponse.Project))
+				Ω(response.Cursor).Should(Equal(signCursor(cursorSigningKey, expectedResponse.Cursor)))
+			})
+
+			When("project repository MoveProject returns error", func() {
+				It("should return the same error", func() {
+					mockRepositoryService.
+						EXPECT().
+						MoveProject(gomock.Any(), gomock.Any()).
+						Return(nil, commonErrors.NewNotFoundError())
+
+					response, err := sut.MoveProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+				})
+			})
+		})
+
+		Context("user is a viewer of the project", func() {
+			It("should return PermissionDeniedError without moving the project", func() {
+				expectRole(mockRepositoryService, models.MemberRoleViewer)
+
+				response, err := sut.MoveProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(models.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
+
+func assertArgumentError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+
+	var argumentErr commonErrors.ArgumentError
+	_ = errors.As(err, &argumentErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
+
+func signCursor(key, cursor string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	_, _ = mac.Write([]byte(cursor))
+
+	return cursor + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signPagination(key string, pagination common.Pagination) common.Pagination {
+	if pagination.After != nil {
+		pagination.After = convertStringToPointer(signCursor(key, *pagination.After))
+	}
+
+	if pagination.Before != nil {
+		pagination.Before = convertStringToPointer(signCursor(key, *pagination.Before))
+	}
+
+	return pagination
+}
+
+func assertSignedProjects(key string, projects, expectedProjects []models.ProjectWithCursor) {
+	Ω(projects).Should(HaveLen(len(expectedProjects)))
+	for idx, project := range projects {
+		Ω(project.Cursor).Should(Equal(signCursor(key, expectedProjects[idx].Cursor)))
+		Ω(project.ProjectID).Should(Equal(expectedProjects[idx].ProjectID))
+		Ω(project.Project).Should(Equal(expectedProjects[idx].Project))
+	}
+}
+
+func convertStringToPointer(str string) *string {
+	return &str
+}
+
+func convertIntToPointer(i int) *int {
+	return &i
+}
+
+// expectRole expects the project repository ReadEffectiveRole method to be called once and to return the role
+// of the user in the project
+func expectRole(mockRepositoryService *repsoitoryMock.MockRepositoryContract, role models.MemberRole) {
+	mockRepositoryService.
+		EXPECT().
+		ReadEffectiveRole(gomock.Any(), gomock.Any()).
+		Return(&repository.ReadEffectiveRoleResponse{Role: role}, nil)
+}
+
+// assertDomainEvent asserts that the event of the change of the project is created for the user
+func assertDomainEvent(event *models.DomainEvent, eventType models.DomainEventType, userEmail string) {
+	Ω(event).ShouldNot(BeNil())
+	Ω(event.EventID).ShouldNot(BeEmpty())
+	Ω(event.Type).Should(Equal(eventType))
+	Ω(event.ProjectID).Should(BeEmpty())
+	Ω(event.UserEmail).Should(Equal(userEmail))
+	Ω(event.OccurredAt).Should(BeTemporally("~", time.Now(), time.Minute))
+}
+
+// watchRepositoryService is a repository service that can also watch the changes of the projects
+type watchRepositoryService struct {
+	*repsoitoryMock.MockRepositoryContract
+	*repsoitoryMock.MockWatchContract
+}
+
+// expectOrganizationRole expects the project repository ReadOrganizationMember method to be called once and to
+// return a member of the organization with the provided role
+func expectOrganizationRole(mockRepositoryService *repsoitoryMock.MockRepositoryContract, role models.MemberRole) {
+	mockRepositoryService.
+		EXPECT().
+		ReadOrganizationMember(gomock.Any(), gomock.Any()).
+		Return(&repository.ReadOrganizationMemberResponse{Member: models.Member{Role: role}}, nil)
+}
+
+// expectMemberRole expects the project repository ReadMember method to be called once and to return a member
+// with the provided role
+func expectMemberRole(mockRepositoryService *repsoitoryMock.MockRepositoryContract, role models.MemberRole) {
+	mockRepositoryService.
+		EXPECT().
+		ReadMember(gomock.Any(), gomock.Any()).
+		Return(&repository.ReadMemberResponse{Member: models.Member{Role: role}}, nil)
+}
+
+// expectOwnershipTransfer expects the project repository ReadOwnershipTransfer method to be called once and to
+// return the provided pending transfer
+func expectOwnershipTransfer(mockRepositoryService *repsoitoryMock.MockRepositoryContract, transfer models.OwnershipTransfer) {
+	mockRepositoryService.
+		EXPECT().
+		ReadOwnershipTransfer(gomock.Any(), gomock.Any()).
+		Return(&repository.ReadOwnershipTransferResponse{Transfer: transfer}, nil)
+}
+
+// expectInvitation expects the project repository ReadInvitation method to be called once and to return the
+// provided invitation. The token hash is only checked if it is provided.
+func expectInvitation(mockRepositoryService *repsoitoryMock.MockRepositoryContract, tokenHash string, invitation models.Invitation) {
+	mockRepositoryService.
+		EXPECT().
+		ReadInvitation(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, mappedRequest *repository.ReadInvitationRequest) {
+			if tokenHash != "" {
+				Ω(mappedRequest.TokenHash).Should(Equal(tokenHash))
+			}
+		}).
+		Return(&repository.ReadInvitationResponse{Invitation: invitation}, nil)
 }
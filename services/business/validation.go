@@ -2,6 +2,7 @@
 package business
 
 import (
+	"github.com/decentralized-cloud/project/models"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 )
@@ -14,6 +15,8 @@ func (val CreateProjectRequest) Validate() error {
 		validation.Field(&val.UserEmail, validation.Required, is.Email),
 		// Validate Project using its own validation rules
 		validation.Field(&val.Project),
+		// IdempotencyKey is optional, but cannot be longer than 255 characters
+		validation.Field(&val.IdempotencyKey, validation.Length(0, 255)),
 	)
 }
 
@@ -36,11 +39,19 @@ func (val UpdateProjectRequest) Validate() error {
 		validation.Field(&val.UserEmail, validation.Required, is.Email),
 		// ProjectID cannot be empty
 		validation.Field(&val.ProjectID, validation.Required),
-		// Validate Project using its own validation rules
-		validation.Field(&val.Project),
+		// Validate the fields of the Project the update mask applies using their own validation rules
+		validation.Field(&val.Project, validation.By(val.validateProject), validation.Skip),
+		// ExpectedVersion is optional, but cannot be negative
+		validation.Field(&val.ExpectedVersion, validation.Min(int64(0))),
+		// UpdateMask is optional, but can only list the fields the users can update
+		validation.Field(&val.UpdateMask, validation.By(models.ValidateUpdateMask)),
 	)
 }
 
+func (val UpdateProjectRequest) validateProject(value interface{}) error {
+	return val.Project.ValidatePaths(val.UpdateMask)
+}
+
 // Validate validates the DeleteProjectRequest model and return error if the validation failes
 // Returns error if validation failes
 func (val DeleteProjectRequest) Validate() error {
@@ -49,11 +60,428 @@ func (val DeleteProjectRequest) Validate() error {
 		validation.Field(&val.UserEmail, validation.Required, is.Email),
 		// ProjectID cannot be empty
 		validation.Field(&val.ProjectID, validation.Required),
+		// ExpectedVersion is optional, but cannot be negative
+		validation.Field(&val.ExpectedVersion, validation.Min(int64(0))),
+	)
+}
+
+// Validate validates the BatchCreateProjectsRequest model and return error if the validation failes. The
+// projects are validated one by one by the business service, so every invalid project gets its own result.
+// Returns error if validation failes
+func (val BatchCreateProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Projects cannot be empty
+		validation.Field(&val.Projects, validation.Required),
+	)
+}
+
+// Validate validates the BatchUpdateProjectsRequest model and return error if the validation failes. The
+// projects are validated one by one by the business service, so every invalid project gets its own result.
+// Returns error if validation failes
+func (val BatchUpdateProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Projects cannot be empty
+		validation.Field(&val.Projects, validation.Required),
+	)
+}
+
+// Validate validates the BatchDeleteProjectsRequest model and return error if the validation failes. The
+// projects are validated one by one by the business service, so every invalid project gets its own result.
+// Returns error if validation failes
+func (val BatchDeleteProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Projects cannot be empty
+		validation.Field(&val.Projects, validation.Required),
+	)
+}
+
+// Validate validates the ArchiveProjectRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ArchiveProjectRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// ExpectedVersion is optional, but cannot be negative
+		validation.Field(&val.ExpectedVersion, validation.Min(int64(0))),
+	)
+}
+
+// Validate validates the UnarchiveProjectRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UnarchiveProjectRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// ExpectedVersion is optional, but cannot be negative
+		validation.Field(&val.ExpectedVersion, validation.Min(int64(0))),
+	)
+}
+
+// Validate validates the RestoreProjectRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RestoreProjectRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// ExpectedVersion is optional, but cannot be negative
+		validation.Field(&val.ExpectedVersion, validation.Min(int64(0))),
+	)
+}
+
+// Validate validates the PurgeDeletedProjectsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val PurgeDeletedProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// DeletedBefore must be provided
+		validation.Field(&val.DeletedBefore, validation.Required),
 	)
 }
 
 // Validate validates the ListProjectsRequest model and return error if the validation failes
 // Returns error if validation failes
 func (val ListProjectsRequest) Validate() error {
-	return nil
+	return validation.ValidateStruct(&val,
+		// SortingOptions can only sort on the fields of the catalogue
+		validation.Field(&val.SortingOptions, validation.By(models.ValidateSortingOptions)),
+		// Validate Filter using its own validation rules
+		validation.Field(&val.Filter),
+	)
+}
+
+// Validate validates the StreamProjectsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val StreamProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// SortingOptions can only sort on the fields of the catalogue
+		validation.Field(&val.SortingOptions, validation.By(models.ValidateSortingOptions)),
+		// Validate Filter using its own validation rules
+		validation.Field(&val.Filter),
+	)
+}
+
+// Validate validates the WatchProjectsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val WatchProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ResourceVersion is optional, but cannot be longer than 1024 characters
+		validation.Field(&val.ResourceVersion, validation.Length(0, 1024)),
+	)
+}
+
+// Validate validates the SearchProjectsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val SearchProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Query must be provided and cannot be longer than 256 characters
+		validation.Field(&val.Query, validation.Required, validation.RuneLength(1, 256)),
+	)
+}
+
+// Validate validates the ListDeletedProjectsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListDeletedProjectsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// SortingOptions can only sort on the fields of the catalogue
+		validation.Field(&val.SortingOptions, validation.By(models.ValidateSortingOptions)),
+	)
+}
+
+// Validate validates the AddMemberRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AddMemberRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// MemberEmail must be provided
+		validation.Field(&val.MemberEmail, validation.Required, is.Email),
+		// A project has a single owner, so the new members can only be viewers or editors
+		validation.Field(&val.Role, validation.In(models.MemberRoleViewer, models.MemberRoleEditor)),
+	)
+}
+
+// Validate validates the RemoveMemberRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RemoveMemberRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// MemberEmail must be provided
+		validation.Field(&val.MemberEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the UpdateMemberRoleRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UpdateMemberRoleRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// MemberEmail must be provided
+		validation.Field(&val.MemberEmail, validation.Required, is.Email),
+		// A project has a single owner, so the members can only become viewers or editors
+		validation.Field(&val.Role, validation.In(models.MemberRoleViewer, models.MemberRoleEditor)),
+	)
+}
+
+// Validate validates the ListMembersRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListMembersRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
+}
+
+// Validate validates the TransferProjectOwnershipRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val TransferProjectOwnershipRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// NewOwnerEmail must be provided
+		validation.Field(&val.NewOwnerEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the AcceptProjectOwnershipTransferRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AcceptProjectOwnershipTransferRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
+}
+
+// Validate validates the DeclineProjectOwnershipTransferRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val DeclineProjectOwnershipTransferRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
+}
+
+// Validate validates the ListProjectAuditEventsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListProjectAuditEventsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
+}
+
+// Validate validates the CreateInvitationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val CreateInvitationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// The email of the invited user must be provided
+		validation.Field(&val.Email, validation.Required, is.Email),
+		// A project has a single owner, so the users can only be invited as viewers or editors
+		validation.Field(&val.Role, validation.In(models.MemberRoleViewer, models.MemberRoleEditor)),
+	)
+}
+
+// Validate validates the ListInvitationsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListInvitationsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
+}
+
+// Validate validates the AcceptInvitationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AcceptInvitationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Token cannot be empty
+		validation.Field(&val.Token, validation.Required),
+	)
+}
+
+// Validate validates the RevokeInvitationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RevokeInvitationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+		// The email of the invited user must be provided
+		validation.Field(&val.Email, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the CreateOrganizationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val CreateOrganizationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Validate Organization using its own validation rules
+		validation.Field(&val.Organization),
+	)
+}
+
+// Validate validates the ReadOrganizationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ReadOrganizationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+	)
+}
+
+// Validate validates the UpdateOrganizationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UpdateOrganizationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+		// Validate Organization using its own validation rules
+		validation.Field(&val.Organization),
+	)
+}
+
+// Validate validates the DeleteOrganizationRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val DeleteOrganizationRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+	)
+}
+
+// Validate validates the ListOrganizationsRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListOrganizationsRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the AddOrganizationMemberRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AddOrganizationMemberRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+		// MemberEmail must be provided
+		validation.Field(&val.MemberEmail, validation.Required, is.Email),
+		// An organization has a single owner, so the new members can only be viewers or editors
+		validation.Field(&val.Role, validation.In(models.MemberRoleViewer, models.MemberRoleEditor)),
+	)
+}
+
+// Validate validates the RemoveOrganizationMemberRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RemoveOrganizationMemberRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+		// MemberEmail must be provided
+		validation.Field(&val.MemberEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the ListOrganizationMembersRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListOrganizationMembersRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// OrganizationID cannot be empty
+		validation.Field(&val.OrganizationID, validation.Required),
+	)
+}
+
+// Validate validates the CreateFolderRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val CreateFolderRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Validate Folder using its own validation rules
+		validation.Field(&val.Folder),
+	)
+}
+
+// Validate validates the ListFoldersRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListFoldersRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the DeleteFolderRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val DeleteFolderRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// FolderID cannot be empty
+		validation.Field(&val.FolderID, validation.Required),
+	)
+}
+
+// Validate validates the MoveProjectRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val MoveProjectRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// ProjectID cannot be empty
+		validation.Field(&val.ProjectID, validation.Required),
+	)
 }
@@ -1,6 +1,51 @@
 // Package configuration implements configuration service required by the project service
 package configuration
 
+import "time"
+
+// DatabaseType defines the storage backend the repository service persists the projects in
+type DatabaseType string
+
+const (
+	// MongoDB stores the projects in a MongoDB database
+	MongoDB DatabaseType = "mongodb"
+
+	// Postgres stores the projects in a PostgreSQL database
+	Postgres DatabaseType = "postgres"
+
+	// Bolt stores the projects in a local bbolt data file, mainly used for edge deployments
+	Bolt DatabaseType = "bolt"
+
+	// Memory keeps the projects in the process memory, mainly used for local development and tests
+	Memory DatabaseType = "memory"
+)
+
+// NotifierType defines the notifier service the invitations are delivered with
+type NotifierType string
+
+const (
+	// LogNotifier writes the invitations to the service log, mainly used for local development
+	LogNotifier NotifierType = "log"
+
+	// FileNotifier appends the invitations to a local file, mainly used for tests
+	FileNotifier NotifierType = "file"
+)
+
+// EventPublisherType defines the publisher service the domain events of the projects are delivered with
+type EventPublisherType string
+
+const (
+	// NatsEventPublisher delivers the domain events to a NATS JetStream stream
+	NatsEventPublisher EventPublisherType = "nats"
+
+	// KafkaEventPublisher delivers the domain events to a Kafka topic
+	KafkaEventPublisher EventPublisherType = "kafka"
+
+	// MemoryEventPublisher keeps the domain events in the process memory without delivering them anywhere, only
+	// used for tests, as the events are lost when the service stops
+	MemoryEventPublisher EventPublisherType = "memory"
+)
+
 // ConfigurationContract declares the service that provides configuration required by different Tenat modules
 type ConfigurationContract interface {
 	// GetGrpcHost retrieves the gRPC host name
@@ -19,6 +64,18 @@ type ConfigurationContract interface {
 	// Returns the HTTP port number or error if something goes wrong
 	GetHttpPort() (int, error)
 
+	// GetAdminHost retrieves the host name the administrative HTTP endpoints, e.g. the data file backup, listen on
+	// Returns the admin host name or error if something goes wrong
+	GetAdminHost() (string, error)
+
+	// GetAdminPort retrieves the port number the administrative HTTP endpoints, e.g. the data file backup, listen on
+	// Returns the admin port number or error if something goes wrong
+	GetAdminPort() (int, error)
+
+	// GetDatabaseType retrieves the type of the database the repository service persists the projects in
+	// Returns the database type or error if something goes wrong
+	GetDatabaseType() (DatabaseType, error)
+
 	// GetDatabaseConnectionString retrieves the database connection string
 	// Returns the database connection string or error if something goes wrong
 	GetDatabaseConnectionString() (string, error)
@@ -31,6 +88,86 @@ type ConfigurationContract interface {
 	// Returns the database collection name or error if something goes wrong
 	GetDatabaseCollectionName() (string, error)
 
+	// GetDatabaseMaxPoolSize retrieves the maximum number of connections allowed in the database connection pool
+	// Returns the maximum connection pool size or error if something goes wrong
+	GetDatabaseMaxPoolSize() (uint64, error)
+
+	// GetDatabaseConnectTimeout retrieves the timeout used when establishing a new database connection
+	// Returns the database connect timeout or error if something goes wrong
+	GetDatabaseConnectTimeout() (time.Duration, error)
+
+	// GetDatabaseServerSelectionTimeout retrieves the timeout used to find an available database server
+	// Returns the database server selection timeout or error if something goes wrong
+	GetDatabaseServerSelectionTimeout() (time.Duration, error)
+
+	// GetDeletedProjectsRetentionPeriod retrieves how long the deleted projects are kept before they are purged
+	// Returns the deleted projects retention period or error if something goes wrong
+	GetDeletedProjectsRetentionPeriod() (time.Duration, error)
+
+	// GetDeletedProjectsPurgeInterval retrieves how often the deleted projects that passed the retention period are purged
+	// Returns the deleted projects purge interval or error if something goes wrong
+	GetDeletedProjectsPurgeInterval() (time.Duration, error)
+
+	// GetOwnershipTransferExpiry retrieves how long the new owner has to accept a pending ownership transfer
+	// Returns the ownership transfer expiry or error if something goes wrong
+	GetOwnershipTransferExpiry() (time.Duration, error)
+
+	// GetInvitationExpiry retrieves how long the invited users have to accept their invitations
+	// Returns the invitation expiry or error if something goes wrong
+	GetInvitationExpiry() (time.Duration, error)
+
+	// GetMaxBatchSize retrieves the maximum number of projects a batch operation can change at once
+	// Returns the maximum batch size or error if something goes wrong
+	GetMaxBatchSize() (int, error)
+
+	// GetIdempotencyKeyTTL retrieves how long the idempotency keys of the created projects are remembered
+	// Returns the idempotency key TTL or error if something goes wrong
+	GetIdempotencyKeyTTL() (time.Duration, error)
+
+	// GetCursorSigningKey retrieves the key the pagination cursors are signed with
+	// Returns the cursor signing key or error if something goes wrong
+	GetCursorSigningKey() (string, error)
+
+	// GetNotifierType retrieves the type of the notifier service the invitations are delivered with
+	// Returns the notifier type or error if something goes wrong
+	GetNotifierType() (NotifierType, error)
+
+	// GetNotifierFilePath retrieves the path to the file the file notifier service appends the invitations to
+	// Returns the notifier file path or error if something goes wrong
+	GetNotifierFilePath() (string, error)
+
+	// GetEventPublisherType retrieves the type of the publisher service the domain events are delivered with
+	// Returns the event publisher type or error if something goes wrong
+	GetEventPublisherType() (EventPublisherType, error)
+
+	// GetNatsURL retrieves the URL of the NATS server the NATS publisher service delivers the domain events to
+	// Returns the NATS URL or error if something goes wrong
+	GetNatsURL() (string, error)
+
+	// GetNatsSubject retrieves the subject prefix the NATS publisher service publishes the domain events to
+	// Returns the NATS subject or error if something goes wrong
+	GetNatsSubject() (string, error)
+
+	// GetKafkaBrokers retrieves the addresses of the Kafka brokers the Kafka publisher service delivers the domain events to
+	// Returns the Kafka brokers or error if something goes wrong
+	GetKafkaBrokers() ([]string, error)
+
+	// GetKafkaTopic retrieves the topic the Kafka publisher service publishes the domain events to
+	// Returns the Kafka topic or error if something goes wrong
+	GetKafkaTopic() (string, error)
+
+	// GetOutboxRelayInterval retrieves how often the domain events written to the outbox are published
+	// Returns the outbox relay interval or error if something goes wrong
+	GetOutboxRelayInterval() (time.Duration, error)
+
+	// GetOutboxRelayBatchSize retrieves the maximum number of domain events read from the outbox at once
+	// Returns the outbox relay batch size or error if something goes wrong
+	GetOutboxRelayBatchSize() (int, error)
+
+	// GetOutboxRelayTimeout retrieves how long publishing a batch of the domain events read from the outbox can take
+	// Returns the outbox relay timeout or error if something goes wrong
+	GetOutboxRelayTimeout() (time.Duration, error)
+
 	// GetJwksURL retrieves the JWKS URL
 	// Returns the JWKS URL or error if something goes wrong
 	GetJwksURL() (string, error)
@@ -2,13 +2,34 @@
 package configuration
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	commonErrors "github.com/micro-business/go-core/system/errors"
 )
 
+const (
+	defaultAdminHost                      = "localhost"
+	defaultAdminPort                      = 82
+	defaultDatabaseMaxPoolSize            = 100
+	defaultDatabaseConnectTimeout         = 30 * time.Second
+	defaultDatabaseServerSelectionTimeout = 30 * time.Second
+	defaultDeletedProjectsRetentionPeriod = 30 * 24 * time.Hour
+	defaultDeletedProjectsPurgeInterval   = time.Hour
+	defaultOwnershipTransferExpiry        = 7 * 24 * time.Hour
+	defaultInvitationExpiry               = 7 * 24 * time.Hour
+	defaultMaxBatchSize                   = 100
+	defaultIdempotencyKeyTTL              = 24 * time.Hour
+	defaultNatsSubject                    = "project.events"
+	defaultKafkaTopic                     = "project-events"
+	defaultOutboxRelayInterval            = time.Second
+	defaultOutboxRelayBatchSize           = 100
+	defaultOutboxRelayTimeout             = 30 * time.Second
+)
+
 type envConfigurationService struct {
 }
 
@@ -62,6 +83,49 @@ func (service *envConfigurationService) GetHttpPort() (int, error) {
 	return portNumber, nil
 }
 
+// GetAdminHost retrieves the host name the administrative HTTP endpoints, e.g. the data file backup, listen on.
+// The endpoints are only reachable from the local machine unless ADMIN_HOST is set explicitly.
+// Returns the admin host name or error if something goes wrong
+func (service *envConfigurationService) GetAdminHost() (string, error) {
+	host := strings.Trim(os.Getenv("ADMIN_HOST"), " ")
+	if host == "" {
+		return defaultAdminHost, nil
+	}
+
+	return host, nil
+}
+
+// GetAdminPort retrieves the port number the administrative HTTP endpoints, e.g. the data file backup, listen on
+// Returns the admin port number or error if something goes wrong
+func (service *envConfigurationService) GetAdminPort() (int, error) {
+	portNumberString := os.Getenv("ADMIN_PORT")
+	if strings.Trim(portNumberString, " ") == "" {
+		return defaultAdminPort, nil
+	}
+
+	portNumber, err := strconv.Atoi(portNumberString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError("failed to convert ADMIN_PORT to integer", err)
+	}
+
+	return portNumber, nil
+}
+
+// GetDatabaseType retrieves the type of the database the repository service persists the projects in
+// Returns the database type or error if something goes wrong
+func (service *envConfigurationService) GetDatabaseType() (DatabaseType, error) {
+	databaseType := DatabaseType(strings.ToLower(strings.Trim(os.Getenv("DATABASE_TYPE"), " ")))
+
+	switch databaseType {
+	case "":
+		return MongoDB, nil
+	case MongoDB, Postgres, Bolt, Memory:
+		return databaseType, nil
+	default:
+		return "", commonErrors.NewUnknownError(fmt.Sprintf("DATABASE_TYPE %s is not supported", databaseType))
+	}
+}
+
 // GetDatabaseConnectionString retrieves the database connection string
 // Returns the database connection string or error if something goes wrong
 func (service *envConfigurationService) GetDatabaseConnectionString() (string, error) {
@@ -99,6 +163,222 @@ func (service *envConfigurationService) GetDatabaseCollectionName() (string, err
 	return databaseCollectionName, nil
 }
 
+// GetDatabaseMaxPoolSize retrieves the maximum number of connections allowed in the database connection pool
+// Returns the maximum connection pool size or error if something goes wrong
+func (service *envConfigurationService) GetDatabaseMaxPoolSize() (uint64, error) {
+	maxPoolSizeString := os.Getenv("DATABASE_MAX_POOL_SIZE")
+	if strings.Trim(maxPoolSizeString, " ") == "" {
+		return defaultDatabaseMaxPoolSize, nil
+	}
+
+	maxPoolSize, err := strconv.ParseUint(maxPoolSizeString, 10, 64)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError("failed to convert DATABASE_MAX_POOL_SIZE to integer", err)
+	}
+
+	return maxPoolSize, nil
+}
+
+// GetDatabaseConnectTimeout retrieves the timeout used when establishing a new database connection
+// Returns the database connect timeout or error if something goes wrong
+func (service *envConfigurationService) GetDatabaseConnectTimeout() (time.Duration, error) {
+	return getDurationFromEnv("DATABASE_CONNECT_TIMEOUT", defaultDatabaseConnectTimeout)
+}
+
+// GetDatabaseServerSelectionTimeout retrieves the timeout used to find an available database server
+// Returns the database server selection timeout or error if something goes wrong
+func (service *envConfigurationService) GetDatabaseServerSelectionTimeout() (time.Duration, error) {
+	return getDurationFromEnv("DATABASE_SERVER_SELECTION_TIMEOUT", defaultDatabaseServerSelectionTimeout)
+}
+
+// GetDeletedProjectsRetentionPeriod retrieves how long the deleted projects are kept before they are purged
+// Returns the deleted projects retention period or error if something goes wrong
+func (service *envConfigurationService) GetDeletedProjectsRetentionPeriod() (time.Duration, error) {
+	return getDurationFromEnv("DELETED_PROJECTS_RETENTION_PERIOD", defaultDeletedProjectsRetentionPeriod)
+}
+
+// GetDeletedProjectsPurgeInterval retrieves how often the deleted projects that passed the retention period are purged
+// Returns the deleted projects purge interval or error if something goes wrong
+func (service *envConfigurationService) GetDeletedProjectsPurgeInterval() (time.Duration, error) {
+	return getDurationFromEnv("DELETED_PROJECTS_PURGE_INTERVAL", defaultDeletedProjectsPurgeInterval)
+}
+
+// GetOwnershipTransferExpiry retrieves how long the new owner has to accept a pending ownership transfer
+// Returns the ownership transfer expiry or error if something goes wrong
+func (service *envConfigurationService) GetOwnershipTransferExpiry() (time.Duration, error) {
+	return getDurationFromEnv("OWNERSHIP_TRANSFER_EXPIRY", defaultOwnershipTransferExpiry)
+}
+
+// GetInvitationExpiry retrieves how long the invited users have to accept their invitations
+// Returns the invitation expiry or error if something goes wrong
+func (service *envConfigurationService) GetInvitationExpiry() (time.Duration, error) {
+	return getDurationFromEnv("INVITATION_EXPIRY", defaultInvitationExpiry)
+}
+
+// GetMaxBatchSize retrieves the maximum number of projects a batch operation can change at once
+// Returns the maximum batch size or error if something goes wrong
+func (service *envConfigurationService) GetMaxBatchSize() (int, error) {
+	maxBatchSizeString := os.Getenv("MAX_BATCH_SIZE")
+	if strings.Trim(maxBatchSizeString, " ") == "" {
+		return defaultMaxBatchSize, nil
+	}
+
+	maxBatchSize, err := strconv.Atoi(maxBatchSizeString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError("failed to convert MAX_BATCH_SIZE to integer", err)
+	}
+
+	if maxBatchSize <= 0 {
+		return 0, commonErrors.NewUnknownError("MAX_BATCH_SIZE must be greater than zero")
+	}
+
+	return maxBatchSize, nil
+}
+
+// GetIdempotencyKeyTTL retrieves how long the idempotency keys of the created projects are remembered
+// Returns the idempotency key TTL or error if something goes wrong
+func (service *envConfigurationService) GetIdempotencyKeyTTL() (time.Duration, error) {
+	return getDurationFromEnv("IDEMPOTENCY_KEY_TTL", defaultIdempotencyKeyTTL)
+}
+
+// GetCursorSigningKey retrieves the key the pagination cursors are signed with
+// Returns the cursor signing key or error if something goes wrong
+func (service *envConfigurationService) GetCursorSigningKey() (string, error) {
+	signingKey := os.Getenv("CURSOR_SIGNING_KEY")
+
+	if strings.Trim(signingKey, " ") == "" {
+		return "", commonErrors.NewUnknownError("CURSOR_SIGNING_KEY is required")
+	}
+
+	return signingKey, nil
+}
+
+// GetNotifierType retrieves the type of the notifier service the invitations are delivered with
+// Returns the notifier type or error if something goes wrong
+func (service *envConfigurationService) GetNotifierType() (NotifierType, error) {
+	notifierType := NotifierType(strings.ToLower(strings.Trim(os.Getenv("NOTIFIER_TYPE"), " ")))
+
+	switch notifierType {
+	case "":
+		return LogNotifier, nil
+	case LogNotifier, FileNotifier:
+		return notifierType, nil
+	default:
+		return "", commonErrors.NewUnknownError(fmt.Sprintf("NOTIFIER_TYPE %s is not supported", notifierType))
+	}
+}
+
+// GetNotifierFilePath retrieves the path to the file the file notifier service appends the invitations to
+// Returns the notifier file path or error if something goes wrong
+func (service *envConfigurationService) GetNotifierFilePath() (string, error) {
+	filePath := os.Getenv("NOTIFIER_FILE_PATH")
+
+	if strings.Trim(filePath, " ") == "" {
+		return "", commonErrors.NewUnknownError("NOTIFIER_FILE_PATH is required")
+	}
+
+	return filePath, nil
+}
+
+// GetEventPublisherType retrieves the type of the publisher service the domain events are delivered with.
+// There is no default, as the memory publisher would silently lose the events, so it must be chosen explicitly.
+// Returns the event publisher type or error if something goes wrong
+func (service *envConfigurationService) GetEventPublisherType() (EventPublisherType, error) {
+	eventPublisherType := EventPublisherType(strings.ToLower(strings.Trim(os.Getenv("EVENT_PUBLISHER_TYPE"), " ")))
+
+	switch eventPublisherType {
+	case "":
+		return "", commonErrors.NewUnknownError("EVENT_PUBLISHER_TYPE is required")
+	case NatsEventPublisher, KafkaEventPublisher, MemoryEventPublisher:
+		return eventPublisherType, nil
+	default:
+		return "", commonErrors.NewUnknownError(fmt.Sprintf("EVENT_PUBLISHER_TYPE %s is not supported", eventPublisherType))
+	}
+}
+
+// GetNatsURL retrieves the URL of the NATS server the NATS publisher service delivers the domain events to
+// Returns the NATS URL or error if something goes wrong
+func (service *envConfigurationService) GetNatsURL() (string, error) {
+	natsURL := os.Getenv("NATS_URL")
+
+	if strings.Trim(natsURL, " ") == "" {
+		return "", commonErrors.NewUnknownError("NATS_URL is required")
+	}
+
+	return natsURL, nil
+}
+
+// GetNatsSubject retrieves the subject prefix the NATS publisher service publishes the domain events to
+// Returns the NATS subject or error if something goes wrong
+func (service *envConfigurationService) GetNatsSubject() (string, error) {
+	subject := strings.Trim(os.Getenv("NATS_SUBJECT"), " ")
+	if subject == "" {
+		return defaultNatsSubject, nil
+	}
+
+	return subject, nil
+}
+
+// GetKafkaBrokers retrieves the addresses of the Kafka brokers the Kafka publisher service delivers the domain events to
+// Returns the Kafka brokers or error if something goes wrong
+func (service *envConfigurationService) GetKafkaBrokers() ([]string, error) {
+	brokers := []string{}
+	for _, broker := range strings.Split(os.Getenv("KAFKA_BROKERS"), ",") {
+		if broker = strings.Trim(broker, " "); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+
+	if len(brokers) == 0 {
+		return nil, commonErrors.NewUnknownError("KAFKA_BROKERS is required")
+	}
+
+	return brokers, nil
+}
+
+// GetKafkaTopic retrieves the topic the Kafka publisher service publishes the domain events to
+// Returns the Kafka topic or error if something goes wrong
+func (service *envConfigurationService) GetKafkaTopic() (string, error) {
+	topic := strings.Trim(os.Getenv("KAFKA_TOPIC"), " ")
+	if topic == "" {
+		return defaultKafkaTopic, nil
+	}
+
+	return topic, nil
+}
+
+// GetOutboxRelayInterval retrieves how often the domain events written to the outbox are published
+// Returns the outbox relay interval or error if something goes wrong
+func (service *envConfigurationService) GetOutboxRelayInterval() (time.Duration, error) {
+	return getDurationFromEnv("OUTBOX_RELAY_INTERVAL", defaultOutboxRelayInterval)
+}
+
+// GetOutboxRelayBatchSize retrieves the maximum number of domain events read from the outbox at once
+// Returns the outbox relay batch size or error if something goes wrong
+func (service *envConfigurationService) GetOutboxRelayBatchSize() (int, error) {
+	batchSizeString := os.Getenv("OUTBOX_RELAY_BATCH_SIZE")
+	if strings.Trim(batchSizeString, " ") == "" {
+		return defaultOutboxRelayBatchSize, nil
+	}
+
+	batchSize, err := strconv.Atoi(batchSizeString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError("failed to convert OUTBOX_RELAY_BATCH_SIZE to integer", err)
+	}
+
+	if batchSize <= 0 {
+		return 0, commonErrors.NewUnknownError("OUTBOX_RELAY_BATCH_SIZE must be greater than zero")
+	}
+
+	return batchSize, nil
+}
+
+// GetOutboxRelayTimeout retrieves how long publishing a batch of the domain events read from the outbox can take
+// Returns the outbox relay timeout or error if something goes wrong
+func (service *envConfigurationService) GetOutboxRelayTimeout() (time.Duration, error) {
+	return getDurationFromEnv("OUTBOX_RELAY_TIMEOUT", defaultOutboxRelayTimeout)
+}
+
 // GetJwksURL retrieves the JWKS URL
 // Returns the JWKS URL or error if something goes wrong
 func (service *envConfigurationService) GetJwksURL() (string, error) {
@@ -110,3 +390,23 @@ func (service *envConfigurationService) GetJwksURL() (string, error) {
 
 	return jwksURL, nil
 }
+
+// getDurationFromEnv parses the duration the environment variable is set to, which must be positive as the
+// durations are used as timeouts, expiries and intervals of the tickers
+func getDurationFromEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	durationString := os.Getenv(name)
+	if strings.Trim(durationString, " ") == "" {
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(durationString)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to convert %s to duration", name), err)
+	}
+
+	if duration <= 0 {
+		return 0, commonErrors.NewUnknownError(fmt.Sprintf("%s must be a positive duration", name))
+	}
+
+	return duration, nil
+}
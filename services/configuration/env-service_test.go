@@ -0,0 +1,270 @@
+package configuration_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/services/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvConfigurationService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Env Configuration Service Tests")
+}
+
+var _ = Describe("Env Configuration Service Tests", func() {
+	var (
+		sut            configuration.ConfigurationContract
+		environment    map[string]string
+		environmentKey = []string{
+			"ADMIN_HOST",
+			"ADMIN_PORT",
+			"DELETED_PROJECTS_RETENTION_PERIOD",
+			"DELETED_PROJECTS_PURGE_INTERVAL",
+			"OUTBOX_RELAY_INTERVAL",
+			"OUTBOX_RELAY_TIMEOUT",
+			"EVENT_PUBLISHER_TYPE",
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		sut, err = configuration.NewEnvConfigurationService()
+		Ω(err).Should(BeNil())
+
+		environment = map[string]string{}
+		for _, key := range environmentKey {
+			if value, ok := os.LookupEnv(key); ok {
+				environment[key] = value
+			}
+
+			Ω(os.Unsetenv(key)).Should(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for _, key := range environmentKey {
+			if value, ok := environment[key]; ok {
+				_ = os.Setenv(key, value)
+			} else {
+				_ = os.Unsetenv(key)
+			}
+		}
+	})
+
+	Context("user retrieves the admin HTTP address", func() {
+		When("ADMIN_HOST is not set", func() {
+			It("should only listen on the local machine", func() {
+				host, err := sut.GetAdminHost()
+				Ω(err).Should(BeNil())
+				Ω(host).Should(Equal("localhost"))
+			})
+		})
+
+		When("ADMIN_HOST is set", func() {
+			It("should return the configured host", func() {
+				Ω(os.Setenv("ADMIN_HOST", "0.0.0.0")).Should(Succeed())
+
+				host, err := sut.GetAdminHost()
+				Ω(err).Should(BeNil())
+				Ω(host).Should(Equal("0.0.0.0"))
+			})
+		})
+
+		When("ADMIN_PORT is not set", func() {
+			It("should return the default port", func() {
+				port, err := sut.GetAdminPort()
+				Ω(err).Should(BeNil())
+				Ω(port).Should(Equal(82))
+			})
+		})
+
+		When("ADMIN_PORT is set", func() {
+			It("should return the configured port", func() {
+				Ω(os.Setenv("ADMIN_PORT", "9090")).Should(Succeed())
+
+				port, err := sut.GetAdminPort()
+				Ω(err).Should(BeNil())
+				Ω(port).Should(Equal(9090))
+			})
+		})
+
+		When("ADMIN_PORT is not a number", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("ADMIN_PORT", "admin")).Should(Succeed())
+
+				_, err := sut.GetAdminPort()
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("user retrieves the settings of the deleted projects purger", func() {
+		When("DELETED_PROJECTS_PURGE_INTERVAL is not set", func() {
+			It("should return the default interval", func() {
+				interval, err := sut.GetDeletedProjectsPurgeInterval()
+				Ω(err).Should(BeNil())
+				Ω(interval).Should(Equal(time.Hour))
+			})
+		})
+
+		When("DELETED_PROJECTS_PURGE_INTERVAL is set", func() {
+			It("should return the configured interval", func() {
+				Ω(os.Setenv("DELETED_PROJECTS_PURGE_INTERVAL", "10m")).Should(Succeed())
+
+				interval, err := sut.GetDeletedProjectsPurgeInterval()
+				Ω(err).Should(BeNil())
+				Ω(interval).Should(Equal(10 * time.Minute))
+			})
+		})
+
+		When("DELETED_PROJECTS_PURGE_INTERVAL is not a duration", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("DELETED_PROJECTS_PURGE_INTERVAL", "hourly")).Should(Succeed())
+
+				_, err := sut.GetDeletedProjectsPurgeInterval()
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		When("DELETED_PROJECTS_PURGE_INTERVAL is zero", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("DELETED_PROJECTS_PURGE_INTERVAL", "0s")).Should(Succeed())
+
+				_, err := sut.GetDeletedProjectsPurgeInterval()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("DELETED_PROJECTS_PURGE_INTERVAL"))
+			})
+		})
+
+		When("DELETED_PROJECTS_PURGE_INTERVAL is negative", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("DELETED_PROJECTS_PURGE_INTERVAL", "-1m")).Should(Succeed())
+
+				_, err := sut.GetDeletedProjectsPurgeInterval()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("DELETED_PROJECTS_PURGE_INTERVAL"))
+			})
+		})
+
+		When("DELETED_PROJECTS_RETENTION_PERIOD is negative", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("DELETED_PROJECTS_RETENTION_PERIOD", "-24h")).Should(Succeed())
+
+				_, err := sut.GetDeletedProjectsRetentionPeriod()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("DELETED_PROJECTS_RETENTION_PERIOD"))
+			})
+		})
+	})
+
+	Context("user retrieves the interval of the outbox relay", func() {
+		When("OUTBOX_RELAY_INTERVAL is not set", func() {
+			It("should return the default interval", func() {
+				interval, err := sut.GetOutboxRelayInterval()
+				Ω(err).Should(BeNil())
+				Ω(interval).Should(Equal(time.Second))
+			})
+		})
+
+		When("OUTBOX_RELAY_INTERVAL is set", func() {
+			It("should return the configured interval", func() {
+				Ω(os.Setenv("OUTBOX_RELAY_INTERVAL", "500ms")).Should(Succeed())
+
+				interval, err := sut.GetOutboxRelayInterval()
+				Ω(err).Should(BeNil())
+				Ω(interval).Should(Equal(500 * time.Millisecond))
+			})
+		})
+
+		When("OUTBOX_RELAY_INTERVAL is zero", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("OUTBOX_RELAY_INTERVAL", "0")).Should(Succeed())
+
+				_, err := sut.GetOutboxRelayInterval()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("OUTBOX_RELAY_INTERVAL"))
+			})
+		})
+
+		When("OUTBOX_RELAY_INTERVAL is negative", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("OUTBOX_RELAY_INTERVAL", "-1s")).Should(Succeed())
+
+				_, err := sut.GetOutboxRelayInterval()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("OUTBOX_RELAY_INTERVAL"))
+			})
+		})
+	})
+
+	Context("user retrieves the timeout of the outbox relay", func() {
+		When("OUTBOX_RELAY_TIMEOUT is not set", func() {
+			It("should return the default timeout", func() {
+				timeout, err := sut.GetOutboxRelayTimeout()
+				Ω(err).Should(BeNil())
+				Ω(timeout).Should(Equal(30 * time.Second))
+			})
+		})
+
+		When("OUTBOX_RELAY_TIMEOUT is set", func() {
+			It("should return the configured timeout", func() {
+				Ω(os.Setenv("OUTBOX_RELAY_TIMEOUT", "5s")).Should(Succeed())
+
+				timeout, err := sut.GetOutboxRelayTimeout()
+				Ω(err).Should(BeNil())
+				Ω(timeout).Should(Equal(5 * time.Second))
+			})
+		})
+
+		When("OUTBOX_RELAY_TIMEOUT is zero", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("OUTBOX_RELAY_TIMEOUT", "0")).Should(Succeed())
+
+				_, err := sut.GetOutboxRelayTimeout()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("OUTBOX_RELAY_TIMEOUT"))
+			})
+		})
+	})
+
+	Context("user retrieves the type of the event publisher", func() {
+		When("EVENT_PUBLISHER_TYPE is not set", func() {
+			It("should return error instead of falling back to the memory publisher", func() {
+				_, err := sut.GetEventPublisherType()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("EVENT_PUBLISHER_TYPE"))
+			})
+		})
+
+		When("EVENT_PUBLISHER_TYPE is set to a supported type", func() {
+			It("should return the configured type", func() {
+				for _, eventPublisherType := range []configuration.EventPublisherType{
+					configuration.NatsEventPublisher,
+					configuration.KafkaEventPublisher,
+					configuration.MemoryEventPublisher,
+				} {
+					Ω(os.Setenv("EVENT_PUBLISHER_TYPE", string(eventPublisherType))).Should(Succeed())
+
+					configuredType, err := sut.GetEventPublisherType()
+					Ω(err).Should(BeNil())
+					Ω(configuredType).Should(Equal(eventPublisherType))
+				}
+			})
+		})
+
+		When("EVENT_PUBLISHER_TYPE is not supported", func() {
+			It("should return error", func() {
+				Ω(os.Setenv("EVENT_PUBLISHER_TYPE", "carrier-pigeon")).Should(Succeed())
+
+				_, err := sut.GetEventPublisherType()
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
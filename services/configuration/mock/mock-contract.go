@@ -6,7 +6,9 @@ package mock_configuration
 
 import (
 	reflect "reflect"
+	time "time"
 
+	configuration "github.com/decentralized-cloud/project/services/configuration"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -33,6 +35,51 @@ func (m *MockConfigurationContract) EXPECT() *MockConfigurationContractMockRecor
 	return m.recorder
 }
 
+// GetAdminHost mocks base method.
+func (m *MockConfigurationContract) GetAdminHost() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminHost")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminHost indicates an expected call of GetAdminHost.
+func (mr *MockConfigurationContractMockRecorder) GetAdminHost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminHost", reflect.TypeOf((*MockConfigurationContract)(nil).GetAdminHost))
+}
+
+// GetAdminPort mocks base method.
+func (m *MockConfigurationContract) GetAdminPort() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminPort")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminPort indicates an expected call of GetAdminPort.
+func (mr *MockConfigurationContractMockRecorder) GetAdminPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetAdminPort))
+}
+
+// GetCursorSigningKey mocks base method.
+func (m *MockConfigurationContract) GetCursorSigningKey() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCursorSigningKey")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCursorSigningKey indicates an expected call of GetCursorSigningKey.
+func (mr *MockConfigurationContractMockRecorder) GetCursorSigningKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCursorSigningKey", reflect.TypeOf((*MockConfigurationContract)(nil).GetCursorSigningKey))
+}
+
 // GetDatabaseCollectionName mocks base method.
 func (m *MockConfigurationContract) GetDatabaseCollectionName() (string, error) {
 	m.ctrl.T.Helper()
@@ -48,6 +95,21 @@ func (mr *MockConfigurationContractMockRecorder) GetDatabaseCollectionName() *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseCollectionName", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseCollectionName))
 }
 
+// GetDatabaseConnectTimeout mocks base method.
+func (m *MockConfigurationContract) GetDatabaseConnectTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseConnectTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseConnectTimeout indicates an expected call of GetDatabaseConnectTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetDatabaseConnectTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseConnectTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseConnectTimeout))
+}
+
 // GetDatabaseConnectionString mocks base method.
 func (m *MockConfigurationContract) GetDatabaseConnectionString() (string, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +125,21 @@ func (mr *MockConfigurationContractMockRecorder) GetDatabaseConnectionString() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseConnectionString", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseConnectionString))
 }
 
+// GetDatabaseMaxPoolSize mocks base method.
+func (m *MockConfigurationContract) GetDatabaseMaxPoolSize() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseMaxPoolSize")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseMaxPoolSize indicates an expected call of GetDatabaseMaxPoolSize.
+func (mr *MockConfigurationContractMockRecorder) GetDatabaseMaxPoolSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseMaxPoolSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseMaxPoolSize))
+}
+
 // GetDatabaseName mocks base method.
 func (m *MockConfigurationContract) GetDatabaseName() (string, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +155,81 @@ func (mr *MockConfigurationContractMockRecorder) GetDatabaseName() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseName", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseName))
 }
 
+// GetDatabaseServerSelectionTimeout mocks base method.
+func (m *MockConfigurationContract) GetDatabaseServerSelectionTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseServerSelectionTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseServerSelectionTimeout indicates an expected call of GetDatabaseServerSelectionTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetDatabaseServerSelectionTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseServerSelectionTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseServerSelectionTimeout))
+}
+
+// GetDatabaseType mocks base method.
+func (m *MockConfigurationContract) GetDatabaseType() (configuration.DatabaseType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseType")
+	ret0, _ := ret[0].(configuration.DatabaseType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseType indicates an expected call of GetDatabaseType.
+func (mr *MockConfigurationContractMockRecorder) GetDatabaseType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseType", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseType))
+}
+
+// GetDeletedProjectsPurgeInterval mocks base method.
+func (m *MockConfigurationContract) GetDeletedProjectsPurgeInterval() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProjectsPurgeInterval")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedProjectsPurgeInterval indicates an expected call of GetDeletedProjectsPurgeInterval.
+func (mr *MockConfigurationContractMockRecorder) GetDeletedProjectsPurgeInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProjectsPurgeInterval", reflect.TypeOf((*MockConfigurationContract)(nil).GetDeletedProjectsPurgeInterval))
+}
+
+// GetDeletedProjectsRetentionPeriod mocks base method.
+func (m *MockConfigurationContract) GetDeletedProjectsRetentionPeriod() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProjectsRetentionPeriod")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedProjectsRetentionPeriod indicates an expected call of GetDeletedProjectsRetentionPeriod.
+func (mr *MockConfigurationContractMockRecorder) GetDeletedProjectsRetentionPeriod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProjectsRetentionPeriod", reflect.TypeOf((*MockConfigurationContract)(nil).GetDeletedProjectsRetentionPeriod))
+}
+
+// GetEventPublisherType mocks base method.
+func (m *MockConfigurationContract) GetEventPublisherType() (configuration.EventPublisherType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventPublisherType")
+	ret0, _ := ret[0].(configuration.EventPublisherType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventPublisherType indicates an expected call of GetEventPublisherType.
+func (mr *MockConfigurationContractMockRecorder) GetEventPublisherType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventPublisherType", reflect.TypeOf((*MockConfigurationContract)(nil).GetEventPublisherType))
+}
+
 // GetGrpcHost mocks base method.
 func (m *MockConfigurationContract) GetGrpcHost() (string, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +290,36 @@ func (mr *MockConfigurationContractMockRecorder) GetHttpPort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpPort))
 }
 
+// GetIdempotencyKeyTTL mocks base method.
+func (m *MockConfigurationContract) GetIdempotencyKeyTTL() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKeyTTL")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKeyTTL indicates an expected call of GetIdempotencyKeyTTL.
+func (mr *MockConfigurationContractMockRecorder) GetIdempotencyKeyTTL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKeyTTL", reflect.TypeOf((*MockConfigurationContract)(nil).GetIdempotencyKeyTTL))
+}
+
+// GetInvitationExpiry mocks base method.
+func (m *MockConfigurationContract) GetInvitationExpiry() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationExpiry")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitationExpiry indicates an expected call of GetInvitationExpiry.
+func (mr *MockConfigurationContractMockRecorder) GetInvitationExpiry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationExpiry", reflect.TypeOf((*MockConfigurationContract)(nil).GetInvitationExpiry))
+}
+
 // GetJwksURL mocks base method.
 func (m *MockConfigurationContract) GetJwksURL() (string, error) {
 	m.ctrl.T.Helper()
@@ -152,3 +334,168 @@ func (mr *MockConfigurationContractMockRecorder) GetJwksURL() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwksURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwksURL))
 }
+
+// GetKafkaBrokers mocks base method.
+func (m *MockConfigurationContract) GetKafkaBrokers() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaBrokers")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKafkaBrokers indicates an expected call of GetKafkaBrokers.
+func (mr *MockConfigurationContractMockRecorder) GetKafkaBrokers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaBrokers", reflect.TypeOf((*MockConfigurationContract)(nil).GetKafkaBrokers))
+}
+
+// GetKafkaTopic mocks base method.
+func (m *MockConfigurationContract) GetKafkaTopic() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaTopic")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKafkaTopic indicates an expected call of GetKafkaTopic.
+func (mr *MockConfigurationContractMockRecorder) GetKafkaTopic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaTopic", reflect.TypeOf((*MockConfigurationContract)(nil).GetKafkaTopic))
+}
+
+// GetMaxBatchSize mocks base method.
+func (m *MockConfigurationContract) GetMaxBatchSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxBatchSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaxBatchSize indicates an expected call of GetMaxBatchSize.
+func (mr *MockConfigurationContractMockRecorder) GetMaxBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxBatchSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetMaxBatchSize))
+}
+
+// GetNatsSubject mocks base method.
+func (m *MockConfigurationContract) GetNatsSubject() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNatsSubject")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNatsSubject indicates an expected call of GetNatsSubject.
+func (mr *MockConfigurationContractMockRecorder) GetNatsSubject() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNatsSubject", reflect.TypeOf((*MockConfigurationContract)(nil).GetNatsSubject))
+}
+
+// GetNatsURL mocks base method.
+func (m *MockConfigurationContract) GetNatsURL() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNatsURL")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNatsURL indicates an expected call of GetNatsURL.
+func (mr *MockConfigurationContractMockRecorder) GetNatsURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNatsURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetNatsURL))
+}
+
+// GetNotifierFilePath mocks base method.
+func (m *MockConfigurationContract) GetNotifierFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifierFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifierFilePath indicates an expected call of GetNotifierFilePath.
+func (mr *MockConfigurationContractMockRecorder) GetNotifierFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifierFilePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetNotifierFilePath))
+}
+
+// GetNotifierType mocks base method.
+func (m *MockConfigurationContract) GetNotifierType() (configuration.NotifierType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifierType")
+	ret0, _ := ret[0].(configuration.NotifierType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifierType indicates an expected call of GetNotifierType.
+func (mr *MockConfigurationContractMockRecorder) GetNotifierType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifierType", reflect.TypeOf((*MockConfigurationContract)(nil).GetNotifierType))
+}
+
+// GetOutboxRelayBatchSize mocks base method.
+func (m *MockConfigurationContract) GetOutboxRelayBatchSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxRelayBatchSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxRelayBatchSize indicates an expected call of GetOutboxRelayBatchSize.
+func (mr *MockConfigurationContractMockRecorder) GetOutboxRelayBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxRelayBatchSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetOutboxRelayBatchSize))
+}
+
+// GetOutboxRelayInterval mocks base method.
+func (m *MockConfigurationContract) GetOutboxRelayInterval() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxRelayInterval")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxRelayInterval indicates an expected call of GetOutboxRelayInterval.
+func (mr *MockConfigurationContractMockRecorder) GetOutboxRelayInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxRelayInterval", reflect.TypeOf((*MockConfigurationContract)(nil).GetOutboxRelayInterval))
+}
+
+// GetOutboxRelayTimeout mocks base method.
+func (m *MockConfigurationContract) GetOutboxRelayTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxRelayTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxRelayTimeout indicates an expected call of GetOutboxRelayTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetOutboxRelayTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxRelayTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetOutboxRelayTimeout))
+}
+
+// GetOwnershipTransferExpiry mocks base method.
+func (m *MockConfigurationContract) GetOwnershipTransferExpiry() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnershipTransferExpiry")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnershipTransferExpiry indicates an expected call of GetOwnershipTransferExpiry.
+func (mr *MockConfigurationContractMockRecorder) GetOwnershipTransferExpiry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnershipTransferExpiry", reflect.TypeOf((*MockConfigurationContract)(nil).GetOwnershipTransferExpiry))
+}
@@ -4,7 +4,7 @@ package endpoint
 import "github.com/go-kit/kit/endpoint"
 
 // EndpointCreatorContract declares the contract that creates endpoints to create new project,
-// read, update and delete existing projects.
+// read, update and delete existing projects and manage their members.
 type EndpointCreatorContract interface {
 	// CreateProjectEndpoint creates Create Project endpoint
 	// Returns the Create Project endpoint
@@ -22,7 +22,147 @@ type EndpointCreatorContract interface {
 	// Returns the Delete Project endpoint
 	DeleteProjectEndpoint() endpoint.Endpoint
 
+	// BatchCreateProjectsEndpoint creates Batch Create Projects endpoint
+	// Returns the Batch Create Projects endpoint
+	BatchCreateProjectsEndpoint() endpoint.Endpoint
+
+	// BatchUpdateProjectsEndpoint creates Batch Update Projects endpoint
+	// Returns the Batch Update Projects endpoint
+	BatchUpdateProjectsEndpoint() endpoint.Endpoint
+
+	// BatchDeleteProjectsEndpoint creates Batch Delete Projects endpoint
+	// Returns the Batch Delete Projects endpoint
+	BatchDeleteProjectsEndpoint() endpoint.Endpoint
+
 	// ListProjectsEndpoint creates ListProjects Project endpoint
 	// Returns the ListProjects Project endpoint
 	ListProjectsEndpoint() endpoint.Endpoint
+
+	// StreamProjectsEndpoint creates StreamProjects Project endpoint
+	// Returns the StreamProjects Project endpoint
+	StreamProjectsEndpoint() endpoint.Endpoint
+
+	// WatchProjectsEndpoint creates WatchProjects Project endpoint
+	// Returns the WatchProjects Project endpoint
+	WatchProjectsEndpoint() endpoint.Endpoint
+
+	// ListProjectSortFieldsEndpoint creates ListProjectSortFields Project endpoint
+	// Returns the ListProjectSortFields Project endpoint
+	ListProjectSortFieldsEndpoint() endpoint.Endpoint
+
+	// SearchProjectsEndpoint creates SearchProjects Project endpoint
+	// Returns the SearchProjects Project endpoint
+	SearchProjectsEndpoint() endpoint.Endpoint
+
+	// ArchiveProjectEndpoint creates Archive Project endpoint
+	// Returns the Archive Project endpoint
+	ArchiveProjectEndpoint() endpoint.Endpoint
+
+	// UnarchiveProjectEndpoint creates Unarchive Project endpoint
+	// Returns the Unarchive Project endpoint
+	UnarchiveProjectEndpoint() endpoint.Endpoint
+
+	// RestoreProjectEndpoint creates Restore Project endpoint
+	// Returns the Restore Project endpoint
+	RestoreProjectEndpoint() endpoint.Endpoint
+
+	// ListDeletedProjectsEndpoint creates ListDeletedProjects Project endpoint
+	// Returns the ListDeletedProjects Project endpoint
+	ListDeletedProjectsEndpoint() endpoint.Endpoint
+
+	// AddMemberEndpoint creates Add Member endpoint
+	// Returns the Add Member endpoint
+	AddMemberEndpoint() endpoint.Endpoint
+
+	// RemoveMemberEndpoint creates Remove Member endpoint
+	// Returns the Remove Member endpoint
+	RemoveMemberEndpoint() endpoint.Endpoint
+
+	// UpdateMemberRoleEndpoint creates Update Member Role endpoint
+	// Returns the Update Member Role endpoint
+	UpdateMemberRoleEndpoint() endpoint.Endpoint
+
+	// ListMembersEndpoint creates List Members endpoint
+	// Returns the List Members endpoint
+	ListMembersEndpoint() endpoint.Endpoint
+
+	// TransferProjectOwnershipEndpoint creates Transfer Project Ownership endpoint
+	// Returns the Transfer Project Ownership endpoint
+	TransferProjectOwnershipEndpoint() endpoint.Endpoint
+
+	// AcceptProjectOwnershipTransferEndpoint creates Accept Project Ownership Transfer endpoint
+	// Returns the Accept Project Ownership Transfer endpoint
+	AcceptProjectOwnershipTransferEndpoint() endpoint.Endpoint
+
+	// DeclineProjectOwnershipTransferEndpoint creates Decline Project Ownership Transfer endpoint
+	// Returns the Decline Project Ownership Transfer endpoint
+	DeclineProjectOwnershipTransferEndpoint() endpoint.Endpoint
+
+	// ListProjectAuditEventsEndpoint creates List Project Audit Events endpoint
+	// Returns the List Project Audit Events endpoint
+	ListProjectAuditEventsEndpoint() endpoint.Endpoint
+
+	// CreateInvitationEndpoint creates Create Invitation endpoint
+	// Returns the Create Invitation endpoint
+	CreateInvitationEndpoint() endpoint.Endpoint
+
+	// ListInvitationsEndpoint creates List Invitations endpoint
+	// Returns the List Invitations endpoint
+	ListInvitationsEndpoint() endpoint.Endpoint
+
+	// AcceptInvitationEndpoint creates Accept Invitation endpoint
+	// Returns the Accept Invitation endpoint
+	AcceptInvitationEndpoint() endpoint.Endpoint
+
+	// RevokeInvitationEndpoint creates Revoke Invitation endpoint
+	// Returns the Revoke Invitation endpoint
+	RevokeInvitationEndpoint() endpoint.Endpoint
+
+	// CreateOrganizationEndpoint creates Create Organization endpoint
+	// Returns the Create Organization endpoint
+	CreateOrganizationEndpoint() endpoint.Endpoint
+
+	// ReadOrganizationEndpoint creates Read Organization endpoint
+	// Returns the Read Organization endpoint
+	ReadOrganizationEndpoint() endpoint.Endpoint
+
+	// UpdateOrganizationEndpoint creates Update Organization endpoint
+	// Returns the Update Organization endpoint
+	UpdateOrganizationEndpoint() endpoint.Endpoint
+
+	// DeleteOrganizationEndpoint creates Delete Organization endpoint
+	// Returns the Delete Organization endpoint
+	DeleteOrganizationEndpoint() endpoint.Endpoint
+
+	// ListOrganizationsEndpoint creates List Organizations endpoint
+	// Returns the List Organizations endpoint
+	ListOrganizationsEndpoint() endpoint.Endpoint
+
+	// AddOrganizationMemberEndpoint creates Add Organization Member endpoint
+	// Returns the Add Organization Member endpoint
+	AddOrganizationMemberEndpoint() endpoint.Endpoint
+
+	// RemoveOrganizationMemberEndpoint creates Remove Organization Member endpoint
+	// Returns the Remove Organization Member endpoint
+	RemoveOrganizationMemberEndpoint() endpoint.Endpoint
+
+	// ListOrganizationMembersEndpoint creates List Organization Members endpoint
+	// Returns the List Organization Members endpoint
+	ListOrganizationMembersEndpoint() endpoint.Endpoint
+
+	// CreateFolderEndpoint creates Create Folder endpoint
+	// Returns the Create Folder endpoint
+	CreateFolderEndpoint() endpoint.Endpoint
+
+	// ListFoldersEndpoint creates List Folders endpoint
+	// Returns the List Folders endpoint
+	ListFoldersEndpoint() endpoint.Endpoint
+
+	// DeleteFolderEndpoint creates Delete Folder endpoint
+	// Returns the Delete Folder endpoint
+	DeleteFolderEndpoint() endpoint.Endpoint
+
+	// MoveProjectEndpoint creates Move Project endpoint
+	// Returns the Move Project endpoint
+	MoveProjectEndpoint() endpoint.Endpoint
 }
@@ -34,6 +34,160 @@ func (m *MockEndpointCreatorContract) EXPECT() *MockEndpointCreatorContractMockR
 	return m.recorder
 }
 
+// AcceptInvitationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AcceptInvitationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AcceptInvitationEndpoint indicates an expected call of AcceptInvitationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AcceptInvitationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AcceptInvitationEndpoint))
+}
+
+// AcceptProjectOwnershipTransferEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AcceptProjectOwnershipTransferEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptProjectOwnershipTransferEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AcceptProjectOwnershipTransferEndpoint indicates an expected call of AcceptProjectOwnershipTransferEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AcceptProjectOwnershipTransferEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptProjectOwnershipTransferEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AcceptProjectOwnershipTransferEndpoint))
+}
+
+// AddMemberEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AddMemberEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AddMemberEndpoint indicates an expected call of AddMemberEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AddMemberEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AddMemberEndpoint))
+}
+
+// AddOrganizationMemberEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AddOrganizationMemberEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMemberEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AddOrganizationMemberEndpoint indicates an expected call of AddOrganizationMemberEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AddOrganizationMemberEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMemberEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AddOrganizationMemberEndpoint))
+}
+
+// ArchiveProjectEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ArchiveProjectEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProjectEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ArchiveProjectEndpoint indicates an expected call of ArchiveProjectEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ArchiveProjectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ArchiveProjectEndpoint))
+}
+
+// BatchCreateProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) BatchCreateProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// BatchCreateProjectsEndpoint indicates an expected call of BatchCreateProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) BatchCreateProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).BatchCreateProjectsEndpoint))
+}
+
+// BatchDeleteProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) BatchDeleteProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// BatchDeleteProjectsEndpoint indicates an expected call of BatchDeleteProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) BatchDeleteProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).BatchDeleteProjectsEndpoint))
+}
+
+// BatchUpdateProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) BatchUpdateProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// BatchUpdateProjectsEndpoint indicates an expected call of BatchUpdateProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) BatchUpdateProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).BatchUpdateProjectsEndpoint))
+}
+
+// CreateFolderEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) CreateFolderEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolderEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// CreateFolderEndpoint indicates an expected call of CreateFolderEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) CreateFolderEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolderEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).CreateFolderEndpoint))
+}
+
+// CreateInvitationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) CreateInvitationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// CreateInvitationEndpoint indicates an expected call of CreateInvitationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) CreateInvitationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).CreateInvitationEndpoint))
+}
+
+// CreateOrganizationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) CreateOrganizationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// CreateOrganizationEndpoint indicates an expected call of CreateOrganizationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) CreateOrganizationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).CreateOrganizationEndpoint))
+}
+
 // CreateProjectEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) CreateProjectEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -48,6 +202,48 @@ func (mr *MockEndpointCreatorContractMockRecorder) CreateProjectEndpoint() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).CreateProjectEndpoint))
 }
 
+// DeclineProjectOwnershipTransferEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) DeclineProjectOwnershipTransferEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineProjectOwnershipTransferEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// DeclineProjectOwnershipTransferEndpoint indicates an expected call of DeclineProjectOwnershipTransferEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) DeclineProjectOwnershipTransferEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineProjectOwnershipTransferEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeclineProjectOwnershipTransferEndpoint))
+}
+
+// DeleteFolderEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) DeleteFolderEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolderEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// DeleteFolderEndpoint indicates an expected call of DeleteFolderEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) DeleteFolderEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolderEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeleteFolderEndpoint))
+}
+
+// DeleteOrganizationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) DeleteOrganizationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// DeleteOrganizationEndpoint indicates an expected call of DeleteOrganizationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) DeleteOrganizationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeleteOrganizationEndpoint))
+}
+
 // DeleteProjectEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) DeleteProjectEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -62,6 +258,118 @@ func (mr *MockEndpointCreatorContractMockRecorder) DeleteProjectEndpoint() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeleteProjectEndpoint))
 }
 
+// ListDeletedProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListDeletedProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListDeletedProjectsEndpoint indicates an expected call of ListDeletedProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListDeletedProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListDeletedProjectsEndpoint))
+}
+
+// ListFoldersEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListFoldersEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFoldersEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListFoldersEndpoint indicates an expected call of ListFoldersEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListFoldersEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFoldersEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListFoldersEndpoint))
+}
+
+// ListInvitationsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListInvitationsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitationsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListInvitationsEndpoint indicates an expected call of ListInvitationsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListInvitationsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListInvitationsEndpoint))
+}
+
+// ListMembersEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListMembersEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembersEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListMembersEndpoint indicates an expected call of ListMembersEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListMembersEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembersEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListMembersEndpoint))
+}
+
+// ListOrganizationMembersEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListOrganizationMembersEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembersEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListOrganizationMembersEndpoint indicates an expected call of ListOrganizationMembersEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListOrganizationMembersEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembersEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListOrganizationMembersEndpoint))
+}
+
+// ListOrganizationsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListOrganizationsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListOrganizationsEndpoint indicates an expected call of ListOrganizationsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListOrganizationsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListOrganizationsEndpoint))
+}
+
+// ListProjectAuditEventsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListProjectAuditEventsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectAuditEventsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListProjectAuditEventsEndpoint indicates an expected call of ListProjectAuditEventsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListProjectAuditEventsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAuditEventsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListProjectAuditEventsEndpoint))
+}
+
+// ListProjectSortFieldsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListProjectSortFieldsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSortFieldsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListProjectSortFieldsEndpoint indicates an expected call of ListProjectSortFieldsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListProjectSortFieldsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSortFieldsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListProjectSortFieldsEndpoint))
+}
+
 // ListProjectsEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) ListProjectsEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -76,6 +384,34 @@ func (mr *MockEndpointCreatorContractMockRecorder) ListProjectsEndpoint() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListProjectsEndpoint))
 }
 
+// MoveProjectEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) MoveProjectEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProjectEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// MoveProjectEndpoint indicates an expected call of MoveProjectEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) MoveProjectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).MoveProjectEndpoint))
+}
+
+// ReadOrganizationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ReadOrganizationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOrganizationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ReadOrganizationEndpoint indicates an expected call of ReadOrganizationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ReadOrganizationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOrganizationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ReadOrganizationEndpoint))
+}
+
 // ReadProjectEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) ReadProjectEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -90,6 +426,146 @@ func (mr *MockEndpointCreatorContractMockRecorder) ReadProjectEndpoint() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ReadProjectEndpoint))
 }
 
+// RemoveMemberEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RemoveMemberEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMemberEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RemoveMemberEndpoint indicates an expected call of RemoveMemberEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RemoveMemberEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RemoveMemberEndpoint))
+}
+
+// RemoveOrganizationMemberEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RemoveOrganizationMemberEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMemberEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RemoveOrganizationMemberEndpoint indicates an expected call of RemoveOrganizationMemberEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RemoveOrganizationMemberEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMemberEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RemoveOrganizationMemberEndpoint))
+}
+
+// RestoreProjectEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RestoreProjectEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProjectEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RestoreProjectEndpoint indicates an expected call of RestoreProjectEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RestoreProjectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RestoreProjectEndpoint))
+}
+
+// RevokeInvitationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RevokeInvitationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RevokeInvitationEndpoint indicates an expected call of RevokeInvitationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RevokeInvitationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RevokeInvitationEndpoint))
+}
+
+// SearchProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) SearchProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// SearchProjectsEndpoint indicates an expected call of SearchProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) SearchProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).SearchProjectsEndpoint))
+}
+
+// StreamProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) StreamProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// StreamProjectsEndpoint indicates an expected call of StreamProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) StreamProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).StreamProjectsEndpoint))
+}
+
+// TransferProjectOwnershipEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) TransferProjectOwnershipEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferProjectOwnershipEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// TransferProjectOwnershipEndpoint indicates an expected call of TransferProjectOwnershipEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) TransferProjectOwnershipEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferProjectOwnershipEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).TransferProjectOwnershipEndpoint))
+}
+
+// UnarchiveProjectEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UnarchiveProjectEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveProjectEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UnarchiveProjectEndpoint indicates an expected call of UnarchiveProjectEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UnarchiveProjectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UnarchiveProjectEndpoint))
+}
+
+// UpdateMemberRoleEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UpdateMemberRoleEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRoleEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UpdateMemberRoleEndpoint indicates an expected call of UpdateMemberRoleEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UpdateMemberRoleEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRoleEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpdateMemberRoleEndpoint))
+}
+
+// UpdateOrganizationEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UpdateOrganizationEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UpdateOrganizationEndpoint indicates an expected call of UpdateOrganizationEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UpdateOrganizationEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpdateOrganizationEndpoint))
+}
+
 // UpdateProjectEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) UpdateProjectEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -103,3 +579,17 @@ func (mr *MockEndpointCreatorContractMockRecorder) UpdateProjectEndpoint() *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpdateProjectEndpoint))
 }
+
+// WatchProjectsEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) WatchProjectsEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProjectsEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// WatchProjectsEndpoint indicates an expected call of WatchProjectsEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) WatchProjectsEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProjectsEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).WatchProjectsEndpoint))
+}
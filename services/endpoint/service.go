@@ -148,6 +148,96 @@ func (service *endpointCreatorService) DeleteProjectEndpoint() endpoint.Endpoint
 	}
 }
 
+// BatchCreateProjectsEndpoint creates Batch Create Projects endpoint
+// Returns the Batch Create Projects endpoint
+func (service *endpointCreatorService) BatchCreateProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.BatchCreateProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.BatchCreateProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.BatchCreateProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.BatchCreateProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.BatchCreateProjects(ctx, castedRequest)
+	}
+}
+
+// BatchUpdateProjectsEndpoint creates Batch Update Projects endpoint
+// Returns the Batch Update Projects endpoint
+func (service *endpointCreatorService) BatchUpdateProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.BatchUpdateProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.BatchUpdateProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.BatchUpdateProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.BatchUpdateProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.BatchUpdateProjects(ctx, castedRequest)
+	}
+}
+
+// BatchDeleteProjectsEndpoint creates Batch Delete Projects endpoint
+// Returns the Batch Delete Projects endpoint
+func (service *endpointCreatorService) BatchDeleteProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.BatchDeleteProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.BatchDeleteProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.BatchDeleteProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.BatchDeleteProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.BatchDeleteProjects(ctx, castedRequest)
+	}
+}
+
 // ListProjectsEndpoint creates ListProjects Project endpoint
 // Returns the ListProjects Project endpoint
 func (service *endpointCreatorService) ListProjectsEndpoint() endpoint.Endpoint {
@@ -177,3 +267,955 @@ func (service *endpointCreatorService) ListProjectsEndpoint() endpoint.Endpoint
 		return service.businessService.ListProjects(ctx, castedRequest)
 	}
 }
+
+// StreamProjectsEndpoint creates StreamProjects Project endpoint
+// Returns the StreamProjects Project endpoint
+func (service *endpointCreatorService) StreamProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.StreamProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.StreamProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.StreamProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.StreamProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.StreamProjects(ctx, castedRequest)
+	}
+}
+
+// WatchProjectsEndpoint creates WatchProjects Project endpoint
+// Returns the WatchProjects Project endpoint
+func (service *endpointCreatorService) WatchProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.WatchProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.WatchProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.WatchProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.WatchProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.WatchProjects(ctx, castedRequest)
+	}
+}
+
+// ListProjectSortFieldsEndpoint creates ListProjectSortFields Project endpoint
+// Returns the ListProjectSortFields Project endpoint
+func (service *endpointCreatorService) ListProjectSortFieldsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListProjectSortFieldsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListProjectSortFieldsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListProjectSortFieldsRequest)
+
+		return service.businessService.ListProjectSortFields(ctx, castedRequest)
+	}
+}
+
+// SearchProjectsEndpoint creates SearchProjects Project endpoint
+// Returns the SearchProjects Project endpoint
+func (service *endpointCreatorService) SearchProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.SearchProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.SearchProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.SearchProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.SearchProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.SearchProjects(ctx, castedRequest)
+	}
+}
+
+// ArchiveProjectEndpoint creates Archive Project endpoint
+// Returns the Archive Project endpoint
+func (service *endpointCreatorService) ArchiveProjectEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ArchiveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ArchiveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ArchiveProjectRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ArchiveProjectResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ArchiveProject(ctx, castedRequest)
+	}
+}
+
+// UnarchiveProjectEndpoint creates Unarchive Project endpoint
+// Returns the Unarchive Project endpoint
+func (service *endpointCreatorService) UnarchiveProjectEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UnarchiveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UnarchiveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UnarchiveProjectRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UnarchiveProjectResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UnarchiveProject(ctx, castedRequest)
+	}
+}
+
+// RestoreProjectEndpoint creates Restore Project endpoint
+// Returns the Restore Project endpoint
+func (service *endpointCreatorService) RestoreProjectEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RestoreProjectResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RestoreProjectResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RestoreProjectRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RestoreProjectResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RestoreProject(ctx, castedRequest)
+	}
+}
+
+// ListDeletedProjectsEndpoint creates ListDeletedProjects Project endpoint
+// Returns the ListDeletedProjects Project endpoint
+func (service *endpointCreatorService) ListDeletedProjectsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListDeletedProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListDeletedProjectsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListDeletedProjectsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListDeletedProjectsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListDeletedProjects(ctx, castedRequest)
+	}
+}
+
+// AddMemberEndpoint creates Add Member endpoint
+// Returns the Add Member endpoint
+func (service *endpointCreatorService) AddMemberEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AddMemberResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AddMemberResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AddMemberRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AddMemberResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AddMember(ctx, castedRequest)
+	}
+}
+
+// RemoveMemberEndpoint creates Remove Member endpoint
+// Returns the Remove Member endpoint
+func (service *endpointCreatorService) RemoveMemberEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RemoveMemberResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RemoveMemberResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RemoveMemberRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RemoveMemberResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RemoveMember(ctx, castedRequest)
+	}
+}
+
+// UpdateMemberRoleEndpoint creates Update Member Role endpoint
+// Returns the Update Member Role endpoint
+func (service *endpointCreatorService) UpdateMemberRoleEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UpdateMemberRoleResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UpdateMemberRoleResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UpdateMemberRoleRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UpdateMemberRoleResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UpdateMemberRole(ctx, castedRequest)
+	}
+}
+
+// ListMembersEndpoint creates List Members endpoint
+// Returns the List Members endpoint
+func (service *endpointCreatorService) ListMembersEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListMembersResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListMembersResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListMembersRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListMembersResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListMembers(ctx, castedRequest)
+	}
+}
+
+// TransferProjectOwnershipEndpoint creates Transfer Project Ownership endpoint
+// Returns the Transfer Project Ownership endpoint
+func (service *endpointCreatorService) TransferProjectOwnershipEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.TransferProjectOwnershipResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.TransferProjectOwnershipResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.TransferProjectOwnershipRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.TransferProjectOwnershipResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.TransferProjectOwnership(ctx, castedRequest)
+	}
+}
+
+// AcceptProjectOwnershipTransferEndpoint creates Accept Project Ownership Transfer endpoint
+// Returns the Accept Project Ownership Transfer endpoint
+func (service *endpointCreatorService) AcceptProjectOwnershipTransferEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AcceptProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AcceptProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AcceptProjectOwnershipTransferRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AcceptProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AcceptProjectOwnershipTransfer(ctx, castedRequest)
+	}
+}
+
+// DeclineProjectOwnershipTransferEndpoint creates Decline Project Ownership Transfer endpoint
+// Returns the Decline Project Ownership Transfer endpoint
+func (service *endpointCreatorService) DeclineProjectOwnershipTransferEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.DeclineProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.DeclineProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.DeclineProjectOwnershipTransferRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.DeclineProjectOwnershipTransferResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.DeclineProjectOwnershipTransfer(ctx, castedRequest)
+	}
+}
+
+// ListProjectAuditEventsEndpoint creates List Project Audit Events endpoint
+// Returns the List Project Audit Events endpoint
+func (service *endpointCreatorService) ListProjectAuditEventsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListProjectAuditEventsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListProjectAuditEventsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListProjectAuditEventsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListProjectAuditEventsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListProjectAuditEvents(ctx, castedRequest)
+	}
+}
+
+// CreateInvitationEndpoint creates Create Invitation endpoint
+// Returns the Create Invitation endpoint
+func (service *endpointCreatorService) CreateInvitationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.CreateInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.CreateInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.CreateInvitationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.CreateInvitationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.CreateInvitation(ctx, castedRequest)
+	}
+}
+
+// ListInvitationsEndpoint creates List Invitations endpoint
+// Returns the List Invitations endpoint
+func (service *endpointCreatorService) ListInvitationsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListInvitationsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListInvitationsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListInvitationsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListInvitationsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListInvitations(ctx, castedRequest)
+	}
+}
+
+// AcceptInvitationEndpoint creates Accept Invitation endpoint
+// Returns the Accept Invitation endpoint
+func (service *endpointCreatorService) AcceptInvitationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AcceptInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AcceptInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AcceptInvitationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AcceptInvitationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AcceptInvitation(ctx, castedRequest)
+	}
+}
+
+// RevokeInvitationEndpoint creates Revoke Invitation endpoint
+// Returns the Revoke Invitation endpoint
+func (service *endpointCreatorService) RevokeInvitationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RevokeInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RevokeInvitationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RevokeInvitationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RevokeInvitationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RevokeInvitation(ctx, castedRequest)
+	}
+}
+
+// CreateOrganizationEndpoint creates Create Organization endpoint
+// Returns the Create Organization endpoint
+func (service *endpointCreatorService) CreateOrganizationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.CreateOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.CreateOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.CreateOrganizationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.CreateOrganizationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.CreateOrganization(ctx, castedRequest)
+	}
+}
+
+// ReadOrganizationEndpoint creates Read Organization endpoint
+// Returns the Read Organization endpoint
+func (service *endpointCreatorService) ReadOrganizationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ReadOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ReadOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ReadOrganizationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ReadOrganizationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ReadOrganization(ctx, castedRequest)
+	}
+}
+
+// UpdateOrganizationEndpoint creates Update Organization endpoint
+// Returns the Update Organization endpoint
+func (service *endpointCreatorService) UpdateOrganizationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UpdateOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UpdateOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UpdateOrganizationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UpdateOrganizationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UpdateOrganization(ctx, castedRequest)
+	}
+}
+
+// DeleteOrganizationEndpoint creates Delete Organization endpoint
+// Returns the Delete Organization endpoint
+func (service *endpointCreatorService) DeleteOrganizationEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.DeleteOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.DeleteOrganizationResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.DeleteOrganizationRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.DeleteOrganizationResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.DeleteOrganization(ctx, castedRequest)
+	}
+}
+
+// ListOrganizationsEndpoint creates List Organizations endpoint
+// Returns the List Organizations endpoint
+func (service *endpointCreatorService) ListOrganizationsEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListOrganizationsResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListOrganizationsResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListOrganizationsRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListOrganizationsResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListOrganizations(ctx, castedRequest)
+	}
+}
+
+// AddOrganizationMemberEndpoint creates Add Organization Member endpoint
+// Returns the Add Organization Member endpoint
+func (service *endpointCreatorService) AddOrganizationMemberEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AddOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AddOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AddOrganizationMemberRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AddOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AddOrganizationMember(ctx, castedRequest)
+	}
+}
+
+// RemoveOrganizationMemberEndpoint creates Remove Organization Member endpoint
+// Returns the Remove Organization Member endpoint
+func (service *endpointCreatorService) RemoveOrganizationMemberEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RemoveOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RemoveOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RemoveOrganizationMemberRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RemoveOrganizationMemberResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RemoveOrganizationMember(ctx, castedRequest)
+	}
+}
+
+// ListOrganizationMembersEndpoint creates List Organization Members endpoint
+// Returns the List Organization Members endpoint
+func (service *endpointCreatorService) ListOrganizationMembersEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListOrganizationMembersResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListOrganizationMembersResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListOrganizationMembersRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListOrganizationMembersResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListOrganizationMembers(ctx, castedRequest)
+	}
+}
+
+// CreateFolderEndpoint creates Create Folder endpoint
+// Returns the Create Folder endpoint
+func (service *endpointCreatorService) CreateFolderEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.CreateFolderResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.CreateFolderResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.CreateFolderRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.CreateFolderResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.CreateFolder(ctx, castedRequest)
+	}
+}
+
+// ListFoldersEndpoint creates List Folders endpoint
+// Returns the List Folders endpoint
+func (service *endpointCreatorService) ListFoldersEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListFoldersResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListFoldersResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListFoldersRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListFoldersResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListFolders(ctx, castedRequest)
+	}
+}
+
+// DeleteFolderEndpoint creates Delete Folder endpoint
+// Returns the Delete Folder endpoint
+func (service *endpointCreatorService) DeleteFolderEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.DeleteFolderResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.DeleteFolderResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.DeleteFolderRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.DeleteFolderResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.DeleteFolder(ctx, castedRequest)
+	}
+}
+
+// MoveProjectEndpoint creates Move Project endpoint
+// Returns the Move Project endpoint
+func (service *endpointCreatorService) MoveProjectEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.MoveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.MoveProjectResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.MoveProjectRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.MoveProjectResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.MoveProject(ctx, castedRequest)
+	}
+}
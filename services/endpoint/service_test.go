@@ -85,7 +85,10 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 				request = business.CreateProjectRequest{
 					UserEmail: cuid.New() + "@test.com",
 					Project: models.Project{
-						Name: cuid.New(),
+						Name:        cuid.New(),
+						Description: cuid.New(),
+						Labels:      map[string]string{"environment": "production"},
+						Annotations: map[string]string{"example.com/owner": cuid.New()},
 					},
 				}
 
@@ -137,6 +140,55 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					})
 				})
 
+				When("endpoint is called with request that contains invalid labels", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Project.Labels = map[string]string{"-invalid-": "production"}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains too long idempotency key", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.IdempotencyKey = strings.Repeat("k", 256)
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains an idempotency key", func() {
+					It("should pass the idempotency key to business service CreateProject method", func() {
+						request.IdempotencyKey = cuid.New()
+						mockBusinessService.
+							EXPECT().
+							CreateProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.CreateProjectRequest) {
+								Ω(mappedRequest.IdempotencyKey).Should(Equal(request.IdempotencyKey))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.CreateProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
 				When("endpoint is called with valid request", func() {
 					It("should call business service CreateProject method", func() {
 						mockBusinessService.
@@ -321,7 +373,10 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					UserEmail: cuid.New() + "@test.com",
 					ProjectID: cuid.New(),
 					Project: models.Project{
-						Name: cuid.New(),
+						Name:        cuid.New(),
+						Description: cuid.New(),
+						Labels:      map[string]string{"environment": "production"},
+						Annotations: map[string]string{"example.com/owner": cuid.New()},
 					}}
 
 				response = business.UpdateProjectResponse{
@@ -372,6 +427,78 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					})
 				})
 
+				When("endpoint is called with request that contains invalid labels", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Project.Labels = map[string]string{"environment": "invalid value"}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains negative expected version", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.ExpectedVersion = -1
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains an update mask with unknown or duplicate fields", func() {
+					It("should return ArgumentError", func() {
+						for _, updateMask := range [][]string{
+							{models.ProjectNamePath, "createdBy"},
+							{models.ProjectDescriptionPath, models.ProjectDescriptionPath},
+						} {
+							invalidRequest := request
+							invalidRequest.UpdateMask = updateMask
+							returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+							Ω(err).Should(BeNil())
+							Ω(returnedResponse).ShouldNot(BeNil())
+							castedResponse := returnedResponse.(*business.UpdateProjectResponse)
+							validationErr := invalidRequest.Validate()
+							Ω(validationErr).Should(HaveOccurred())
+							assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+						}
+					})
+				})
+
+				When("endpoint is called with request that only updates the description", func() {
+					It("should not require the name and call business service UpdateProject method", func() {
+						partialRequest := request
+						partialRequest.Project = models.Project{Description: cuid.New()}
+						partialRequest.UpdateMask = []string{models.ProjectDescriptionPath}
+						mockBusinessService.
+							EXPECT().
+							UpdateProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.UpdateProjectRequest) {
+								Ω(mappedRequest.UpdateMask).Should(Equal(partialRequest.UpdateMask))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &partialRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
 				When("endpoint is called with valid request", func() {
 					It("should call business service UpdateProject method", func() {
 						mockBusinessService.
@@ -483,6 +610,21 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					})
 				})
 
+				When("endpoint is called with request that contains negative expected version", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.ExpectedVersion = -1
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
 				When("endpoint is called with valid request", func() {
 					It("should call business service DeleteProject method", func() {
 						mockBusinessService.
@@ -534,75 +676,155 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 	})
 
 	Context("EndpointCreatorService is instantiated", func() {
-		When("ListProjectsEndpoint is called", func() {
+		When("BatchCreateProjectsEndpoint is called", func() {
 			It("should return valid function", func() {
-				endpoint := sut.ListProjectsEndpoint()
+				endpoint := sut.BatchCreateProjectsEndpoint()
 				Ω(endpoint).ShouldNot(BeNil())
 			})
 
 			var (
-				endpoint   gokitendpoint.Endpoint
-				projectIDs []string
-				request    business.ListProjectsRequest
-				response   business.ListProjectsResponse
+				endpoint gokitendpoint.Endpoint
+				request  business.BatchCreateProjectsRequest
+				response business.BatchCreateProjectsResponse
 			)
 
 			BeforeEach(func() {
-				endpoint = sut.ListProjectsEndpoint()
-				projectIDs = []string{}
-				for idx := 0; idx < rand.Intn(20)+1; idx++ {
-					projectIDs = append(projectIDs, cuid.New())
+				endpoint = sut.BatchCreateProjectsEndpoint()
+				request = business.BatchCreateProjectsRequest{
+					UserEmail:    cuid.New() + "@test.com",
+					Projects:     []models.Project{{Name: cuid.New()}},
+					AllOrNothing: true,
 				}
 
-				request = business.ListProjectsRequest{
-					UserEmail: cuid.New() + "@test.com",
-					Pagination: common.Pagination{
-						After:  convertStringToPointer(cuid.New()),
-						First:  convertIntToPointer(rand.Intn(1000)),
-						Before: convertStringToPointer(cuid.New()),
-						Last:   convertIntToPointer(rand.Intn(1000)),
-					},
-					SortingOptions: []common.SortingOptionPair{
-						{
-							Name:      cuid.New(),
-							Direction: common.Ascending,
-						},
-						{
-							Name:      cuid.New(),
-							Direction: common.Descending,
-						},
-					},
-					ProjectIDs: projectIDs,
+				response = business.BatchCreateProjectsResponse{
+					Results: []business.BatchCreateProjectResult{{}},
 				}
+			})
 
-				projects := []models.ProjectWithCursor{}
+			Context("BatchCreateProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
 
-				for idx := 0; idx < rand.Intn(20)+1; idx++ {
-					projects = append(projects, models.ProjectWithCursor{
-						ProjectID: cuid.New(),
-						Project: models.Project{
-							Name: cuid.New(),
-						},
-						Cursor: cuid.New(),
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchCreateProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchCreateProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with request that contains no projects", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Projects = nil
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchCreateProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service BatchCreateProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							BatchCreateProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.BatchCreateProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.Projects).Should(Equal(request.Projects))
+								Ω(mappedRequest.AllOrNothing).Should(BeTrue())
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchCreateProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service BatchCreateProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							BatchCreateProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service BatchCreateProjects returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							BatchCreateProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
 					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("BatchUpdateProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.BatchUpdateProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.BatchUpdateProjectsRequest
+				response business.BatchUpdateProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.BatchUpdateProjectsEndpoint()
+				request = business.BatchUpdateProjectsRequest{
+					UserEmail:    cuid.New() + "@test.com",
+					Projects:     []business.BatchUpdateProjectItem{{ProjectID: cuid.New(), Project: models.Project{Name: cuid.New()}}},
+					AllOrNothing: true,
 				}
 
-				response = business.ListProjectsResponse{
-					HasPreviousPage: (rand.Intn(10) % 2) == 0,
-					HasNextPage:     (rand.Intn(10) % 2) == 0,
-					TotalCount:      rand.Int63n(1000),
-					Projects:        projects,
+				response = business.BatchUpdateProjectsResponse{
+					Results: []business.BatchUpdateProjectResult{{}},
 				}
 			})
 
-			Context("ListProjectsEndpoint function is returned", func() {
+			Context("BatchUpdateProjectsEndpoint function is returned", func() {
 				When("endpoint is called with nil context", func() {
 					It("should return ArgumentNilError", func() {
 						returnedResponse, err := endpoint(nil, &request)
 
 						Ω(err).Should(BeNil())
 						Ω(returnedResponse).ShouldNot(BeNil())
-						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						castedResponse := returnedResponse.(*business.BatchUpdateProjectsResponse)
 						assertArgumentNilError("ctx", "", castedResponse.Err)
 					})
 				})
@@ -613,20 +835,35 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 
 						Ω(err).Should(BeNil())
 						Ω(returnedResponse).ShouldNot(BeNil())
-						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						castedResponse := returnedResponse.(*business.BatchUpdateProjectsResponse)
 						assertArgumentNilError("request", "", castedResponse.Err)
 					})
 				})
 
+				When("endpoint is called with request that contains no projects", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Projects = nil
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchUpdateProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
 				When("endpoint is called with valid request", func() {
-					It("should call business service ListProjects method", func() {
+					It("should call business service BatchUpdateProjects method", func() {
 						mockBusinessService.
 							EXPECT().
-							ListProjects(ctx, gomock.Any()).
-							Do(func(_ context.Context, mappedRequest *business.ListProjectsRequest) {
-								Ω(mappedRequest.Pagination).Should(Equal(request.Pagination))
-								Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
-								Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+							BatchUpdateProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.BatchUpdateProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.Projects).Should(Equal(request.Projects))
+								Ω(mappedRequest.AllOrNothing).Should(BeTrue())
 							}).
 							Return(&response, nil)
 
@@ -634,17 +871,17 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 
 						Ω(err).Should(BeNil())
 						Ω(response).ShouldNot(BeNil())
-						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						castedResponse := returnedResponse.(*business.BatchUpdateProjectsResponse)
 						Ω(castedResponse.Err).Should(BeNil())
 					})
 				})
 
-				When("business service ListProjects returns error", func() {
+				When("business service BatchUpdateProjects returns error", func() {
 					It("should return the same error", func() {
 						expectedErr := errors.New(cuid.New())
 						mockBusinessService.
 							EXPECT().
-							ListProjects(gomock.Any(), gomock.Any()).
+							BatchUpdateProjects(gomock.Any(), gomock.Any()).
 							Return(nil, expectedErr)
 
 						_, err := endpoint(ctx, &request)
@@ -653,11 +890,4053 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					})
 				})
 
-				When("business service ListProjects returns response", func() {
+				When("business service BatchUpdateProjects returns response", func() {
 					It("should return the same response", func() {
 						mockBusinessService.
 							EXPECT().
-							ListProjects(gomock.Any(), gomock.Any()).
+							BatchUpdateProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("BatchDeleteProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.BatchDeleteProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.BatchDeleteProjectsRequest
+				response business.BatchDeleteProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.BatchDeleteProjectsEndpoint()
+				request = business.BatchDeleteProjectsRequest{
+					UserEmail:    cuid.New() + "@test.com",
+					Projects:     []business.BatchDeleteProjectItem{{ProjectID: cuid.New()}},
+					AllOrNothing: true,
+				}
+
+				response = business.BatchDeleteProjectsResponse{
+					Results: []business.BatchDeleteProjectResult{{}},
+				}
+			})
+
+			Context("BatchDeleteProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchDeleteProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchDeleteProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with request that contains no projects", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Projects = nil
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchDeleteProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service BatchDeleteProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							BatchDeleteProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.BatchDeleteProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.Projects).Should(Equal(request.Projects))
+								Ω(mappedRequest.AllOrNothing).Should(BeTrue())
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.BatchDeleteProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service BatchDeleteProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							BatchDeleteProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service BatchDeleteProjects returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							BatchDeleteProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint   gokitendpoint.Endpoint
+				projectIDs []string
+				request    business.ListProjectsRequest
+				response   business.ListProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListProjectsEndpoint()
+				projectIDs = []string{}
+				for idx := 0; idx < rand.Intn(20)+1; idx++ {
+					projectIDs = append(projectIDs, cuid.New())
+				}
+
+				request = business.ListProjectsRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Pagination: common.Pagination{
+						After:  convertStringToPointer(cuid.New()),
+						First:  convertIntToPointer(rand.Intn(1000)),
+						Before: convertStringToPointer(cuid.New()),
+						Last:   convertIntToPointer(rand.Intn(1000)),
+					},
+					SortingOptions: []common.SortingOptionPair{
+						{
+							Name:      "name",
+							Direction: common.Ascending,
+						},
+						{
+							Name:      "createdAt",
+							Direction: common.Descending,
+						},
+					},
+					ProjectIDs: projectIDs,
+				}
+
+				projects := []models.ProjectWithCursor{}
+
+				for idx := 0; idx < rand.Intn(20)+1; idx++ {
+					projects = append(projects, models.ProjectWithCursor{
+						ProjectID: cuid.New(),
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+					})
+				}
+
+				response = business.ListProjectsResponse{
+					HasPreviousPage: (rand.Intn(10) % 2) == 0,
+					HasNextPage:     (rand.Intn(10) % 2) == 0,
+					TotalCount:      rand.Int63n(1000),
+					Projects:        projects,
+				}
+			})
+
+			Context("ListProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid filter", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListProjectsRequest{
+							Filter: &models.ProjectFilter{
+								Name: &models.NameCondition{Operator: models.NameOperatorPrefix},
+							},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with a field that cannot be sorted on", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListProjectsRequest{
+							SortingOptions: []common.SortingOptionPair{{Name: "userEmail", Direction: common.Ascending}},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListProjectsRequest) {
+								Ω(mappedRequest.Pagination).Should(Equal(request.Pagination))
+								Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+								Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListProjects returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("StreamProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.StreamProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.StreamProjectsRequest
+				response business.StreamProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.StreamProjectsEndpoint()
+				request = business.StreamProjectsRequest{
+					SortingOptions: []common.SortingOptionPair{
+						{
+							Name:      "name",
+							Direction: common.Descending,
+						},
+					},
+					ProjectIDs:      []string{cuid.New(), cuid.New()},
+					IncludeArchived: (rand.Intn(10) % 2) == 0,
+					Filter: &models.ProjectFilter{
+						Name: &models.NameCondition{Operator: models.NameOperatorPrefix, Value: cuid.New()},
+					},
+				}
+				response = business.StreamProjectsResponse{}
+			})
+
+			Context("StreamProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.StreamProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.StreamProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with a field that cannot be sorted on", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.StreamProjectsRequest{
+							SortingOptions: []common.SortingOptionPair{{Name: "userEmail", Direction: common.Ascending}},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.StreamProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service StreamProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							StreamProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.StreamProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+								Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+								Ω(mappedRequest.Filter).Should(Equal(request.Filter))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.StreamProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service StreamProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							StreamProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("WatchProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.WatchProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.WatchProjectsRequest
+				response business.WatchProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.WatchProjectsEndpoint()
+				request = business.WatchProjectsRequest{
+					ResourceVersion: cuid.New(),
+				}
+				response = business.WatchProjectsResponse{}
+			})
+
+			Context("WatchProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with a resource version that is too long", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.WatchProjectsRequest{
+							ResourceVersion: strings.Repeat("a", 1025),
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service WatchProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							WatchProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.WatchProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.ResourceVersion).Should(Equal(request.ResourceVersion))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.WatchProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service WatchProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							WatchProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListProjectSortFieldsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListProjectSortFieldsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListProjectSortFieldsRequest
+				response business.ListProjectSortFieldsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListProjectSortFieldsEndpoint()
+				request = business.ListProjectSortFieldsRequest{}
+				response = business.ListProjectSortFieldsResponse{
+					Fields: []models.ProjectSortField{{Name: cuid.New(), Description: cuid.New()}},
+				}
+			})
+
+			Context("ListProjectSortFieldsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectSortFieldsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectSortFieldsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("business service ListProjectSortFields returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListProjectSortFields(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListProjectSortFields returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListProjectSortFields(ctx, gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("SearchProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.SearchProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.SearchProjectsRequest
+				response business.SearchProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.SearchProjectsEndpoint()
+				request = business.SearchProjectsRequest{
+					Query: cuid.New(),
+					Pagination: common.Pagination{
+						After: convertStringToPointer(cuid.New()),
+						First: convertIntToPointer(rand.Intn(1000)),
+					},
+				}
+
+				projects := []models.ProjectSearchResult{}
+
+				for idx := 0; idx < rand.Intn(20)+1; idx++ {
+					projects = append(projects, models.ProjectSearchResult{
+						ProjectID: cuid.New(),
+						Project: models.Project{
+							Name: cuid.New(),
+						},
+						Cursor: cuid.New(),
+						Score:  rand.Float64(),
+					})
+				}
+
+				response = business.SearchProjectsResponse{
+					HasPreviousPage: (rand.Intn(10) % 2) == 0,
+					HasNextPage:     (rand.Intn(10) % 2) == 0,
+					TotalCount:      rand.Int63n(1000),
+					Projects:        projects,
+				}
+			})
+
+			Context("SearchProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.SearchProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.SearchProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with empty query", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.SearchProjectsRequest{}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.SearchProjectsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service SearchProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							SearchProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.SearchProjectsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+								Ω(mappedRequest.Query).Should(Equal(request.Query))
+								Ω(mappedRequest.Pagination).Should(Equal(request.Pagination))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.SearchProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service SearchProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							SearchProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service SearchProjects returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							SearchProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ArchiveProjectEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ArchiveProjectEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ArchiveProjectRequest
+				response business.ArchiveProjectResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ArchiveProjectEndpoint()
+				request = business.ArchiveProjectRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.ArchiveProjectResponse{
+					Project: models.Project{
+						Name:      cuid.New(),
+						Version:   rand.Int63n(100) + 1,
+						State:     models.ProjectStateArchived,
+						UpdatedAt: time.Now(),
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("ArchiveProjectEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ArchiveProjectResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ArchiveProjectResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentNilError", func() {
+						invalidRequest := business.ArchiveProjectRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ArchiveProjectResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains negative expected version", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.ExpectedVersion = -1
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ArchiveProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ArchiveProject method", func() {
+						mockBusinessService.
+							EXPECT().
+							ArchiveProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ArchiveProjectRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ArchiveProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ArchiveProject returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ArchiveProject(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ArchiveProject returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ArchiveProject(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UnarchiveProjectEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UnarchiveProjectEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UnarchiveProjectRequest
+				response business.UnarchiveProjectResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UnarchiveProjectEndpoint()
+				request = business.UnarchiveProjectRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.UnarchiveProjectResponse{
+					Project: models.Project{
+						Name:      cuid.New(),
+						Version:   rand.Int63n(100) + 1,
+						UpdatedAt: time.Now(),
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("UnarchiveProjectEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UnarchiveProjectResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UnarchiveProjectResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentNilError", func() {
+						invalidRequest := business.UnarchiveProjectRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UnarchiveProjectResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains negative expected version", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.ExpectedVersion = -1
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UnarchiveProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UnarchiveProject method", func() {
+						mockBusinessService.
+							EXPECT().
+							UnarchiveProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.UnarchiveProjectRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UnarchiveProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UnarchiveProject returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UnarchiveProject(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UnarchiveProject returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UnarchiveProject(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RestoreProjectEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RestoreProjectEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RestoreProjectRequest
+				response business.RestoreProjectResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RestoreProjectEndpoint()
+				request = business.RestoreProjectRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.RestoreProjectResponse{
+					Project: models.Project{
+						Name:      cuid.New(),
+						Version:   rand.Int63n(100) + 1,
+						UpdatedAt: time.Now(),
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("RestoreProjectEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RestoreProjectResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RestoreProjectResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentNilError", func() {
+						invalidRequest := business.RestoreProjectRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RestoreProjectResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains negative expected version", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.ExpectedVersion = -1
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RestoreProjectResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RestoreProject method", func() {
+						mockBusinessService.
+							EXPECT().
+							RestoreProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.RestoreProjectRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RestoreProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RestoreProject returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RestoreProject(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RestoreProject returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RestoreProject(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListDeletedProjectsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListDeletedProjectsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint   gokitendpoint.Endpoint
+				projectIDs []string
+				request    business.ListDeletedProjectsRequest
+				response   business.ListDeletedProjectsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListDeletedProjectsEndpoint()
+				projectIDs = []string{}
+				for idx := 0; idx < rand.Intn(20)+1; idx++ {
+					projectIDs = append(projectIDs, cuid.New())
+				}
+
+				request = business.ListDeletedProjectsRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Pagination: common.Pagination{
+						After:  convertStringToPointer(cuid.New()),
+						First:  convertIntToPointer(rand.Intn(1000)),
+						Before: convertStringToPointer(cuid.New()),
+						Last:   convertIntToPointer(rand.Intn(1000)),
+					},
+					SortingOptions: []common.SortingOptionPair{
+						{
+							Name:      "name",
+							Direction: common.Ascending,
+						},
+						{
+							Name:      "createdAt",
+							Direction: common.Descending,
+						},
+					},
+					ProjectIDs: projectIDs,
+				}
+
+				projects := []models.ProjectWithCursor{}
+
+				for idx := 0; idx < rand.Intn(20)+1; idx++ {
+					projects = append(projects, models.ProjectWithCursor{
+						ProjectID: cuid.New(),
+						Project: models.Project{
+							Name:      cuid.New(),
+							DeletedAt: time.Now(),
+						},
+						Cursor: cuid.New(),
+					})
+				}
+
+				response = business.ListDeletedProjectsResponse{
+					HasPreviousPage: (rand.Intn(10) % 2) == 0,
+					HasNextPage:     (rand.Intn(10) % 2) == 0,
+					TotalCount:      rand.Int63n(1000),
+					Projects:        projects,
+				}
+			})
+
+			Context("ListDeletedProjectsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListDeletedProjectsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListDeletedProjectsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListDeletedProjects method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListDeletedProjects(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListDeletedProjectsRequest) {
+								Ω(mappedRequest.Pagination).Should(Equal(request.Pagination))
+								Ω(mappedRequest.SortingOptions).Should(Equal(request.SortingOptions))
+								Ω(mappedRequest.ProjectIDs).Should(Equal(request.ProjectIDs))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListDeletedProjectsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListDeletedProjects returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListDeletedProjects(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListDeletedProjects returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListDeletedProjects(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AddMemberEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AddMemberEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AddMemberRequest
+				response business.AddMemberResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AddMemberEndpoint()
+				request = business.AddMemberRequest{
+					UserEmail:   cuid.New() + "@test.com",
+					ProjectID:   cuid.New(),
+					MemberEmail: cuid.New() + "@test.com",
+					Role:        models.MemberRoleEditor,
+				}
+
+				response = business.AddMemberResponse{
+					Member: models.Member{
+						UserEmail: request.MemberEmail,
+						Role:      request.Role,
+					},
+				}
+			})
+
+			Context("AddMemberEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddMemberResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddMemberResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.AddMemberRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddMemberResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that adds an owner", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Role = models.MemberRoleOwner
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddMemberResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AddMember method", func() {
+						mockBusinessService.
+							EXPECT().
+							AddMember(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.AddMemberRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.MemberEmail).Should(Equal(request.MemberEmail))
+								Ω(mappedRequest.Role).Should(Equal(request.Role))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddMemberResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AddMember returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AddMember(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AddMember returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AddMember(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RemoveMemberEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RemoveMemberEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RemoveMemberRequest
+				response business.RemoveMemberResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RemoveMemberEndpoint()
+				request = business.RemoveMemberRequest{
+					UserEmail:   cuid.New() + "@test.com",
+					ProjectID:   cuid.New(),
+					MemberEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.RemoveMemberResponse{}
+			})
+
+			Context("RemoveMemberEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveMemberResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveMemberResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.RemoveMemberRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveMemberResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that contains invalid member email", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.MemberEmail = cuid.New()
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveMemberResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RemoveMember method", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveMember(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.RemoveMemberRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.MemberEmail).Should(Equal(request.MemberEmail))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveMemberResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RemoveMember returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RemoveMember(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RemoveMember returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveMember(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UpdateMemberRoleEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UpdateMemberRoleEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UpdateMemberRoleRequest
+				response business.UpdateMemberRoleResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UpdateMemberRoleEndpoint()
+				request = business.UpdateMemberRoleRequest{
+					UserEmail:   cuid.New() + "@test.com",
+					ProjectID:   cuid.New(),
+					MemberEmail: cuid.New() + "@test.com",
+					Role:        models.MemberRoleViewer,
+				}
+
+				response = business.UpdateMemberRoleResponse{
+					Member: models.Member{
+						UserEmail: request.MemberEmail,
+						Role:      request.Role,
+					},
+				}
+			})
+
+			Context("UpdateMemberRoleEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateMemberRoleResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateMemberRoleResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.UpdateMemberRoleRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateMemberRoleResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request that changes the role to owner", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := request
+						invalidRequest.Role = models.MemberRoleOwner
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateMemberRoleResponse)
+						validationErr := invalidRequest.Validate()
+						Ω(validationErr).Should(HaveOccurred())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UpdateMemberRole method", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateMemberRole(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.UpdateMemberRoleRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.MemberEmail).Should(Equal(request.MemberEmail))
+								Ω(mappedRequest.Role).Should(Equal(request.Role))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateMemberRoleResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UpdateMemberRole returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UpdateMemberRole(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UpdateMemberRole returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateMemberRole(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListMembersEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListMembersEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListMembersRequest
+				response business.ListMembersResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListMembersEndpoint()
+				request = business.ListMembersRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.ListMembersResponse{
+					Members: []models.Member{
+						{
+							UserEmail: request.UserEmail,
+							Role:      models.MemberRoleOwner,
+						},
+					},
+				}
+			})
+
+			Context("ListMembersEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListMembersResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListMembersResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListMembersRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListMembersResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListMembers method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListMembers(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListMembersRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListMembersResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListMembers returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListMembers(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListMembers returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListMembers(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("TransferProjectOwnershipEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.TransferProjectOwnershipEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.TransferProjectOwnershipRequest
+				response business.TransferProjectOwnershipResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.TransferProjectOwnershipEndpoint()
+				request = business.TransferProjectOwnershipRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					ProjectID:     cuid.New(),
+					NewOwnerEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.TransferProjectOwnershipResponse{
+					Project: models.Project{
+						Name: cuid.New(),
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("TransferProjectOwnershipEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.TransferProjectOwnershipResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.TransferProjectOwnershipResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.TransferProjectOwnershipRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.TransferProjectOwnershipResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service TransferProjectOwnership method", func() {
+						mockBusinessService.
+							EXPECT().
+							TransferProjectOwnership(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.TransferProjectOwnershipRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.TransferProjectOwnershipResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service TransferProjectOwnership returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							TransferProjectOwnership(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service TransferProjectOwnership returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							TransferProjectOwnership(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AcceptProjectOwnershipTransferEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AcceptProjectOwnershipTransferEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AcceptProjectOwnershipTransferRequest
+				response business.AcceptProjectOwnershipTransferResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AcceptProjectOwnershipTransferEndpoint()
+				request = business.AcceptProjectOwnershipTransferRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.AcceptProjectOwnershipTransferResponse{
+					Project: models.Project{
+						Name: cuid.New(),
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("AcceptProjectOwnershipTransferEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptProjectOwnershipTransferResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptProjectOwnershipTransferResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.AcceptProjectOwnershipTransferRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptProjectOwnershipTransferResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AcceptProjectOwnershipTransfer method", func() {
+						mockBusinessService.
+							EXPECT().
+							AcceptProjectOwnershipTransfer(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.AcceptProjectOwnershipTransferRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptProjectOwnershipTransferResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AcceptProjectOwnershipTransfer returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AcceptProjectOwnershipTransfer(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AcceptProjectOwnershipTransfer returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AcceptProjectOwnershipTransfer(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("DeclineProjectOwnershipTransferEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.DeclineProjectOwnershipTransferEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.DeclineProjectOwnershipTransferRequest
+				response business.DeclineProjectOwnershipTransferResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.DeclineProjectOwnershipTransferEndpoint()
+				request = business.DeclineProjectOwnershipTransferRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.DeclineProjectOwnershipTransferResponse{}
+			})
+
+			Context("DeclineProjectOwnershipTransferEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeclineProjectOwnershipTransferResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeclineProjectOwnershipTransferResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.DeclineProjectOwnershipTransferRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeclineProjectOwnershipTransferResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service DeclineProjectOwnershipTransfer method", func() {
+						mockBusinessService.
+							EXPECT().
+							DeclineProjectOwnershipTransfer(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.DeclineProjectOwnershipTransferRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeclineProjectOwnershipTransferResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service DeclineProjectOwnershipTransfer returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							DeclineProjectOwnershipTransfer(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service DeclineProjectOwnershipTransfer returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							DeclineProjectOwnershipTransfer(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListProjectAuditEventsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListProjectAuditEventsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListProjectAuditEventsRequest
+				response business.ListProjectAuditEventsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListProjectAuditEventsEndpoint()
+				request = business.ListProjectAuditEventsRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.ListProjectAuditEventsResponse{
+					AuditEvents: []models.AuditEvent{
+						{
+							Action:          models.AuditActionOwnershipTransferred,
+							UserEmail:       request.UserEmail,
+							TargetUserEmail: cuid.New() + "@test.com",
+							OccurredAt:      time.Now(),
+						},
+					},
+				}
+			})
+
+			Context("ListProjectAuditEventsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectAuditEventsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectAuditEventsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListProjectAuditEventsRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectAuditEventsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListProjectAuditEvents method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListProjectAuditEvents(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListProjectAuditEventsRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListProjectAuditEventsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListProjectAuditEvents returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListProjectAuditEvents(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListProjectAuditEvents returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListProjectAuditEvents(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("CreateInvitationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.CreateInvitationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.CreateInvitationRequest
+				response business.CreateInvitationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.CreateInvitationEndpoint()
+				request = business.CreateInvitationRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+					Email:     cuid.New() + "@test.com",
+					Role:      models.MemberRoleViewer,
+				}
+
+				response = business.CreateInvitationResponse{
+					Invitation: models.Invitation{
+						ProjectID: request.ProjectID,
+						Email:     request.Email,
+						Role:      request.Role,
+						InvitedBy: request.UserEmail,
+						CreatedAt: time.Now(),
+						ExpiresAt: time.Now().Add(time.Hour),
+					},
+				}
+			})
+
+			Context("CreateInvitationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateInvitationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateInvitationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.CreateInvitationRequest{
+							ProjectID: request.ProjectID,
+							Email:     "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateInvitationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service CreateInvitation method", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateInvitation(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.CreateInvitationRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.Email).Should(Equal(request.Email))
+								Ω(mappedRequest.Role).Should(Equal(request.Role))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateInvitationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service CreateInvitation returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							CreateInvitation(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service CreateInvitation returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateInvitation(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListInvitationsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListInvitationsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListInvitationsRequest
+				response business.ListInvitationsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListInvitationsEndpoint()
+				request = business.ListInvitationsRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+				}
+
+				response = business.ListInvitationsResponse{
+					Invitations: []models.Invitation{
+						{
+							ProjectID: request.ProjectID,
+							Email:     cuid.New() + "@test.com",
+							Role:      models.MemberRoleEditor,
+							InvitedBy: request.UserEmail,
+						},
+					},
+				}
+			})
+
+			Context("ListInvitationsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListInvitationsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListInvitationsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListInvitationsRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListInvitationsResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListInvitations method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListInvitations(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListInvitationsRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListInvitationsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListInvitations returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListInvitations(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListInvitations returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListInvitations(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AcceptInvitationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AcceptInvitationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AcceptInvitationRequest
+				response business.AcceptInvitationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AcceptInvitationEndpoint()
+				request = business.AcceptInvitationRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Token:     cuid.New(),
+				}
+
+				response = business.AcceptInvitationResponse{
+					ProjectID: cuid.New(),
+					Member: models.Member{
+						UserEmail: request.UserEmail,
+						Role:      models.MemberRoleEditor,
+					},
+				}
+			})
+
+			Context("AcceptInvitationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptInvitationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptInvitationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.AcceptInvitationRequest{
+							Token: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptInvitationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AcceptInvitation method", func() {
+						mockBusinessService.
+							EXPECT().
+							AcceptInvitation(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.AcceptInvitationRequest) {
+								Ω(mappedRequest.Token).Should(Equal(request.Token))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AcceptInvitationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AcceptInvitation returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AcceptInvitation(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AcceptInvitation returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AcceptInvitation(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RevokeInvitationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RevokeInvitationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RevokeInvitationRequest
+				response business.RevokeInvitationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RevokeInvitationEndpoint()
+				request = business.RevokeInvitationRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+					Email:     cuid.New() + "@test.com",
+				}
+
+				response = business.RevokeInvitationResponse{}
+			})
+
+			Context("RevokeInvitationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeInvitationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeInvitationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.RevokeInvitationRequest{
+							ProjectID: request.ProjectID,
+							Email:     "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeInvitationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RevokeInvitation method", func() {
+						mockBusinessService.
+							EXPECT().
+							RevokeInvitation(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.RevokeInvitationRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.Email).Should(Equal(request.Email))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeInvitationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RevokeInvitation returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RevokeInvitation(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RevokeInvitation returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RevokeInvitation(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("CreateOrganizationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.CreateOrganizationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.CreateOrganizationRequest
+				response business.CreateOrganizationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.CreateOrganizationEndpoint()
+				request = business.CreateOrganizationRequest{
+					UserEmail:    cuid.New() + "@test.com",
+					Organization: models.Organization{Name: cuid.New()},
+				}
+
+				response = business.CreateOrganizationResponse{
+					OrganizationID: cuid.New(),
+					Organization:   models.Organization{Name: request.Organization.Name},
+				}
+			})
+
+			Context("CreateOrganizationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateOrganizationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateOrganizationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.CreateOrganizationRequest{
+							Organization: models.Organization{Name: ""},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateOrganizationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service CreateOrganization method", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateOrganization(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.CreateOrganizationRequest) {
+								Ω(mappedRequest.Organization).Should(Equal(request.Organization))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateOrganizationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service CreateOrganization returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							CreateOrganization(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service CreateOrganization returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateOrganization(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ReadOrganizationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ReadOrganizationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ReadOrganizationRequest
+				response business.ReadOrganizationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ReadOrganizationEndpoint()
+				request = business.ReadOrganizationRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+				}
+
+				response = business.ReadOrganizationResponse{
+					Organization: models.Organization{Name: cuid.New()},
+				}
+			})
+
+			Context("ReadOrganizationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ReadOrganizationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ReadOrganizationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ReadOrganizationRequest{
+							OrganizationID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ReadOrganizationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ReadOrganization method", func() {
+						mockBusinessService.
+							EXPECT().
+							ReadOrganization(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ReadOrganizationRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ReadOrganizationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ReadOrganization returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ReadOrganization(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ReadOrganization returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ReadOrganization(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UpdateOrganizationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UpdateOrganizationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UpdateOrganizationRequest
+				response business.UpdateOrganizationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UpdateOrganizationEndpoint()
+				request = business.UpdateOrganizationRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+					Organization:   models.Organization{Name: cuid.New()},
+				}
+
+				response = business.UpdateOrganizationResponse{
+					Organization: models.Organization{Name: request.Organization.Name},
+				}
+			})
+
+			Context("UpdateOrganizationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateOrganizationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateOrganizationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.UpdateOrganizationRequest{
+							OrganizationID: "",
+							Organization:   models.Organization{Name: ""},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateOrganizationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UpdateOrganization method", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateOrganization(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.UpdateOrganizationRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+								Ω(mappedRequest.Organization).Should(Equal(request.Organization))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateOrganizationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UpdateOrganization returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UpdateOrganization(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UpdateOrganization returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateOrganization(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("DeleteOrganizationEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.DeleteOrganizationEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.DeleteOrganizationRequest
+				response business.DeleteOrganizationResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.DeleteOrganizationEndpoint()
+				request = business.DeleteOrganizationRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+				}
+
+				response = business.DeleteOrganizationResponse{}
+			})
+
+			Context("DeleteOrganizationEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrganizationResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrganizationResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.DeleteOrganizationRequest{
+							OrganizationID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrganizationResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service DeleteOrganization method", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteOrganization(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.DeleteOrganizationRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrganizationResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service DeleteOrganization returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							DeleteOrganization(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service DeleteOrganization returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteOrganization(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListOrganizationsEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListOrganizationsEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListOrganizationsRequest
+				response business.ListOrganizationsResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListOrganizationsEndpoint()
+				request = business.ListOrganizationsRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.ListOrganizationsResponse{
+					Organizations: []models.OrganizationWithID{
+						{
+							OrganizationID: cuid.New(),
+							Organization:   models.Organization{Name: cuid.New()},
+						},
+					},
+				}
+			})
+
+			Context("ListOrganizationsEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationsResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationsResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListOrganizations method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrganizations(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListOrganizationsRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationsResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListOrganizations returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListOrganizations(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListOrganizations returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrganizations(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AddOrganizationMemberEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AddOrganizationMemberEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AddOrganizationMemberRequest
+				response business.AddOrganizationMemberResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AddOrganizationMemberEndpoint()
+				request = business.AddOrganizationMemberRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+					MemberEmail:    cuid.New() + "@test.com",
+					Role:           models.MemberRoleEditor,
+				}
+
+				response = business.AddOrganizationMemberResponse{}
+			})
+
+			Context("AddOrganizationMemberEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddOrganizationMemberResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddOrganizationMemberResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.AddOrganizationMemberRequest{
+							OrganizationID: request.OrganizationID,
+							MemberEmail:    request.MemberEmail,
+							Role:           models.MemberRoleOwner,
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddOrganizationMemberResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AddOrganizationMember method", func() {
+						mockBusinessService.
+							EXPECT().
+							AddOrganizationMember(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.AddOrganizationMemberRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+								Ω(mappedRequest.MemberEmail).Should(Equal(request.MemberEmail))
+								Ω(mappedRequest.Role).Should(Equal(request.Role))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddOrganizationMemberResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AddOrganizationMember returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AddOrganizationMember(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AddOrganizationMember returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AddOrganizationMember(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RemoveOrganizationMemberEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RemoveOrganizationMemberEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RemoveOrganizationMemberRequest
+				response business.RemoveOrganizationMemberResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RemoveOrganizationMemberEndpoint()
+				request = business.RemoveOrganizationMemberRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+					MemberEmail:    cuid.New() + "@test.com",
+				}
+
+				response = business.RemoveOrganizationMemberResponse{}
+			})
+
+			Context("RemoveOrganizationMemberEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveOrganizationMemberResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveOrganizationMemberResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.RemoveOrganizationMemberRequest{
+							OrganizationID: request.OrganizationID,
+							MemberEmail:    "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveOrganizationMemberResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RemoveOrganizationMember method", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveOrganizationMember(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.RemoveOrganizationMemberRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+								Ω(mappedRequest.MemberEmail).Should(Equal(request.MemberEmail))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveOrganizationMemberResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RemoveOrganizationMember returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RemoveOrganizationMember(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RemoveOrganizationMember returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveOrganizationMember(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListOrganizationMembersEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListOrganizationMembersEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListOrganizationMembersRequest
+				response business.ListOrganizationMembersResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListOrganizationMembersEndpoint()
+				request = business.ListOrganizationMembersRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					OrganizationID: cuid.New(),
+				}
+
+				response = business.ListOrganizationMembersResponse{
+					Members: []models.Member{
+						{
+							UserEmail: request.UserEmail,
+							Role:      models.MemberRoleOwner,
+						},
+					},
+				}
+			})
+
+			Context("ListOrganizationMembersEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationMembersResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationMembersResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.ListOrganizationMembersRequest{
+							OrganizationID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationMembersResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListOrganizationMembers method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrganizationMembers(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListOrganizationMembersRequest) {
+								Ω(mappedRequest.OrganizationID).Should(Equal(request.OrganizationID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrganizationMembersResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListOrganizationMembers returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListOrganizationMembers(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListOrganizationMembers returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrganizationMembers(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("CreateFolderEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.CreateFolderEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.CreateFolderRequest
+				response business.CreateFolderResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.CreateFolderEndpoint()
+				request = business.CreateFolderRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Folder: models.Folder{
+						Name:           cuid.New(),
+						ParentFolderID: cuid.New(),
+					},
+				}
+
+				response = business.CreateFolderResponse{
+					FolderID: cuid.New(),
+					Folder:   request.Folder,
+				}
+			})
+
+			Context("CreateFolderEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateFolderResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateFolderResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.CreateFolderRequest{
+							Folder: models.Folder{},
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateFolderResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service CreateFolder method", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateFolder(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.CreateFolderRequest) {
+								Ω(mappedRequest.Folder).Should(Equal(request.Folder))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateFolderResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service CreateFolder returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							CreateFolder(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service CreateFolder returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							CreateFolder(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListFoldersEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListFoldersEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListFoldersRequest
+				response business.ListFoldersResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListFoldersEndpoint()
+				request = business.ListFoldersRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.ListFoldersResponse{
+					Folders: []models.FolderWithID{
+						{
+							FolderID: cuid.New(),
+							Folder:   models.Folder{Name: cuid.New()},
+						},
+					},
+				}
+			})
+
+			Context("ListFoldersEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListFoldersResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListFoldersResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListFolders method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListFolders(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.ListFoldersRequest) {
+								Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListFoldersResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListFolders returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListFolders(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListFolders returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListFolders(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("DeleteFolderEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.DeleteFolderEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.DeleteFolderRequest
+				response business.DeleteFolderResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.DeleteFolderEndpoint()
+				request = business.DeleteFolderRequest{
+					UserEmail: cuid.New() + "@test.com",
+					FolderID:  cuid.New(),
+					Cascade:   true,
+				}
+
+				response = business.DeleteFolderResponse{}
+			})
+
+			Context("DeleteFolderEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteFolderResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteFolderResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.DeleteFolderRequest{
+							FolderID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteFolderResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service DeleteFolder method", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteFolder(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.DeleteFolderRequest) {
+								Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+								Ω(mappedRequest.Cascade).Should(BeTrue())
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteFolderResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service DeleteFolder returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							DeleteFolder(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service DeleteFolder returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteFolder(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("MoveProjectEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.MoveProjectEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.MoveProjectRequest
+				response business.MoveProjectResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.MoveProjectEndpoint()
+				request = business.MoveProjectRequest{
+					UserEmail: cuid.New() + "@test.com",
+					ProjectID: cuid.New(),
+					FolderID:  cuid.New(),
+				}
+
+				response = business.MoveProjectResponse{
+					Project: models.Project{
+						Name:     cuid.New(),
+						FolderID: request.FolderID,
+					},
+					Cursor: cuid.New(),
+				}
+			})
+
+			Context("MoveProjectEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.MoveProjectResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.MoveProjectResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						invalidRequest := business.MoveProjectRequest{
+							ProjectID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.MoveProjectResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service MoveProject method", func() {
+						mockBusinessService.
+							EXPECT().
+							MoveProject(ctx, gomock.Any()).
+							Do(func(_ context.Context, mappedRequest *business.MoveProjectRequest) {
+								Ω(mappedRequest.ProjectID).Should(Equal(request.ProjectID))
+								Ω(mappedRequest.FolderID).Should(Equal(request.FolderID))
+							}).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.MoveProjectResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service MoveProject returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							MoveProject(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service MoveProject returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							MoveProject(gomock.Any(), gomock.Any()).
 							Return(&response, nil)
 
 						returnedResponse, err := endpoint(ctx, &request)
@@ -0,0 +1,15 @@
+// Package notifier implements different notifier services that deliver the notifications required by the project service
+package notifier
+
+import "context"
+
+// NotifierContract declares the service that delivers the invitations to the invited users
+type NotifierContract interface {
+	// SendInvitation delivers the invitation, together with the token it is accepted with, to the invited user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to deliver an invitation
+	// Returns error if something goes wrong.
+	SendInvitation(
+		ctx context.Context,
+		request *SendInvitationRequest) error
+}
@@ -0,0 +1 @@
+package notifier_test
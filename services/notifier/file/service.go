@@ -0,0 +1,107 @@
+// Package file implements the notifier service that appends the notifications to a local file instead of
+// delivering them, mainly used for tests that need to read the delivered tokens
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/notifier"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// Invitation is a delivered invitation as it is written to the file, one JSON object per line
+type Invitation struct {
+	Invitation models.Invitation `json:"invitation"`
+	Token      string            `json:"token"`
+}
+
+type fileNotifierService struct {
+	filePath string
+	lock     sync.Mutex
+}
+
+// NewFileNotifierService creates new instance of the fileNotifierService, setting up all dependencies and returns the instance.
+// The file is created on the first notification if it does not exist.
+// filePath: Mandatory. The path to the file to append the notifications to
+// Returns the new service or error if something goes wrong
+func NewFileNotifierService(filePath string) (notifier.NotifierContract, error) {
+	if filePath == "" {
+		return nil, commonErrors.NewArgumentError("filePath", "filePath is required")
+	}
+
+	return &fileNotifierService{
+		filePath: filePath,
+	}, nil
+}
+
+// SendInvitation appends the invitation, together with the token it is accepted with, to the file
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to deliver an invitation
+// Returns error if something goes wrong.
+func (service *fileNotifierService) SendInvitation(
+	ctx context.Context,
+	request *notifier.SendInvitationRequest) error {
+	line, err := json.Marshal(Invitation{
+		Invitation: request.Invitation,
+		Token:      request.Token,
+	})
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to encode the invitation", err)
+	}
+
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if err = os.MkdirAll(filepath.Dir(service.filePath), 0700); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the notifications file directory", err)
+	}
+
+	file, err := os.OpenFile(service.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to open the notifications file", err)
+	}
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+
+		return commonErrors.NewUnknownErrorWithError("failed to write the invitation", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the notifications file", err)
+	}
+
+	return nil
+}
+
+// ReadInvitations reads all the invitations written to the file, in the order they were delivered
+// filePath: Mandatory. The path to the file the notifications are appended to
+// Returns either the delivered invitations or error if something goes wrong.
+func ReadInvitations(filePath string) ([]Invitation, error) {
+	content, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return []Invitation{}, nil
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the notifications file", err)
+	}
+
+	invitations := []Invitation{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	for decoder.More() {
+		var invitation Invitation
+		if err = decoder.Decode(&invitation); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the invitation", err)
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, nil
+}
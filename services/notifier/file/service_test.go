@@ -0,0 +1,108 @@
+package file_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/notifier"
+	"github.com/decentralized-cloud/project/services/notifier/file"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFileNotifierService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "File Notifier Service Tests")
+}
+
+var _ = Describe("File Notifier Service Tests", func() {
+	var (
+		ctx      context.Context
+		dir      string
+		filePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+		dir, err = ioutil.TempDir("", "project-notifier")
+		Ω(err).Should(BeNil())
+		filePath = filepath.Join(dir, "notifications", "invitations.jsonl")
+	})
+
+	AfterEach(func() {
+		Ω(os.RemoveAll(dir)).Should(BeNil())
+	})
+
+	Context("user tries to instantiate FileNotifierService", func() {
+		When("file path is not provided and NewFileNotifierService is called", func() {
+			It("should return ArgumentError", func() {
+				service, err := file.NewFileNotifierService("")
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("nothing is delivered yet", func() {
+		When("the invitations are read", func() {
+			It("should return no invitation", func() {
+				invitations, err := file.ReadInvitations(filePath)
+				Ω(err).Should(BeNil())
+				Ω(invitations).Should(BeEmpty())
+			})
+		})
+	})
+
+	Context("invitations are delivered", func() {
+		When("the invitations are read", func() {
+			It("should return the invitations in the order they were delivered", func() {
+				service, err := file.NewFileNotifierService(filePath)
+				Ω(err).Should(BeNil())
+
+				requests := []*notifier.SendInvitationRequest{newSendInvitationRequest(), newSendInvitationRequest()}
+				for _, request := range requests {
+					Ω(service.SendInvitation(ctx, request)).Should(BeNil())
+				}
+
+				invitations, err := file.ReadInvitations(filePath)
+				Ω(err).Should(BeNil())
+				Ω(invitations).Should(HaveLen(len(requests)))
+
+				for idx, request := range requests {
+					Ω(invitations[idx].Token).Should(Equal(request.Token))
+					Ω(invitations[idx].Invitation.ProjectID).Should(Equal(request.Invitation.ProjectID))
+					Ω(invitations[idx].Invitation.Email).Should(Equal(request.Invitation.Email))
+					Ω(invitations[idx].Invitation.Role).Should(Equal(request.Invitation.Role))
+					Ω(invitations[idx].Invitation.InvitedBy).Should(Equal(request.Invitation.InvitedBy))
+					Ω(invitations[idx].Invitation.ExpiresAt).Should(BeTemporally("==", request.Invitation.ExpiresAt))
+				}
+			})
+		})
+	})
+})
+
+func newSendInvitationRequest() *notifier.SendInvitationRequest {
+	createdAt := time.Now().UTC()
+
+	return &notifier.SendInvitationRequest{
+		Invitation: models.Invitation{
+			ProjectID: cuid.New(),
+			Email:     cuid.New() + "@test.com",
+			Role:      models.MemberRoleEditor,
+			InvitedBy: cuid.New() + "@test.com",
+			CreatedAt: createdAt,
+			ExpiresAt: createdAt.Add(time.Hour),
+		},
+		Token: cuid.New(),
+	}
+}
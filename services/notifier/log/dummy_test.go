@@ -0,0 +1 @@
+package log_test
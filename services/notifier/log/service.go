@@ -0,0 +1,47 @@
+// Package log implements the notifier service that writes the notifications to the service log instead of
+// delivering them, mainly used for local development
+package log
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/project/services/notifier"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type logNotifierService struct {
+	logger *zap.Logger
+}
+
+// NewLogNotifierService creates new instance of the logNotifierService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// Returns the new service or error if something goes wrong
+func NewLogNotifierService(logger *zap.Logger) (notifier.NotifierContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	return &logNotifierService{
+		logger: logger,
+	}, nil
+}
+
+// SendInvitation writes the invitation, together with the token it is accepted with, to the service log
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to deliver an invitation
+// Returns error if something goes wrong.
+func (service *logNotifierService) SendInvitation(
+	ctx context.Context,
+	request *notifier.SendInvitationRequest) error {
+	service.logger.Info(
+		"invitation created",
+		zap.String("projectID", request.Invitation.ProjectID),
+		zap.String("email", request.Invitation.Email),
+		zap.Int("role", int(request.Invitation.Role)),
+		zap.String("invitedBy", request.Invitation.InvitedBy),
+		zap.Time("expiresAt", request.Invitation.ExpiresAt),
+		zap.String("token", request.Token))
+
+	return nil
+}
@@ -0,0 +1,11 @@
+// Package notifier implements different notifier services that deliver the notifications required by the project service
+package notifier
+
+import "github.com/decentralized-cloud/project/models"
+
+// SendInvitationRequest contains the request to deliver an invitation to the invited user. The token is only
+// available when the invitation is created, it is never stored.
+type SendInvitationRequest struct {
+	Invitation models.Invitation
+	Token      string
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/notifier/contract.go
+
+// Package mock_notifier is a generated GoMock package.
+package mock_notifier
+
+import (
+	context "context"
+	reflect "reflect"
+
+	notifier "github.com/decentralized-cloud/project/services/notifier"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotifierContract is a mock of NotifierContract interface.
+type MockNotifierContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierContractMockRecorder
+}
+
+// MockNotifierContractMockRecorder is the mock recorder for MockNotifierContract.
+type MockNotifierContractMockRecorder struct {
+	mock *MockNotifierContract
+}
+
+// NewMockNotifierContract creates a new mock instance.
+func NewMockNotifierContract(ctrl *gomock.Controller) *MockNotifierContract {
+	mock := &MockNotifierContract{ctrl: ctrl}
+	mock.recorder = &MockNotifierContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifierContract) EXPECT() *MockNotifierContractMockRecorder {
+	return m.recorder
+}
+
+// SendInvitation mocks base method.
+func (m *MockNotifierContract) SendInvitation(ctx context.Context, request *notifier.SendInvitationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvitation", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendInvitation indicates an expected call of SendInvitation.
+func (mr *MockNotifierContractMockRecorder) SendInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitation", reflect.TypeOf((*MockNotifierContract)(nil).SendInvitation), ctx, request)
+}
@@ -0,0 +1,20 @@
+// Package publisher implements different publisher services that deliver the domain events of the project service
+package publisher
+
+import "context"
+
+// EventPublisherContract declares the service that delivers the domain events to the other services
+type EventPublisherContract interface {
+	// PublishEvent delivers the domain event. The event is delivered at least once, so the same event can be
+	// published more than once and the consumers should deduplicate the events by their identifier.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to publish a domain event
+	// Returns error if something goes wrong.
+	PublishEvent(
+		ctx context.Context,
+		request *PublishEventRequest) error
+
+	// Close closes the connection to the messaging system.
+	// Returns error if something goes wrong.
+	Close() error
+}
@@ -0,0 +1 @@
+package publisher_test
@@ -0,0 +1,77 @@
+// Package kafka implements the publisher service that delivers the domain events to a Kafka topic
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/decentralized-cloud/project/services/publisher"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+type kafkaEventPublisherService struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaEventPublisherService creates new instance of the kafkaEventPublisherService, setting up all dependencies and returns the instance.
+// The events are keyed by the project identifier, so the events of a project are delivered to the same partition
+// in the order they are published.
+// brokers: Mandatory. The addresses of the Kafka brokers
+// topic: Mandatory. The topic to publish the events to
+// Returns the new service or error if something goes wrong
+func NewKafkaEventPublisherService(brokers []string, topic string) (publisher.EventPublisherContract, error) {
+	if len(brokers) == 0 {
+		return nil, commonErrors.NewArgumentError("brokers", "brokers is required")
+	}
+
+	if topic == "" {
+		return nil, commonErrors.NewArgumentError("topic", "topic is required")
+	}
+
+	return &kafkaEventPublisherService{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchSize:    1,
+		},
+	}, nil
+}
+
+// PublishEvent delivers the domain event to the topic and waits for all the in-sync replicas to acknowledge it
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to publish a domain event
+// Returns error if something goes wrong.
+func (service *kafkaEventPublisherService) PublishEvent(
+	ctx context.Context,
+	request *publisher.PublishEventRequest) error {
+	payload, err := json.Marshal(request.Event)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to encode the event", err)
+	}
+
+	if err = service.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(request.Event.ProjectID),
+		Value: payload,
+		Headers: []kafka.Header{
+			{Key: "eventID", Value: []byte(request.Event.EventID)},
+			{Key: "type", Value: []byte(request.Event.Type)},
+		},
+	}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to publish the event", err)
+	}
+
+	return nil
+}
+
+// Close flushes the pending events and closes the connections to the brokers.
+// Returns error if something goes wrong.
+func (service *kafkaEventPublisherService) Close() error {
+	if err := service.writer.Close(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the Kafka writer", err)
+	}
+
+	return nil
+}
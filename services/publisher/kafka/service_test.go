@@ -0,0 +1,46 @@
+package kafka_test
+
+import (
+	"testing"
+
+	"github.com/decentralized-cloud/project/services/publisher/kafka"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestKafkaEventPublisherService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kafka Event Publisher Service Tests")
+}
+
+var _ = Describe("Kafka Event Publisher Service Tests", func() {
+	Context("user tries to instantiate KafkaEventPublisherService", func() {
+		When("brokers are not provided and NewKafkaEventPublisherService is called", func() {
+			It("should return ArgumentError", func() {
+				service, err := kafka.NewKafkaEventPublisherService([]string{}, cuid.New())
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("topic is not provided and NewKafkaEventPublisherService is called", func() {
+			It("should return ArgumentError", func() {
+				service, err := kafka.NewKafkaEventPublisherService([]string{"localhost:9092"}, "")
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("all dependencies are resolved and NewKafkaEventPublisherService is called", func() {
+			It("should instantiate the new KafkaEventPublisherService", func() {
+				service, err := kafka.NewKafkaEventPublisherService([]string{"localhost:9092"}, cuid.New())
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+				Ω(service.Close()).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Package memory implements the publisher service that keeps the published events in memory instead of
+// delivering them, mainly used for local development and tests
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/publisher"
+)
+
+// EventReaderContract declares the service that reads the events published by the memory publisher service
+type EventReaderContract interface {
+	// ListPublishedEvents returns the published events, in the order they were published
+	ListPublishedEvents() []models.DomainEvent
+}
+
+type memoryEventPublisherService struct {
+	events []models.DomainEvent
+	lock   sync.RWMutex
+}
+
+// NewMemoryEventPublisherService creates new instance of the memoryEventPublisherService, setting up all dependencies and returns the instance.
+// The returned service also implements EventReaderContract.
+// Returns the new service or error if something goes wrong
+func NewMemoryEventPublisherService() (publisher.EventPublisherContract, error) {
+	return &memoryEventPublisherService{
+		events: []models.DomainEvent{},
+	}, nil
+}
+
+// PublishEvent keeps the domain event in memory
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to publish a domain event
+// Returns error if something goes wrong.
+func (service *memoryEventPublisherService) PublishEvent(
+	ctx context.Context,
+	request *publisher.PublishEventRequest) error {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	service.events = append(service.events, request.Event)
+
+	return nil
+}
+
+// Close does nothing as the memory publisher service has no connection to close.
+// Returns error if something goes wrong.
+func (service *memoryEventPublisherService) Close() error {
+	return nil
+}
+
+// ListPublishedEvents returns the published events, in the order they were published
+func (service *memoryEventPublisherService) ListPublishedEvents() []models.DomainEvent {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	events := make([]models.DomainEvent, len(service.events))
+	copy(events, service.events)
+
+	return events
+}
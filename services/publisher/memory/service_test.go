@@ -0,0 +1,75 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/publisher"
+	"github.com/decentralized-cloud/project/services/publisher/memory"
+	"github.com/lucsky/cuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryEventPublisherService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Memory Event Publisher Service Tests")
+}
+
+var _ = Describe("Memory Event Publisher Service Tests", func() {
+	var (
+		ctx    context.Context
+		sut    publisher.EventPublisherContract
+		reader memory.EventReaderContract
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+		sut, err = memory.NewMemoryEventPublisherService()
+		Ω(err).Should(BeNil())
+
+		var ok bool
+		reader, ok = sut.(memory.EventReaderContract)
+		Ω(ok).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		Ω(sut.Close()).Should(BeNil())
+	})
+
+	Context("nothing is published yet", func() {
+		When("the published events are listed", func() {
+			It("should return no event", func() {
+				Ω(reader.ListPublishedEvents()).Should(BeEmpty())
+			})
+		})
+	})
+
+	Context("events are published", func() {
+		When("the published events are listed", func() {
+			It("should return the events in the order they were published", func() {
+				events := []models.DomainEvent{newDomainEvent(), newDomainEvent()}
+				for _, event := range events {
+					Ω(sut.PublishEvent(ctx, &publisher.PublishEventRequest{Event: event})).Should(BeNil())
+				}
+
+				Ω(reader.ListPublishedEvents()).Should(Equal(events))
+			})
+		})
+	})
+})
+
+func newDomainEvent() models.DomainEvent {
+	return models.DomainEvent{
+		EventID:    cuid.New(),
+		Type:       models.DomainEventTypeProjectCreated,
+		ProjectID:  cuid.New(),
+		UserEmail:  cuid.New(),
+		OccurredAt: time.Now().UTC(),
+	}
+}
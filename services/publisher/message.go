@@ -0,0 +1,9 @@
+// Package publisher implements different publisher services that deliver the domain events of the project service
+package publisher
+
+import "github.com/decentralized-cloud/project/models"
+
+// PublishEventRequest contains the request to publish a domain event
+type PublishEventRequest struct {
+	Event models.DomainEvent
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/publisher/contract.go
+
+// Package mock_publisher is a generated GoMock package.
+package mock_publisher
+
+import (
+	context "context"
+	reflect "reflect"
+
+	publisher "github.com/decentralized-cloud/project/services/publisher"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventPublisherContract is a mock of EventPublisherContract interface.
+type MockEventPublisherContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherContractMockRecorder
+}
+
+// MockEventPublisherContractMockRecorder is the mock recorder for MockEventPublisherContract.
+type MockEventPublisherContractMockRecorder struct {
+	mock *MockEventPublisherContract
+}
+
+// NewMockEventPublisherContract creates a new mock instance.
+func NewMockEventPublisherContract(ctrl *gomock.Controller) *MockEventPublisherContract {
+	mock := &MockEventPublisherContract{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisherContract) EXPECT() *MockEventPublisherContractMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockEventPublisherContract) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockEventPublisherContractMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockEventPublisherContract)(nil).Close))
+}
+
+// PublishEvent mocks base method.
+func (m *MockEventPublisherContract) PublishEvent(ctx context.Context, request *publisher.PublishEventRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockEventPublisherContractMockRecorder) PublishEvent(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockEventPublisherContract)(nil).PublishEvent), ctx, request)
+}
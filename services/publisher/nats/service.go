@@ -0,0 +1,84 @@
+// Package nats implements the publisher service that delivers the domain events to a NATS JetStream stream
+package nats
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/decentralized-cloud/project/services/publisher"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/nats-io/nats.go"
+)
+
+type natsEventPublisherService struct {
+	connection *nats.Conn
+	jetStream  nats.JetStreamContext
+	subject    string
+}
+
+// NewNatsEventPublisherService creates new instance of the natsEventPublisherService, setting up all dependencies and returns the instance.
+// The events are published to the subject suffixed by the type of the event, e.g. project.events.ProjectCreated, and
+// the stream that captures the subjects must already exist. The identifier of the event is used as the JetStream
+// message ID, so the stream drops the events that are published again within its duplicate window.
+// natsURL: Mandatory. The URL of the NATS server
+// subject: Mandatory. The subject prefix to publish the events to
+// Returns the new service or error if something goes wrong
+func NewNatsEventPublisherService(natsURL, subject string) (publisher.EventPublisherContract, error) {
+	if natsURL == "" {
+		return nil, commonErrors.NewArgumentError("natsURL", "natsURL is required")
+	}
+
+	if subject == "" {
+		return nil, commonErrors.NewArgumentError("subject", "subject is required")
+	}
+
+	connection, err := nats.Connect(natsURL)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to connect to NATS", err)
+	}
+
+	jetStream, err := connection.JetStream()
+	if err != nil {
+		connection.Close()
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create the JetStream context", err)
+	}
+
+	return &natsEventPublisherService{
+		connection: connection,
+		jetStream:  jetStream,
+		subject:    subject,
+	}, nil
+}
+
+// PublishEvent delivers the domain event to the stream and waits for the stream to acknowledge it
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to publish a domain event
+// Returns error if something goes wrong.
+func (service *natsEventPublisherService) PublishEvent(
+	ctx context.Context,
+	request *publisher.PublishEventRequest) error {
+	payload, err := json.Marshal(request.Event)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to encode the event", err)
+	}
+
+	message := nats.NewMsg(service.subject + "." + string(request.Event.Type))
+	message.Data = payload
+
+	if _, err = service.jetStream.PublishMsg(message, nats.Context(ctx), nats.MsgId(request.Event.EventID)); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to publish the event", err)
+	}
+
+	return nil
+}
+
+// Close drains the pending events and closes the connection to the NATS server.
+// Returns error if something goes wrong.
+func (service *natsEventPublisherService) Close() error {
+	if err := service.connection.Drain(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the NATS connection", err)
+	}
+
+	return nil
+}
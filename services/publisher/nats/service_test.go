@@ -0,0 +1,161 @@
+package nats_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/publisher"
+	natsPublisher "github.com/decentralized-cloud/project/services/publisher/nats"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNatsEventPublisherService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NATS Event Publisher Service Tests")
+}
+
+var _ = Describe("NATS Event Publisher Service Tests", func() {
+	const subject = "project.events"
+
+	var (
+		ctx        context.Context
+		dir        string
+		natsServer *server.Server
+		connection *nats.Conn
+		jetStream  nats.JetStreamContext
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+		dir, err = ioutil.TempDir("", "project-publisher")
+		Ω(err).Should(BeNil())
+
+		natsServer, err = server.NewServer(&server.Options{
+			Host:      "127.0.0.1",
+			Port:      server.RANDOM_PORT,
+			JetStream: true,
+			StoreDir:  dir,
+			NoLog:     true,
+			NoSigs:    true,
+		})
+		Ω(err).Should(BeNil())
+
+		natsServer.Start()
+		Ω(natsServer.ReadyForConnections(10 * time.Second)).Should(BeTrue())
+
+		connection, err = nats.Connect(natsServer.ClientURL())
+		Ω(err).Should(BeNil())
+
+		jetStream, err = connection.JetStream()
+		Ω(err).Should(BeNil())
+
+		_, err = jetStream.AddStream(&nats.StreamConfig{
+			Name:     "PROJECT_EVENTS",
+			Subjects: []string{subject + ".>"},
+		})
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		connection.Close()
+		natsServer.Shutdown()
+		natsServer.WaitForShutdown()
+		Ω(os.RemoveAll(dir)).Should(BeNil())
+	})
+
+	Context("user tries to instantiate NatsEventPublisherService", func() {
+		When("NATS URL is not provided and NewNatsEventPublisherService is called", func() {
+			It("should return ArgumentError", func() {
+				service, err := natsPublisher.NewNatsEventPublisherService("", subject)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("subject is not provided and NewNatsEventPublisherService is called", func() {
+			It("should return ArgumentError", func() {
+				service, err := natsPublisher.NewNatsEventPublisherService(natsServer.ClientURL(), "")
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("NatsEventPublisherService is instantiated", func() {
+		var sut publisher.EventPublisherContract
+
+		BeforeEach(func() {
+			var err error
+
+			sut, err = natsPublisher.NewNatsEventPublisherService(natsServer.ClientURL(), subject)
+			Ω(err).Should(BeNil())
+		})
+
+		AfterEach(func() {
+			Ω(sut.Close()).Should(BeNil())
+		})
+
+		When("an event is published", func() {
+			It("should deliver the event to the subject of its type", func() {
+				event := newDomainEvent()
+				Ω(sut.PublishEvent(ctx, &publisher.PublishEventRequest{Event: event})).Should(BeNil())
+
+				message, err := jetStream.GetLastMsg("PROJECT_EVENTS", subject+"."+string(event.Type))
+				Ω(err).Should(BeNil())
+				Ω(message.Header.Get(nats.MsgIdHdr)).Should(Equal(event.EventID))
+
+				var publishedEvent models.DomainEvent
+				Ω(json.Unmarshal(message.Data, &publishedEvent)).Should(BeNil())
+				Ω(publishedEvent.EventID).Should(Equal(event.EventID))
+				Ω(publishedEvent.Type).Should(Equal(event.Type))
+				Ω(publishedEvent.ProjectID).Should(Equal(event.ProjectID))
+				Ω(publishedEvent.UserEmail).Should(Equal(event.UserEmail))
+				Ω(publishedEvent.OccurredAt).Should(BeTemporally("==", event.OccurredAt))
+			})
+		})
+
+		When("the same event is published more than once", func() {
+			It("should deliver the event once", func() {
+				event := newDomainEvent()
+				Ω(sut.PublishEvent(ctx, &publisher.PublishEventRequest{Event: event})).Should(BeNil())
+				Ω(sut.PublishEvent(ctx, &publisher.PublishEventRequest{Event: event})).Should(BeNil())
+
+				info, err := jetStream.StreamInfo("PROJECT_EVENTS")
+				Ω(err).Should(BeNil())
+				Ω(info.State.Msgs).Should(Equal(uint64(1)))
+			})
+		})
+
+		When("the stream does not capture the subject of the event", func() {
+			It("should return UnknownError", func() {
+				Ω(jetStream.DeleteStream("PROJECT_EVENTS")).Should(BeNil())
+
+				err := sut.PublishEvent(ctx, &publisher.PublishEventRequest{Event: newDomainEvent()})
+				Ω(commonErrors.IsUnknownError(err)).Should(BeTrue())
+			})
+		})
+	})
+})
+
+func newDomainEvent() models.DomainEvent {
+	return models.DomainEvent{
+		EventID:    cuid.New(),
+		Type:       models.DomainEventTypeProjectUpdated,
+		ProjectID:  cuid.New(),
+		UserEmail:  cuid.New(),
+		OccurredAt: time.Now().UTC(),
+	}
+}
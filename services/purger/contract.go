@@ -0,0 +1,14 @@
+// Package purger implements the service that permanently removes the deleted projects once their retention period is over
+package purger
+
+// PurgerContract declares the service that periodically purges the deleted projects
+type PurgerContract interface {
+	// Start starts purging the deleted projects periodically and blocks until the service is stopped.
+	// Returns error if something goes wrong.
+	Start() error
+
+	// Stop stops the purger service and waits until the purge in progress is cancelled, so the repository
+	// service can be closed afterwards.
+	// Returns error if something goes wrong.
+	Stop() error
+}
@@ -0,0 +1,146 @@
+// Package purger implements the service that permanently removes the deleted projects once their retention period is over
+package purger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/services/business"
+	"github.com/decentralized-cloud/project/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type purgerService struct {
+	logger               *zap.Logger
+	configurationService configuration.ConfigurationContract
+	businessService      business.BusinessContract
+	ctx                  context.Context
+	cancel               context.CancelFunc
+	lock                 sync.Mutex
+	running              sync.WaitGroup
+}
+
+// NewPurgerService creates new instance of the PurgerService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// businessService: Mandatory. Reference to the business service that purges the deleted projects
+// Returns the new service or error if something goes wrong
+func NewPurgerService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	businessService business.BusinessContract) (PurgerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if businessService == nil {
+		return nil, commonErrors.NewArgumentNilError("businessService", "businessService is required")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &purgerService{
+		logger:               logger,
+		configurationService: configurationService,
+		businessService:      businessService,
+		ctx:                  ctx,
+		cancel:               cancel,
+	}, nil
+}
+
+// Start starts purging the deleted projects periodically and blocks until the service is stopped.
+// Returns error if something goes wrong.
+func (service *purgerService) Start() error {
+	if !service.enterRunning() {
+		return nil
+	}
+
+	defer service.running.Done()
+
+	retentionPeriod, err := service.configurationService.GetDeletedProjectsRetentionPeriod()
+	if err != nil {
+		return err
+	}
+
+	purgeInterval, err := service.configurationService.GetDeletedProjectsPurgeInterval()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	service.logger.Info(
+		"purger service started",
+		zap.Duration("retentionPeriod", retentionPeriod),
+		zap.Duration("purgeInterval", purgeInterval))
+
+	for {
+		select {
+		case <-service.ctx.Done():
+			return nil
+		case <-ticker.C:
+			// The ticker can fire together with the service being stopped, which takes precedence
+			if service.ctx.Err() != nil {
+				return nil
+			}
+
+			service.purge(retentionPeriod)
+		}
+	}
+}
+
+// Stop stops the purger service and waits until the purge in progress is cancelled, so the repository service
+// can be closed afterwards.
+// Returns error if something goes wrong.
+func (service *purgerService) Stop() error {
+	service.lock.Lock()
+	service.cancel()
+	service.lock.Unlock()
+
+	service.running.Wait()
+
+	return nil
+}
+
+// enterRunning registers the call to Start, so Stop can wait for it to return.
+// Returns false if the service is already stopped and must not start.
+func (service *purgerService) enterRunning() bool {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if service.ctx.Err() != nil {
+		return false
+	}
+
+	service.running.Add(1)
+
+	return true
+}
+
+func (service *purgerService) purge(retentionPeriod time.Duration) {
+	response, err := service.businessService.PurgeDeletedProjects(
+		service.ctx,
+		&business.PurgeDeletedProjectsRequest{
+			DeletedBefore: time.Now().Add(-retentionPeriod),
+		})
+	if err == nil {
+		err = response.Err
+	}
+
+	if err != nil {
+		service.logger.Error("failed to purge the deleted projects", zap.Error(err))
+
+		return
+	}
+
+	if response.PurgedCount > 0 {
+		service.logger.Info("purged the deleted projects", zap.Int64("purgedCount", response.PurgedCount))
+	}
+}
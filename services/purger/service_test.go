@@ -0,0 +1,204 @@
+package purger_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/services/business"
+	businessMock "github.com/decentralized-cloud/project/services/business/mock"
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/purger"
+	"github.com/golang/mock/gomock"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPurgerService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Purger Service Tests")
+}
+
+var _ = Describe("Purger Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		logger                   *zap.Logger
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockBusinessService      *businessMock.MockBusinessContract
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		logger = zap.NewNop()
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockBusinessService = businessMock.NewMockBusinessContract(mockCtrl)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate PurgerService", func() {
+		When("logger is not provided and NewPurgerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := purger.NewPurgerService(nil, mockConfigurationService, mockBusinessService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("logger", "", err)
+			})
+		})
+
+		When("configuration service is not provided and NewPurgerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := purger.NewPurgerService(logger, nil, mockBusinessService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("business service is not provided and NewPurgerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := purger.NewPurgerService(logger, mockConfigurationService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("businessService", "", err)
+			})
+		})
+
+		When("all dependencies are resolved and NewPurgerService is called", func() {
+			It("should instantiate the new PurgerService", func() {
+				service, err := purger.NewPurgerService(logger, mockConfigurationService, mockBusinessService)
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+			})
+		})
+	})
+
+	Context("PurgerService is instantiated", func() {
+		var (
+			sut             purger.PurgerContract
+			retentionPeriod time.Duration
+		)
+
+		BeforeEach(func() {
+			sut, _ = purger.NewPurgerService(logger, mockConfigurationService, mockBusinessService)
+			retentionPeriod = 24 * time.Hour
+		})
+
+		When("configuration service fails to return the retention period", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New("retention period is not valid")
+				mockConfigurationService.
+					EXPECT().
+					GetDeletedProjectsRetentionPeriod().
+					Return(time.Duration(0), expectedError)
+
+				Ω(sut.Start()).Should(Equal(expectedError))
+			})
+		})
+
+		When("the purger service is started", func() {
+			It("should periodically purge the projects deleted before the retention period until it is stopped", func() {
+				mockConfigurationService.
+					EXPECT().
+					GetDeletedProjectsRetentionPeriod().
+					Return(retentionPeriod, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDeletedProjectsPurgeInterval().
+					Return(10*time.Millisecond, nil)
+
+				purged := make(chan time.Time, 100)
+				mockBusinessService.
+					EXPECT().
+					PurgeDeletedProjects(gomock.Any(), gomock.Any()).
+					Do(func(_ context.Context, request *business.PurgeDeletedProjectsRequest) {
+						purged <- request.DeletedBefore
+					}).
+					Return(&business.PurgeDeletedProjectsResponse{PurgedCount: 1}, nil).
+					MinTimes(2)
+
+				stopped := make(chan error, 1)
+				go func() {
+					stopped <- sut.Start()
+				}()
+
+				for idx := 0; idx < 2; idx++ {
+					var deletedBefore time.Time
+					Eventually(purged).Should(Receive(&deletedBefore))
+					Ω(deletedBefore).Should(BeTemporally("~", time.Now().Add(-retentionPeriod), time.Second))
+				}
+
+				Ω(sut.Stop()).Should(Succeed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the purger service is stopped while the deleted projects are purged", func() {
+			It("should cancel the purge and wait for it to return", func() {
+				mockConfigurationService.
+					EXPECT().
+					GetDeletedProjectsRetentionPeriod().
+					Return(retentionPeriod, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDeletedProjectsPurgeInterval().
+					Return(10*time.Millisecond, nil)
+
+				purging := make(chan struct{})
+				cancelled := make(chan struct{})
+				mockBusinessService.
+					EXPECT().
+					PurgeDeletedProjects(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						_ *business.PurgeDeletedProjectsRequest) (*business.PurgeDeletedProjectsResponse, error) {
+						close(purging)
+						<-ctx.Done()
+						time.Sleep(10 * time.Millisecond)
+						close(cancelled)
+
+						return nil, ctx.Err()
+					})
+
+				stopped := make(chan error, 1)
+				go func() {
+					stopped <- sut.Start()
+				}()
+
+				Eventually(purging).Should(BeClosed())
+
+				Ω(sut.Stop()).Should(Succeed())
+				Ω(cancelled).Should(BeClosed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the purger service is stopped before it is started", func() {
+			It("should not start", func() {
+				Ω(sut.Stop()).Should(Succeed())
+				Ω(sut.Start()).Should(Succeed())
+			})
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
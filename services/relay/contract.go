@@ -0,0 +1,14 @@
+// Package relay implements the service that publishes the domain events written to the outbox of the repository service
+package relay
+
+// RelayContract declares the service that periodically publishes the domain events written to the outbox
+type RelayContract interface {
+	// Start starts publishing the domain events periodically and blocks until the service is stopped.
+	// Returns error if something goes wrong.
+	Start() error
+
+	// Stop stops the relay service and waits until the batch being published is cancelled, so the publisher and
+	// the repository services can be closed afterwards.
+	// Returns error if something goes wrong.
+	Stop() error
+}
@@ -0,0 +1,201 @@
+// Package relay implements the service that publishes the domain events written to the outbox of the repository service
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/services/configuration"
+	"github.com/decentralized-cloud/project/services/publisher"
+	"github.com/decentralized-cloud/project/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type relayService struct {
+	logger                *zap.Logger
+	configurationService  configuration.ConfigurationContract
+	repositoryService     repository.RepositoryContract
+	eventPublisherService publisher.EventPublisherContract
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	lock                  sync.Mutex
+	running               sync.WaitGroup
+}
+
+// NewRelayService creates new instance of the RelayService, setting up all dependencies and returns the instance.
+// The events are removed from the outbox only after they are published, so an event can be published again if the
+// relay service stops in between, which makes the delivery at-least-once. Stopping the service cancels the batch
+// being published and waits for it to return.
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// repositoryService: Mandatory. Reference to the repository service the events are read from
+// eventPublisherService: Mandatory. Reference to the publisher service the events are delivered with
+// Returns the new service or error if something goes wrong
+func NewRelayService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	repositoryService repository.RepositoryContract,
+	eventPublisherService publisher.EventPublisherContract) (RelayContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if eventPublisherService == nil {
+		return nil, commonErrors.NewArgumentNilError("eventPublisherService", "eventPublisherService is required")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &relayService{
+		logger:                logger,
+		configurationService:  configurationService,
+		repositoryService:     repositoryService,
+		eventPublisherService: eventPublisherService,
+		ctx:                   ctx,
+		cancel:                cancel,
+	}, nil
+}
+
+// Start starts publishing the domain events periodically and blocks until the service is stopped.
+// Returns error if something goes wrong.
+func (service *relayService) Start() error {
+	if !service.enterRunning() {
+		return nil
+	}
+
+	defer service.running.Done()
+
+	relayInterval, err := service.configurationService.GetOutboxRelayInterval()
+	if err != nil {
+		return err
+	}
+
+	batchSize, err := service.configurationService.GetOutboxRelayBatchSize()
+	if err != nil {
+		return err
+	}
+
+	timeout, err := service.configurationService.GetOutboxRelayTimeout()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(relayInterval)
+	defer ticker.Stop()
+
+	service.logger.Info(
+		"relay service started",
+		zap.Duration("relayInterval", relayInterval),
+		zap.Int("batchSize", batchSize),
+		zap.Duration("timeout", timeout))
+
+	for {
+		select {
+		case <-service.ctx.Done():
+			return nil
+		case <-ticker.C:
+			// The ticker can fire together with the service being stopped, which takes precedence
+			if service.ctx.Err() != nil {
+				return nil
+			}
+
+			service.relay(batchSize, timeout)
+		}
+	}
+}
+
+// Stop stops the relay service and waits until the batch being published is cancelled, so the publisher and the
+// repository services can be closed afterwards.
+// Returns error if something goes wrong.
+func (service *relayService) Stop() error {
+	service.lock.Lock()
+	service.cancel()
+	service.lock.Unlock()
+
+	service.running.Wait()
+
+	return nil
+}
+
+// enterRunning registers the call to Start, so Stop can wait for it to return.
+// Returns false if the service is already stopped and must not start.
+func (service *relayService) enterRunning() bool {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if service.ctx.Err() != nil {
+		return false
+	}
+
+	service.running.Add(1)
+
+	return true
+}
+
+// relay publishes the events of the outbox batch by batch until the outbox is drained, a batch fails or the
+// service is stopped
+func (service *relayService) relay(batchSize int, timeout time.Duration) {
+	for service.relayBatch(batchSize, timeout) {
+		if service.ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// relayBatch publishes a batch of the events of the outbox in the order they were written and removes the
+// published events from the outbox. The publishing stops at the first event that fails, so the later events
+// are not published before it. The batch is abandoned once the timeout is over, so a broker or a database that
+// does not respond cannot block the relay.
+// Returns true if the batch is fully published and the outbox can have more events.
+func (service *relayService) relayBatch(batchSize int, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(service.ctx, timeout)
+	defer cancel()
+
+	response, err := service.repositoryService.ListOutboxEvents(ctx, &repository.ListOutboxEventsRequest{
+		Limit: batchSize,
+	})
+	if err != nil {
+		service.logger.Error("failed to read the events from the outbox", zap.Error(err))
+
+		return false
+	}
+
+	eventIDs := make([]string, 0, len(response.Events))
+	for _, event := range response.Events {
+		if err = service.eventPublisherService.PublishEvent(ctx, &publisher.PublishEventRequest{
+			Event: event,
+		}); err != nil {
+			service.logger.Error("failed to publish the event", zap.String("eventID", event.EventID), zap.Error(err))
+
+			break
+		}
+
+		eventIDs = append(eventIDs, event.EventID)
+	}
+
+	if len(eventIDs) == 0 {
+		return false
+	}
+
+	if _, deleteErr := service.repositoryService.DeleteOutboxEvents(ctx, &repository.DeleteOutboxEventsRequest{
+		EventIDs: eventIDs,
+	}); deleteErr != nil {
+		service.logger.Error("failed to remove the published events from the outbox", zap.Error(deleteErr))
+
+		return false
+	}
+
+	service.logger.Info("published the events", zap.Int("publishedCount", len(eventIDs)))
+
+	return err == nil && len(response.Events) == batchSize
+}
@@ -0,0 +1,428 @@
+package relay_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/publisher"
+	publisherMock "github.com/decentralized-cloud/project/services/publisher/mock"
+	"github.com/decentralized-cloud/project/services/relay"
+	"github.com/decentralized-cloud/project/services/repository"
+	repositoryMock "github.com/decentralized-cloud/project/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRelayService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Relay Service Tests")
+}
+
+var _ = Describe("Relay Service Tests", func() {
+	var (
+		mockCtrl                  *gomock.Controller
+		logger                    *zap.Logger
+		mockConfigurationService  *configurationMock.MockConfigurationContract
+		mockRepositoryService     *repositoryMock.MockRepositoryContract
+		mockEventPublisherService *publisherMock.MockEventPublisherContract
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		logger = zap.NewNop()
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		mockEventPublisherService = publisherMock.NewMockEventPublisherContract(mockCtrl)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate RelayService", func() {
+		When("logger is not provided and NewRelayService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := relay.NewRelayService(nil, mockConfigurationService, mockRepositoryService, mockEventPublisherService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("logger", "", err)
+			})
+		})
+
+		When("configuration service is not provided and NewRelayService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := relay.NewRelayService(logger, nil, mockRepositoryService, mockEventPublisherService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("repository service is not provided and NewRelayService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := relay.NewRelayService(logger, mockConfigurationService, nil, mockEventPublisherService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("repositoryService", "", err)
+			})
+		})
+
+		When("event publisher service is not provided and NewRelayService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := relay.NewRelayService(logger, mockConfigurationService, mockRepositoryService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("eventPublisherService", "", err)
+			})
+		})
+
+		When("all dependencies are resolved and NewRelayService is called", func() {
+			It("should instantiate the new RelayService", func() {
+				service, err := relay.NewRelayService(logger, mockConfigurationService, mockRepositoryService, mockEventPublisherService)
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+			})
+		})
+	})
+
+	Context("RelayService is instantiated", func() {
+		var (
+			sut       relay.RelayContract
+			published chan models.DomainEvent
+			deleted   chan []string
+		)
+
+		BeforeEach(func() {
+			sut, _ = relay.NewRelayService(logger, mockConfigurationService, mockRepositoryService, mockEventPublisherService)
+			published = make(chan models.DomainEvent, 100)
+			deleted = make(chan []string, 100)
+		})
+
+		expectConfigurationWithTimeout := func(batchSize int, timeout time.Duration) {
+			mockConfigurationService.
+				EXPECT().
+				GetOutboxRelayInterval().
+				Return(10*time.Millisecond, nil)
+
+			mockConfigurationService.
+				EXPECT().
+				GetOutboxRelayBatchSize().
+				Return(batchSize, nil)
+
+			mockConfigurationService.
+				EXPECT().
+				GetOutboxRelayTimeout().
+				Return(timeout, nil)
+		}
+
+		expectConfiguration := func(batchSize int) {
+			expectConfigurationWithTimeout(batchSize, time.Minute)
+		}
+
+		expectOutboxEvents := func(events ...models.DomainEvent) {
+			mockRepositoryService.
+				EXPECT().
+				ListOutboxEvents(gomock.Any(), gomock.Any()).
+				Return(&repository.ListOutboxEventsResponse{Events: events}, nil)
+		}
+
+		expectDeleteOutboxEvents := func() {
+			mockRepositoryService.
+				EXPECT().
+				DeleteOutboxEvents(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, request *repository.DeleteOutboxEventsRequest) {
+					deleted <- request.EventIDs
+				}).
+				Return(&repository.DeleteOutboxEventsResponse{}, nil).
+				AnyTimes()
+		}
+
+		start := func() chan error {
+			stopped := make(chan error, 1)
+			go func() {
+				stopped <- sut.Start()
+			}()
+
+			return stopped
+		}
+
+		When("configuration service fails to return the relay interval", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New("relay interval is not valid")
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayInterval().
+					Return(time.Duration(0), expectedError)
+
+				Ω(sut.Start()).Should(Equal(expectedError))
+			})
+		})
+
+		When("configuration service fails to return the batch size", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New("batch size is not valid")
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayInterval().
+					Return(time.Second, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayBatchSize().
+					Return(0, expectedError)
+
+				Ω(sut.Start()).Should(Equal(expectedError))
+			})
+		})
+
+		When("configuration service fails to return the timeout", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New("timeout is not valid")
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayInterval().
+					Return(time.Second, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayBatchSize().
+					Return(10, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetOutboxRelayTimeout().
+					Return(time.Duration(0), expectedError)
+
+				Ω(sut.Start()).Should(Equal(expectedError))
+			})
+		})
+
+		When("the relay service is started", func() {
+			It("should publish the events of the outbox batch by batch in order and remove them from the outbox", func() {
+				events := []models.DomainEvent{newDomainEvent(), newDomainEvent(), newDomainEvent()}
+				expectConfiguration(2)
+
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						ListOutboxEvents(gomock.Any(), gomock.Any()).
+						Do(func(_ context.Context, request *repository.ListOutboxEventsRequest) {
+							Ω(request.Limit).Should(Equal(2))
+						}).
+						Return(&repository.ListOutboxEventsResponse{Events: events[:2]}, nil),
+					mockRepositoryService.
+						EXPECT().
+						ListOutboxEvents(gomock.Any(), gomock.Any()).
+						Return(&repository.ListOutboxEventsResponse{Events: events[2:]}, nil),
+					mockRepositoryService.
+						EXPECT().
+						ListOutboxEvents(gomock.Any(), gomock.Any()).
+						Return(&repository.ListOutboxEventsResponse{}, nil).
+						AnyTimes(),
+				)
+
+				mockEventPublisherService.
+					EXPECT().
+					PublishEvent(gomock.Any(), gomock.Any()).
+					Do(func(_ context.Context, request *publisher.PublishEventRequest) {
+						published <- request.Event
+					}).
+					Return(nil).
+					Times(len(events))
+
+				expectDeleteOutboxEvents()
+
+				stopped := start()
+
+				for _, event := range events {
+					Eventually(published).Should(Receive(Equal(event)))
+				}
+
+				Eventually(deleted).Should(Receive(Equal([]string{events[0].EventID, events[1].EventID})))
+				Eventually(deleted).Should(Receive(Equal([]string{events[2].EventID})))
+
+				Ω(sut.Stop()).Should(Succeed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the event publisher service fails to publish an event", func() {
+			It("should only remove the events published before it from the outbox and retry it later", func() {
+				events := []models.DomainEvent{newDomainEvent(), newDomainEvent(), newDomainEvent()}
+				expectConfiguration(len(events))
+				expectOutboxEvents(events...)
+				expectOutboxEvents(events[1:]...)
+				mockRepositoryService.
+					EXPECT().
+					ListOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(&repository.ListOutboxEventsResponse{}, nil).
+					AnyTimes()
+
+				gomock.InOrder(
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: events[0]}).
+						Return(nil),
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: events[1]}).
+						Return(errors.New(cuid.New())),
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: events[1]}).
+						Return(nil),
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: events[2]}).
+						Return(nil),
+				)
+
+				expectDeleteOutboxEvents()
+
+				stopped := start()
+
+				Eventually(deleted).Should(Receive(Equal([]string{events[0].EventID})))
+				Eventually(deleted).Should(Receive(Equal([]string{events[1].EventID, events[2].EventID})))
+
+				Ω(sut.Stop()).Should(Succeed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the event publisher service does not respond", func() {
+			It("should abandon the batch once the timeout is over and retry it later", func() {
+				event := newDomainEvent()
+				expectConfigurationWithTimeout(10, 50*time.Millisecond)
+				expectOutboxEvents(event)
+				expectOutboxEvents(event)
+				mockRepositoryService.
+					EXPECT().
+					ListOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(&repository.ListOutboxEventsResponse{}, nil).
+					AnyTimes()
+
+				gomock.InOrder(
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: event}).
+						DoAndReturn(func(ctx context.Context, _ *publisher.PublishEventRequest) error {
+							<-ctx.Done()
+
+							return ctx.Err()
+						}),
+					mockEventPublisherService.
+						EXPECT().
+						PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: event}).
+						Return(nil),
+				)
+
+				expectDeleteOutboxEvents()
+
+				stopped := start()
+
+				Eventually(deleted).Should(Receive(Equal([]string{event.EventID})))
+
+				Ω(sut.Stop()).Should(Succeed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the relay service is stopped while a batch is published", func() {
+			It("should cancel the batch, keep its events in the outbox and wait for it to return", func() {
+				expectConfiguration(10)
+				expectOutboxEvents(newDomainEvent())
+
+				cancelled := make(chan struct{})
+				mockEventPublisherService.
+					EXPECT().
+					PublishEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, request *publisher.PublishEventRequest) error {
+						published <- request.Event
+						<-ctx.Done()
+						time.Sleep(10 * time.Millisecond)
+						close(cancelled)
+
+						return ctx.Err()
+					})
+
+				stopped := start()
+
+				Eventually(published).Should(Receive())
+
+				Ω(sut.Stop()).Should(Succeed())
+				Ω(cancelled).Should(BeClosed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the relay service is stopped before it is started", func() {
+			It("should not start", func() {
+				Ω(sut.Stop()).Should(Succeed())
+				Ω(sut.Start()).Should(Succeed())
+			})
+		})
+
+		When("the repository service fails to read the outbox", func() {
+			It("should not publish any event and retry later", func() {
+				event := newDomainEvent()
+				expectConfiguration(10)
+
+				mockRepositoryService.
+					EXPECT().
+					ListOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New(cuid.New()))
+				expectOutboxEvents(event)
+				mockRepositoryService.
+					EXPECT().
+					ListOutboxEvents(gomock.Any(), gomock.Any()).
+					Return(&repository.ListOutboxEventsResponse{}, nil).
+					AnyTimes()
+
+				mockEventPublisherService.
+					EXPECT().
+					PublishEvent(gomock.Any(), &publisher.PublishEventRequest{Event: event}).
+					Return(nil)
+
+				expectDeleteOutboxEvents()
+
+				stopped := start()
+
+				Eventually(deleted).Should(Receive(Equal([]string{event.EventID})))
+
+				Ω(sut.Stop()).Should(Succeed())
+				Eventually(stopped).Should(Receive(BeNil()))
+			})
+		})
+	})
+})
+
+func newDomainEvent() models.DomainEvent {
+	return models.DomainEvent{
+		EventID:    cuid.New(),
+		Type:       models.DomainEventTypeProjectCreated,
+		ProjectID:  cuid.New(),
+		UserEmail:  cuid.New(),
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BatchItemError indicates a batch was rolled back because one of its requests failed. Index is the position
+// of the failed request in the batch and Err is the error the request failed with.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+// Error returns message for the BatchItemError error type
+// Returns the error message
+func (e BatchItemError) Error() string {
+	return fmt.Sprintf("Batch item %d failed. Error: %s", e.Index, e.Err.Error())
+}
+
+// Unwrap returns the error the request failed with
+// Returns the wrapped error
+func (e BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// AsBatchItemError finds the first BatchItemError in the chain of the error
+// err: Mandatory. The error to inspect
+// Returns the BatchItemError and true if the chain contains one, otherwise returns false
+func AsBatchItemError(err error) (BatchItemError, bool) {
+	var batchItemError BatchItemError
+
+	return batchItemError, errors.As(err, &batchItemError)
+}
+
+// NewBatchItemError creates a new BatchItemError error
+// index: Mandatory. The position of the failed request in the batch
+// err: Mandatory. The error the request failed with
+// Returns the new error
+func NewBatchItemError(index int, err error) error {
+	return BatchItemError{
+		Index: index,
+		Err:   err,
+	}
+}
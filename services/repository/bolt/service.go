@@ -0,0 +1,3052 @@
+// Package bolt implements repository services that keep the projects in a local bbolt data file
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/configuration"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
+	"github.com/decentralized-cloud/project/services/repository/internal/watch"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	bbolt "go.etcd.io/bbolt"
+)
+
+var (
+	projectsBucket = []byte("projects")
+	// namesBucket is the unique index of the project names, mapping the owner and the normalized name of
+	// every project to the project identifier
+	namesBucket = []byte("project-names")
+	// invitationsBucket is the index of the pending invitations, mapping the hash of the token of every
+	// invitation to the project identifier
+	invitationsBucket   = []byte("project-invitations")
+	organizationsBucket = []byte("organizations")
+	foldersBucket       = []byte("folders")
+	// termsBucket is the inverted index of the words of the names and the descriptions of the projects, mapping
+	// every word and the identifier of every project that contains it to nothing
+	termsBucket = []byte("project-terms")
+	// idempotencyKeysBucket maps the email of every user and the idempotency keys the user created the projects
+	// with to the created projects
+	idempotencyKeysBucket = []byte("project-idempotency-keys")
+	// outboxBucket keeps the events written together with the changes of the projects that are not published
+	// yet, keyed by the sequence they were written in
+	outboxBucket = []byte("project-outbox")
+)
+
+type project struct {
+	UserEmail   string              `json:"userEmail"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Labels      map[string]string   `json:"labels"`
+	Annotations map[string]string   `json:"annotations"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   time.Time           `json:"updatedAt"`
+	CreatedBy   string              `json:"createdBy"`
+	Version     int64               `json:"version"`
+	DeletedAt   time.Time           `json:"deletedAt"`
+	State       models.ProjectState `json:"state"`
+	// Members maps the emails of the members of the project to their roles, including the owner
+	Members           map[string]models.MemberRole `json:"members"`
+	OwnershipTransfer *models.OwnershipTransfer    `json:"ownershipTransfer,omitempty"`
+	AuditEvents       []models.AuditEvent          `json:"auditEvents,omitempty"`
+	// Invitations maps the emails of the invited users to their pending invitations
+	Invitations    map[string]query.Invitation `json:"invitations,omitempty"`
+	OrganizationID string                      `json:"organizationID,omitempty"`
+	FolderID       string                      `json:"folderID,omitempty"`
+}
+
+type organization struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	CreatedBy   string    `json:"createdBy"`
+	// Members maps the emails of the members of the organization to their roles, including the owner
+	Members map[string]models.MemberRole `json:"members"`
+}
+
+type folder struct {
+	UserEmail      string    `json:"userEmail"`
+	Name           string    `json:"name"`
+	ParentFolderID string    `json:"parentFolderID,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type boltRepositoryService struct {
+	filePath    string
+	openTimeout time.Duration
+	dbLock      sync.RWMutex
+	db          *bbolt.DB
+	// updateLock serializes the transactions that change the projects until their changes are published, so the
+	// watchers receive the changes in the order they were committed
+	updateLock  sync.Mutex
+	broadcaster *watch.Broadcaster
+}
+
+// changeRecorder records the changes of the projects made in a transaction, they are published to the watchers
+// once the transaction is committed
+type changeRecorder struct {
+	changes []watch.Change
+}
+
+// NewBoltRepositoryService creates new instance of the boltRepositoryService, setting up all dependencies and returns the instance.
+// The database connection string is the path to the data file, which is created if it does not exist.
+// Returns the new service or error if something goes wrong
+func NewBoltRepositoryService(
+	configurationService configuration.ConfigurationContract) (repository.RepositoryContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	filePath, err := configurationService.GetDatabaseConnectionString()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the path to the data file", err)
+	}
+
+	openTimeout, err := configurationService.GetDatabaseConnectTimeout()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database connect timeout", err)
+	}
+
+	return &boltRepositoryService{
+		filePath:    filePath,
+		openTimeout: openTimeout,
+		broadcaster: watch.NewBroadcaster(),
+	}, nil
+}
+
+// CreateProject creates a new project.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new project
+// Returns either the result of creating new project or error if something goes wrong.
+func (service *boltRepositoryService) CreateProject(
+	ctx context.Context,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var response *repository.CreateProjectResponse
+	var requestErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) (err error) {
+		if request.IdempotencyKey == nil {
+			response, requestErr, err = createProject(tx, recorder, request)
+		} else {
+			response, requestErr, err = createProjectOnce(tx, recorder, request)
+		}
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create project", err)
+	}
+
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	return response, nil
+}
+
+// BatchCreateProjects creates new projects in a single transaction, either all the projects are created or
+// none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns either the results of creating the projects or error if something goes wrong.
+func (service *boltRepositoryService) BatchCreateProjects(
+	ctx context.Context,
+	request *repository.BatchCreateProjectsRequest) (*repository.BatchCreateProjectsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []repository.CreateProjectResponse
+	var batchErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		for idx := range request.Requests {
+			response, requestErr, err := createProject(tx, recorder, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			if requestErr != nil {
+				// Returning the error rolls back the transaction
+				batchErr = repository.NewBatchItemError(idx, requestErr)
+
+				return batchErr
+			}
+
+			responses = append(responses, *response)
+		}
+
+		return nil
+	}); batchErr != nil {
+		return nil, batchErr
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create projects", err)
+	}
+
+	return &repository.BatchCreateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// ReadProject read an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing project
+// Returns either the result of reading an existing project or error if something goes wrong.
+func (service *boltRepositoryService) ReadProject(
+	ctx context.Context,
+	request *repository.ReadProjectRequest) (*repository.ReadProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var project project
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		project, found, err = getProject(tx, request.UserEmail, request.ProjectID, false)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve project", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadProjectResponse{
+		Project: mapFromInternalProject(project),
+	}, nil
+}
+
+// UpdateProject update an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing project
+// Returns either the result of updateing an existing project or error if something goes wrong.
+func (service *boltRepositoryService) UpdateProject(
+	ctx context.Context,
+	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var response *repository.UpdateProjectResponse
+	var requestErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) (err error) {
+		response, requestErr, err = updateProject(tx, recorder, request)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project", err)
+	}
+
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	return response, nil
+}
+
+// BatchUpdateProjects updates existing projects in a single transaction, either all the projects are updated
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns either the results of updating the projects or error if something goes wrong.
+func (service *boltRepositoryService) BatchUpdateProjects(
+	ctx context.Context,
+	request *repository.BatchUpdateProjectsRequest) (*repository.BatchUpdateProjectsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []repository.UpdateProjectResponse
+	var batchErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		for idx := range request.Requests {
+			response, requestErr, err := updateProject(tx, recorder, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			if requestErr != nil {
+				// Returning the error rolls back the transaction
+				batchErr = repository.NewBatchItemError(idx, requestErr)
+
+				return batchErr
+			}
+
+			responses = append(responses, *response)
+		}
+
+		return nil
+	}); batchErr != nil {
+		return nil, batchErr
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update projects", err)
+	}
+
+	return &repository.BatchUpdateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// DeleteProject delete an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing project
+// Returns either the result of deleting an existing project or error if something goes wrong.
+func (service *boltRepositoryService) DeleteProject(
+	ctx context.Context,
+	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var response *repository.DeleteProjectResponse
+	var requestErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) (err error) {
+		response, requestErr, err = deleteProject(tx, recorder, request)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete project", err)
+	}
+
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	return response, nil
+}
+
+// BatchDeleteProjects deletes existing projects in a single transaction, either all the projects are deleted
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns either the results of deleting the projects or error if something goes wrong.
+func (service *boltRepositoryService) BatchDeleteProjects(
+	ctx context.Context,
+	request *repository.BatchDeleteProjectsRequest) (*repository.BatchDeleteProjectsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []repository.DeleteProjectResponse
+	var batchErr error
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		for idx := range request.Requests {
+			response, requestErr, err := deleteProject(tx, recorder, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			if requestErr != nil {
+				// Returning the error rolls back the transaction
+				batchErr = repository.NewBatchItemError(idx, requestErr)
+
+				return batchErr
+			}
+
+			responses = append(responses, *response)
+		}
+
+		return nil
+	}); batchErr != nil {
+		return nil, batchErr
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete projects", err)
+	}
+
+	return &repository.BatchDeleteProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet
+// context: Optional The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns either the result of restoring a deleted project or error if something goes wrong.
+func (service *boltRepositoryService) RestoreProject(
+	ctx context.Context,
+	request *repository.RestoreProjectRequest) (*repository.RestoreProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var restoredProject project
+	var found, conflict, nameTaken bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProject(tx, request.UserEmail, request.ProjectID, true)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		if !query.MatchesVersion(mapFromInternalProject(project), request.ExpectedVersion) {
+			conflict = true
+
+			return nil
+		}
+
+		names := tx.Bucket(namesBucket)
+		key := nameKey(project.UserEmail, project.Name)
+		if names.Get(key) != nil {
+			nameTaken = true
+
+			return nil
+		}
+
+		if err = names.Put(key, []byte(request.ProjectID)); err != nil {
+			return err
+		}
+
+		restoredProject = updateInternalProject(project, query.RestoreProject(mapFromInternalProject(project)))
+		if err = putProject(tx, request.ProjectID, restoredProject); err != nil {
+			return err
+		}
+
+		if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+			return err
+		}
+
+		return recorder.record(tx, nil, request.ProjectID, restoredProject, true)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to restore project", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if conflict {
+		return nil, models.NewConflictError()
+	}
+
+	if nameTaken {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.RestoreProjectResponse{
+		Project: mapFromInternalProject(restoredProject),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// UpdateProjectState changes the state of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the state of an existing project
+// Returns either the result of changing the state of an existing project or error if something goes wrong.
+func (service *boltRepositoryService) UpdateProjectState(
+	ctx context.Context,
+	request *repository.UpdateProjectStateRequest) (*repository.UpdateProjectStateResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedProject project
+	var found, conflict bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProject(tx, request.UserEmail, request.ProjectID, false)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		if !query.MatchesVersion(mapFromInternalProject(project), request.ExpectedVersion) {
+			conflict = true
+
+			return nil
+		}
+
+		updatedProject = updateInternalProject(project, query.UpdateProjectState(mapFromInternalProject(project), request.State))
+		if err = putProject(tx, request.ProjectID, updatedProject); err != nil {
+			return err
+		}
+
+		if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+			return err
+		}
+
+		return recorder.recordModified(tx, request.ProjectID, updatedProject)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project state", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if conflict {
+		return nil, models.NewConflictError()
+	}
+
+	return &repository.UpdateProjectStateResponse{
+		Project: mapFromInternalProject(updatedProject),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+// time provided in the request. The projects whose events are not published yet are kept until their events
+// are published.
+// context: Optional The reference to the context
+// request: Mandatory. The request contains the time the projects must have been deleted before
+// Returns either the result of purging the deleted projects or error if something goes wrong.
+func (service *boltRepositoryService) PurgeDeletedProjects(
+	ctx context.Context,
+	request *repository.PurgeDeletedProjectsRequest) (*repository.PurgeDeletedProjectsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &repository.PurgeDeletedProjectsResponse{}
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		projects := tx.Bucket(projectsBucket)
+		invitations := tx.Bucket(invitationsBucket)
+		purgedProjects := map[string]project{}
+		tokenHashes := [][]byte{}
+
+		pendingProjectIDs, err := getPendingProjectIDs(tx)
+		if err != nil {
+			return err
+		}
+
+		// The keys are collected first, as the bucket cannot be modified while it is being iterated over
+		if err := projects.ForEach(func(key, value []byte) error {
+			var project project
+			if err := json.Unmarshal(value, &project); err != nil {
+				return err
+			}
+
+			if !project.DeletedAt.IsZero() &&
+				project.DeletedAt.Before(request.DeletedBefore) &&
+				!pendingProjectIDs[string(key)] {
+				purgedProjects[string(key)] = project
+				for _, invitation := range project.Invitations {
+					tokenHashes = append(tokenHashes, []byte(invitation.TokenHash))
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for projectID, project := range purgedProjects {
+			if err := projects.Delete([]byte(projectID)); err != nil {
+				return err
+			}
+
+			if err := deleteProjectTerms(tx, projectID, project); err != nil {
+				return err
+			}
+		}
+
+		for _, tokenHash := range tokenHashes {
+			if err := invitations.Delete(tokenHash); err != nil {
+				return err
+			}
+		}
+
+		response.PurgedCount = int64(len(purgedProjects))
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to purge the deleted projects", err)
+	}
+
+	return response, nil
+}
+
+// ListProjects returns the list of projects that matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of projects that matched the criteria
+func (service *boltRepositoryService) ListProjects(
+	ctx context.Context,
+	request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
+	projects, organizationRoles, err := service.getProjects(request.UserEmail, false)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := service.getFolders(request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.ListProjects(projects, organizationRoles, folders, request)
+}
+
+// StreamProjects returns an iterator over all the projects that matched the criteria. The projects are sorted in memory like ListProjects does, so they
+// are listed at once and the iterator walks through them.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the iterator over the projects that matched the criteria
+func (service *boltRepositoryService) StreamProjects(
+	ctx context.Context,
+	request *repository.StreamProjectsRequest) (*repository.StreamProjectsResponse, error) {
+	response, err := service.ListProjects(ctx, request.ToListProjectsRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.StreamProjectsResponse{Projects: query.NewSliceIterator(response.Projects)}, nil
+}
+
+// WatchProjects watches the changes of the projects the user can see, as they are made by the service. The
+// changes made by other processes that share the data file are not watched.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the user and the resource version to resume watching from
+// Returns either the watcher over the changes of the projects or error if something goes wrong.
+func (service *boltRepositoryService) WatchProjects(
+	ctx context.Context,
+	request *repository.WatchProjectsRequest) (*repository.WatchProjectsResponse, error) {
+	watcher, err := service.broadcaster.Watch(request.UserEmail, request.ResourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.WatchProjectsResponse{Watcher: watcher}, nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *boltRepositoryService) SearchProjects(
+	ctx context.Context,
+	request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []query.Project{}
+	var organizationRoles map[string]models.MemberRole
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		if organizationRoles, err = getOrganizationRoles(tx, request.UserEmail); err != nil {
+			return err
+		}
+
+		projectIDs := map[string]bool{}
+		cursor := tx.Bucket(termsBucket).Cursor()
+		for _, term := range search.Terms(request.Query) {
+			prefix := termKey(term, "")
+			for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+				projectIDs[string(key[len(prefix):])] = true
+			}
+		}
+
+		for projectID := range projectIDs {
+			project, ok, err := getProjectByID(tx, projectID)
+			if err != nil {
+				return err
+			}
+
+			if ok && project.DeletedAt.IsZero() {
+				projects = append(projects, mapToQueryProject(projectID, project))
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to search the projects", err)
+	}
+
+	return query.SearchProjects(projects, organizationRoles, request)
+}
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of deleted projects that matched the criteria
+func (service *boltRepositoryService) ListDeletedProjects(
+	ctx context.Context,
+	request *repository.ListDeletedProjectsRequest) (*repository.ListDeletedProjectsResponse, error) {
+	projects, organizationRoles, err := service.getProjects(request.UserEmail, true)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := query.ListProjects(projects, organizationRoles, nil, request.ToListProjectsRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	deletedResponse := repository.ListDeletedProjectsResponse(*response)
+
+	return &deletedResponse, nil
+}
+
+// ReadMember reads a member of an existing or a deleted project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of a project
+// Returns either the result of reading a member of a project or error if something goes wrong.
+func (service *boltRepositoryService) ReadMember(
+	ctx context.Context,
+	request *repository.ReadMemberRequest) (*repository.ReadMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var role models.MemberRole
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok {
+			return err
+		}
+
+		role, found = project.Members[request.UserEmail]
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      role,
+		},
+	}, nil
+}
+
+// ReadEffectiveRole reads the role of a user in an existing or a deleted project, taking the organization
+// of the project into account
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the role of a user in a project
+// Returns either the role of the user in the project or error if something goes wrong.
+func (service *boltRepositoryService) ReadEffectiveRole(
+	ctx context.Context,
+	request *repository.ReadEffectiveRoleRequest) (*repository.ReadEffectiveRoleResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var role models.MemberRole
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok {
+			return err
+		}
+
+		organizationRoles, err := getOrganizationRoles(tx, request.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		role, found = mapToQueryProject(request.ProjectID, project).EffectiveRole(request.UserEmail, organizationRoles)
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the role", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadEffectiveRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// AddMember adds a new member to an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns either the result of adding a new member or error if something goes wrong.
+func (service *boltRepositoryService) AddMember(
+	ctx context.Context,
+	request *repository.AddMemberRequest) (*repository.AddMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found, alreadyMember bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		if _, ok = project.Members[request.Member.UserEmail]; ok {
+			alreadyMember = true
+
+			return nil
+		}
+
+		before, err := audience(tx, request.ProjectID, project)
+		if err != nil {
+			return err
+		}
+
+		project.Members[request.Member.UserEmail] = request.Member.Role
+		if err = putProject(tx, request.ProjectID, project); err != nil {
+			return err
+		}
+
+		return recorder.record(tx, before, request.ProjectID, project, false)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if alreadyMember {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.AddMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns either the result of changing the role of an existing member or error if something goes wrong.
+func (service *boltRepositoryService) UpdateMemberRole(
+	ctx context.Context,
+	request *repository.UpdateMemberRoleRequest) (*repository.UpdateMemberRoleResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		if _, found = project.Members[request.UserEmail]; !found {
+			return nil
+		}
+
+		project.Members[request.UserEmail] = request.Role
+
+		return putProject(tx, request.ProjectID, project)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update member role", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.UpdateMemberRoleResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      request.Role,
+		},
+	}, nil
+}
+
+// RemoveMember removes an existing member from a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns either the result of removing an existing member or error if something goes wrong.
+func (service *boltRepositoryService) RemoveMember(
+	ctx context.Context,
+	request *repository.RemoveMemberRequest) (*repository.RemoveMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		if _, found = project.Members[request.UserEmail]; !found {
+			return nil
+		}
+
+		before, err := audience(tx, request.ProjectID, project)
+		if err != nil {
+			return err
+		}
+
+		delete(project.Members, request.UserEmail)
+		if err = putProject(tx, request.ProjectID, project); err != nil {
+			return err
+		}
+
+		return recorder.record(tx, before, request.ProjectID, project, false)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveMemberResponse{}, nil
+}
+
+// ListMembers returns the members of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns either the members of the project or error if something goes wrong.
+func (service *boltRepositoryService) ListMembers(
+	ctx context.Context,
+	request *repository.ListMembersRequest) (*repository.ListMembersResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var members map[string]models.MemberRole
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		members = project.Members
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list members", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListMembersResponse{
+		Members: query.ListMembers(members),
+	}, nil
+}
+
+// RequestOwnershipTransfer records a pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to record a pending ownership transfer
+// Returns either the result of recording the pending ownership transfer or error if something goes wrong.
+func (service *boltRepositoryService) RequestOwnershipTransfer(
+	ctx context.Context,
+	request *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		transfer := request.Transfer
+		project.OwnershipTransfer = &transfer
+		project.AuditEvents = append(project.AuditEvents, request.AuditEvent)
+
+		return putProject(tx, request.ProjectID, project)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to request ownership transfer", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RequestOwnershipTransferResponse{
+		Transfer: request.Transfer,
+	}, nil
+}
+
+// ReadOwnershipTransfer reads the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the pending ownership transfer
+// Returns either the pending ownership transfer or error if something goes wrong.
+func (service *boltRepositoryService) ReadOwnershipTransfer(
+	ctx context.Context,
+	request *repository.ReadOwnershipTransferRequest) (*repository.ReadOwnershipTransferResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer *models.OwnershipTransfer
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		transfer = project.OwnershipTransfer
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read ownership transfer", err)
+	}
+
+	if transfer == nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOwnershipTransferResponse{
+		Transfer: *transfer,
+	}, nil
+}
+
+// DeleteOwnershipTransfer removes the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove the pending ownership transfer
+// Returns either the result of removing the pending ownership transfer or error if something goes wrong.
+func (service *boltRepositoryService) DeleteOwnershipTransfer(
+	ctx context.Context,
+	request *repository.DeleteOwnershipTransferRequest) (*repository.DeleteOwnershipTransferResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() || project.OwnershipTransfer == nil {
+			return err
+		}
+
+		found = true
+		project.OwnershipTransfer = nil
+		project.AuditEvents = append(project.AuditEvents, request.AuditEvent)
+
+		return putProject(tx, request.ProjectID, project)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete ownership transfer", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteOwnershipTransferResponse{}, nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user
+// context: Optional The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns either the result of transferring the ownership or error if something goes wrong.
+func (service *boltRepositoryService) TransferProjectOwnership(
+	ctx context.Context,
+	request *repository.TransferProjectOwnershipRequest) (*repository.TransferProjectOwnershipResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var transferredProject project
+	var found, nameTaken bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() || project.UserEmail != request.FromUserEmail {
+			return err
+		}
+
+		found = true
+		names := tx.Bucket(namesBucket)
+		key := nameKey(request.ToUserEmail, project.Name)
+		if projectID := names.Get(key); projectID != nil && string(projectID) != request.ProjectID {
+			nameTaken = true
+
+			return nil
+		}
+
+		if err = names.Delete(nameKey(project.UserEmail, project.Name)); err != nil {
+			return err
+		}
+
+		if err = names.Put(key, []byte(request.ProjectID)); err != nil {
+			return err
+		}
+
+		before, err := audience(tx, request.ProjectID, project)
+		if err != nil {
+			return err
+		}
+
+		query.TransferMembers(project.Members, request.FromUserEmail, request.ToUserEmail)
+		transferredProject = updateInternalProject(project, query.TransferProjectOwnership(mapFromInternalProject(project)))
+		transferredProject.UserEmail = request.ToUserEmail
+		transferredProject.OwnershipTransfer = nil
+		transferredProject.AuditEvents = append(transferredProject.AuditEvents, request.AuditEvent)
+		if err = putProject(tx, request.ProjectID, transferredProject); err != nil {
+			return err
+		}
+
+		if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+			return err
+		}
+
+		return recorder.record(tx, before, request.ProjectID, transferredProject, true)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to transfer project ownership", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if nameTaken {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.TransferProjectOwnershipResponse{
+		Project: mapFromInternalProject(transferredProject),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// ListAuditEvents returns the audit trail of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns either the audit events of the project or error if something goes wrong.
+func (service *boltRepositoryService) ListAuditEvents(
+	ctx context.Context,
+	request *repository.ListAuditEventsRequest) (*repository.ListAuditEventsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var auditEvents []models.AuditEvent
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		auditEvents = project.AuditEvents
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list audit events", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListAuditEventsResponse{
+		AuditEvents: append([]models.AuditEvent{}, auditEvents...),
+	}, nil
+}
+
+// CreateInvitation stores a new invitation to join an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to store a new invitation
+// Returns either the result of storing the new invitation or error if something goes wrong.
+func (service *boltRepositoryService) CreateInvitation(
+	ctx context.Context,
+	request *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.Invitation.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		invitations := tx.Bucket(invitationsBucket)
+		if project.Invitations == nil {
+			project.Invitations = map[string]query.Invitation{}
+		}
+
+		if invitation, ok := project.Invitations[request.Invitation.Email]; ok {
+			if err = invitations.Delete([]byte(invitation.TokenHash)); err != nil {
+				return err
+			}
+		}
+
+		project.Invitations[request.Invitation.Email] = query.Invitation{
+			Invitation: request.Invitation,
+			TokenHash:  request.TokenHash,
+		}
+
+		if err = invitations.Put([]byte(request.TokenHash), []byte(request.Invitation.ProjectID)); err != nil {
+			return err
+		}
+
+		return putProject(tx, request.Invitation.ProjectID, project)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create invitation", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.CreateInvitationResponse{
+		Invitation: request.Invitation,
+	}, nil
+}
+
+// ReadInvitation reads an invitation by the hash of its token
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an invitation
+// Returns either the invitation or error if something goes wrong.
+func (service *boltRepositoryService) ReadInvitation(
+	ctx context.Context,
+	request *repository.ReadInvitationRequest) (*repository.ReadInvitationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var invitation *models.Invitation
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		projectID := tx.Bucket(invitationsBucket).Get([]byte(request.TokenHash))
+		if projectID == nil {
+			return nil
+		}
+
+		project, ok, err := getProjectByID(tx, string(projectID))
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		for _, storedInvitation := range project.Invitations {
+			if storedInvitation.TokenHash == request.TokenHash {
+				invitation = &storedInvitation.Invitation
+
+				return nil
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read invitation", err)
+	}
+
+	if invitation == nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadInvitationResponse{
+		Invitation: *invitation,
+	}, nil
+}
+
+// ListInvitations returns the pending invitations of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns either the pending invitations of the project or error if something goes wrong.
+func (service *boltRepositoryService) ListInvitations(
+	ctx context.Context,
+	request *repository.ListInvitationsRequest) (*repository.ListInvitationsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var invitations map[string]query.Invitation
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		found = true
+		invitations = project.Invitations
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list invitations", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListInvitationsResponse{
+		Invitations: query.ListInvitations(invitations),
+	}, nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+// context: Optional The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns either the result of accepting the invitation or error if something goes wrong.
+func (service *boltRepositoryService) AcceptInvitation(
+	ctx context.Context,
+	request *repository.AcceptInvitationRequest) (*repository.AcceptInvitationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var role models.MemberRole
+	var found, alreadyMember bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		invitation, ok := project.Invitations[request.Email]
+		if !ok {
+			return nil
+		}
+
+		found = true
+		if _, ok = project.Members[request.Email]; ok {
+			alreadyMember = true
+
+			return nil
+		}
+
+		before, err := audience(tx, request.ProjectID, project)
+		if err != nil {
+			return err
+		}
+
+		role = invitation.Invitation.Role
+		delete(project.Invitations, request.Email)
+		project.Members[request.Email] = role
+
+		if err = tx.Bucket(invitationsBucket).Delete([]byte(invitation.TokenHash)); err != nil {
+			return err
+		}
+
+		if err = putProject(tx, request.ProjectID, project); err != nil {
+			return err
+		}
+
+		return recorder.record(tx, before, request.ProjectID, project, false)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to accept invitation", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if alreadyMember {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.AcceptInvitationResponse{
+		Member: models.Member{
+			UserEmail: request.Email,
+			Role:      role,
+		},
+	}, nil
+}
+
+// DeleteInvitation removes a pending invitation of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove a pending invitation
+// Returns either the result of removing the invitation or error if something goes wrong.
+func (service *boltRepositoryService) DeleteInvitation(
+	ctx context.Context,
+	request *repository.DeleteInvitationRequest) (*repository.DeleteInvitationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		project, ok, err := getProjectByID(tx, request.ProjectID)
+		if err != nil || !ok || !project.DeletedAt.IsZero() {
+			return err
+		}
+
+		invitation, ok := project.Invitations[request.Email]
+		if !ok {
+			return nil
+		}
+
+		found = true
+		delete(project.Invitations, request.Email)
+
+		if err = tx.Bucket(invitationsBucket).Delete([]byte(invitation.TokenHash)); err != nil {
+			return err
+		}
+
+		return putProject(tx, request.ProjectID, project)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete invitation", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteInvitationResponse{}, nil
+}
+
+// CreateOrganization creates a new organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns either the result of creating the new organization or error if something goes wrong.
+func (service *boltRepositoryService) CreateOrganization(
+	ctx context.Context,
+	request *repository.CreateOrganizationRequest) (*repository.CreateOrganizationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := query.NewOrganizationID()
+	newOrganization := mapToInternalOrganization(
+		query.NewMembers(request.UserEmail),
+		query.NewOrganization(request.UserEmail, request.Organization))
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		return putOrganization(tx, organizationID, newOrganization)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create organization", err)
+	}
+
+	return &repository.CreateOrganizationResponse{
+		OrganizationID: organizationID,
+		Organization:   mapFromInternalOrganization(newOrganization),
+	}, nil
+}
+
+// ReadOrganization reads an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns either the result of reading the organization or error if something goes wrong.
+func (service *boltRepositoryService) ReadOrganization(
+	ctx context.Context,
+	request *repository.ReadOrganizationRequest) (*repository.ReadOrganizationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var organization organization
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		organization, found, err = getMemberOrganization(tx, request.UserEmail, request.OrganizationID)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOrganizationResponse{
+		Organization: mapFromInternalOrganization(organization),
+	}, nil
+}
+
+// UpdateOrganization updates an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns either the result of updating the organization or error if something goes wrong.
+func (service *boltRepositoryService) UpdateOrganization(
+	ctx context.Context,
+	request *repository.UpdateOrganizationRequest) (*repository.UpdateOrganizationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedOrganization organization
+	var found bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		organization, ok, err := getMemberOrganization(tx, request.UserEmail, request.OrganizationID)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		updatedOrganization = mapToInternalOrganization(
+			organization.Members,
+			query.UpdateOrganization(mapFromInternalOrganization(organization), request.Organization))
+
+		return putOrganization(tx, request.OrganizationID, updatedOrganization)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update organization", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.UpdateOrganizationResponse{
+		Organization: mapFromInternalOrganization(updatedOrganization),
+	}, nil
+}
+
+// DeleteOrganization deletes an existing organization that has no project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns either the result of deleting the organization or error if something goes wrong.
+func (service *boltRepositoryService) DeleteOrganization(
+	ctx context.Context,
+	request *repository.DeleteOrganizationRequest) (*repository.DeleteOrganizationResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found, notEmpty bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		_, ok, err := getMemberOrganization(tx, request.UserEmail, request.OrganizationID)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		if err = tx.Bucket(projectsBucket).ForEach(func(_, value []byte) error {
+			var project project
+			if err := json.Unmarshal(value, &project); err != nil {
+				return err
+			}
+
+			notEmpty = notEmpty || project.OrganizationID == request.OrganizationID
+
+			return nil
+		}); err != nil || notEmpty {
+			return err
+		}
+
+		return tx.Bucket(organizationsBucket).Delete([]byte(request.OrganizationID))
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete organization", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if notEmpty {
+		return nil, models.NewOrganizationNotEmptyError()
+	}
+
+	return &repository.DeleteOrganizationResponse{}, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the organizations of a user
+// Returns either the organizations of the user or error if something goes wrong.
+func (service *boltRepositoryService) ListOrganizations(
+	ctx context.Context,
+	request *repository.ListOrganizationsRequest) (*repository.ListOrganizationsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := []query.Organization{}
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(organizationsBucket).ForEach(func(key, value []byte) error {
+			var organization organization
+			if err := json.Unmarshal(value, &organization); err != nil {
+				return err
+			}
+
+			organizations = append(organizations, query.Organization{
+				OrganizationID: string(key),
+				Organization:   mapFromInternalOrganization(organization),
+				Members:        organization.Members,
+			})
+
+			return nil
+		})
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+	}
+
+	return &repository.ListOrganizationsResponse{
+		Organizations: query.ListOrganizations(organizations, request.UserEmail),
+	}, nil
+}
+
+// ReadOrganizationMember reads a member of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of an existing organization
+// Returns either the result of reading the member or error if something goes wrong.
+func (service *boltRepositoryService) ReadOrganizationMember(
+	ctx context.Context,
+	request *repository.ReadOrganizationMemberRequest) (*repository.ReadOrganizationMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var organization organization
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		organization, found, err = getMemberOrganization(tx, request.UserEmail, request.OrganizationID)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOrganizationMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      organization.Members[request.UserEmail],
+		},
+	}, nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns either the result of adding the new member or error if something goes wrong.
+func (service *boltRepositoryService) AddOrganizationMember(
+	ctx context.Context,
+	request *repository.AddOrganizationMemberRequest) (*repository.AddOrganizationMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found, alreadyMember bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		organization, ok, err := getOrganization(tx, request.OrganizationID)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		if _, ok = organization.Members[request.Member.UserEmail]; ok {
+			alreadyMember = true
+
+			return nil
+		}
+
+		audiences, err := organizationAudiences(tx, request.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		organization.Members[request.Member.UserEmail] = request.Member.Role
+		if err = putOrganization(tx, request.OrganizationID, organization); err != nil {
+			return err
+		}
+
+		return recorder.recordOrganizationChanges(tx, audiences)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add organization member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if alreadyMember {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.AddOrganizationMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns either the result of removing the member or error if something goes wrong.
+func (service *boltRepositoryService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *repository.RemoveOrganizationMemberRequest) (*repository.RemoveOrganizationMemberResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		organization, ok, err := getMemberOrganization(tx, request.UserEmail, request.OrganizationID)
+		if err != nil || !ok {
+			return err
+		}
+
+		found = true
+		audiences, err := organizationAudiences(tx, request.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		delete(organization.Members, request.UserEmail)
+		if err = putOrganization(tx, request.OrganizationID, organization); err != nil {
+			return err
+		}
+
+		return recorder.recordOrganizationChanges(tx, audiences)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove organization member", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveOrganizationMemberResponse{}, nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns either the members of the organization or error if something goes wrong.
+func (service *boltRepositoryService) ListOrganizationMembers(
+	ctx context.Context,
+	request *repository.ListOrganizationMembersRequest) (*repository.ListOrganizationMembersResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var organization organization
+	var found bool
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		organization, found, err = getOrganization(tx, request.OrganizationID)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organization members", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListOrganizationMembersResponse{
+		Members: query.ListMembers(organization.Members),
+	}, nil
+}
+
+// MoveProject moves an existing project into a folder of the user or out of its folder
+// context: Optional The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns either the result of moving the project or error if something goes wrong.
+func (service *boltRepositoryService) MoveProject(
+	ctx context.Context,
+	request *repository.MoveProjectRequest) (*repository.MoveProjectResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var movedProject project
+	var found bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		project, ok, err := getProject(tx, request.UserEmail, request.ProjectID, false)
+		if err != nil || !ok {
+			return err
+		}
+
+		if request.FolderID != "" {
+			if _, ok, err = getFolder(tx, request.UserEmail, request.FolderID); err != nil || !ok {
+				return err
+			}
+		}
+
+		found = true
+		movedProject = updateInternalProject(project, query.MoveProject(mapFromInternalProject(project), request.FolderID))
+		if err = putProject(tx, request.ProjectID, movedProject); err != nil {
+			return err
+		}
+
+		if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+			return err
+		}
+
+		return recorder.recordModified(tx, request.ProjectID, movedProject)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to move project", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.MoveProjectResponse{
+		Project: mapFromInternalProject(movedProject),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// CreateFolder creates a new folder for the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns either the result of creating the new folder or error if something goes wrong.
+func (service *boltRepositoryService) CreateFolder(
+	ctx context.Context,
+	request *repository.CreateFolderRequest) (*repository.CreateFolderResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	folderID := query.NewFolderID()
+	newFolder := mapToInternalFolder(request.UserEmail, query.NewFolder(request.Folder))
+	var parentMissing bool
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		if request.Folder.ParentFolderID != "" {
+			if _, ok, err := getFolder(tx, request.UserEmail, request.Folder.ParentFolderID); err != nil || !ok {
+				parentMissing = !ok
+
+				return err
+			}
+		}
+
+		return putFolder(tx, folderID, newFolder)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create folder", err)
+	}
+
+	if parentMissing {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.CreateFolderResponse{
+		FolderID: folderID,
+		Folder:   mapFromInternalFolder(newFolder),
+	}, nil
+}
+
+// ListFolders returns the folders of the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the folders of a user
+// Returns either the folders of the user or error if something goes wrong.
+func (service *boltRepositoryService) ListFolders(
+	ctx context.Context,
+	request *repository.ListFoldersRequest) (*repository.ListFoldersResponse, error) {
+	folders, err := service.getFolders(request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ListFoldersResponse{
+		Folders: query.ListFolders(folders, request.UserEmail),
+	}, nil
+}
+
+// DeleteFolder deletes an existing folder of the user together with its subfolders
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns either the result of deleting the folder or error if something goes wrong.
+func (service *boltRepositoryService) DeleteFolder(
+	ctx context.Context,
+	request *repository.DeleteFolderRequest) (*repository.DeleteFolderResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var found, notEmpty bool
+
+	if err = service.update(db, func(tx *bbolt.Tx, recorder *changeRecorder) error {
+		folders, err := readFolders(tx, request.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		folderIDs, ok := query.FolderTree(folders, request.UserEmail, request.FolderID, true)
+		if !ok {
+			return nil
+		}
+
+		found = true
+		organizationRoles, err := getOrganizationRoles(tx, request.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		updatedProjects := map[string]project{}
+		deletedProjectIDs := map[string]bool{}
+		audiences := map[string]map[string]bool{}
+
+		// The projects are collected first, as the bucket cannot be modified while it is being iterated over
+		if err = tx.Bucket(projectsBucket).ForEach(func(key, value []byte) error {
+			var project project
+			if err := json.Unmarshal(value, &project); err != nil {
+				return err
+			}
+
+			if !folderIDs[project.FolderID] {
+				return nil
+			}
+
+			before, err := audience(tx, string(key), project)
+			if err != nil {
+				return err
+			}
+
+			audiences[string(key)] = before
+
+			if project.DeletedAt.IsZero() && mapToQueryProject(string(key), project).CanAccess(request.UserEmail, organizationRoles) {
+				notEmpty = true
+				deletedProjectIDs[string(key)] = true
+				project = updateInternalProject(project, query.DeleteProject(mapFromInternalProject(project)))
+			}
+
+			project.FolderID = ""
+			updatedProjects[string(key)] = project
+
+			return nil
+		}); err != nil || (notEmpty && !request.Cascade) {
+			return err
+		}
+
+		names := tx.Bucket(namesBucket)
+		for projectID, project := range updatedProjects {
+			if !project.DeletedAt.IsZero() {
+				if err = names.Delete(nameKey(project.UserEmail, project.Name)); err != nil {
+					return err
+				}
+			}
+
+			if err = putProject(tx, projectID, project); err != nil {
+				return err
+			}
+
+			if deletedProjectIDs[projectID] {
+				if err = writeEvent(tx, query.NewCascadeEvent(request.Event, projectID), projectID); err != nil {
+					return err
+				}
+			}
+
+			if err = recorder.record(tx, audiences[projectID], projectID, project, true); err != nil {
+				return err
+			}
+		}
+
+		for folderID := range folderIDs {
+			if err = tx.Bucket(foldersBucket).Delete([]byte(folderID)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete folder", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if notEmpty && !request.Cascade {
+		return nil, models.NewFolderNotEmptyError()
+	}
+
+	return &repository.DeleteFolderResponse{}, nil
+}
+
+// ListOutboxEvents returns the events written to the outbox that are not published yet, the oldest ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the maximum number of events to return
+// Returns either the events that are not published yet or error if something goes wrong.
+func (service *boltRepositoryService) ListOutboxEvents(
+	ctx context.Context,
+	request *repository.ListOutboxEventsRequest) (*repository.ListOutboxEventsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	events := []models.DomainEvent{}
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(outboxBucket).Cursor()
+		for key, value := cursor.First(); key != nil && len(events) < request.Limit; key, value = cursor.Next() {
+			var event models.DomainEvent
+			if err := json.Unmarshal(value, &event); err != nil {
+				return err
+			}
+
+			events = append(events, event)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+	}
+
+	return &repository.ListOutboxEventsResponse{
+		Events: events,
+	}, nil
+}
+
+// DeleteOutboxEvents removes the published events from the outbox
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the identifiers of the published events
+// Returns either the result of removing the published events or error if something goes wrong.
+func (service *boltRepositoryService) DeleteOutboxEvents(
+	ctx context.Context,
+	request *repository.DeleteOutboxEventsRequest) (*repository.DeleteOutboxEventsResponse, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	published := make(map[string]bool, len(request.EventIDs))
+	for _, eventID := range request.EventIDs {
+		published[eventID] = true
+	}
+
+	if err = db.Update(func(tx *bbolt.Tx) error {
+		outbox := tx.Bucket(outboxBucket)
+		keys := [][]byte{}
+
+		// The keys are collected first, as the bucket cannot be modified while it is being iterated over
+		if err := outbox.ForEach(func(key, value []byte) error {
+			var event models.DomainEvent
+			if err := json.Unmarshal(value, &event); err != nil {
+				return err
+			}
+
+			if published[event.EventID] {
+				keys = append(keys, append([]byte{}, key...))
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if err := outbox.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete the outbox events", err)
+	}
+
+	return &repository.DeleteOutboxEventsResponse{}, nil
+}
+
+// Backup writes a consistent snapshot of the data file to the writer. The snapshot is taken in a read
+// transaction, so the projects can be created, updated and deleted while the backup is in progress.
+// ctx: Mandatory The reference to the context
+// writer: Mandatory. The writer to write the snapshot to
+// Returns either the number of bytes written or error if something goes wrong.
+func (service *boltRepositoryService) Backup(
+	ctx context.Context,
+	writer io.Writer) (int64, error) {
+	if writer == nil {
+		return 0, commonErrors.NewArgumentNilError("writer", "writer is required")
+	}
+
+	db, err := service.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	var written int64
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		written, err = tx.WriteTo(writer)
+
+		return
+	}); err != nil {
+		return written, commonErrors.NewUnknownErrorWithError("failed to back up the data file", err)
+	}
+
+	return written, nil
+}
+
+// Close closes the data file
+// Returns error if something goes wrong
+func (service *boltRepositoryService) Close() error {
+	service.dbLock.Lock()
+	defer service.dbLock.Unlock()
+
+	if service.db == nil {
+		return nil
+	}
+
+	err := service.db.Close()
+	service.db = nil
+
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the data file", err)
+	}
+
+	return nil
+}
+
+// getDB returns the database shared by all the requests, opening the data file on the first call.
+// Every write transaction is synced to the disk before it is committed, so a crash can never leave
+// a partially written project behind.
+func (service *boltRepositoryService) getDB() (*bbolt.DB, error) {
+	service.dbLock.RLock()
+	db := service.db
+	service.dbLock.RUnlock()
+
+	if db != nil {
+		return db, nil
+	}
+
+	service.dbLock.Lock()
+	defer service.dbLock.Unlock()
+
+	if service.db != nil {
+		return service.db, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(service.filePath), 0700); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create the data file directory", err)
+	}
+
+	// The timeout applies to acquiring the exclusive lock on the data file, which is held by another
+	// process if the data file is already in use
+	db, err := bbolt.Open(service.filePath, 0600, &bbolt.Options{Timeout: service.openTimeout})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("could not open the data file", err)
+	}
+
+	if err = db.Update(initialize); err != nil {
+		_ = db.Close()
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to initialize the data file", err)
+	}
+
+	service.db = db
+
+	return db, nil
+}
+
+// update runs the function in a read-write transaction and publishes the changes of the projects it recorded to
+// the watchers once the transaction is committed. The changes are dropped if the transaction is rolled back.
+func (service *boltRepositoryService) update(db *bbolt.DB, fn func(tx *bbolt.Tx, recorder *changeRecorder) error) error {
+	service.updateLock.Lock()
+	defer service.updateLock.Unlock()
+
+	recorder := &changeRecorder{}
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		return fn(tx, recorder)
+	}); err != nil {
+		return err
+	}
+
+	service.broadcaster.Publish(recorder.changes...)
+
+	return nil
+}
+
+// initialize creates the buckets that do not exist yet. The data files created before the project names
+// had to be unique have no names bucket, so it is populated from the stored projects. If such a data file
+// already contains projects that share the same name, only the first one is indexed.
+func initialize(tx *bbolt.Tx) error {
+	projects, err := tx.CreateBucketIfNotExists(projectsBucket)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.CreateBucketIfNotExists(invitationsBucket); err != nil {
+		return err
+	}
+
+	if _, err = tx.CreateBucketIfNotExists(organizationsBucket); err != nil {
+		return err
+	}
+
+	if _, err = tx.CreateBucketIfNotExists(foldersBucket); err != nil {
+		return err
+	}
+
+	if _, err = tx.CreateBucketIfNotExists(idempotencyKeysBucket); err != nil {
+		return err
+	}
+
+	if _, err = tx.CreateBucketIfNotExists(outboxBucket); err != nil {
+		return err
+	}
+
+	if err = addMissingOwners(projects); err != nil {
+		return err
+	}
+
+	if err = addMissingTerms(tx); err != nil {
+		return err
+	}
+
+	if tx.Bucket(namesBucket) != nil {
+		return nil
+	}
+
+	names, err := tx.CreateBucket(namesBucket)
+	if err != nil {
+		return err
+	}
+
+	return projects.ForEach(func(projectID, value []byte) error {
+		var project project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return err
+		}
+
+		key := nameKey(project.UserEmail, project.Name)
+		if !project.DeletedAt.IsZero() || names.Get(key) != nil {
+			return nil
+		}
+
+		return names.Put(key, projectID)
+	})
+}
+
+// addMissingTerms creates the inverted index of the words of the projects if it does not exist yet, indexing
+// the projects stored before the projects could be searched
+func addMissingTerms(tx *bbolt.Tx) error {
+	if tx.Bucket(termsBucket) != nil {
+		return nil
+	}
+
+	if _, err := tx.CreateBucket(termsBucket); err != nil {
+		return err
+	}
+
+	return tx.Bucket(projectsBucket).ForEach(func(projectID, value []byte) error {
+		var project project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return err
+		}
+
+		return putProjectTerms(tx, string(projectID), project)
+	})
+}
+
+// addMissingOwners makes the users who created the projects stored before the projects had members the
+// owners of their projects
+func addMissingOwners(projects *bbolt.Bucket) error {
+	updatedProjects := map[string]project{}
+
+	// The projects are collected first, as the bucket cannot be modified while it is being iterated over
+	if err := projects.ForEach(func(projectID, value []byte) error {
+		var project project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return err
+		}
+
+		if project.Members == nil {
+			project.Members = query.NewMembers(project.UserEmail)
+			updatedProjects[string(projectID)] = project
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for projectID, project := range updatedProjects {
+		value, err := json.Marshal(project)
+		if err != nil {
+			return err
+		}
+
+		if err = projects.Put([]byte(projectID), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getProjects returns the projects the user is a member of, either directly or through the organizations of
+// the projects, either the deleted projects or the ones that are not deleted, together with the roles of the
+// user in the organizations the user is a member of. The projects of the other users are filtered here to
+// avoid keeping them in memory.
+func (service *boltRepositoryService) getProjects(
+	userEmail string,
+	deleted bool) ([]query.Project, map[string]models.MemberRole, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects := []query.Project{}
+	var organizationRoles map[string]models.MemberRole
+
+	if err = db.View(func(tx *bbolt.Tx) error {
+		if organizationRoles, err = getOrganizationRoles(tx, userEmail); err != nil {
+			return err
+		}
+
+		return tx.Bucket(projectsBucket).ForEach(func(key, value []byte) error {
+			var project project
+			if err := json.Unmarshal(value, &project); err != nil {
+				return err
+			}
+
+			queryProject := mapToQueryProject(string(key), project)
+			if !queryProject.CanAccess(userEmail, organizationRoles) || project.DeletedAt.IsZero() == deleted {
+				return nil
+			}
+
+			projects = append(projects, queryProject)
+
+			return nil
+		})
+	}); err != nil {
+		return nil, nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the projects", err)
+	}
+
+	return projects, organizationRoles, nil
+}
+
+// getFolders returns the folders of the user
+func (service *boltRepositoryService) getFolders(userEmail string) ([]query.Folder, error) {
+	db, err := service.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var folders []query.Folder
+
+	if err = db.View(func(tx *bbolt.Tx) (err error) {
+		folders, err = readFolders(tx, userEmail)
+
+		return
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the folders", err)
+	}
+
+	return folders, nil
+}
+
+// readFolders returns the folders of the user
+func readFolders(tx *bbolt.Tx, userEmail string) ([]query.Folder, error) {
+	folders := []query.Folder{}
+
+	if err := tx.Bucket(foldersBucket).ForEach(func(key, value []byte) error {
+		var folder folder
+		if err := json.Unmarshal(value, &folder); err != nil {
+			return err
+		}
+
+		if folder.UserEmail == userEmail {
+			folders = append(folders, query.Folder{
+				FolderID:  string(key),
+				UserEmail: folder.UserEmail,
+				Folder:    mapFromInternalFolder(folder),
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+// getFolder returns the folder of the user
+func getFolder(tx *bbolt.Tx, userEmail, folderID string) (folder, bool, error) {
+	var folder folder
+
+	value := tx.Bucket(foldersBucket).Get([]byte(folderID))
+	if value == nil {
+		return folder, false, nil
+	}
+
+	if err := json.Unmarshal(value, &folder); err != nil {
+		return folder, false, err
+	}
+
+	return folder, folder.UserEmail == userEmail, nil
+}
+
+// getProject returns the project the user is a member of, either directly or through the organization of the
+// project, only if the project is deleted or not as requested
+func getProject(tx *bbolt.Tx, userEmail, projectID string, deleted bool) (project, bool, error) {
+	project, ok, err := getProjectByID(tx, projectID)
+	if err != nil || !ok || project.DeletedAt.IsZero() == deleted {
+		return project, false, err
+	}
+
+	organizationRoles, err := getOrganizationRoles(tx, userEmail)
+	if err != nil {
+		return project, false, err
+	}
+
+	return project, mapToQueryProject(projectID, project).CanAccess(userEmail, organizationRoles), nil
+}
+
+// getProjectByID returns the project regardless of its members, whether the project is deleted or not
+func getProjectByID(tx *bbolt.Tx, projectID string) (project, bool, error) {
+	var project project
+
+	value := tx.Bucket(projectsBucket).Get([]byte(projectID))
+	if value == nil {
+		return project, false, nil
+	}
+
+	if err := json.Unmarshal(value, &project); err != nil {
+		return project, false, err
+	}
+
+	return project, true, nil
+}
+
+// getOrganization returns the organization regardless of its members
+func getOrganization(tx *bbolt.Tx, organizationID string) (organization, bool, error) {
+	var organization organization
+
+	value := tx.Bucket(organizationsBucket).Get([]byte(organizationID))
+	if value == nil {
+		return organization, false, nil
+	}
+
+	if err := json.Unmarshal(value, &organization); err != nil {
+		return organization, false, err
+	}
+
+	return organization, true, nil
+}
+
+// audience returns the users who can see the stored project
+func audience(tx *bbolt.Tx, projectID string, project project) (map[string]bool, error) {
+	organization, _, err := getOrganization(tx, project.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch.Audience(mapToQueryProject(projectID, project), organization.Members), nil
+}
+
+// organizationAudiences returns the users who can see the projects of the organization, before its members are
+// changed
+func organizationAudiences(tx *bbolt.Tx, organizationID string) (map[string]map[string]bool, error) {
+	audiences := map[string]map[string]bool{}
+
+	return audiences, tx.Bucket(projectsBucket).ForEach(func(key, value []byte) error {
+		var project project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return err
+		}
+
+		if project.OrganizationID != organizationID {
+			return nil
+		}
+
+		before, err := audience(tx, string(key), project)
+		if err != nil {
+			return err
+		}
+
+		audiences[string(key)] = before
+
+		return nil
+	})
+}
+
+// record records the change of the project
+// before: Optional. The users who could see the project before the change, nil for the new projects
+// project: Mandatory. The stored project after the change
+// modified: Mandatory. Whether the project itself was changed, rather than only the users who can see it
+func (recorder *changeRecorder) record(tx *bbolt.Tx, before map[string]bool, projectID string, project project, modified bool) error {
+	after, err := audience(tx, projectID, project)
+	if err != nil {
+		return err
+	}
+
+	recorder.changes = append(recorder.changes, watch.Change{
+		ProjectID: projectID,
+		Project:   mapFromInternalProject(project),
+		Before:    before,
+		After:     after,
+		Modified:  modified,
+	})
+
+	return nil
+}
+
+// recordModified records the change of the project that did not change the users who can see it
+func (recorder *changeRecorder) recordModified(tx *bbolt.Tx, projectID string, project project) error {
+	before, err := audience(tx, projectID, project)
+	if err != nil {
+		return err
+	}
+
+	return recorder.record(tx, before, projectID, project, true)
+}
+
+// recordOrganizationChanges records the changes of the projects of the organization whose members were changed
+func (recorder *changeRecorder) recordOrganizationChanges(tx *bbolt.Tx, audiences map[string]map[string]bool) error {
+	for projectID, before := range audiences {
+		project, _, err := getProjectByID(tx, projectID)
+		if err != nil {
+			return err
+		}
+
+		if err = recorder.record(tx, before, projectID, project, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getMemberOrganization returns the organization the user is a member of
+func getMemberOrganization(tx *bbolt.Tx, userEmail, organizationID string) (organization, bool, error) {
+	organization, ok, err := getOrganization(tx, organizationID)
+	if err != nil || !ok {
+		return organization, false, err
+	}
+
+	_, ok = organization.Members[userEmail]
+
+	return organization, ok, nil
+}
+
+// getOrganizationRoles returns the roles of the user in the organizations the user is a member of
+func getOrganizationRoles(tx *bbolt.Tx, userEmail string) (map[string]models.MemberRole, error) {
+	organizationRoles := map[string]models.MemberRole{}
+
+	if err := tx.Bucket(organizationsBucket).ForEach(func(key, value []byte) error {
+		var organization organization
+		if err := json.Unmarshal(value, &organization); err != nil {
+			return err
+		}
+
+		if role, ok := organization.Members[userEmail]; ok {
+			organizationRoles[string(key)] = role
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return organizationRoles, nil
+}
+
+func putOrganization(tx *bbolt.Tx, organizationID string, organization organization) error {
+	value, err := json.Marshal(organization)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(organizationsBucket).Put([]byte(organizationID), value)
+}
+
+func putFolder(tx *bbolt.Tx, folderID string, folder folder) error {
+	value, err := json.Marshal(folder)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(foldersBucket).Put([]byte(folderID), value)
+}
+
+func putProject(tx *bbolt.Tx, projectID string, project project) error {
+	value, err := json.Marshal(project)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(projectsBucket).Put([]byte(projectID), value)
+}
+
+// putProjectTerms adds the words of the name and the description of the project to the inverted index
+func putProjectTerms(tx *bbolt.Tx, projectID string, project project) error {
+	terms := tx.Bucket(termsBucket)
+	for _, term := range search.ProjectTerms(mapFromInternalProject(project)) {
+		if err := terms.Put(termKey(term, projectID), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteProjectTerms removes the words of the name and the description of the project from the inverted index
+func deleteProjectTerms(tx *bbolt.Tx, projectID string, project project) error {
+	terms := tx.Bucket(termsBucket)
+	for _, term := range search.ProjectTerms(mapFromInternalProject(project)) {
+		if err := terms.Delete(termKey(term, projectID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeEvent writes the event, if any, of the change of the project to the outbox in the transaction
+func writeEvent(tx *bbolt.Tx, event *models.DomainEvent, projectID string) error {
+	if event == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(query.NewOutboxEvent(*event, projectID))
+	if err != nil {
+		return err
+	}
+
+	outbox := tx.Bucket(outboxBucket)
+	sequence, err := outbox.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return outbox.Put(key, value)
+}
+
+// getPendingProjectIDs returns the identifiers of the projects whose events are not published yet
+func getPendingProjectIDs(tx *bbolt.Tx) (map[string]bool, error) {
+	projectIDs := map[string]bool{}
+	if err := tx.Bucket(outboxBucket).ForEach(func(_, value []byte) error {
+		var event models.DomainEvent
+		if err := json.Unmarshal(value, &event); err != nil {
+			return err
+		}
+
+		projectIDs[event.ProjectID] = true
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return projectIDs, nil
+}
+
+func termKey(term, projectID string) []byte {
+	return []byte(term + "\x00" + projectID)
+}
+
+func idempotencyKey(userEmail, key string) []byte {
+	return []byte(userEmail + "\x00" + key)
+}
+
+func nameKey(userEmail, name string) []byte {
+	return []byte(userEmail + "\x00" + repository.NormalizeProjectName(name))
+}
+
+func mapToInternalProject(email string, members map[string]models.MemberRole, from models.Project) project {
+	return project{
+		UserEmail:      email,
+		Members:        members,
+		Name:           from.Name,
+		Description:    from.Description,
+		Labels:         from.Labels,
+		Annotations:    from.Annotations,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+		CreatedBy:      from.CreatedBy,
+		Version:        from.Version,
+		DeletedAt:      from.DeletedAt,
+		State:          from.State,
+		OrganizationID: from.OrganizationID,
+		FolderID:       from.FolderID,
+	}
+}
+
+// createProject creates the project of the request in the transaction
+// Returns the result of creating the project, or the error the request failed with, or the error of the
+// transaction
+func createProject(tx *bbolt.Tx, recorder *changeRecorder, request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error, error) {
+	if request.Project.OrganizationID != "" {
+		if _, ok, err := getOrganization(tx, request.Project.OrganizationID); err != nil {
+			return nil, nil, err
+		} else if !ok {
+			return nil, commonErrors.NewNotFoundError(), nil
+		}
+	}
+
+	names := tx.Bucket(namesBucket)
+	key := nameKey(request.UserEmail, request.Project.Name)
+	if names.Get(key) != nil {
+		return nil, commonErrors.NewAlreadyExistsError(), nil
+	}
+
+	projectID := query.NewProjectID()
+	newProject := mapToInternalProject(
+		request.UserEmail,
+		query.NewMembers(request.UserEmail),
+		query.NewProject(request.UserEmail, request.Project))
+
+	if err := names.Put(key, []byte(projectID)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := putProjectTerms(tx, projectID, newProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err := putProject(tx, projectID, newProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err := recorder.record(tx, nil, projectID, newProject, true); err != nil {
+		return nil, nil, err
+	}
+
+	if err := writeEvent(tx, request.Event, projectID); err != nil {
+		return nil, nil, err
+	}
+
+	return &repository.CreateProjectResponse{
+		ProjectID: projectID,
+		Project:   mapFromInternalProject(newProject),
+		Cursor:    repository.NewProjectCursor(projectID),
+	}, nil, nil
+}
+
+// createProjectOnce creates the project of the request in the transaction, unless the user already created a
+// project with the same idempotency key, in which case the originally created project is returned, or the not
+// found error if the originally created project is deleted
+// Returns the result of creating the project, or the error the request failed with, or the error of the
+// transaction
+func createProjectOnce(tx *bbolt.Tx, recorder *changeRecorder, request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error, error) {
+	record, err := findIdempotencyRecord(tx, request.UserEmail, request.IdempotencyKey.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectID, replay, requestErr := query.ReplayIdempotencyKey(record, *request.IdempotencyKey)
+	if requestErr != nil {
+		return nil, requestErr, nil
+	}
+
+	if replay {
+		project, ok, err := getProjectByID(tx, projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !ok || !project.DeletedAt.IsZero() {
+			return nil, commonErrors.NewNotFoundError(), nil
+		}
+
+		return &repository.CreateProjectResponse{
+			ProjectID: projectID,
+			Project:   mapFromInternalProject(project),
+			Cursor:    repository.NewProjectCursor(projectID),
+		}, nil, nil
+	}
+
+	response, requestErr, err := createProject(tx, recorder, request)
+	if requestErr != nil || err != nil {
+		return nil, requestErr, err
+	}
+
+	value, err := json.Marshal(query.NewIdempotencyRecord(*request.IdempotencyKey, response.ProjectID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Bucket(idempotencyKeysBucket).Put(idempotencyKey(request.UserEmail, request.IdempotencyKey.Key), value); err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// findIdempotencyRecord returns the record of the idempotency key of the user, or nil if the key is not stored.
+// The expired keys of the user are removed.
+func findIdempotencyRecord(tx *bbolt.Tx, userEmail, key string) (*query.IdempotencyRecord, error) {
+	bucket := tx.Bucket(idempotencyKeysBucket)
+	prefix := idempotencyKey(userEmail, "")
+	now := time.Now()
+
+	var expiredKeys [][]byte
+	var found *query.IdempotencyRecord
+
+	cursor := bucket.Cursor()
+	for storedKey, value := cursor.Seek(prefix); storedKey != nil && bytes.HasPrefix(storedKey, prefix); storedKey, value = cursor.Next() {
+		var record query.IdempotencyRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, err
+		}
+
+		if !now.Before(record.ExpiresAt) {
+			expiredKeys = append(expiredKeys, append([]byte{}, storedKey...))
+		} else if string(storedKey[len(prefix):]) == key {
+			found = &record
+		}
+	}
+
+	for _, expiredKey := range expiredKeys {
+		if err := bucket.Delete(expiredKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+// updateProject updates the project of the request in the transaction
+// Returns the result of updating the project, or the error the request failed with, or the error of the
+// transaction
+func updateProject(tx *bbolt.Tx, recorder *changeRecorder, request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error, error) {
+	if err := models.ValidateUpdateMask(request.UpdateMask); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("updateMask", "", err), nil
+	}
+
+	project, ok, err := getProject(tx, request.UserEmail, request.ProjectID, false)
+	if err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, commonErrors.NewNotFoundError(), nil
+	}
+
+	if project.State == models.ProjectStateArchived {
+		return nil, models.NewProjectArchivedError(), nil
+	}
+
+	if !query.MatchesVersion(mapFromInternalProject(project), request.ExpectedVersion) {
+		return nil, models.NewConflictError(), nil
+	}
+
+	updatedProject := updateInternalProject(project, query.UpdateProject(mapFromInternalProject(project), request.Project, request.UpdateMask))
+
+	// The project names are unique among the projects of the owner, regardless of the member who renames the project
+	names := tx.Bucket(namesBucket)
+	key := nameKey(project.UserEmail, updatedProject.Name)
+	if projectID := names.Get(key); projectID != nil && string(projectID) != request.ProjectID {
+		return nil, commonErrors.NewAlreadyExistsError(), nil
+	}
+
+	if err = names.Delete(nameKey(project.UserEmail, project.Name)); err != nil {
+		return nil, nil, err
+	}
+
+	if err = names.Put(key, []byte(request.ProjectID)); err != nil {
+		return nil, nil, err
+	}
+
+	if err = deleteProjectTerms(tx, request.ProjectID, project); err != nil {
+		return nil, nil, err
+	}
+
+	if err = putProjectTerms(tx, request.ProjectID, updatedProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err = putProject(tx, request.ProjectID, updatedProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err = recorder.recordModified(tx, request.ProjectID, updatedProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+		return nil, nil, err
+	}
+
+	return &repository.UpdateProjectResponse{
+		Project: mapFromInternalProject(updatedProject),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil, nil
+}
+
+// deleteProject deletes the project of the request in the transaction
+// Returns the result of deleting the project, or the error the request failed with, or the error of the
+// transaction
+func deleteProject(tx *bbolt.Tx, recorder *changeRecorder, request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error, error) {
+	project, ok, err := getProject(tx, request.UserEmail, request.ProjectID, false)
+	if err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, commonErrors.NewNotFoundError(), nil
+	}
+
+	if !query.MatchesVersion(mapFromInternalProject(project), request.ExpectedVersion) {
+		return nil, models.NewConflictError(), nil
+	}
+
+	if err = tx.Bucket(namesBucket).Delete(nameKey(project.UserEmail, project.Name)); err != nil {
+		return nil, nil, err
+	}
+
+	before, err := audience(tx, request.ProjectID, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deletedProject := updateInternalProject(project, query.DeleteProject(mapFromInternalProject(project)))
+	if err = putProject(tx, request.ProjectID, deletedProject); err != nil {
+		return nil, nil, err
+	}
+
+	if err = recorder.record(tx, before, request.ProjectID, deletedProject, true); err != nil {
+		return nil, nil, err
+	}
+
+	if err = writeEvent(tx, request.Event, request.ProjectID); err != nil {
+		return nil, nil, err
+	}
+
+	return &repository.DeleteProjectResponse{}, nil, nil
+}
+
+// updateInternalProject returns the stored project with the fields of the project replaced, keeping the
+// owner, the members, the pending ownership transfer, the audit trail and the pending invitations of the
+// stored project
+func updateInternalProject(storedProject project, from models.Project) project {
+	updatedProject := mapToInternalProject(storedProject.UserEmail, storedProject.Members, from)
+	updatedProject.OwnershipTransfer = storedProject.OwnershipTransfer
+	updatedProject.AuditEvents = storedProject.AuditEvents
+	updatedProject.Invitations = storedProject.Invitations
+
+	return updatedProject
+}
+
+func mapToQueryProject(projectID string, from project) query.Project {
+	return query.Project{
+		ProjectID: projectID,
+		UserEmail: from.UserEmail,
+		Project:   mapFromInternalProject(from),
+		Members:   from.Members,
+	}
+}
+
+func mapFromInternalProject(from project) models.Project {
+	return models.Project{
+		Name:           from.Name,
+		Description:    from.Description,
+		Labels:         from.Labels,
+		Annotations:    from.Annotations,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+		CreatedBy:      from.CreatedBy,
+		Version:        from.Version,
+		DeletedAt:      from.DeletedAt,
+		State:          from.State,
+		OrganizationID: from.OrganizationID,
+		FolderID:       from.FolderID,
+	}
+}
+
+func mapToInternalOrganization(members map[string]models.MemberRole, from models.Organization) organization {
+	return organization{
+		Members:     members,
+		Name:        from.Name,
+		Description: from.Description,
+		CreatedAt:   from.CreatedAt,
+		UpdatedAt:   from.UpdatedAt,
+		CreatedBy:   from.CreatedBy,
+	}
+}
+
+func mapFromInternalOrganization(from organization) models.Organization {
+	return models.Organization{
+		Name:        from.Name,
+		Description: from.Description,
+		CreatedAt:   from.CreatedAt,
+		UpdatedAt:   from.UpdatedAt,
+		CreatedBy:   from.CreatedBy,
+	}
+}
+
+func mapToInternalFolder(email string, from models.Folder) folder {
+	return folder{
+		UserEmail:      email,
+		Name:           from.Name,
+		ParentFolderID: from.ParentFolderID,
+		CreatedAt:      from.CreatedAt,
+	}
+}
+
+func mapFromInternalFolder(from folder) models.Folder {
+	return models.Folder{
+		Name:           from.Name,
+		ParentFolderID: from.ParentFolderID,
+		CreatedAt:      from.CreatedAt,
+	}
+}
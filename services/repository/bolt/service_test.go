@@ -0,0 +1,166 @@
+package bolt_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/bolt"
+	"github.com/decentralized-cloud/project/services/repository/conformance"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var dataDirectory string
+
+func TestBoltRepositoryService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bolt Repository Service Tests")
+}
+
+var _ = BeforeSuite(func() {
+	var err error
+	dataDirectory, err = ioutil.TempDir("", "project-bolt")
+	Ω(err).Should(BeNil())
+})
+
+var _ = AfterSuite(func() {
+	Ω(os.RemoveAll(dataDirectory)).Should(Succeed())
+})
+
+var _ = Describe("Bolt Repository Service Tests", func() {
+	var (
+		mockCtrl      *gomock.Controller
+		sut           repository.RepositoryContract
+		ctx           context.Context
+		filePath      string
+		createRequest repository.CreateProjectRequest
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		filePath = newDataFilePath()
+		sut = newRepositoryService(mockCtrl, filePath)
+		ctx = context.Background()
+		createRequest = repository.CreateProjectRequest{
+			UserEmail: cuid.New() + "@test.com",
+			Project: models.Project{
+				Name: cuid.New(),
+			}}
+	})
+
+	AfterEach(func() {
+		_ = sut.Close()
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate RepositoryService", func() {
+		When("configuration service is not provided and NewBoltRepositoryService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := bolt.NewBoltRepositoryService(nil)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("all dependencies are resolved and NewBoltRepositoryService is called", func() {
+			It("should instantiate the new RepositoryService", func() {
+				service, err := bolt.NewBoltRepositoryService(newMockConfigurationService(mockCtrl, newDataFilePath()))
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+			})
+		})
+	})
+
+	Context("project already exists", func() {
+		var (
+			projectID string
+		)
+
+		BeforeEach(func() {
+			response, err := sut.CreateProject(ctx, &createRequest)
+			Ω(err).Should(BeNil())
+			projectID = response.ProjectID
+		})
+
+		When("the repository service is closed and opened again", func() {
+			It("should return the project from the data file", func() {
+				Ω(sut.Close()).Should(Succeed())
+
+				sut = newRepositoryService(mockCtrl, filePath)
+				response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
+				Ω(err).Should(BeNil())
+				Ω(response.Project.Name).Should(Equal(createRequest.Project.Name))
+			})
+		})
+
+		When("user backs up the data file", func() {
+			It("should write a data file that contains the project", func() {
+				var backup bytes.Buffer
+
+				written, err := sut.(repository.BackupContract).Backup(ctx, &backup)
+				Ω(err).Should(BeNil())
+				Ω(written).Should(Equal(int64(backup.Len())))
+
+				backupFilePath := newDataFilePath()
+				Ω(ioutil.WriteFile(backupFilePath, backup.Bytes(), 0600)).Should(Succeed())
+
+				restoredService := newRepositoryService(mockCtrl, backupFilePath)
+				defer func() {
+					_ = restoredService.Close()
+				}()
+
+				response, err := restoredService.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
+				Ω(err).Should(BeNil())
+				Ω(response.Project.Name).Should(Equal(createRequest.Project.Name))
+			})
+		})
+	})
+})
+
+var _ = conformance.DescribeRepositoryService("Bolt Repository Service", func() repository.RepositoryContract {
+	return newRepositoryService(gomock.NewController(GinkgoT()), newDataFilePath())
+})
+
+var _ = conformance.DescribeWatchService("Bolt Repository Service", func() repository.RepositoryContract {
+	return newRepositoryService(gomock.NewController(GinkgoT()), newDataFilePath())
+})
+
+var _ = conformance.DescribeInProcessWatchService("Bolt Repository Service", func() repository.RepositoryContract {
+	return newRepositoryService(gomock.NewController(GinkgoT()), newDataFilePath())
+})
+
+func newRepositoryService(mockCtrl *gomock.Controller, filePath string) repository.RepositoryContract {
+	service, err := bolt.NewBoltRepositoryService(newMockConfigurationService(mockCtrl, filePath))
+	Ω(err).Should(BeNil())
+
+	return service
+}
+
+func newMockConfigurationService(mockCtrl *gomock.Controller, filePath string) *configurationMock.MockConfigurationContract {
+	mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectionString().
+		Return(filePath, nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectTimeout().
+		Return(time.Second, nil)
+
+	return mockConfigurationService
+}
+
+func newDataFilePath() string {
+	return filepath.Join(dataDirectory, cuid.New()+".db")
+}
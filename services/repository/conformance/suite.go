@@ -0,0 +1,4032 @@
+// Package conformance implements the specs every repository service must pass, regardless of the
+// storage backend, so different backends cannot drift apart in behaviour
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/lucsky/cuid"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// outboxLimit is the maximum number of events the specs read from the outbox, which is shared by all the specs
+const outboxLimit = 10000
+
+// RepositoryServiceFactory creates a new instance of the repository service under test
+type RepositoryServiceFactory func() repository.RepositoryContract
+
+// DescribeRepositoryService registers the conformance specs for a repository service implementation.
+// Every spec uses a unique user email, so the specs can run against a shared database.
+// name: Mandatory. The name of the repository service under test
+// factory: Mandatory. The factory that creates a new repository service for every spec
+// Returns true so it can be called while declaring package level variables
+func DescribeRepositoryService(name string, factory RepositoryServiceFactory) bool {
+	return Describe(fmt.Sprintf("%s conformance", name), func() {
+		var (
+			sut       repository.RepositoryContract
+			ctx       context.Context
+			userEmail string
+		)
+
+		BeforeEach(func() {
+			sut = factory()
+			ctx = context.Background()
+			userEmail = newUserEmail()
+		})
+
+		AfterEach(func() {
+			Ω(sut.Close()).Should(Succeed())
+		})
+
+		Context("user creates a new project", func() {
+			It("should create the new project", func() {
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+				}
+
+				response, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.ProjectID).ShouldNot(BeEmpty())
+				Ω(response.Cursor).Should(Equal(repository.NewProjectCursor(response.ProjectID)))
+				assertProject(response.Project, request.Project)
+			})
+
+			It("should set the fields managed by the repository service", func() {
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+				}
+
+				request.Project.CreatedAt = time.Now().Add(-time.Hour)
+				request.Project.UpdatedAt = time.Now().Add(-time.Hour)
+				request.Project.CreatedBy = newUserEmail()
+				request.Project.Version = 10
+				request.Project.State = models.ProjectStateArchived
+
+				before := time.Now().Add(-time.Second)
+				response, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Project.CreatedAt).Should(BeTemporally(">=", before))
+				Ω(response.Project.CreatedAt).Should(BeTemporally("<=", time.Now()))
+				Ω(response.Project.UpdatedAt).Should(BeTemporally("==", response.Project.CreatedAt))
+				Ω(response.Project.CreatedBy).Should(Equal(userEmail))
+				Ω(response.Project.Version).Should(Equal(int64(1)))
+				Ω(response.Project.State).Should(Equal(models.ProjectStateActive))
+			})
+
+			It("should assign a unique identifier to every project", func() {
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   models.Project{Name: cuid.New()},
+				}
+
+				firstResponse, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+
+				request.Project.Name = cuid.New()
+				secondResponse, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+
+				Ω(firstResponse.ProjectID).ShouldNot(Equal(secondResponse.ProjectID))
+			})
+		})
+
+		Context("user creates a new project with an idempotency key", func() {
+			var (
+				request  repository.CreateProjectRequest
+				response *repository.CreateProjectResponse
+			)
+
+			BeforeEach(func() {
+				request = repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+					IdempotencyKey: &repository.IdempotencyKey{
+						Key:         cuid.New(),
+						PayloadHash: cuid.New(),
+						ExpiresAt:   time.Now().Add(time.Hour),
+					},
+				}
+
+				var err error
+				response, err = sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+			})
+
+			When("user retries the request with the same idempotency key", func() {
+				It("should return the original project without creating a new one", func() {
+					retryResponse, err := sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(retryResponse.ProjectID).Should(Equal(response.ProjectID))
+					Ω(retryResponse.Cursor).Should(Equal(response.Cursor))
+					assertProject(retryResponse.Project, response.Project)
+					assertManagedFields(retryResponse.Project, response.Project)
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+				})
+			})
+
+			When("user reuses the idempotency key with a different payload", func() {
+				It("should return IdempotencyKeyReusedError without creating a new project", func() {
+					reusedRequest := request
+					reusedRequest.Project = newProject()
+					reusedRequest.IdempotencyKey = &repository.IdempotencyKey{
+						Key:         request.IdempotencyKey.Key,
+						PayloadHash: cuid.New(),
+						ExpiresAt:   time.Now().Add(time.Hour),
+					}
+
+					reusedResponse, err := sut.CreateProject(ctx, &reusedRequest)
+					Ω(reusedResponse).Should(BeNil())
+					Ω(models.IsIdempotencyKeyReusedError(err)).Should(BeTrue())
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+				})
+			})
+
+			When("user retries the request after the project is deleted", func() {
+				It("should return NotFoundError without creating a new project", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: response.ProjectID,
+					})
+					Ω(err).Should(BeNil())
+
+					retryResponse, err := sut.CreateProject(ctx, &request)
+					Ω(retryResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(BeEmpty())
+				})
+			})
+
+			When("user retries the request after the project is purged", func() {
+				It("should return NotFoundError without creating a new project", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: response.ProjectID,
+					})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{
+						DeletedBefore: time.Now().Add(time.Hour),
+					})
+					Ω(err).Should(BeNil())
+
+					retryResponse, err := sut.CreateProject(ctx, &request)
+					Ω(retryResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(BeEmpty())
+				})
+			})
+
+			When("another user uses the same idempotency key", func() {
+				It("should create a new project for the other user", func() {
+					otherRequest := request
+					otherRequest.UserEmail = newUserEmail()
+
+					otherResponse, err := sut.CreateProject(ctx, &otherRequest)
+					Ω(err).Should(BeNil())
+					Ω(otherResponse.ProjectID).ShouldNot(Equal(response.ProjectID))
+				})
+			})
+		})
+
+		Context("user sends the same request with an idempotency key concurrently", func() {
+			It("should create a single project and return it to every request", func() {
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+					IdempotencyKey: &repository.IdempotencyKey{
+						Key:         cuid.New(),
+						PayloadHash: cuid.New(),
+						ExpiresAt:   time.Now().Add(time.Hour),
+					},
+				}
+
+				const count = 5
+				responses := make([]*repository.CreateProjectResponse, count)
+				errs := make([]error, count)
+
+				var wg sync.WaitGroup
+				for idx := 0; idx < count; idx++ {
+					wg.Add(1)
+
+					go func(idx int) {
+						defer wg.Done()
+
+						responses[idx], errs[idx] = sut.CreateProject(ctx, &request)
+					}(idx)
+				}
+
+				wg.Wait()
+
+				for idx := 0; idx < count; idx++ {
+					Ω(errs[idx]).Should(BeNil())
+					Ω(responses[idx].ProjectID).Should(Equal(responses[0].ProjectID))
+				}
+
+				listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+				Ω(err).Should(BeNil())
+				Ω(listResponse.Projects).Should(HaveLen(1))
+			})
+		})
+
+		Context("user creates a new project with an expired idempotency key", func() {
+			It("should create a new project every time", func() {
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   models.Project{Name: cuid.New()},
+					IdempotencyKey: &repository.IdempotencyKey{
+						Key:         cuid.New(),
+						PayloadHash: cuid.New(),
+						ExpiresAt:   time.Now().Add(-time.Second),
+					},
+				}
+
+				firstResponse, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+
+				request.Project.Name = cuid.New()
+				secondResponse, err := sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(secondResponse.ProjectID).ShouldNot(Equal(firstResponse.ProjectID))
+			})
+		})
+
+		Context("user fails to create a new project with an idempotency key", func() {
+			It("should not remember the idempotency key", func() {
+				existingProject := newProject()
+				_, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   existingProject,
+				})
+				Ω(err).Should(BeNil())
+
+				request := repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   existingProject,
+					IdempotencyKey: &repository.IdempotencyKey{
+						Key:         cuid.New(),
+						PayloadHash: cuid.New(),
+						ExpiresAt:   time.Now().Add(time.Hour),
+					},
+				}
+
+				response, err := sut.CreateProject(ctx, &request)
+				Ω(response).Should(BeNil())
+				assertAlreadyExistsError(err)
+
+				request.Project = newProject()
+				request.IdempotencyKey.PayloadHash = cuid.New()
+				response, err = sut.CreateProject(ctx, &request)
+				Ω(err).Should(BeNil())
+				assertProject(response.Project, request.Project)
+			})
+		})
+
+		Context("project already exists", func() {
+			var (
+				projectID string
+				project   models.Project
+			)
+
+			BeforeEach(func() {
+				response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+				})
+				Ω(err).Should(BeNil())
+
+				projectID = response.ProjectID
+				project = response.Project
+			})
+
+			When("user reads the project", func() {
+				It("should return the project", func() {
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(response.Project, project)
+					assertManagedFields(response.Project, project)
+				})
+
+				It("should not change the stored project when the returned project is changed", func() {
+					labels := map[string]string{}
+					for key, value := range project.Labels {
+						labels[key] = value
+					}
+
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response.Project.Labels["environment"] = cuid.New()
+					project.Labels["environment"] = cuid.New()
+					for key := range response.Project.Annotations {
+						delete(response.Project.Annotations, key)
+					}
+
+					response, err = sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.Labels).Should(Equal(labels))
+					Ω(response.Project.Annotations).ShouldNot(BeEmpty())
+				})
+			})
+
+			When("user updates the project", func() {
+				It("should update the project information", func() {
+					updateRequest := repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   newProject(),
+					}
+
+					updateResponse, err := sut.UpdateProject(ctx, &updateRequest)
+					Ω(err).Should(BeNil())
+					Ω(updateResponse.Cursor).Should(Equal(repository.NewProjectCursor(projectID)))
+					assertProject(updateResponse.Project, updateRequest.Project)
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, updateRequest.Project)
+					assertManagedFields(readResponse.Project, updateResponse.Project)
+				})
+
+				It("should keep the creation details and refresh the update time", func() {
+					updateRequest := repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   newProject(),
+					}
+
+					updateRequest.Project.CreatedAt = time.Now().Add(-time.Hour)
+					updateRequest.Project.CreatedBy = newUserEmail()
+					updateRequest.Project.Version = 10
+					updateRequest.Project.State = models.ProjectStateArchived
+
+					updateResponse, err := sut.UpdateProject(ctx, &updateRequest)
+					Ω(err).Should(BeNil())
+					Ω(updateResponse.Project.CreatedAt).Should(BeTemporally("==", project.CreatedAt))
+					Ω(updateResponse.Project.CreatedBy).Should(Equal(userEmail))
+					Ω(updateResponse.Project.UpdatedAt).Should(BeTemporally(">=", project.UpdatedAt))
+					Ω(updateResponse.Project.State).Should(Equal(models.ProjectStateActive))
+				})
+
+				It("should increment the version every time the project is updated", func() {
+					for idx := int64(1); idx <= 3; idx++ {
+						updateResponse, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+							UserEmail: userEmail,
+							ProjectID: projectID,
+							Project:   newProject(),
+						})
+						Ω(err).Should(BeNil())
+						Ω(updateResponse.Project.Version).Should(Equal(project.Version + idx))
+					}
+				})
+
+				It("should remove the labels and the annotations that are not provided anymore", func() {
+					updateRequest := repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   models.Project{Name: cuid.New()},
+					}
+
+					_, err := sut.UpdateProject(ctx, &updateRequest)
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, updateRequest.Project)
+				})
+			})
+
+			When("user updates the project based on the current version", func() {
+				It("should update the project", func() {
+					updateRequest := repository.UpdateProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						Project:         newProject(),
+						ExpectedVersion: project.Version,
+					}
+
+					updateResponse, err := sut.UpdateProject(ctx, &updateRequest)
+					Ω(err).Should(BeNil())
+					assertProject(updateResponse.Project, updateRequest.Project)
+					Ω(updateResponse.Project.Version).Should(Equal(project.Version + 1))
+				})
+			})
+
+			When("user updates the project based on a stale version", func() {
+				It("should return ConflictError and leave the project untouched", func() {
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						Project:         newProject(),
+						ExpectedVersion: project.Version,
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						Project:         newProject(),
+						ExpectedVersion: project.Version,
+					})
+					Ω(response).Should(BeNil())
+					assertConflictError(err)
+
+					latestResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(latestResponse.Project, readResponse.Project)
+					assertManagedFields(latestResponse.Project, readResponse.Project)
+				})
+			})
+
+			When("user updates the project with an update mask", func() {
+				It("should only update the fields the update mask lists", func() {
+					updateRequest := repository.UpdateProjectRequest{
+						UserEmail:  userEmail,
+						ProjectID:  projectID,
+						Project:    models.Project{Description: cuid.New()},
+						UpdateMask: []string{models.ProjectDescriptionPath},
+					}
+
+					updateResponse, err := sut.UpdateProject(ctx, &updateRequest)
+					Ω(err).Should(BeNil())
+					Ω(updateResponse.Project.Version).Should(Equal(project.Version + 1))
+
+					expectedProject := project
+					expectedProject.Description = updateRequest.Project.Description
+					assertProject(updateResponse.Project, expectedProject)
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, expectedProject)
+				})
+
+				It("should replace the labels and keep the name and the annotations", func() {
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:  userEmail,
+						ProjectID:  projectID,
+						Project:    models.Project{Labels: map[string]string{"team": "platform"}},
+						UpdateMask: []string{models.ProjectLabelsPath},
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.Name).Should(Equal(project.Name))
+					assertMap(response.Project.Labels, map[string]string{"team": "platform"})
+					assertMap(response.Project.Annotations, project.Annotations)
+				})
+
+				It("should return ArgumentError and leave the project untouched when the update mask lists an unknown field", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:  userEmail,
+						ProjectID:  projectID,
+						Project:    newProject(),
+						UpdateMask: []string{models.ProjectNamePath, "createdBy"},
+					})
+					Ω(response).Should(BeNil())
+					Ω(err).Should(HaveOccurred())
+					Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, project)
+					assertManagedFields(readResponse.Project, project)
+				})
+			})
+
+			When("user deletes the project based on the current version", func() {
+				It("should delete the project", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						ExpectedVersion: project.Version,
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user deletes the project based on a stale version", func() {
+				It("should return ConflictError and leave the project untouched", func() {
+					response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						ExpectedVersion: project.Version + 1,
+					})
+					Ω(response).Should(BeNil())
+					assertConflictError(err)
+
+					_, err = sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+				})
+			})
+
+			When("user lists the projects", func() {
+				It("should return all the project details", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(HaveLen(1))
+					assertProject(response.Projects[0].Project, project)
+					assertManagedFields(response.Projects[0].Project, project)
+				})
+			})
+
+			When("user deletes the project", func() {
+				It("should delete the project", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+
+				It("should return NotFoundError when the project is deleted again", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user restores the project that is not deleted", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the deleted projects are purged", func() {
+				It("should not purge the project", func() {
+					_, err := sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{DeletedBefore: time.Now().Add(time.Hour)})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+				})
+			})
+
+			Context("another user owns the project", func() {
+				var (
+					anotherUserEmail string
+				)
+
+				BeforeEach(func() {
+					anotherUserEmail = newUserEmail()
+				})
+
+				When("another user reads the project", func() {
+					It("should return NotFoundError", func() {
+						response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: anotherUserEmail, ProjectID: projectID})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+					})
+				})
+
+				When("another user updates the project", func() {
+					It("should return NotFoundError and leave the project untouched", func() {
+						response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+							UserEmail: anotherUserEmail,
+							ProjectID: projectID,
+							Project:   models.Project{Name: cuid.New()},
+						})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+
+						readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+						Ω(err).Should(BeNil())
+						assertProject(readResponse.Project, project)
+					})
+				})
+
+				When("another user updates the project based on a stale version", func() {
+					It("should return NotFoundError rather than revealing the project exists", func() {
+						response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+							UserEmail:       anotherUserEmail,
+							ProjectID:       projectID,
+							Project:         models.Project{Name: cuid.New()},
+							ExpectedVersion: project.Version + 1,
+						})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+					})
+				})
+
+				When("another user deletes the project", func() {
+					It("should return NotFoundError and leave the project untouched", func() {
+						response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: anotherUserEmail, ProjectID: projectID})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+
+						_, err = sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+						Ω(err).Should(BeNil())
+					})
+				})
+
+				When("another user lists the projects", func() {
+					It("should not return the project", func() {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: anotherUserEmail})
+						Ω(err).Should(BeNil())
+						Ω(response.TotalCount).Should(Equal(int64(0)))
+						Ω(response.Projects).Should(BeEmpty())
+					})
+
+					It("should not return the project when the project ID is explicitly requested", func() {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail:  anotherUserEmail,
+							ProjectIDs: []string{projectID},
+						})
+						Ω(err).Should(BeNil())
+						Ω(response.TotalCount).Should(Equal(int64(0)))
+						Ω(response.Projects).Should(BeEmpty())
+					})
+				})
+			})
+		})
+
+		Context("project does not exist", func() {
+			var (
+				projectID string
+			)
+
+			BeforeEach(func() {
+				projectID = cuid.New()
+			})
+
+			When("user reads the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user updates the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   models.Project{Name: cuid.New()},
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user deletes the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user archives the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						State:     models.ProjectStateArchived,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("a member is added to the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+						ProjectID: projectID,
+						Member:    models.Member{UserEmail: newUserEmail(), Role: models.MemberRoleViewer},
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the members of the project are listed", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.ListMembers(ctx, &repository.ListMembersRequest{ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("a user is invited to the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.CreateInvitation(ctx, &repository.CreateInvitationRequest{
+						Invitation: newInvitation(projectID, userEmail, newUserEmail()),
+						TokenHash:  cuid.New(),
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the invitations of the project are listed", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.ListInvitations(ctx, &repository.ListInvitationsRequest{ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("an invitation is read with an unknown token hash", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: cuid.New()})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user restores the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user updates the project based on a version", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						Project:         models.Project{Name: cuid.New()},
+						ExpectedVersion: 1,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user deletes the project based on a version", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						ExpectedVersion: 1,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the ownership of the project is transferred", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newUserEmail(),
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newUserEmail()),
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the audit trail of the project is listed", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("project is deleted", func() {
+			var (
+				projectID    string
+				project      models.Project
+				deletedAfter time.Time
+			)
+
+			BeforeEach(func() {
+				response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   newProject(),
+				})
+				Ω(err).Should(BeNil())
+
+				projectID = response.ProjectID
+				project = response.Project
+
+				deletedAfter = time.Now().Add(-time.Second)
+				_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+				Ω(err).Should(BeNil())
+			})
+
+			When("user lists the projects", func() {
+				It("should not return the deleted project", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(BeEmpty())
+					Ω(response.TotalCount).Should(Equal(int64(0)))
+				})
+			})
+
+			When("user lists the deleted projects", func() {
+				It("should return the deleted project with the time it was deleted", func() {
+					response, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(1)))
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectID))
+					Ω(response.Projects[0].Cursor).ShouldNot(BeEmpty())
+
+					deletedProject := response.Projects[0].Project
+					assertProject(deletedProject, project)
+					Ω(deletedProject.DeletedAt).Should(BeTemporally(">=", deletedAfter))
+					Ω(deletedProject.DeletedAt).Should(BeTemporally("<=", time.Now()))
+					Ω(deletedProject.UpdatedAt).Should(BeTemporally("==", project.UpdatedAt))
+					Ω(deletedProject.Version).Should(Equal(project.Version + 1))
+				})
+			})
+
+			When("another user lists the deleted projects", func() {
+				It("should not return the deleted project", func() {
+					response, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: newUserEmail()})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(BeEmpty())
+				})
+			})
+
+			When("user archives the deleted project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						State:     models.ProjectStateArchived,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user updates the deleted project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   newProject(),
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user restores the project", func() {
+				It("should restore the project", func() {
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.Cursor).ShouldNot(BeEmpty())
+					assertProject(response.Project, project)
+					Ω(response.Project.DeletedAt.IsZero()).Should(BeTrue())
+					Ω(response.Project.Version).Should(Equal(project.Version + 2))
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, project)
+					assertManagedFields(readResponse.Project, response.Project)
+
+					listResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(BeEmpty())
+				})
+
+				It("should return NotFoundError when the project is restored again", func() {
+					_, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user restores the project based on the current version", func() {
+				It("should restore the project", func() {
+					_, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						ExpectedVersion: project.Version + 1,
+					})
+					Ω(err).Should(BeNil())
+				})
+			})
+
+			When("user restores the project based on a stale version", func() {
+				It("should return ConflictError and leave the project deleted", func() {
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						ExpectedVersion: project.Version,
+					})
+					Ω(response).Should(BeNil())
+					assertConflictError(err)
+
+					_, err = sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					assertNotFoundError(err)
+				})
+			})
+
+			When("another user restores the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: newUserEmail(), ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user creates a new project with the same name and restores the deleted project", func() {
+				It("should return AlreadyExistsError and leave the project deleted", func() {
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: project.Name})
+
+					response, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					listResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+					Ω(listResponse.Projects[0].ProjectID).Should(Equal(projectID))
+				})
+			})
+
+			When("the projects deleted before now are purged", func() {
+				It("should permanently remove the project", func() {
+					response, err := sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{DeletedBefore: time.Now().Add(time.Second)})
+					Ω(err).Should(BeNil())
+					Ω(response.PurgedCount).Should(BeNumerically(">=", 1))
+
+					listResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(BeEmpty())
+
+					restoreResponse, err := sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(restoreResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the projects deleted before the project was deleted are purged", func() {
+				It("should keep the project", func() {
+					_, err := sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{DeletedBefore: deletedAfter.Add(-time.Hour)})
+					Ω(err).Should(BeNil())
+
+					listResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+				})
+			})
+		})
+
+		Context("project is archived", func() {
+			var (
+				projectID string
+				project   models.Project
+			)
+
+			BeforeEach(func() {
+				projectID = createProject(ctx, sut, userEmail, newProject())
+
+				response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+					UserEmail: userEmail,
+					ProjectID: projectID,
+					State:     models.ProjectStateArchived,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Cursor).ShouldNot(BeEmpty())
+				Ω(response.Project.State).Should(Equal(models.ProjectStateArchived))
+				Ω(response.Project.Version).Should(Equal(int64(2)))
+
+				project = response.Project
+			})
+
+			When("user reads the project", func() {
+				It("should return the archived project", func() {
+					response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.State).Should(Equal(models.ProjectStateArchived))
+					assertProject(response.Project, project)
+					assertManagedFields(response.Project, project)
+				})
+			})
+
+			When("user updates the archived project", func() {
+				It("should return ProjectArchivedError and leave the project unchanged", func() {
+					for _, expectedVersion := range []int64{0, project.Version, project.Version - 1} {
+						response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+							UserEmail:       userEmail,
+							ProjectID:       projectID,
+							Project:         newProject(),
+							ExpectedVersion: expectedVersion,
+						})
+						Ω(response).Should(BeNil())
+						assertProjectArchivedError(err)
+					}
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, project)
+					assertManagedFields(readResponse.Project, project)
+				})
+			})
+
+			When("user updates the archived project in a batch", func() {
+				It("should return ProjectArchivedError for the archived project and update none of the projects", func() {
+					otherProject := newProject()
+					otherProjectID := createProject(ctx, sut, userEmail, otherProject)
+
+					response, err := sut.BatchUpdateProjects(ctx, &repository.BatchUpdateProjectsRequest{
+						Requests: []repository.UpdateProjectRequest{
+							{UserEmail: userEmail, ProjectID: otherProjectID, Project: newProject()},
+							{UserEmail: userEmail, ProjectID: projectID, Project: newProject()},
+						},
+					})
+					Ω(response).Should(BeNil())
+					Ω(err).Should(HaveOccurred())
+					batchItemError, ok := repository.AsBatchItemError(err)
+					Ω(ok).Should(BeTrue())
+					Ω(batchItemError.Index).Should(Equal(1))
+					assertProjectArchivedError(batchItemError.Err)
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: otherProjectID})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, otherProject)
+					Ω(readResponse.Project.Version).Should(Equal(int64(1)))
+				})
+			})
+
+			When("another user updates the archived project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: newUserEmail(),
+						ProjectID: projectID,
+						Project:   newProject(),
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user lists the projects", func() {
+				It("should not return the archived project", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(BeEmpty())
+					Ω(response.TotalCount).Should(Equal(int64(0)))
+				})
+			})
+
+			When("user lists the projects including the archived projects", func() {
+				It("should return the archived project", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail, IncludeArchived: true})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(1)))
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectID))
+					Ω(response.Projects[0].Project.State).Should(Equal(models.ProjectStateArchived))
+				})
+			})
+
+			When("user unarchives the project", func() {
+				It("should make the project active again", func() {
+					response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						State:           models.ProjectStateActive,
+						ExpectedVersion: project.Version,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.State).Should(Equal(models.ProjectStateActive))
+					Ω(response.Project.Version).Should(Equal(project.Version + 1))
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+					Ω(listResponse.Projects[0].Project.State).Should(Equal(models.ProjectStateActive))
+				})
+			})
+
+			When("user unarchives the project based on a stale version", func() {
+				It("should return ConflictError and leave the project archived", func() {
+					response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						State:           models.ProjectStateActive,
+						ExpectedVersion: project.Version - 1,
+					})
+					Ω(response).Should(BeNil())
+					assertConflictError(err)
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Project.State).Should(Equal(models.ProjectStateArchived))
+				})
+			})
+
+			When("another user unarchives the project", func() {
+				It("should return NotFoundError", func() {
+					response, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail: newUserEmail(),
+						ProjectID: projectID,
+						State:     models.ProjectStateActive,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user deletes the archived project", func() {
+				It("should list the project among the deleted projects", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].Project.State).Should(Equal(models.ProjectStateArchived))
+				})
+			})
+		})
+
+		Context("project has members", func() {
+			var (
+				projectID   string
+				memberEmail string
+			)
+
+			BeforeEach(func() {
+				projectID = createProject(ctx, sut, userEmail, newProject())
+				memberEmail = newUserEmail()
+
+				response, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+					ProjectID: projectID,
+					Member:    models.Member{UserEmail: memberEmail, Role: models.MemberRoleEditor},
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Member.UserEmail).Should(Equal(memberEmail))
+				Ω(response.Member.Role).Should(Equal(models.MemberRoleEditor))
+			})
+
+			When("the members of the project are read", func() {
+				It("should return the user who created the project as the owner", func() {
+					response, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Member.UserEmail).Should(Equal(userEmail))
+					Ω(response.Member.Role).Should(Equal(models.MemberRoleOwner))
+				})
+
+				It("should return the role of the member", func() {
+					response, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Member.Role).Should(Equal(models.MemberRoleEditor))
+				})
+
+				It("should return NotFoundError for the users who are not members", func() {
+					response, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: newUserEmail()})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the members of the project are listed", func() {
+				It("should return all the members ordered by their emails", func() {
+					response, err := sut.ListMembers(ctx, &repository.ListMembersRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					expectedMembers := []models.Member{
+						{UserEmail: userEmail, Role: models.MemberRoleOwner},
+						{UserEmail: memberEmail, Role: models.MemberRoleEditor},
+					}
+					sort.Slice(expectedMembers, func(i, j int) bool {
+						return expectedMembers[i].UserEmail < expectedMembers[j].UserEmail
+					})
+
+					Ω(response.Members).Should(Equal(expectedMembers))
+				})
+			})
+
+			When("the member reads and lists the projects", func() {
+				It("should return the project", func() {
+					_, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: memberEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(1)))
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectID))
+				})
+			})
+
+			When("the member renames the project", func() {
+				It("should keep the name reserved among the projects of the owner", func() {
+					name := cuid.New()
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: memberEmail,
+						ProjectID: projectID,
+						Project:   models.Project{Name: name},
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   models.Project{Name: name},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					_, err = sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: memberEmail,
+						Project:   models.Project{Name: name},
+					})
+					Ω(err).Should(BeNil())
+				})
+			})
+
+			When("the member is added again", func() {
+				It("should return AlreadyExistsError and keep the role of the member", func() {
+					response, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+						ProjectID: projectID,
+						Member:    models.Member{UserEmail: memberEmail, Role: models.MemberRoleViewer},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					readResponse, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Member.Role).Should(Equal(models.MemberRoleEditor))
+				})
+			})
+
+			When("the role of the member is changed", func() {
+				It("should change the role of the member", func() {
+					response, err := sut.UpdateMemberRole(ctx, &repository.UpdateMemberRoleRequest{
+						ProjectID: projectID,
+						UserEmail: memberEmail,
+						Role:      models.MemberRoleViewer,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Member.Role).Should(Equal(models.MemberRoleViewer))
+
+					readResponse, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Member.Role).Should(Equal(models.MemberRoleViewer))
+				})
+
+				It("should return NotFoundError for the users who are not members", func() {
+					response, err := sut.UpdateMemberRole(ctx, &repository.UpdateMemberRoleRequest{
+						ProjectID: projectID,
+						UserEmail: newUserEmail(),
+						Role:      models.MemberRoleViewer,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the member is removed", func() {
+				It("should not give the member access to the project anymore", func() {
+					_, err := sut.RemoveMember(ctx, &repository.RemoveMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: memberEmail, ProjectID: projectID})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListMembers(ctx, &repository.ListMembersRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Members).Should(Equal([]models.Member{{UserEmail: userEmail, Role: models.MemberRoleOwner}}))
+				})
+
+				It("should return NotFoundError when the member is removed again", func() {
+					_, err := sut.RemoveMember(ctx, &repository.RemoveMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.RemoveMember(ctx, &repository.RemoveMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the project is deleted", func() {
+				BeforeEach(func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: memberEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+				})
+
+				It("should list the project among the deleted projects of the member", func() {
+					response, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectID))
+				})
+
+				It("should still return the role of the member", func() {
+					response, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Member.Role).Should(Equal(models.MemberRoleEditor))
+				})
+
+				It("should not manage the members of the deleted project", func() {
+					addResponse, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+						ProjectID: projectID,
+						Member:    models.Member{UserEmail: newUserEmail(), Role: models.MemberRoleViewer},
+					})
+					Ω(addResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListMembers(ctx, &repository.ListMembersRequest{ProjectID: projectID})
+					Ω(listResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+
+				It("should remove the members when the project is purged", func() {
+					_, err := sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{DeletedBefore: time.Now().Add(time.Second)})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: userEmail})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("project ownership is transferred", func() {
+			var (
+				projectID     string
+				project       models.Project
+				newOwnerEmail string
+			)
+
+			BeforeEach(func() {
+				project = newProject()
+				projectID = createProject(ctx, sut, userEmail, project)
+				newOwnerEmail = newUserEmail()
+			})
+
+			When("the project has no pending ownership transfer", func() {
+				It("should return NotFoundError", func() {
+					readResponse, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					deleteResponse, err := sut.DeleteOwnershipTransfer(ctx, &repository.DeleteOwnershipTransferRequest{
+						ProjectID:  projectID,
+						AuditEvent: newAuditEvent(models.AuditActionOwnershipTransferDeclined, newOwnerEmail, newOwnerEmail),
+					})
+					Ω(deleteResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+
+				It("should return an empty audit trail", func() {
+					response, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.AuditEvents).Should(BeEmpty())
+				})
+			})
+
+			When("an ownership transfer is requested", func() {
+				var (
+					transfer models.OwnershipTransfer
+				)
+
+				BeforeEach(func() {
+					transfer = newOwnershipTransfer(userEmail, newOwnerEmail)
+					response, err := sut.RequestOwnershipTransfer(ctx, &repository.RequestOwnershipTransferRequest{
+						ProjectID:  projectID,
+						Transfer:   transfer,
+						AuditEvent: newAuditEvent(models.AuditActionOwnershipTransferRequested, userEmail, newOwnerEmail),
+					})
+					Ω(err).Should(BeNil())
+					assertOwnershipTransfer(response.Transfer, transfer)
+				})
+
+				It("should return the pending ownership transfer", func() {
+					response, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertOwnershipTransfer(response.Transfer, transfer)
+				})
+
+				It("should replace the pending ownership transfer when another transfer is requested", func() {
+					anotherTransfer := newOwnershipTransfer(userEmail, newUserEmail())
+					_, err := sut.RequestOwnershipTransfer(ctx, &repository.RequestOwnershipTransferRequest{
+						ProjectID:  projectID,
+						Transfer:   anotherTransfer,
+						AuditEvent: newAuditEvent(models.AuditActionOwnershipTransferRequested, userEmail, anotherTransfer.ToUserEmail),
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertOwnershipTransfer(response.Transfer, anotherTransfer)
+				})
+
+				It("should keep the pending ownership transfer and the audit trail when the project is updated", func() {
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   newProject(),
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					assertOwnershipTransfer(readResponse.Transfer, transfer)
+
+					listResponse, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.AuditEvents).Should(HaveLen(1))
+				})
+
+				It("should remove the pending ownership transfer when it is declined", func() {
+					_, err := sut.DeleteOwnershipTransfer(ctx, &repository.DeleteOwnershipTransferRequest{
+						ProjectID:  projectID,
+						AuditEvent: newAuditEvent(models.AuditActionOwnershipTransferDeclined, newOwnerEmail, newOwnerEmail),
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.AuditEvents).Should(HaveLen(2))
+					Ω(listResponse.AuditEvents[0].Action).Should(Equal(models.AuditActionOwnershipTransferRequested))
+					Ω(listResponse.AuditEvents[1].Action).Should(Equal(models.AuditActionOwnershipTransferDeclined))
+				})
+
+				It("should remove the pending ownership transfer when the ownership is transferred", func() {
+					_, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newOwnerEmail,
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferAccepted, newOwnerEmail, userEmail),
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ReadOwnershipTransfer(ctx, &repository.ReadOwnershipTransferRequest{ProjectID: projectID})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the ownership is transferred", func() {
+				var (
+					auditEvent models.AuditEvent
+					response   *repository.TransferProjectOwnershipResponse
+				)
+
+				BeforeEach(func() {
+					var err error
+					auditEvent = newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newOwnerEmail)
+					response, err = sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newOwnerEmail,
+						AuditEvent:    auditEvent,
+					})
+					Ω(err).Should(BeNil())
+				})
+
+				It("should return the project with a new version", func() {
+					Ω(response.Cursor).Should(Equal(repository.NewProjectCursor(projectID)))
+					assertProject(response.Project, project)
+					Ω(response.Project.CreatedBy).Should(Equal(userEmail))
+					Ω(response.Project.Version).Should(Equal(int64(2)))
+				})
+
+				It("should make the new owner the owner and the previous owner an editor", func() {
+					listResponse, err := sut.ListMembers(ctx, &repository.ListMembersRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					expectedMembers := []models.Member{
+						{UserEmail: userEmail, Role: models.MemberRoleEditor},
+						{UserEmail: newOwnerEmail, Role: models.MemberRoleOwner},
+					}
+					sort.Slice(expectedMembers, func(i, j int) bool {
+						return expectedMembers[i].UserEmail < expectedMembers[j].UserEmail
+					})
+
+					Ω(listResponse.Members).Should(Equal(expectedMembers))
+				})
+
+				It("should record the audit event", func() {
+					listResponse, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.AuditEvents).Should(HaveLen(1))
+					assertAuditEvent(listResponse.AuditEvents[0], auditEvent)
+				})
+
+				It("should move the project name to the projects of the new owner", func() {
+					createResponse, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: newOwnerEmail,
+						Project:   models.Project{Name: project.Name},
+					})
+					Ω(createResponse).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					_, err = sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   models.Project{Name: project.Name},
+					})
+					Ω(err).Should(BeNil())
+				})
+
+				It("should return NotFoundError when the previous owner transfers the ownership again", func() {
+					transferResponse, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newUserEmail(),
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newOwnerEmail),
+					})
+					Ω(transferResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the new owner already has a project with the same name", func() {
+				It("should return AlreadyExistsError and leave the project untouched", func() {
+					createProject(ctx, sut, newOwnerEmail, models.Project{Name: project.Name})
+
+					response, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newOwnerEmail,
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newOwnerEmail),
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					readResponse, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Member.Role).Should(Equal(models.MemberRoleOwner))
+
+					listResponse, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.AuditEvents).Should(BeEmpty())
+				})
+			})
+
+			When("the project is deleted", func() {
+				It("should return NotFoundError", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					requestResponse, err := sut.RequestOwnershipTransfer(ctx, &repository.RequestOwnershipTransferRequest{
+						ProjectID:  projectID,
+						Transfer:   newOwnershipTransfer(userEmail, newOwnerEmail),
+						AuditEvent: newAuditEvent(models.AuditActionOwnershipTransferRequested, userEmail, newOwnerEmail),
+					})
+					Ω(requestResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					transferResponse, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newOwnerEmail,
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newOwnerEmail),
+					})
+					Ω(transferResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListAuditEvents(ctx, &repository.ListAuditEventsRequest{ProjectID: projectID})
+					Ω(listResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("project has a pending invitation", func() {
+			var (
+				projectID    string
+				inviteeEmail string
+				invitation   models.Invitation
+				tokenHash    string
+			)
+
+			BeforeEach(func() {
+				projectID = createProject(ctx, sut, userEmail, newProject())
+				inviteeEmail = newUserEmail()
+				invitation = newInvitation(projectID, userEmail, inviteeEmail)
+				tokenHash = cuid.New()
+
+				response, err := sut.CreateInvitation(ctx, &repository.CreateInvitationRequest{
+					Invitation: invitation,
+					TokenHash:  tokenHash,
+				})
+				Ω(err).Should(BeNil())
+				assertInvitation(response.Invitation, invitation)
+			})
+
+			When("the invitation is read by the hash of its token", func() {
+				It("should return the invitation", func() {
+					response, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(err).Should(BeNil())
+					assertInvitation(response.Invitation, invitation)
+				})
+			})
+
+			When("the invitations of the project are listed", func() {
+				It("should return the invitations ordered by their emails", func() {
+					anotherInvitation := newInvitation(projectID, userEmail, newUserEmail())
+					_, err := sut.CreateInvitation(ctx, &repository.CreateInvitationRequest{
+						Invitation: anotherInvitation,
+						TokenHash:  cuid.New(),
+					})
+					Ω(err).Should(BeNil())
+
+					expectedInvitations := []models.Invitation{invitation, anotherInvitation}
+					sort.Slice(expectedInvitations, func(i, j int) bool {
+						return expectedInvitations[i].Email < expectedInvitations[j].Email
+					})
+
+					response, err := sut.ListInvitations(ctx, &repository.ListInvitationsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(response.Invitations).Should(HaveLen(2))
+					assertInvitation(response.Invitations[0], expectedInvitations[0])
+					assertInvitation(response.Invitations[1], expectedInvitations[1])
+				})
+			})
+
+			When("the same user is invited again", func() {
+				It("should replace the pending invitation", func() {
+					newTokenHash := cuid.New()
+					newInvitation := newInvitation(projectID, userEmail, inviteeEmail)
+					newInvitation.Role = models.MemberRoleViewer
+					_, err := sut.CreateInvitation(ctx, &repository.CreateInvitationRequest{
+						Invitation: newInvitation,
+						TokenHash:  newTokenHash,
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					readResponse, err = sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: newTokenHash})
+					Ω(err).Should(BeNil())
+					assertInvitation(readResponse.Invitation, newInvitation)
+
+					listResponse, err := sut.ListInvitations(ctx, &repository.ListInvitationsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Invitations).Should(HaveLen(1))
+					assertInvitation(listResponse.Invitations[0], newInvitation)
+				})
+			})
+
+			When("the invitation is accepted", func() {
+				It("should add the invited user to the members and remove the invitation", func() {
+					response, err := sut.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+						ProjectID: projectID,
+						Email:     inviteeEmail,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Member).Should(Equal(models.Member{UserEmail: inviteeEmail, Role: invitation.Role}))
+
+					memberResponse, err := sut.ReadMember(ctx, &repository.ReadMemberRequest{ProjectID: projectID, UserEmail: inviteeEmail})
+					Ω(err).Should(BeNil())
+					Ω(memberResponse.Member.Role).Should(Equal(invitation.Role))
+
+					readResponse, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListInvitations(ctx, &repository.ListInvitationsRequest{ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Invitations).Should(BeEmpty())
+
+					acceptResponse, err := sut.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+						ProjectID: projectID,
+						Email:     inviteeEmail,
+					})
+					Ω(acceptResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the invited user is already a member of the project", func() {
+				It("should return AlreadyExistsError and keep the invitation", func() {
+					_, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+						ProjectID: projectID,
+						Member:    models.Member{UserEmail: inviteeEmail, Role: models.MemberRoleViewer},
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+						ProjectID: projectID,
+						Email:     inviteeEmail,
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					readResponse, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(err).Should(BeNil())
+					assertInvitation(readResponse.Invitation, invitation)
+				})
+			})
+
+			When("the invitation is deleted", func() {
+				It("should remove the invitation", func() {
+					_, err := sut.DeleteInvitation(ctx, &repository.DeleteInvitationRequest{ProjectID: projectID, Email: inviteeEmail})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					deleteResponse, err := sut.DeleteInvitation(ctx, &repository.DeleteInvitationRequest{ProjectID: projectID, Email: inviteeEmail})
+					Ω(deleteResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					acceptResponse, err := sut.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+						ProjectID: projectID,
+						Email:     inviteeEmail,
+					})
+					Ω(acceptResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the project is deleted", func() {
+				It("should return NotFoundError", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadInvitation(ctx, &repository.ReadInvitationRequest{TokenHash: tokenHash})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListInvitations(ctx, &repository.ListInvitationsRequest{ProjectID: projectID})
+					Ω(listResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					acceptResponse, err := sut.AcceptInvitation(ctx, &repository.AcceptInvitationRequest{
+						ProjectID: projectID,
+						Email:     inviteeEmail,
+					})
+					Ω(acceptResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("user creates a new organization", func() {
+			It("should create the organization and make the user its owner", func() {
+				organization := newOrganization()
+				response, err := sut.CreateOrganization(ctx, &repository.CreateOrganizationRequest{
+					UserEmail:    userEmail,
+					Organization: organization,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.OrganizationID).ShouldNot(BeEmpty())
+				assertOrganization(response.Organization, organization)
+				Ω(response.Organization.CreatedBy).Should(Equal(userEmail))
+				Ω(response.Organization.CreatedAt).ShouldNot(BeZero())
+				Ω(response.Organization.UpdatedAt).Should(BeTemporally("==", response.Organization.CreatedAt))
+
+				readResponse, err := sut.ReadOrganization(ctx, &repository.ReadOrganizationRequest{
+					UserEmail:      userEmail,
+					OrganizationID: response.OrganizationID,
+				})
+				Ω(err).Should(BeNil())
+				assertOrganization(readResponse.Organization, organization)
+				Ω(readResponse.Organization.CreatedAt).Should(BeTemporally("==", response.Organization.CreatedAt))
+
+				memberResponse, err := sut.ReadOrganizationMember(ctx, &repository.ReadOrganizationMemberRequest{
+					OrganizationID: response.OrganizationID,
+					UserEmail:      userEmail,
+				})
+				Ω(err).Should(BeNil())
+				Ω(memberResponse.Member.Role).Should(Equal(models.MemberRoleOwner))
+			})
+		})
+
+		Context("organization does not exist", func() {
+			var organizationID string
+
+			BeforeEach(func() {
+				organizationID = createOrganization(ctx, sut, newUserEmail())
+				_, err := sut.CreateOrganization(ctx, &repository.CreateOrganizationRequest{
+					UserEmail:    userEmail,
+					Organization: newOrganization(),
+				})
+				Ω(err).Should(BeNil())
+			})
+
+			When("user reads, updates or deletes the organization of another user", func() {
+				It("should return NotFoundError", func() {
+					readResponse, err := sut.ReadOrganization(ctx, &repository.ReadOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					updateResponse, err := sut.UpdateOrganization(ctx, &repository.UpdateOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+						Organization:   newOrganization(),
+					})
+					Ω(updateResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					deleteResponse, err := sut.DeleteOrganization(ctx, &repository.DeleteOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(deleteResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+
+				It("should not list the organization", func() {
+					response, err := sut.ListOrganizations(ctx, &repository.ListOrganizationsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Organizations).Should(HaveLen(1))
+					Ω(response.Organizations[0].OrganizationID).ShouldNot(Equal(organizationID))
+				})
+			})
+
+			When("user manages the members of an organization that does not exist", func() {
+				It("should return NotFoundError", func() {
+					unknownOrganizationID := cuid.New()
+
+					addResponse, err := sut.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+						OrganizationID: unknownOrganizationID,
+						Member:         models.Member{UserEmail: userEmail, Role: models.MemberRoleViewer},
+					})
+					Ω(addResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					readResponse, err := sut.ReadOrganizationMember(ctx, &repository.ReadOrganizationMemberRequest{
+						OrganizationID: unknownOrganizationID,
+						UserEmail:      userEmail,
+					})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					removeResponse, err := sut.RemoveOrganizationMember(ctx, &repository.RemoveOrganizationMemberRequest{
+						OrganizationID: unknownOrganizationID,
+						UserEmail:      userEmail,
+					})
+					Ω(removeResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListOrganizationMembers(ctx, &repository.ListOrganizationMembersRequest{
+						OrganizationID: unknownOrganizationID,
+					})
+					Ω(listResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user creates a project in an organization that does not exist", func() {
+				It("should return NotFoundError", func() {
+					project := newProject()
+					project.OrganizationID = cuid.New()
+
+					response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   project,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("project belongs to an organization", func() {
+			var (
+				organizationID string
+				projectID      string
+				memberEmail    string
+			)
+
+			BeforeEach(func() {
+				organizationID = createOrganization(ctx, sut, userEmail)
+				memberEmail = newUserEmail()
+
+				project := newProject()
+				project.OrganizationID = organizationID
+				response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   project,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Project.OrganizationID).Should(Equal(organizationID))
+				projectID = response.ProjectID
+
+				addResponse, err := sut.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+					OrganizationID: organizationID,
+					Member:         models.Member{UserEmail: memberEmail, Role: models.MemberRoleEditor},
+				})
+				Ω(err).Should(BeNil())
+				Ω(addResponse.Member.UserEmail).Should(Equal(memberEmail))
+				Ω(addResponse.Member.Role).Should(Equal(models.MemberRoleEditor))
+			})
+
+			When("the member of the organization reads and lists the projects", func() {
+				It("should return the project", func() {
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: memberEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Project.OrganizationID).Should(Equal(organizationID))
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.TotalCount).Should(Equal(int64(1)))
+					Ω(listResponse.Projects).Should(HaveLen(1))
+					Ω(listResponse.Projects[0].ProjectID).Should(Equal(projectID))
+				})
+			})
+
+			When("the member of the organization updates the project", func() {
+				It("should update the project and keep its organization", func() {
+					project := newProject()
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: memberEmail,
+						ProjectID: projectID,
+						Project:   project,
+					})
+					Ω(err).Should(BeNil())
+					assertProject(response.Project, project)
+					Ω(response.Project.OrganizationID).Should(Equal(organizationID))
+				})
+			})
+
+			When("the projects are listed by organization", func() {
+				It("should only return the projects of the organization", func() {
+					createProject(ctx, sut, userEmail, newProject())
+
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(2)))
+
+					response, err = sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(1)))
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectID))
+				})
+			})
+
+			When("the effective roles are read", func() {
+				It("should return the highest of the project role and the organization role", func() {
+					response, err := sut.ReadEffectiveRole(ctx, &repository.ReadEffectiveRoleRequest{ProjectID: projectID, UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Role).Should(Equal(models.MemberRoleOwner))
+
+					response, err = sut.ReadEffectiveRole(ctx, &repository.ReadEffectiveRoleRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Role).Should(Equal(models.MemberRoleEditor))
+
+					_, err = sut.AddMember(ctx, &repository.AddMemberRequest{
+						ProjectID: projectID,
+						Member:    models.Member{UserEmail: memberEmail, Role: models.MemberRoleViewer},
+					})
+					Ω(err).Should(BeNil())
+
+					response, err = sut.ReadEffectiveRole(ctx, &repository.ReadEffectiveRoleRequest{ProjectID: projectID, UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Role).Should(Equal(models.MemberRoleEditor))
+				})
+
+				It("should return NotFoundError for the users who are not members", func() {
+					response, err := sut.ReadEffectiveRole(ctx, &repository.ReadEffectiveRoleRequest{ProjectID: projectID, UserEmail: newUserEmail()})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the member is removed from the organization", func() {
+				It("should not give the member access to the project anymore", func() {
+					_, err := sut.RemoveOrganizationMember(ctx, &repository.RemoveOrganizationMemberRequest{
+						OrganizationID: organizationID,
+						UserEmail:      memberEmail,
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: memberEmail, ProjectID: projectID})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListOrganizations(ctx, &repository.ListOrganizationsRequest{UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Organizations).Should(BeEmpty())
+				})
+
+				It("should return NotFoundError when the member is removed again", func() {
+					_, err := sut.RemoveOrganizationMember(ctx, &repository.RemoveOrganizationMemberRequest{
+						OrganizationID: organizationID,
+						UserEmail:      memberEmail,
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.RemoveOrganizationMember(ctx, &repository.RemoveOrganizationMemberRequest{
+						OrganizationID: organizationID,
+						UserEmail:      memberEmail,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("the member is added to the organization again", func() {
+				It("should return AlreadyExistsError and keep the role of the member", func() {
+					response, err := sut.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+						OrganizationID: organizationID,
+						Member:         models.Member{UserEmail: memberEmail, Role: models.MemberRoleViewer},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					readResponse, err := sut.ReadOrganizationMember(ctx, &repository.ReadOrganizationMemberRequest{
+						OrganizationID: organizationID,
+						UserEmail:      memberEmail,
+					})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Member.Role).Should(Equal(models.MemberRoleEditor))
+				})
+			})
+
+			When("the organizations and their members are listed", func() {
+				It("should return the organization to its members", func() {
+					response, err := sut.ListOrganizations(ctx, &repository.ListOrganizationsRequest{UserEmail: memberEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Organizations).Should(HaveLen(1))
+					Ω(response.Organizations[0].OrganizationID).Should(Equal(organizationID))
+				})
+
+				It("should return the organizations ordered by their names", func() {
+					otherOrganization := newOrganization()
+					otherOrganization.Name = "0" + otherOrganization.Name
+					createResponse, err := sut.CreateOrganization(ctx, &repository.CreateOrganizationRequest{
+						UserEmail:    userEmail,
+						Organization: otherOrganization,
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ListOrganizations(ctx, &repository.ListOrganizationsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Organizations).Should(HaveLen(2))
+					Ω(response.Organizations[0].OrganizationID).Should(Equal(createResponse.OrganizationID))
+					Ω(response.Organizations[1].OrganizationID).Should(Equal(organizationID))
+				})
+
+				It("should return all the members ordered by their emails", func() {
+					response, err := sut.ListOrganizationMembers(ctx, &repository.ListOrganizationMembersRequest{
+						OrganizationID: organizationID,
+					})
+					Ω(err).Should(BeNil())
+
+					expectedMembers := []models.Member{
+						{UserEmail: userEmail, Role: models.MemberRoleOwner},
+						{UserEmail: memberEmail, Role: models.MemberRoleEditor},
+					}
+					sort.Slice(expectedMembers, func(i, j int) bool {
+						return expectedMembers[i].UserEmail < expectedMembers[j].UserEmail
+					})
+
+					Ω(response.Members).Should(Equal(expectedMembers))
+				})
+			})
+
+			When("the organization is updated", func() {
+				It("should update the organization and keep the creation details", func() {
+					readResponse, err := sut.ReadOrganization(ctx, &repository.ReadOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(err).Should(BeNil())
+
+					organization := newOrganization()
+					response, err := sut.UpdateOrganization(ctx, &repository.UpdateOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+						Organization:   organization,
+					})
+					Ω(err).Should(BeNil())
+					assertOrganization(response.Organization, organization)
+					Ω(response.Organization.CreatedBy).Should(Equal(userEmail))
+					Ω(response.Organization.CreatedAt).Should(BeTemporally("==", readResponse.Organization.CreatedAt))
+					Ω(response.Organization.UpdatedAt).Should(BeTemporally(">=", readResponse.Organization.UpdatedAt))
+
+					projectResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+					Ω(projectResponse.Project.OrganizationID).Should(Equal(organizationID))
+				})
+			})
+
+			When("the organization is deleted", func() {
+				It("should return OrganizationNotEmptyError while the organization has projects", func() {
+					response, err := sut.DeleteOrganization(ctx, &repository.DeleteOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(response).Should(BeNil())
+					assertOrganizationNotEmptyError(err)
+
+					_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					response, err = sut.DeleteOrganization(ctx, &repository.DeleteOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(response).Should(BeNil())
+					assertOrganizationNotEmptyError(err)
+				})
+
+				It("should delete the organization once its projects are purged", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.PurgeDeletedProjects(ctx, &repository.PurgeDeletedProjectsRequest{DeletedBefore: time.Now().Add(time.Second)})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.DeleteOrganization(ctx, &repository.DeleteOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(err).Should(BeNil())
+
+					readResponse, err := sut.ReadOrganization(ctx, &repository.ReadOrganizationRequest{
+						UserEmail:      userEmail,
+						OrganizationID: organizationID,
+					})
+					Ω(readResponse).Should(BeNil())
+					assertNotFoundError(err)
+
+					listResponse, err := sut.ListOrganizationMembers(ctx, &repository.ListOrganizationMembersRequest{
+						OrganizationID: organizationID,
+					})
+					Ω(listResponse).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+		})
+
+		Context("user organizes the projects in folders", func() {
+			var (
+				folderID    string
+				subfolderID string
+				projectIDs  []string
+			)
+
+			BeforeEach(func() {
+				folderID = createFolder(ctx, sut, userEmail, "")
+				subfolderID = createFolder(ctx, sut, userEmail, folderID)
+				projectIDs = []string{
+					createProject(ctx, sut, userEmail, newProject()),
+					createProject(ctx, sut, userEmail, newProject()),
+					createProject(ctx, sut, userEmail, newProject()),
+				}
+
+				moveProject(ctx, sut, userEmail, projectIDs[0], folderID)
+				moveProject(ctx, sut, userEmail, projectIDs[1], subfolderID)
+			})
+
+			When("user creates folders", func() {
+				It("should list the folders of the user sorted by name", func() {
+					otherFolderID := createFolder(ctx, sut, newUserEmail(), "")
+
+					response, err := sut.ListFolders(ctx, &repository.ListFoldersRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Folders).Should(HaveLen(2))
+					Ω(response.Folders[0].Folder.Name <= response.Folders[1].Folder.Name).Should(BeTrue())
+
+					folders := map[string]models.Folder{}
+					for _, folder := range response.Folders {
+						folders[folder.FolderID] = folder.Folder
+						Ω(folder.Folder.CreatedAt).ShouldNot(BeZero())
+					}
+
+					Ω(folders).Should(HaveKey(folderID))
+					Ω(folders).Should(HaveKey(subfolderID))
+					Ω(folders).ShouldNot(HaveKey(otherFolderID))
+					Ω(folders[folderID].ParentFolderID).Should(BeEmpty())
+					Ω(folders[subfolderID].ParentFolderID).Should(Equal(folderID))
+				})
+
+				It("should return NotFoundError if the parent folder does not belong to the user", func() {
+					for _, parentFolderID := range []string{createFolder(ctx, sut, newUserEmail(), ""), cuid.New()} {
+						response, err := sut.CreateFolder(ctx, &repository.CreateFolderRequest{
+							UserEmail: userEmail,
+							Folder:    models.Folder{Name: cuid.New(), ParentFolderID: parentFolderID},
+						})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+					}
+				})
+			})
+
+			When("user moves a project", func() {
+				It("should move the project into and out of the folder", func() {
+					response, err := sut.MoveProject(ctx, &repository.MoveProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[2],
+						FolderID:  subfolderID,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.FolderID).Should(Equal(subfolderID))
+					Ω(response.Cursor).ShouldNot(BeEmpty())
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[2],
+					})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Project.FolderID).Should(Equal(subfolderID))
+					Ω(readResponse.Project.Version).Should(Equal(int64(2)))
+
+					response, err = sut.MoveProject(ctx, &repository.MoveProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[2],
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.FolderID).Should(BeEmpty())
+					Ω(response.Project.Version).Should(Equal(int64(3)))
+				})
+
+				It("should keep the folder when the project is updated", func() {
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[0],
+					})
+					Ω(err).Should(BeNil())
+
+					updateResponse, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[0],
+						Project:   readResponse.Project,
+					})
+					Ω(err).Should(BeNil())
+					Ω(updateResponse.Project.FolderID).Should(Equal(folderID))
+				})
+
+				It("should return NotFoundError if the folder does not belong to the user", func() {
+					for _, otherFolderID := range []string{createFolder(ctx, sut, newUserEmail(), ""), cuid.New()} {
+						response, err := sut.MoveProject(ctx, &repository.MoveProjectRequest{
+							UserEmail: userEmail,
+							ProjectID: projectIDs[2],
+							FolderID:  otherFolderID,
+						})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+					}
+				})
+
+				It("should return NotFoundError if the project does not exist", func() {
+					response, err := sut.MoveProject(ctx, &repository.MoveProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: cuid.New(),
+						FolderID:  folderID,
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(err)
+				})
+			})
+
+			When("user lists the projects in a folder", func() {
+				It("should only return the projects directly in the folder", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail: userEmail,
+						FolderID:  folderID,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(1)))
+					Ω(response.Projects).Should(HaveLen(1))
+					Ω(response.Projects[0].ProjectID).Should(Equal(projectIDs[0]))
+				})
+
+				It("should also return the projects in the subfolders if requested", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:         userEmail,
+						FolderID:          folderID,
+						IncludeSubfolders: true,
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(2)))
+
+					listedProjectIDs := []string{}
+					for _, project := range response.Projects {
+						listedProjectIDs = append(listedProjectIDs, project.ProjectID)
+					}
+
+					Ω(listedProjectIDs).Should(ConsistOf(projectIDs[0], projectIDs[1]))
+				})
+
+				It("should not return any project if the folder does not belong to the user", func() {
+					for _, otherFolderID := range []string{createFolder(ctx, sut, newUserEmail(), ""), cuid.New()} {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail: userEmail,
+							FolderID:  otherFolderID,
+						})
+						Ω(err).Should(BeNil())
+						Ω(response.TotalCount).Should(BeZero())
+						Ω(response.Projects).Should(BeEmpty())
+					}
+				})
+			})
+
+			When("user deletes a folder", func() {
+				It("should return FolderNotEmptyError if a project is in the folder or its subfolders", func() {
+					for _, id := range []string{folderID, subfolderID} {
+						response, err := sut.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+							UserEmail: userEmail,
+							FolderID:  id,
+						})
+						Ω(response).Should(BeNil())
+						assertFolderNotEmptyError(err)
+					}
+				})
+
+				It("should delete the folder and its subfolders once the projects are moved out", func() {
+					moveProject(ctx, sut, userEmail, projectIDs[0], "")
+					moveProject(ctx, sut, userEmail, projectIDs[1], "")
+
+					_, err := sut.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+						UserEmail: userEmail,
+						FolderID:  folderID,
+					})
+					Ω(err).Should(BeNil())
+
+					response, err := sut.ListFolders(ctx, &repository.ListFoldersRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(response.Folders).Should(BeEmpty())
+				})
+
+				It("should delete the projects in the folder and its subfolders if cascade is requested", func() {
+					_, err := sut.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+						UserEmail: userEmail,
+						FolderID:  folderID,
+						Cascade:   true,
+					})
+					Ω(err).Should(BeNil())
+
+					for _, projectID := range projectIDs[:2] {
+						readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{
+							UserEmail: userEmail,
+							ProjectID: projectID,
+						})
+						Ω(readResponse).Should(BeNil())
+						assertNotFoundError(err)
+					}
+
+					deletedResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(deletedResponse.Projects).Should(HaveLen(2))
+
+					for _, project := range deletedResponse.Projects {
+						Ω(project.Project.FolderID).Should(BeEmpty())
+					}
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[2],
+					})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Project.DeletedAt).Should(BeZero())
+
+					foldersResponse, err := sut.ListFolders(ctx, &repository.ListFoldersRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(foldersResponse.Folders).Should(BeEmpty())
+				})
+
+				It("should return NotFoundError if the folder does not belong to the user", func() {
+					for _, otherFolderID := range []string{createFolder(ctx, sut, newUserEmail(), ""), cuid.New()} {
+						response, err := sut.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+							UserEmail: userEmail,
+							FolderID:  otherFolderID,
+						})
+						Ω(response).Should(BeNil())
+						assertNotFoundError(err)
+					}
+				})
+			})
+		})
+
+		Context("user filters the projects", func() {
+			var (
+				projectIDs []string
+				createdAt  time.Time
+			)
+
+			BeforeEach(func() {
+				// Some of the backends store the time with millisecond precision
+				createdAt = time.Now().Truncate(time.Millisecond)
+				projectIDs = []string{
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:   "alpha-web",
+						Labels: map[string]string{"tier": "frontend", "team": "red", "app.name": "web"},
+					}),
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:   "alpha-api",
+						Labels: map[string]string{"tier": "backend", "team": "blue"},
+					}),
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:   "beta-api",
+						Labels: map[string]string{"tier": "backend"},
+					}),
+					createProject(ctx, sut, userEmail, models.Project{Name: "gamma.web"}),
+				}
+
+				_, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+					UserEmail: userEmail,
+					ProjectID: projectIDs[3],
+					State:     models.ProjectStateArchived,
+				})
+				Ω(err).Should(BeNil())
+
+				// Projects that belong to another user must never leak into the result
+				_ = createProject(ctx, sut, newUserEmail(), models.Project{Name: "alpha-other"})
+			})
+
+			assertFilter := func(filter models.ProjectFilter, expectedIndexes []int) {
+				response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+					UserEmail:       userEmail,
+					IncludeArchived: true,
+					Filter:          &filter,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.TotalCount).Should(Equal(int64(len(expectedIndexes))))
+				assertProjectIndexes(response.Projects, projectIDs, expectedIndexes)
+			}
+
+			nameFilter := func(operator models.NameOperator, value string) models.ProjectFilter {
+				return models.ProjectFilter{Name: &models.NameCondition{Operator: operator, Value: value}}
+			}
+
+			labelFilter := func(key string, operator models.LabelOperator, values ...string) models.ProjectFilter {
+				return models.ProjectFilter{Label: &models.LabelSelector{Key: key, Operator: operator, Values: values}}
+			}
+
+			When("user filters the projects by name", func() {
+				It("should return the projects whose names match the condition", func() {
+					assertFilter(nameFilter(models.NameOperatorEquals, "beta-api"), []int{2})
+					assertFilter(nameFilter(models.NameOperatorPrefix, "alpha"), []int{0, 1})
+					assertFilter(nameFilter(models.NameOperatorContains, "api"), []int{1, 2})
+					assertFilter(nameFilter(models.NameOperatorEquals, "Beta-API"), []int{})
+				})
+
+				It("should not treat the value as a pattern", func() {
+					assertFilter(nameFilter(models.NameOperatorContains, "a.w"), []int{3})
+					assertFilter(nameFilter(models.NameOperatorPrefix, "."), []int{})
+				})
+			})
+
+			When("user filters the projects by label", func() {
+				It("should return the projects whose labels match the selector", func() {
+					assertFilter(labelFilter("tier", models.LabelOperatorIn, "backend"), []int{1, 2})
+					assertFilter(labelFilter("tier", models.LabelOperatorIn, "backend", "frontend"), []int{0, 1, 2})
+					assertFilter(labelFilter("tier", models.LabelOperatorNotIn, "backend"), []int{0, 3})
+					assertFilter(labelFilter("team", models.LabelOperatorExists), []int{0, 1})
+					assertFilter(labelFilter("team", models.LabelOperatorDoesNotExist), []int{2, 3})
+				})
+
+				It("should match the label keys that contain dots as a whole", func() {
+					assertFilter(labelFilter("app.name", models.LabelOperatorIn, "web"), []int{0})
+					assertFilter(labelFilter("app", models.LabelOperatorExists), []int{})
+				})
+			})
+
+			When("user filters the projects by time and state", func() {
+				It("should return the projects that match the condition", func() {
+					assertFilter(models.ProjectFilter{CreatedAt: &models.TimeRange{From: createdAt}}, []int{0, 1, 2, 3})
+					assertFilter(models.ProjectFilter{CreatedAt: &models.TimeRange{To: createdAt}}, []int{})
+					assertFilter(models.ProjectFilter{UpdatedAt: &models.TimeRange{From: createdAt, To: createdAt.Add(time.Hour)}}, []int{0, 1, 2, 3})
+
+					archived := models.ProjectStateArchived
+					assertFilter(models.ProjectFilter{State: &archived}, []int{3})
+				})
+			})
+
+			When("user combines the conditions", func() {
+				It("should return the projects that match the expression", func() {
+					archived := models.ProjectStateArchived
+
+					assertFilter(models.ProjectFilter{
+						Operator: models.FilterOperatorOr,
+						Filters: []models.ProjectFilter{
+							nameFilter(models.NameOperatorEquals, "beta-api"),
+							labelFilter("team", models.LabelOperatorIn, "red"),
+						},
+					}, []int{0, 2})
+
+					assertFilter(models.ProjectFilter{
+						Operator: models.FilterOperatorOr,
+						Filters: []models.ProjectFilter{
+							{
+								Operator: models.FilterOperatorAnd,
+								Filters: []models.ProjectFilter{
+									nameFilter(models.NameOperatorPrefix, "alpha"),
+									labelFilter("tier", models.LabelOperatorIn, "backend"),
+								},
+							},
+							{State: &archived},
+						},
+					}, []int{1, 3})
+				})
+			})
+
+			When("user provides a filter that is not valid", func() {
+				It("should return ArgumentError", func() {
+					for _, filter := range []models.ProjectFilter{
+						{},
+						nameFilter(models.NameOperator(100), "alpha"),
+						labelFilter("labels.$where", models.LabelOperatorExists),
+						labelFilter("tier", models.LabelOperatorIn),
+					} {
+						filter := filter
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail: userEmail,
+							Filter:    &filter,
+						})
+						Ω(response).Should(BeNil())
+						Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+					}
+				})
+			})
+		})
+
+		Context("user searches the projects", func() {
+			var projectIDs []string
+
+			BeforeEach(func() {
+				projectIDs = []string{
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:        "Payments gateway",
+						Description: "Handles the card payments",
+					}),
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:        "Billing service",
+						Description: "Sends the payments reminders and gateway alerts",
+					}),
+					createProject(ctx, sut, userEmail, models.Project{
+						Name:        "Gateway monitor",
+						Description: "Watches the gateway",
+					}),
+					createProject(ctx, sut, userEmail, models.Project{Name: "Archived payments"}),
+				}
+
+				_, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+					UserEmail: userEmail,
+					ProjectID: projectIDs[3],
+					State:     models.ProjectStateArchived,
+				})
+				Ω(err).Should(BeNil())
+
+				// The projects of the organizations the user is a member of are visible to the user
+				organizationUserEmail := newUserEmail()
+				organizationID := createOrganization(ctx, sut, organizationUserEmail)
+				_, err = sut.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+					OrganizationID: organizationID,
+					Member:         models.Member{UserEmail: userEmail, Role: models.MemberRoleViewer},
+				})
+				Ω(err).Should(BeNil())
+
+				projectIDs = append(projectIDs, createProject(ctx, sut, organizationUserEmail, models.Project{
+					Name:           "Shared payments",
+					OrganizationID: organizationID,
+				}))
+
+				// Neither the deleted projects nor the projects of the other users must ever be returned
+				deletedProjectID := createProject(ctx, sut, userEmail, models.Project{Name: "Deleted payments"})
+				_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: deletedProjectID})
+				Ω(err).Should(BeNil())
+
+				_ = createProject(ctx, sut, newUserEmail(), models.Project{Name: "Other payments gateway"})
+			})
+
+			searchProjects := func(request repository.SearchProjectsRequest) *repository.SearchProjectsResponse {
+				request.UserEmail = userEmail
+				response, err := sut.SearchProjects(ctx, &request)
+				Ω(err).Should(BeNil())
+
+				return response
+			}
+
+			assertSearchIndexes := func(results []models.ProjectSearchResult, expectedIndexes []int) {
+				Ω(results).Should(HaveLen(len(expectedIndexes)))
+
+				for idx, expectedIndex := range expectedIndexes {
+					Ω(results[idx].ProjectID).Should(Equal(projectIDs[expectedIndex]))
+					Ω(results[idx].Cursor).ShouldNot(BeEmpty())
+					Ω(results[idx].Score).Should(BeNumerically(">", 0))
+				}
+			}
+
+			When("user searches the projects by a word", func() {
+				It("should return the visible projects that contain the word, the most relevant ones first", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: "payments"})
+					Ω(response.TotalCount).Should(Equal(int64(3)))
+					Ω(response.HasPreviousPage).Should(BeFalse())
+					Ω(response.HasNextPage).Should(BeFalse())
+					assertSearchIndexes(response.Projects, []int{0, 4, 1})
+					Ω(response.Projects[0].Score).Should(BeNumerically(">", response.Projects[1].Score))
+					Ω(response.Projects[1].Score).Should(BeNumerically(">", response.Projects[2].Score))
+				})
+
+				It("should ignore the case and the punctuation of the query", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: "GATEWAY!"})
+					assertSearchIndexes(response.Projects, []int{2, 0, 1})
+				})
+
+				It("should return the archived projects only if they are included", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: "payments", IncludeArchived: true})
+					Ω(response.TotalCount).Should(Equal(int64(4)))
+					Ω(response.Projects).Should(HaveLen(4))
+					Ω(response.Projects[3].ProjectID).Should(Equal(projectIDs[1]))
+
+					resultProjectIDs := []string{}
+					for _, result := range response.Projects {
+						resultProjectIDs = append(resultProjectIDs, result.ProjectID)
+					}
+
+					Ω(resultProjectIDs).Should(ConsistOf(projectIDs[0], projectIDs[1], projectIDs[3], projectIDs[4]))
+				})
+
+				It("should return no project if no project contains the word", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: cuid.New()})
+					Ω(response.TotalCount).Should(BeZero())
+					Ω(response.Projects).Should(BeEmpty())
+				})
+			})
+
+			When("user searches the projects by multiple words", func() {
+				It("should return the projects that contain any of the words", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: "gateway payments"})
+					Ω(response.TotalCount).Should(Equal(int64(4)))
+					assertSearchIndexes(response.Projects, []int{0, 2, 4, 1})
+				})
+			})
+
+			When("user searches the projects", func() {
+				It("should highlight the words that matched", func() {
+					response := searchProjects(repository.SearchProjectsRequest{Query: "payments"})
+					Ω(response.Projects[0].Highlights).Should(Equal([]models.Highlight{
+						{Field: "name", Ranges: []models.TextRange{{Start: 0, End: 8}}},
+						{Field: "description", Ranges: []models.TextRange{{Start: 17, End: 25}}},
+					}))
+					Ω(response.Projects[2].Highlights).Should(Equal([]models.Highlight{
+						{Field: "description", Ranges: []models.TextRange{{Start: 10, End: 18}}},
+					}))
+				})
+			})
+
+			When("user pages through the search results", func() {
+				It("should return the pages in the order of the relevance", func() {
+					firstPage := searchProjects(repository.SearchProjectsRequest{
+						Query:      "gateway payments",
+						Pagination: common.Pagination{First: intPointer(2)},
+					})
+					Ω(firstPage.TotalCount).Should(Equal(int64(4)))
+					Ω(firstPage.HasPreviousPage).Should(BeFalse())
+					Ω(firstPage.HasNextPage).Should(BeTrue())
+					assertSearchIndexes(firstPage.Projects, []int{0, 2})
+
+					secondPage := searchProjects(repository.SearchProjectsRequest{
+						Query:      "gateway payments",
+						Pagination: common.Pagination{After: &firstPage.Projects[1].Cursor, First: intPointer(2)},
+					})
+					Ω(secondPage.HasPreviousPage).Should(BeTrue())
+					Ω(secondPage.HasNextPage).Should(BeFalse())
+					assertSearchIndexes(secondPage.Projects, []int{4, 1})
+
+					previousPage := searchProjects(repository.SearchProjectsRequest{
+						Query:      "gateway payments",
+						Pagination: common.Pagination{Before: &secondPage.Projects[0].Cursor, Last: intPointer(1)},
+					})
+					Ω(previousPage.HasPreviousPage).Should(BeTrue())
+					Ω(previousPage.HasNextPage).Should(BeTrue())
+					assertSearchIndexes(previousPage.Projects, []int{2})
+				})
+
+				It("should return error if the cursor is not valid", func() {
+					invalidCursor := cuid.New()
+					response, err := sut.SearchProjects(ctx, &repository.SearchProjectsRequest{
+						UserEmail:  userEmail,
+						Query:      "payments",
+						Pagination: common.Pagination{After: &invalidCursor},
+					})
+					Ω(err).Should(HaveOccurred())
+					Ω(response).Should(BeNil())
+				})
+			})
+
+			When("user renames a project", func() {
+				It("should search the new name of the project", func() {
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectIDs[2],
+						Project:   models.Project{Name: "Payments monitor"},
+					})
+					Ω(err).Should(BeNil())
+
+					response := searchProjects(repository.SearchProjectsRequest{Query: "payments"})
+					Ω(response.TotalCount).Should(Equal(int64(4)))
+
+					response = searchProjects(repository.SearchProjectsRequest{Query: "gateway"})
+					assertSearchIndexes(response.Projects, []int{0, 1})
+				})
+			})
+		})
+
+		Context("user has multiple projects", func() {
+			var (
+				projectIDs []string
+				cursors    []string
+			)
+
+			BeforeEach(func() {
+				projectIDs = []string{}
+				cursors = []string{}
+				for idx := 0; idx < 10; idx++ {
+					projectID := createProject(ctx, sut, userEmail, models.Project{Name: fmt.Sprintf("Name%d", idx)})
+					projectIDs = append(projectIDs, projectID)
+					cursors = append(cursors, repository.NewProjectCursor(projectID))
+				}
+
+				// Projects that belong to another user must never leak into the result
+				anotherUserEmail := newUserEmail()
+				for idx := 0; idx < 3; idx++ {
+					_ = createProject(ctx, sut, anotherUserEmail, models.Project{Name: fmt.Sprintf("Name%d", idx)})
+				}
+			})
+
+			assertListProjects := func(pagination common.Pagination, projectIDsFilter []string, expectedIndexes []int) {
+				response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+					UserEmail:      userEmail,
+					ProjectIDs:     projectIDsFilter,
+					Pagination:     pagination,
+					SortingOptions: []common.SortingOptionPair{},
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.TotalCount).Should(Equal(int64(10)))
+				assertProjectIndexes(response.Projects, projectIDs, expectedIndexes)
+			}
+
+			When("user lists the projects without pagination", func() {
+				It("should return all the projects", func() {
+					assertListProjects(common.Pagination{}, nil, indexes(0, 10))
+				})
+			})
+
+			When("user lists the first 10 projects", func() {
+				It("should return all the projects", func() {
+					assertListProjects(common.Pagination{First: intPointer(10)}, projectIDs, indexes(0, 10))
+				})
+			})
+
+			When("user lists the first 5 projects", func() {
+				It("should return the first 5 projects", func() {
+					assertListProjects(common.Pagination{First: intPointer(5)}, projectIDs, indexes(0, 5))
+				})
+			})
+
+			When("user lists the first 20 projects", func() {
+				It("should return all the projects", func() {
+					assertListProjects(common.Pagination{First: intPointer(20)}, nil, indexes(0, 10))
+				})
+			})
+
+			When("user lists the first 9 projects after the first project", func() {
+				It("should return the rest of the projects", func() {
+					assertListProjects(common.Pagination{After: &cursors[0], First: intPointer(9)}, projectIDs, indexes(1, 10))
+				})
+			})
+
+			When("user lists the first 5 projects after the first project", func() {
+				It("should return the next 5 projects", func() {
+					assertListProjects(common.Pagination{After: &cursors[0], First: intPointer(5)}, projectIDs, indexes(1, 6))
+				})
+			})
+
+			When("user lists the first 5 projects after the fourth project without project IDs", func() {
+				It("should return the next 5 projects", func() {
+					assertListProjects(common.Pagination{After: &cursors[3], First: intPointer(5)}, nil, indexes(4, 9))
+				})
+			})
+
+			When("user lists the first 5 projects after the last project", func() {
+				It("should return no project", func() {
+					assertListProjects(common.Pagination{After: &cursors[9], First: intPointer(5)}, projectIDs, []int{})
+				})
+			})
+
+			When("user lists the last 10 projects", func() {
+				It("should return all the projects", func() {
+					assertListProjects(common.Pagination{Last: intPointer(10)}, projectIDs, indexes(0, 10))
+				})
+			})
+
+			When("user lists the last 3 projects", func() {
+				It("should return the last 3 projects", func() {
+					assertListProjects(common.Pagination{Last: intPointer(3)}, projectIDs, indexes(7, 10))
+				})
+			})
+
+			When("user lists the last 9 projects before the last project", func() {
+				It("should return the previous 9 projects", func() {
+					assertListProjects(common.Pagination{Before: &cursors[9], Last: intPointer(9)}, projectIDs, indexes(0, 9))
+				})
+			})
+
+			When("user lists the last 5 projects before the last project", func() {
+				It("should return the previous 5 projects", func() {
+					assertListProjects(common.Pagination{Before: &cursors[9], Last: intPointer(5)}, projectIDs, indexes(4, 9))
+				})
+			})
+
+			When("user lists the last 5 projects before the sixth project without project IDs", func() {
+				It("should return the previous 5 projects", func() {
+					assertListProjects(common.Pagination{Before: &cursors[5], Last: intPointer(5)}, nil, indexes(0, 5))
+				})
+			})
+
+			When("user lists the last 5 projects before the first project", func() {
+				It("should return no project", func() {
+					assertListProjects(common.Pagination{Before: &cursors[0], Last: intPointer(5)}, projectIDs, []int{})
+				})
+			})
+
+			When("user lists the projects between two cursors using after and before together", func() {
+				It("should return the projects in between", func() {
+					assertListProjects(common.Pagination{After: &cursors[2], Before: &cursors[7]}, nil, indexes(3, 7))
+				})
+			})
+
+			When("user lists a subset of the projects using project IDs", func() {
+				It("should only return the requested projects", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:  userEmail,
+						ProjectIDs: []string{projectIDs[1], projectIDs[3], projectIDs[8]},
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.TotalCount).Should(Equal(int64(3)))
+					assertProjectIndexes(response.Projects, projectIDs, []int{1, 3, 8})
+				})
+			})
+
+			When("user lists the projects in ascending order on name property", func() {
+				It("should return the projects in ascending order on name field", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						Pagination:     common.Pagination{First: intPointer(10)},
+						SortingOptions: []common.SortingOptionPair{{Name: "name", Direction: common.Ascending}},
+					})
+					Ω(err).Should(BeNil())
+					assertProjectIndexes(response.Projects, projectIDs, indexes(0, 10))
+				})
+			})
+
+			When("user lists the projects in descending order on name property", func() {
+				It("should return the projects in descending order on name field", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						Pagination:     common.Pagination{First: intPointer(10)},
+						SortingOptions: []common.SortingOptionPair{{Name: "name", Direction: common.Descending}},
+					})
+					Ω(err).Should(BeNil())
+					assertProjectIndexes(response.Projects, projectIDs, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+				})
+			})
+
+			When("user lists the first 3 projects in descending order on name property", func() {
+				It("should return the 3 projects with the highest names", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						Pagination:     common.Pagination{First: intPointer(3)},
+						SortingOptions: []common.SortingOptionPair{{Name: "name", Direction: common.Descending}},
+					})
+					Ω(err).Should(BeNil())
+					assertProjectIndexes(response.Projects, projectIDs, []int{9, 8, 7})
+				})
+			})
+			When("user lists the projects in ascending order on createdAt property", func() {
+				It("should return the projects in the order they were created in", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						SortingOptions: []common.SortingOptionPair{{Name: "createdAt", Direction: common.Ascending}},
+					})
+					Ω(err).Should(BeNil())
+					assertProjectIndexes(response.Projects, projectIDs, indexes(0, 10))
+				})
+			})
+
+			When("user lists the projects in descending order on updatedAt property", func() {
+				It("should return the most recently updated projects first", func() {
+					for _, idx := range []int{2, 5} {
+						// Some of the backends store the time with millisecond precision
+						time.Sleep(2 * time.Millisecond)
+						_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+							UserEmail: userEmail,
+							ProjectID: projectIDs[idx],
+							Project:   models.Project{Name: fmt.Sprintf("Updated%d", idx)},
+						})
+						Ω(err).Should(BeNil())
+					}
+
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						Pagination:     common.Pagination{First: intPointer(2)},
+						SortingOptions: []common.SortingOptionPair{{Name: "updatedAt", Direction: common.Descending}},
+					})
+					Ω(err).Should(BeNil())
+					assertProjectIndexes(response.Projects, projectIDs, []int{5, 2})
+				})
+			})
+
+			When("user pages through the projects in descending order on name property", func() {
+				It("should return every project once and set the page flags exactly", func() {
+					sortingOptions := []common.SortingOptionPair{{Name: "name", Direction: common.Descending}}
+					listPage := func(pagination common.Pagination) *repository.ListProjectsResponse {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail:      userEmail,
+							Pagination:     pagination,
+							SortingOptions: sortingOptions,
+						})
+						Ω(err).Should(BeNil())
+						Ω(response.TotalCount).Should(Equal(int64(10)))
+
+						return response
+					}
+
+					firstPage := listPage(common.Pagination{First: intPointer(4)})
+					assertProjectIndexes(firstPage.Projects, projectIDs, []int{9, 8, 7, 6})
+					Ω(firstPage.HasPreviousPage).Should(BeFalse())
+					Ω(firstPage.HasNextPage).Should(BeTrue())
+
+					secondPage := listPage(common.Pagination{After: &firstPage.Projects[3].Cursor, First: intPointer(4)})
+					assertProjectIndexes(secondPage.Projects, projectIDs, []int{5, 4, 3, 2})
+					Ω(secondPage.HasPreviousPage).Should(BeTrue())
+					Ω(secondPage.HasNextPage).Should(BeTrue())
+
+					lastPage := listPage(common.Pagination{After: &secondPage.Projects[3].Cursor, First: intPointer(4)})
+					assertProjectIndexes(lastPage.Projects, projectIDs, []int{1, 0})
+					Ω(lastPage.HasPreviousPage).Should(BeTrue())
+					Ω(lastPage.HasNextPage).Should(BeFalse())
+
+					previousPage := listPage(common.Pagination{Before: &secondPage.Projects[0].Cursor, Last: intPointer(4)})
+					assertProjectIndexes(previousPage.Projects, projectIDs, []int{9, 8, 7, 6})
+					Ω(previousPage.HasPreviousPage).Should(BeFalse())
+					Ω(previousPage.HasNextPage).Should(BeTrue())
+				})
+			})
+
+			When("user lists the projects after the last project", func() {
+				It("should only have a previous page", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:  userEmail,
+						Pagination: common.Pagination{After: &cursors[9], First: intPointer(5)},
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Projects).Should(BeEmpty())
+					Ω(response.HasPreviousPage).Should(BeTrue())
+					Ω(response.HasNextPage).Should(BeFalse())
+				})
+			})
+
+			When("user lists the projects using a cursor of another sort order", func() {
+				It("should return ArgumentError", func() {
+					response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						Pagination:     common.Pagination{After: &cursors[2], First: intPointer(5)},
+						SortingOptions: []common.SortingOptionPair{{Name: "name", Direction: common.Descending}},
+					})
+					Ω(response).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+				})
+			})
+
+			When("user lists the projects using a malformed cursor", func() {
+				It("should return ArgumentError", func() {
+					for _, cursor := range []string{projectIDs[2], cuid.New(), "!", repository.EncodeCursor("_id", []string{})} {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail:  userEmail,
+							Pagination: common.Pagination{Before: &cursor, Last: intPointer(5)},
+						})
+						Ω(response).Should(BeNil())
+						Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+					}
+				})
+			})
+
+			When("user streams the projects", func() {
+				streamProjects := func(ctx context.Context, request repository.StreamProjectsRequest) []models.ProjectWithCursor {
+					request.UserEmail = userEmail
+					response, err := sut.StreamProjects(ctx, &request)
+					Ω(err).Should(BeNil())
+
+					defer func() {
+						Ω(response.Projects.Close()).Should(Succeed())
+					}()
+
+					projects := []models.ProjectWithCursor{}
+					for response.Projects.Next(ctx) {
+						projects = append(projects, response.Projects.Project())
+					}
+
+					Ω(response.Projects.Err()).Should(BeNil())
+
+					return projects
+				}
+
+				It("should return all the projects in the requested order", func() {
+					sortingOptions := []common.SortingOptionPair{{Name: "name", Direction: common.Descending}}
+					projects := streamProjects(ctx, repository.StreamProjectsRequest{SortingOptions: sortingOptions})
+					assertProjectIndexes(projects, projectIDs, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+
+					listResponse, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+						UserEmail:      userEmail,
+						SortingOptions: sortingOptions,
+					})
+					Ω(err).Should(BeNil())
+					Ω(projects).Should(Equal(listResponse.Projects))
+				})
+
+				It("should only return the projects that matched the criteria", func() {
+					projects := streamProjects(ctx, repository.StreamProjectsRequest{
+						ProjectIDs: []string{projectIDs[1], projectIDs[3], projectIDs[8]},
+						Filter: &models.ProjectFilter{
+							Name: &models.NameCondition{Operator: models.NameOperatorPrefix, Value: "Name"},
+						},
+					})
+					assertProjectIndexes(projects, projectIDs, []int{1, 3, 8})
+
+					projects = streamProjects(ctx, repository.StreamProjectsRequest{OrganizationID: createOrganization(ctx, sut, userEmail)})
+					Ω(projects).Should(BeEmpty())
+				})
+
+				It("should stop when the context is cancelled", func() {
+					cancellableCtx, cancel := context.WithCancel(ctx)
+					defer cancel()
+
+					response, err := sut.StreamProjects(cancellableCtx, &repository.StreamProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+
+					defer func() {
+						Ω(response.Projects.Close()).Should(Succeed())
+					}()
+
+					Ω(response.Projects.Next(cancellableCtx)).Should(BeTrue())
+					Ω(response.Projects.Project().ProjectID).Should(Equal(projectIDs[0]))
+
+					cancel()
+					Ω(response.Projects.Next(cancellableCtx)).Should(BeFalse())
+					Ω(response.Projects.Err()).Should(HaveOccurred())
+				})
+
+				It("should return ArgumentError if the sorting options are not valid", func() {
+					response, err := sut.StreamProjects(ctx, &repository.StreamProjectsRequest{
+						UserEmail:      userEmail,
+						SortingOptions: []common.SortingOptionPair{{Name: "userEmail", Direction: common.Ascending}},
+					})
+					Ω(response).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+				})
+			})
+
+			When("user lists the projects on a field that cannot be sorted on", func() {
+				It("should return ArgumentError", func() {
+					for _, sortingOptions := range [][]common.SortingOptionPair{
+						{{Name: cuid.New(), Direction: common.Ascending}},
+						{{Name: "userEmail", Direction: common.Ascending}},
+						{{Name: "name", Direction: common.Ascending}, {Name: "name", Direction: common.Descending}},
+						{{Name: "name", Direction: common.SortingDirection(100)}},
+					} {
+						response, err := sut.ListProjects(ctx, &repository.ListProjectsRequest{
+							UserEmail:      userEmail,
+							SortingOptions: sortingOptions,
+						})
+						Ω(response).Should(BeNil())
+						Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+
+						deletedResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{
+							UserEmail:      userEmail,
+							SortingOptions: sortingOptions,
+						})
+						Ω(deletedResponse).Should(BeNil())
+						Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+					}
+				})
+			})
+		})
+
+		Context("user changes projects in batches", func() {
+			var (
+				projectIDs []string
+				projects   []models.Project
+			)
+
+			BeforeEach(func() {
+				projects = []models.Project{newProject(), newProject(), newProject()}
+				projectIDs = []string{}
+
+				for _, project := range projects {
+					projectIDs = append(projectIDs, createProject(ctx, sut, userEmail, project))
+				}
+			})
+
+			searchCount := func(query string) int64 {
+				response, err := sut.SearchProjects(ctx, &repository.SearchProjectsRequest{UserEmail: userEmail, Query: query})
+				Ω(err).Should(BeNil())
+
+				return response.TotalCount
+			}
+
+			assertBatchItemError := func(err error, expectedIndex int) error {
+				Ω(err).Should(HaveOccurred())
+				batchItemError, ok := repository.AsBatchItemError(err)
+				Ω(ok).Should(BeTrue())
+				Ω(batchItemError.Index).Should(Equal(expectedIndex))
+
+				return batchItemError.Err
+			}
+
+			When("user creates the projects in a batch", func() {
+				It("should create all the projects in the order of the requests and index them", func() {
+					word := strings.ToLower(cuid.New())
+					requests := []repository.CreateProjectRequest{
+						{UserEmail: userEmail, Project: models.Project{Name: cuid.New(), Description: word}},
+						{UserEmail: userEmail, Project: models.Project{Name: cuid.New(), Description: word}},
+					}
+
+					response, err := sut.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{Requests: requests})
+					Ω(err).Should(BeNil())
+					Ω(response.Responses).Should(HaveLen(len(requests)))
+
+					for idx, createResponse := range response.Responses {
+						Ω(createResponse.Cursor).Should(Equal(repository.NewProjectCursor(createResponse.ProjectID)))
+						assertProject(createResponse.Project, requests[idx].Project)
+
+						readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: createResponse.ProjectID})
+						Ω(err).Should(BeNil())
+						assertProject(readResponse.Project, requests[idx].Project)
+					}
+
+					Ω(searchCount(word)).Should(Equal(int64(len(requests))))
+				})
+			})
+
+			When("one of the projects of the batch cannot be created", func() {
+				It("should return the error of the failed request and create none of the projects", func() {
+					word := strings.ToLower(cuid.New())
+					name := cuid.New()
+					requests := []repository.CreateProjectRequest{
+						{UserEmail: userEmail, Project: models.Project{Name: name, Description: word}},
+						{UserEmail: userEmail, Project: models.Project{Name: cuid.New(), Description: word}},
+						{UserEmail: userEmail, Project: models.Project{Name: projects[0].Name, Description: word}},
+					}
+
+					response, err := sut.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{Requests: requests})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(assertBatchItemError(err, 2))
+
+					Ω(searchCount(word)).Should(Equal(int64(0)))
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: name})
+				})
+
+				It("should detect the duplicate names within the batch", func() {
+					name := cuid.New()
+					response, err := sut.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{
+						Requests: []repository.CreateProjectRequest{
+							{UserEmail: userEmail, Project: models.Project{Name: name}},
+							{UserEmail: userEmail, Project: models.Project{Name: strings.ToUpper(name)}},
+						},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(assertBatchItemError(err, 1))
+
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: name})
+				})
+			})
+
+			When("user updates the projects in a batch", func() {
+				It("should update all the projects and re-index them", func() {
+					word := strings.ToLower(cuid.New())
+					requests := []repository.UpdateProjectRequest{}
+					for _, projectID := range projectIDs {
+						requests = append(requests, repository.UpdateProjectRequest{
+							UserEmail:  userEmail,
+							ProjectID:  projectID,
+							Project:    models.Project{Description: word},
+							UpdateMask: []string{models.ProjectDescriptionPath},
+						})
+					}
+
+					response, err := sut.BatchUpdateProjects(ctx, &repository.BatchUpdateProjectsRequest{Requests: requests})
+					Ω(err).Should(BeNil())
+					Ω(response.Responses).Should(HaveLen(len(requests)))
+
+					for idx, updateResponse := range response.Responses {
+						Ω(updateResponse.Cursor).Should(Equal(repository.NewProjectCursor(projectIDs[idx])))
+						Ω(updateResponse.Project.Name).Should(Equal(projects[idx].Name))
+						Ω(updateResponse.Project.Description).Should(Equal(word))
+					}
+
+					Ω(searchCount(word)).Should(Equal(int64(len(requests))))
+				})
+			})
+
+			When("one of the projects of the batch cannot be updated", func() {
+				It("should return the error of the failed request and update none of the projects", func() {
+					word := strings.ToLower(cuid.New())
+					response, err := sut.BatchUpdateProjects(ctx, &repository.BatchUpdateProjectsRequest{
+						Requests: []repository.UpdateProjectRequest{
+							{UserEmail: userEmail, ProjectID: projectIDs[0], Project: models.Project{Name: cuid.New(), Description: word}},
+							{UserEmail: userEmail, ProjectID: projectIDs[1], Project: models.Project{Name: cuid.New(), Description: word}, ExpectedVersion: 10},
+						},
+					})
+					Ω(response).Should(BeNil())
+					assertConflictError(assertBatchItemError(err, 1))
+
+					for idx := range projectIDs[:2] {
+						readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectIDs[idx]})
+						Ω(err).Should(BeNil())
+						assertProject(readResponse.Project, projects[idx])
+						Ω(readResponse.Project.Version).Should(Equal(int64(1)))
+					}
+
+					Ω(searchCount(word)).Should(Equal(int64(0)))
+					Ω(searchCount(strings.ToLower(projects[0].Name))).Should(Equal(int64(1)))
+				})
+			})
+
+			When("user deletes the projects in a batch", func() {
+				It("should delete all the projects", func() {
+					requests := []repository.DeleteProjectRequest{}
+					for _, projectID := range projectIDs {
+						requests = append(requests, repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					}
+
+					response, err := sut.BatchDeleteProjects(ctx, &repository.BatchDeleteProjectsRequest{Requests: requests})
+					Ω(err).Should(BeNil())
+					Ω(response.Responses).Should(HaveLen(len(requests)))
+
+					for _, projectID := range projectIDs {
+						readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+						Ω(readResponse).Should(BeNil())
+						assertNotFoundError(err)
+					}
+
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: projects[0].Name})
+				})
+			})
+
+			When("one of the projects of the batch cannot be deleted", func() {
+				It("should return the error of the failed request and delete none of the projects", func() {
+					response, err := sut.BatchDeleteProjects(ctx, &repository.BatchDeleteProjectsRequest{
+						Requests: []repository.DeleteProjectRequest{
+							{UserEmail: userEmail, ProjectID: projectIDs[0]},
+							{UserEmail: newUserEmail(), ProjectID: projectIDs[1]},
+						},
+					})
+					Ω(response).Should(BeNil())
+					assertNotFoundError(assertBatchItemError(err, 1))
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: projectIDs[0]})
+					Ω(err).Should(BeNil())
+					assertProject(readResponse.Project, projects[0])
+
+					_, err = sut.BatchDeleteProjects(ctx, &repository.BatchDeleteProjectsRequest{
+						Requests: []repository.DeleteProjectRequest{{UserEmail: userEmail, ProjectID: projectIDs[0]}},
+					})
+					Ω(err).Should(BeNil())
+				})
+			})
+		})
+
+		Context("user changes the projects together with their events", func() {
+			var eventIDs []string
+
+			BeforeEach(func() {
+				eventIDs = []string{}
+			})
+
+			AfterEach(func() {
+				_, err := sut.DeleteOutboxEvents(ctx, &repository.DeleteOutboxEventsRequest{EventIDs: eventIDs})
+				Ω(err).Should(BeNil())
+			})
+
+			newEvent := func(eventType models.DomainEventType) *models.DomainEvent {
+				event := newDomainEvent(eventType, userEmail)
+				eventIDs = append(eventIDs, event.EventID)
+
+				return event
+			}
+
+			When("user creates, updates and deletes a project", func() {
+				It("should write the events to the outbox in the order the project was changed", func() {
+					createEvent := newEvent(models.DomainEventTypeProjectCreated)
+					createResponse, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   newProject(),
+						Event:     createEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					projectID := createResponse.ProjectID
+					updateEvent := newEvent(models.DomainEventTypeProjectUpdated)
+					_, err = sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   newProject(),
+						Event:     updateEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					deleteEvent := newEvent(models.DomainEventTypeProjectDeleted)
+					_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Event:     deleteEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(3))
+					assertDomainEvent(events[0], *createEvent, projectID)
+					assertDomainEvent(events[1], *updateEvent, projectID)
+					assertDomainEvent(events[2], *deleteEvent, projectID)
+				})
+			})
+
+			When("the events are published", func() {
+				It("should remove the published events from the outbox", func() {
+					publishedEvent := newEvent(models.DomainEventTypeProjectCreated)
+					_, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   newProject(),
+						Event:     publishedEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					pendingEvent := newEvent(models.DomainEventTypeProjectCreated)
+					_, err = sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   newProject(),
+						Event:     pendingEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.DeleteOutboxEvents(ctx, &repository.DeleteOutboxEventsRequest{
+						EventIDs: []string{publishedEvent.EventID, cuid.New()},
+					})
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(1))
+					Ω(events[0].EventID).Should(Equal(pendingEvent.EventID))
+				})
+			})
+
+			When("the events are listed with a limit", func() {
+				It("should return at most as many events as the limit", func() {
+					for idx := 0; idx < 2; idx++ {
+						_, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+							UserEmail: userEmail,
+							Project:   newProject(),
+							Event:     newEvent(models.DomainEventTypeProjectCreated),
+						})
+						Ω(err).Should(BeNil())
+					}
+
+					response, err := sut.ListOutboxEvents(ctx, &repository.ListOutboxEventsRequest{Limit: 1})
+					Ω(err).Should(BeNil())
+					Ω(response.Events).Should(HaveLen(1))
+				})
+			})
+
+			When("the change of the project fails", func() {
+				It("should not write the event to the outbox", func() {
+					projectID := createProject(ctx, sut, userEmail, newProject())
+
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail:       userEmail,
+						ProjectID:       projectID,
+						Project:         newProject(),
+						ExpectedVersion: 10,
+						Event:           newEvent(models.DomainEventTypeProjectUpdated),
+					})
+					assertConflictError(err)
+
+					_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: newUserEmail(),
+						ProjectID: projectID,
+						Event:     newEvent(models.DomainEventTypeProjectDeleted),
+					})
+					assertNotFoundError(err)
+
+					Ω(listOutboxEvents(ctx, sut, eventIDs)).Should(BeEmpty())
+				})
+			})
+
+			When("user retries creating the project with the same idempotency key", func() {
+				It("should not write the event of the retry to the outbox", func() {
+					request := repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   newProject(),
+						IdempotencyKey: &repository.IdempotencyKey{
+							Key:         cuid.New(),
+							PayloadHash: cuid.New(),
+							ExpiresAt:   time.Now().Add(time.Hour),
+						},
+						Event: newEvent(models.DomainEventTypeProjectCreated),
+					}
+
+					response, err := sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+
+					firstEvent := *request.Event
+					request.Event = newEvent(models.DomainEventTypeProjectCreated)
+					_, err = sut.CreateProject(ctx, &request)
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(1))
+					assertDomainEvent(events[0], firstEvent, response.ProjectID)
+				})
+			})
+
+			When("one of the projects of the batch cannot be created", func() {
+				It("should write none of the events of the batch to the outbox", func() {
+					name := cuid.New()
+					_, err := sut.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{
+						Requests: []repository.CreateProjectRequest{
+							{UserEmail: userEmail, Project: models.Project{Name: name}, Event: newEvent(models.DomainEventTypeProjectCreated)},
+							{UserEmail: userEmail, Project: models.Project{Name: name}, Event: newEvent(models.DomainEventTypeProjectCreated)},
+						},
+					})
+					Ω(err).Should(HaveOccurred())
+
+					Ω(listOutboxEvents(ctx, sut, eventIDs)).Should(BeEmpty())
+				})
+			})
+
+			When("user archives, unarchives, moves, deletes and restores a project", func() {
+				It("should write the events to the outbox in the order the project was changed", func() {
+					projectID := createProject(ctx, sut, userEmail, newProject())
+
+					archiveEvent := newEvent(models.DomainEventTypeProjectArchived)
+					_, err := sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						State:     models.ProjectStateArchived,
+						Event:     archiveEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					unarchiveEvent := newEvent(models.DomainEventTypeProjectUnarchived)
+					_, err = sut.UpdateProjectState(ctx, &repository.UpdateProjectStateRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						State:     models.ProjectStateActive,
+						Event:     unarchiveEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					moveEvent := newEvent(models.DomainEventTypeProjectMoved)
+					_, err = sut.MoveProject(ctx, &repository.MoveProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						FolderID:  createFolder(ctx, sut, userEmail, ""),
+						Event:     moveEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					deleteEvent := newEvent(models.DomainEventTypeProjectDeleted)
+					_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Event:     deleteEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					restoreEvent := newEvent(models.DomainEventTypeProjectRestored)
+					_, err = sut.RestoreProject(ctx, &repository.RestoreProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Event:     restoreEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(5))
+					assertDomainEvent(events[0], *archiveEvent, projectID)
+					assertDomainEvent(events[1], *unarchiveEvent, projectID)
+					assertDomainEvent(events[2], *moveEvent, projectID)
+					assertDomainEvent(events[3], *deleteEvent, projectID)
+					assertDomainEvent(events[4], *restoreEvent, projectID)
+				})
+			})
+
+			When("user transfers the ownership of a project", func() {
+				It("should write the event to the outbox", func() {
+					projectID := createProject(ctx, sut, userEmail, newProject())
+					newOwnerEmail := newUserEmail()
+
+					transferEvent := newEvent(models.DomainEventTypeProjectOwnershipTransferred)
+					_, err := sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     projectID,
+						FromUserEmail: userEmail,
+						ToUserEmail:   newOwnerEmail,
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, userEmail, newOwnerEmail),
+						Event:         transferEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(1))
+					assertDomainEvent(events[0], *transferEvent, projectID)
+				})
+			})
+
+			When("user deletes a folder together with its projects", func() {
+				It("should write a copy of the event to the outbox for every deleted project", func() {
+					folderID := createFolder(ctx, sut, userEmail, "")
+					subfolderID := createFolder(ctx, sut, userEmail, folderID)
+					projectIDs := []string{
+						createProject(ctx, sut, userEmail, newProject()),
+						createProject(ctx, sut, userEmail, newProject()),
+					}
+					moveProject(ctx, sut, userEmail, projectIDs[0], folderID)
+					moveProject(ctx, sut, userEmail, projectIDs[1], subfolderID)
+
+					deleteEvent := newEvent(models.DomainEventTypeProjectDeleted)
+					for _, projectID := range projectIDs {
+						eventIDs = append(eventIDs, deleteEvent.EventID+"-"+projectID)
+					}
+
+					_, err := sut.DeleteFolder(ctx, &repository.DeleteFolderRequest{
+						UserEmail: userEmail,
+						FolderID:  folderID,
+						Cascade:   true,
+						Event:     deleteEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(2))
+
+					eventProjectIDs := []string{}
+					for _, event := range events {
+						expectedEvent := *deleteEvent
+						expectedEvent.EventID = deleteEvent.EventID + "-" + event.ProjectID
+						assertDomainEvent(event, expectedEvent, event.ProjectID)
+						eventProjectIDs = append(eventProjectIDs, event.ProjectID)
+					}
+
+					Ω(eventProjectIDs).Should(ConsistOf(projectIDs))
+				})
+			})
+
+			When("user fails to restore a project or to transfer its ownership", func() {
+				It("should not write the events to the outbox", func() {
+					name := cuid.New()
+					projectID := createProject(ctx, sut, userEmail, models.Project{Name: name})
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					createProject(ctx, sut, userEmail, models.Project{Name: name})
+					_, err = sut.RestoreProject(ctx, &repository.RestoreProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Event:     newEvent(models.DomainEventTypeProjectRestored),
+					})
+					assertAlreadyExistsError(err)
+
+					otherProjectID := createProject(ctx, sut, userEmail, newProject())
+					otherUserEmail := newUserEmail()
+					_, err = sut.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+						ProjectID:     otherProjectID,
+						FromUserEmail: otherUserEmail,
+						ToUserEmail:   newUserEmail(),
+						AuditEvent:    newAuditEvent(models.AuditActionOwnershipTransferred, otherUserEmail, userEmail),
+						Event:         newEvent(models.DomainEventTypeProjectOwnershipTransferred),
+					})
+					assertNotFoundError(err)
+
+					Ω(listOutboxEvents(ctx, sut, eventIDs)).Should(BeEmpty())
+				})
+			})
+
+			When("the deleted project is purged before its events are published", func() {
+				It("should keep the project and its events until the events are published", func() {
+					projectID := createProject(ctx, sut, userEmail, newProject())
+
+					deleteEvent := newEvent(models.DomainEventTypeProjectDeleted)
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Event:     deleteEvent,
+					})
+					Ω(err).Should(BeNil())
+
+					purgeRequest := &repository.PurgeDeletedProjectsRequest{DeletedBefore: time.Now().Add(time.Hour)}
+					_, err = sut.PurgeDeletedProjects(ctx, purgeRequest)
+					Ω(err).Should(BeNil())
+
+					events := listOutboxEvents(ctx, sut, eventIDs)
+					Ω(events).Should(HaveLen(1))
+					assertDomainEvent(events[0], *deleteEvent, projectID)
+
+					listResponse, err := sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(HaveLen(1))
+					Ω(listResponse.Projects[0].ProjectID).Should(Equal(projectID))
+
+					_, err = sut.DeleteOutboxEvents(ctx, &repository.DeleteOutboxEventsRequest{EventIDs: eventIDs})
+					Ω(err).Should(BeNil())
+
+					_, err = sut.PurgeDeletedProjects(ctx, purgeRequest)
+					Ω(err).Should(BeNil())
+
+					listResponse, err = sut.ListDeletedProjects(ctx, &repository.ListDeletedProjectsRequest{UserEmail: userEmail})
+					Ω(err).Should(BeNil())
+					Ω(listResponse.Projects).Should(BeEmpty())
+				})
+			})
+		})
+
+		Context("user already has a project with the same name", func() {
+			var (
+				projectID string
+				name      string
+			)
+
+			BeforeEach(func() {
+				name = "My Project " + cuid.New()
+				projectID = createProject(ctx, sut, userEmail, models.Project{Name: name})
+			})
+
+			When("user creates another project with the same name", func() {
+				It("should return AlreadyExistsError", func() {
+					response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   models.Project{Name: name},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+				})
+			})
+
+			When("user creates another project with the same name in a different case and spacing", func() {
+				It("should return AlreadyExistsError", func() {
+					response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: userEmail,
+						Project:   models.Project{Name: "  " + strings.ReplaceAll(strings.ToUpper(name), " ", "   ") + " "},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+				})
+			})
+
+			When("another user creates a project with the same name", func() {
+				It("should create the new project", func() {
+					response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+						UserEmail: newUserEmail(),
+						Project:   models.Project{Name: name},
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.ProjectID).ShouldNot(Equal(projectID))
+				})
+			})
+
+			When("user renames another project to the same name", func() {
+				It("should return AlreadyExistsError and leave the project untouched", func() {
+					anotherProjectID := createProject(ctx, sut, userEmail, models.Project{Name: cuid.New()})
+
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: anotherProjectID,
+						Project:   models.Project{Name: strings.ToLower(name)},
+					})
+					Ω(response).Should(BeNil())
+					assertAlreadyExistsError(err)
+
+					readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: anotherProjectID})
+					Ω(err).Should(BeNil())
+					Ω(readResponse.Project.Name).ShouldNot(Equal(strings.ToLower(name)))
+				})
+			})
+
+			When("user updates the project without changing the name", func() {
+				It("should update the project", func() {
+					response, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   models.Project{Name: strings.ToUpper(name), Description: cuid.New()},
+					})
+					Ω(err).Should(BeNil())
+					Ω(response.Project.Name).Should(Equal(strings.ToUpper(name)))
+				})
+			})
+
+			When("user renames the project and creates a new project with the previous name", func() {
+				It("should create the new project", func() {
+					_, err := sut.UpdateProject(ctx, &repository.UpdateProjectRequest{
+						UserEmail: userEmail,
+						ProjectID: projectID,
+						Project:   models.Project{Name: cuid.New()},
+					})
+					Ω(err).Should(BeNil())
+
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: name})
+				})
+			})
+
+			When("user deletes the project and creates a new project with the same name", func() {
+				It("should create the new project", func() {
+					_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+					Ω(err).Should(BeNil())
+
+					_ = createProject(ctx, sut, userEmail, models.Project{Name: name})
+				})
+			})
+		})
+	})
+}
+
+func createProject(ctx context.Context, sut repository.RepositoryContract, userEmail string, project models.Project) string {
+	response, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+		UserEmail: userEmail,
+		Project:   project,
+	})
+	Ω(err).Should(BeNil())
+
+	return response.ProjectID
+}
+
+func newProject() models.Project {
+	return models.Project{
+		Name:        cuid.New(),
+		Description: cuid.New(),
+		Labels: map[string]string{
+			"environment": "production",
+			"team":        cuid.New(),
+		},
+		Annotations: map[string]string{
+			"example.com/owner": cuid.New() + "@test.com",
+		},
+	}
+}
+
+// assertProject asserts the fields the users can change. Empty and nil maps are considered equal, as the
+// different backends do not distinguish between them.
+func assertProject(project, expectedProject models.Project) {
+	Ω(project).ShouldNot(BeNil())
+	Ω(project.Name).Should(Equal(expectedProject.Name))
+	Ω(project.Description).Should(Equal(expectedProject.Description))
+	assertMap(project.Labels, expectedProject.Labels)
+	assertMap(project.Annotations, expectedProject.Annotations)
+}
+
+func assertManagedFields(project, expectedProject models.Project) {
+	Ω(project.CreatedAt).Should(BeTemporally("==", expectedProject.CreatedAt))
+	Ω(project.UpdatedAt).Should(BeTemporally("==", expectedProject.UpdatedAt))
+	Ω(project.CreatedBy).Should(Equal(expectedProject.CreatedBy))
+	Ω(project.Version).Should(Equal(expectedProject.Version))
+}
+
+func assertMap(values, expectedValues map[string]string) {
+	Ω(values).Should(HaveLen(len(expectedValues)))
+
+	for key, value := range expectedValues {
+		Ω(values).Should(HaveKeyWithValue(key, value))
+	}
+}
+
+func assertProjectIndexes(projects []models.ProjectWithCursor, projectIDs []string, expectedIndexes []int) {
+	Ω(projects).Should(HaveLen(len(expectedIndexes)))
+
+	for idx, expectedIndex := range expectedIndexes {
+		Ω(projects[idx].ProjectID).Should(Equal(projectIDs[expectedIndex]))
+		Ω(projects[idx].Cursor).ShouldNot(BeEmpty())
+	}
+}
+
+// newOwnershipTransfer returns a pending ownership transfer. The times are truncated to milliseconds, as some
+// of the backends store the time with millisecond precision.
+func newOwnershipTransfer(fromUserEmail, toUserEmail string) models.OwnershipTransfer {
+	requestedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	return models.OwnershipTransfer{
+		FromUserEmail: fromUserEmail,
+		ToUserEmail:   toUserEmail,
+		RequestedAt:   requestedAt,
+		ExpiresAt:     requestedAt.Add(time.Hour),
+	}
+}
+
+func newAuditEvent(action models.AuditAction, userEmail, targetUserEmail string) models.AuditEvent {
+	return models.AuditEvent{
+		Action:          action,
+		UserEmail:       userEmail,
+		TargetUserEmail: targetUserEmail,
+		OccurredAt:      time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// newDomainEvent returns a new event of the change of a project made by the user. The time is truncated to
+// milliseconds, as some of the backends store the time with millisecond precision.
+func newDomainEvent(eventType models.DomainEventType, userEmail string) *models.DomainEvent {
+	return &models.DomainEvent{
+		EventID:    cuid.New(),
+		Type:       eventType,
+		UserEmail:  userEmail,
+		OccurredAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// listOutboxEvents returns the events of the outbox that have one of the identifiers, in the order the outbox
+// returns them. The outbox is shared by all the specs, so the events of the other specs are skipped.
+func listOutboxEvents(ctx context.Context, sut repository.RepositoryContract, eventIDs []string) []models.DomainEvent {
+	response, err := sut.ListOutboxEvents(ctx, &repository.ListOutboxEventsRequest{Limit: outboxLimit})
+	Ω(err).Should(BeNil())
+
+	events := []models.DomainEvent{}
+	for _, event := range response.Events {
+		for _, eventID := range eventIDs {
+			if event.EventID == eventID {
+				events = append(events, event)
+			}
+		}
+	}
+
+	return events
+}
+
+func assertDomainEvent(event, expectedEvent models.DomainEvent, projectID string) {
+	Ω(event.EventID).Should(Equal(expectedEvent.EventID))
+	Ω(event.Type).Should(Equal(expectedEvent.Type))
+	Ω(event.ProjectID).Should(Equal(projectID))
+	Ω(event.UserEmail).Should(Equal(expectedEvent.UserEmail))
+	Ω(event.OccurredAt).Should(BeTemporally("==", expectedEvent.OccurredAt))
+}
+
+func assertOwnershipTransfer(transfer, expectedTransfer models.OwnershipTransfer) {
+	Ω(transfer.FromUserEmail).Should(Equal(expectedTransfer.FromUserEmail))
+	Ω(transfer.ToUserEmail).Should(Equal(expectedTransfer.ToUserEmail))
+	Ω(transfer.RequestedAt).Should(BeTemporally("==", expectedTransfer.RequestedAt))
+	Ω(transfer.ExpiresAt).Should(BeTemporally("==", expectedTransfer.ExpiresAt))
+}
+
+func assertAuditEvent(auditEvent, expectedAuditEvent models.AuditEvent) {
+	Ω(auditEvent.Action).Should(Equal(expectedAuditEvent.Action))
+	Ω(auditEvent.UserEmail).Should(Equal(expectedAuditEvent.UserEmail))
+	Ω(auditEvent.TargetUserEmail).Should(Equal(expectedAuditEvent.TargetUserEmail))
+	Ω(auditEvent.OccurredAt).Should(BeTemporally("==", expectedAuditEvent.OccurredAt))
+}
+
+// newInvitation returns a pending invitation of the invited user to the project as an editor. The times are
+// truncated to milliseconds, as some of the backends store the time with millisecond precision.
+func newInvitation(projectID, invitedBy, email string) models.Invitation {
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	return models.Invitation{
+		ProjectID: projectID,
+		Email:     email,
+		Role:      models.MemberRoleEditor,
+		InvitedBy: invitedBy,
+		CreatedAt: createdAt,
+		ExpiresAt: createdAt.Add(time.Hour),
+	}
+}
+
+func assertInvitation(invitation, expectedInvitation models.Invitation) {
+	Ω(invitation.ProjectID).Should(Equal(expectedInvitation.ProjectID))
+	Ω(invitation.Email).Should(Equal(expectedInvitation.Email))
+	Ω(invitation.Role).Should(Equal(expectedInvitation.Role))
+	Ω(invitation.InvitedBy).Should(Equal(expectedInvitation.InvitedBy))
+	Ω(invitation.CreatedAt).Should(BeTemporally("==", expectedInvitation.CreatedAt))
+	Ω(invitation.ExpiresAt).Should(BeTemporally("==", expectedInvitation.ExpiresAt))
+}
+
+func createOrganization(ctx context.Context, sut repository.RepositoryContract, userEmail string) string {
+	response, err := sut.CreateOrganization(ctx, &repository.CreateOrganizationRequest{
+		UserEmail:    userEmail,
+		Organization: newOrganization(),
+	})
+	Ω(err).Should(BeNil())
+
+	return response.OrganizationID
+}
+
+func newOrganization() models.Organization {
+	return models.Organization{
+		Name:        cuid.New(),
+		Description: cuid.New(),
+	}
+}
+
+func assertOrganization(organization, expectedOrganization models.Organization) {
+	Ω(organization.Name).Should(Equal(expectedOrganization.Name))
+	Ω(organization.Description).Should(Equal(expectedOrganization.Description))
+}
+
+func createFolder(ctx context.Context, sut repository.RepositoryContract, userEmail, parentFolderID string) string {
+	response, err := sut.CreateFolder(ctx, &repository.CreateFolderRequest{
+		UserEmail: userEmail,
+		Folder: models.Folder{
+			Name:           cuid.New(),
+			ParentFolderID: parentFolderID,
+		},
+	})
+	Ω(err).Should(BeNil())
+
+	return response.FolderID
+}
+
+func moveProject(ctx context.Context, sut repository.RepositoryContract, userEmail, projectID, folderID string) {
+	_, err := sut.MoveProject(ctx, &repository.MoveProjectRequest{
+		UserEmail: userEmail,
+		ProjectID: projectID,
+		FolderID:  folderID,
+	})
+	Ω(err).Should(BeNil())
+}
+
+func assertNotFoundError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+}
+
+func assertAlreadyExistsError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(commonErrors.IsAlreadyExistsError(err)).Should(BeTrue())
+}
+
+func assertConflictError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(models.IsConflictError(err)).Should(BeTrue())
+}
+
+func assertProjectArchivedError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(models.IsProjectArchivedError(err)).Should(BeTrue())
+}
+
+func assertOrganizationNotEmptyError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(models.IsOrganizationNotEmptyError(err)).Should(BeTrue())
+}
+
+func assertFolderNotEmptyError(err error) {
+	Ω(err).Should(HaveOccurred())
+	Ω(models.IsFolderNotEmptyError(err)).Should(BeTrue())
+}
+
+func newUserEmail() string {
+	return cuid.New() + "@test.com"
+}
+
+func indexes(from, to int) []int {
+	result := []int{}
+	for idx := from; idx < to; idx++ {
+		result = append(result, idx)
+	}
+
+	return result
+}
+
+func intPointer(value int) *int {
+	return &value
+}
@@ -0,0 +1,261 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// eventTimeout is the time the specs wait for an event before they fail
+const eventTimeout = 5 * time.Second
+
+// DescribeWatchService registers the conformance specs for a repository service implementation that notifies
+// about the changes of the projects, either from a change feed of the database or from the changes it makes itself
+// name: Mandatory. The name of the repository service under test
+// factory: Mandatory. The factory that creates a new repository service for every spec, which must implement
+// repository.WatchContract
+// Returns true so it can be called while declaring package level variables
+func DescribeWatchService(name string, factory RepositoryServiceFactory) bool {
+	return Describe(fmt.Sprintf("%s watch conformance", name), func() {
+		var (
+			sut       repository.RepositoryContract
+			ctx       context.Context
+			userEmail string
+		)
+
+		BeforeEach(func() {
+			sut = factory()
+			ctx = context.Background()
+			userEmail = newUserEmail()
+		})
+
+		AfterEach(func() {
+			Ω(sut.Close()).Should(Succeed())
+		})
+
+		Context("user watches the projects", func() {
+			var watcher repository.ProjectWatcher
+
+			BeforeEach(func() {
+				watcher = watchProjects(ctx, sut, userEmail, "")
+			})
+
+			AfterEach(func() {
+				Ω(watcher.Close()).Should(Succeed())
+			})
+
+			It("should notify about the lifecycle of the project", func() {
+				projectID := createProject(ctx, sut, userEmail, newProject())
+				event := nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+				Ω(event.ProjectID).Should(Equal(projectID))
+				Ω(event.ResourceVersion).ShouldNot(BeEmpty())
+
+				updateRequest := repository.UpdateProjectRequest{
+					UserEmail: userEmail,
+					ProjectID: projectID,
+					Project:   newProject(),
+				}
+
+				_, err := sut.UpdateProject(ctx, &updateRequest)
+				Ω(err).Should(BeNil())
+				event = nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeModified))
+				Ω(event.ProjectID).Should(Equal(projectID))
+				assertProject(event.Project, updateRequest.Project)
+
+				_, err = sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+				Ω(err).Should(BeNil())
+				event = nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeDeleted))
+				Ω(event.ProjectID).Should(Equal(projectID))
+
+				_, err = sut.RestoreProject(ctx, &repository.RestoreProjectRequest{UserEmail: userEmail, ProjectID: projectID})
+				Ω(err).Should(BeNil())
+				event = nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+				Ω(event.ProjectID).Should(Equal(projectID))
+			})
+
+			It("should not notify about the projects of the other users", func() {
+				_ = createProject(ctx, sut, newUserEmail(), newProject())
+				projectID := createProject(ctx, sut, userEmail, newProject())
+
+				Ω(nextEvent(watcher).ProjectID).Should(Equal(projectID))
+			})
+
+			It("should not notify about the batches that failed", func() {
+				project := newProject()
+				_, err := sut.BatchCreateProjects(ctx, &repository.BatchCreateProjectsRequest{
+					Requests: []repository.CreateProjectRequest{
+						{UserEmail: userEmail, Project: project},
+						{UserEmail: userEmail, Project: project},
+					},
+				})
+				Ω(err).ShouldNot(BeNil())
+
+				projectID := createProject(ctx, sut, userEmail, newProject())
+				Ω(nextEvent(watcher).ProjectID).Should(Equal(projectID))
+			})
+
+			It("should stop waiting for the changes when the context is cancelled", func() {
+				cancelledCtx, cancel := context.WithCancel(ctx)
+				cancel()
+
+				Ω(watcher.Next(cancelledCtx)).Should(BeFalse())
+				Ω(watcher.Err()).Should(Equal(context.Canceled))
+			})
+		})
+
+		Context("user resumes watching the projects", func() {
+			It("should return the changes after the resource version first", func() {
+				watcher := watchProjects(ctx, sut, userEmail, "")
+				_ = createProject(ctx, sut, userEmail, newProject())
+				resourceVersion := nextEvent(watcher).ResourceVersion
+				Ω(watcher.Close()).Should(Succeed())
+
+				projectID := createProject(ctx, sut, userEmail, newProject())
+
+				watcher = watchProjects(ctx, sut, userEmail, resourceVersion)
+				defer func() {
+					_ = watcher.Close()
+				}()
+
+				event := nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+				Ω(event.ProjectID).Should(Equal(projectID))
+			})
+
+			It("should return ArgumentError if the resource version is invalid", func() {
+				response, err := sut.(repository.WatchContract).WatchProjects(ctx, &repository.WatchProjectsRequest{
+					UserEmail:       userEmail,
+					ResourceVersion: "invalid",
+				})
+				Ω(response).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+}
+
+// DescribeInProcessWatchService registers the conformance specs for a repository service implementation that
+// notifies about the changes of the projects it makes itself. Such a service knows who could see a project before
+// every change and keeps the changes in its own memory, which the change feeds of the databases do not provide.
+// name: Mandatory. The name of the repository service under test
+// factory: Mandatory. The factory that creates a new repository service for every spec, which must implement
+// repository.WatchContract
+// Returns true so it can be called while declaring package level variables
+func DescribeInProcessWatchService(name string, factory RepositoryServiceFactory) bool {
+	return Describe(fmt.Sprintf("%s in-process watch conformance", name), func() {
+		var (
+			sut       repository.RepositoryContract
+			ctx       context.Context
+			userEmail string
+		)
+
+		BeforeEach(func() {
+			sut = factory()
+			ctx = context.Background()
+			userEmail = newUserEmail()
+		})
+
+		AfterEach(func() {
+			Ω(sut.Close()).Should(Succeed())
+		})
+
+		Context("user watches the projects", func() {
+			var watcher repository.ProjectWatcher
+
+			BeforeEach(func() {
+				watcher = watchProjects(ctx, sut, userEmail, "")
+			})
+
+			AfterEach(func() {
+				Ω(watcher.Close()).Should(Succeed())
+			})
+
+			It("should notify the members who are added to and removed from the project", func() {
+				ownerEmail := newUserEmail()
+				projectID := createProject(ctx, sut, ownerEmail, newProject())
+
+				_, err := sut.AddMember(ctx, &repository.AddMemberRequest{
+					ProjectID: projectID,
+					Member:    models.Member{UserEmail: userEmail, Role: models.MemberRoleViewer},
+				})
+				Ω(err).Should(BeNil())
+				event := nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+				Ω(event.ProjectID).Should(Equal(projectID))
+
+				_, err = sut.RemoveMember(ctx, &repository.RemoveMemberRequest{ProjectID: projectID, UserEmail: userEmail})
+				Ω(err).Should(BeNil())
+				event = nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeDeleted))
+				Ω(event.ProjectID).Should(Equal(projectID))
+			})
+
+			It("should notify the members who are added to the organization of the project", func() {
+				ownerEmail := newUserEmail()
+				organizationID := createOrganization(ctx, sut, ownerEmail)
+				project := newProject()
+				project.OrganizationID = organizationID
+				projectID := createProject(ctx, sut, ownerEmail, project)
+
+				_, err := sut.AddOrganizationMember(ctx, &repository.AddOrganizationMemberRequest{
+					OrganizationID: organizationID,
+					Member:         models.Member{UserEmail: userEmail, Role: models.MemberRoleViewer},
+				})
+				Ω(err).Should(BeNil())
+				event := nextEvent(watcher)
+				Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+				Ω(event.ProjectID).Should(Equal(projectID))
+			})
+		})
+
+		Context("user resumes watching the projects", func() {
+			It("should return ResourceVersionExpiredError if the resource version is of another run of the service", func() {
+				watcher := watchProjects(ctx, sut, userEmail, "")
+				_ = createProject(ctx, sut, userEmail, newProject())
+				resourceVersion := nextEvent(watcher).ResourceVersion
+				Ω(watcher.Close()).Should(Succeed())
+
+				otherService := factory()
+				defer func() {
+					_ = otherService.Close()
+				}()
+
+				response, err := otherService.(repository.WatchContract).WatchProjects(ctx, &repository.WatchProjectsRequest{
+					UserEmail:       userEmail,
+					ResourceVersion: resourceVersion,
+				})
+				Ω(response).Should(BeNil())
+				Ω(models.IsResourceVersionExpiredError(err)).Should(BeTrue())
+			})
+		})
+	})
+}
+
+func watchProjects(ctx context.Context, sut repository.RepositoryContract, userEmail, resourceVersion string) repository.ProjectWatcher {
+	response, err := sut.(repository.WatchContract).WatchProjects(ctx, &repository.WatchProjectsRequest{
+		UserEmail:       userEmail,
+		ResourceVersion: resourceVersion,
+	})
+	Ω(err).Should(BeNil())
+
+	return response.Watcher
+}
+
+func nextEvent(watcher repository.ProjectWatcher) models.ProjectEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), eventTimeout)
+	defer cancel()
+
+	Ω(watcher.Next(ctx)).Should(BeTrue())
+
+	return watcher.Event()
+}
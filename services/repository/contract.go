@@ -1,10 +1,19 @@
 // Package repository implements different repository services required by the project service
 package repository
 
-import "context"
+import (
+	"context"
+	"io"
+
+	"github.com/decentralized-cloud/project/models"
+)
 
 // RepositoryContract declares the repository service that can create new project, read, update
-// and delete existing projects.
+// and delete existing projects and manage their members, and manage the organizations the projects belong
+// to. The operations on an existing project are only applied if the user provided in the request is a
+// member of the project or a member of the organization of the project, regardless of the role of the user,
+// the roles are enforced by the business service. Likewise, the operations on an existing organization are
+// only applied if the user provided in the request is a member of the organization.
 type RepositoryContract interface {
 	// CreateProject creates a new project.
 	// ctx: Mandatory The reference to the context
@@ -14,6 +23,16 @@ type RepositoryContract interface {
 		ctx context.Context,
 		request *CreateProjectRequest) (*CreateProjectResponse, error)
 
+	// BatchCreateProjects creates new projects in a single transaction, either all the projects are created or
+	// none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create new projects
+	// Returns either the results of creating the projects in the order of the requests or error if something
+	// goes wrong. The BatchItemError identifies the request that failed the batch.
+	BatchCreateProjects(
+		ctx context.Context,
+		request *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+
 	// ReadProject read an existing project
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request to read an esiting project
@@ -30,7 +49,18 @@ type RepositoryContract interface {
 		ctx context.Context,
 		request *UpdateProjectRequest) (*UpdateProjectResponse, error)
 
-	// DeleteProject delete an existing project
+	// BatchUpdateProjects updates existing projects in a single transaction, either all the projects are updated
+	// or none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to update existing projects
+	// Returns either the results of updating the projects in the order of the requests or error if something
+	// goes wrong. The BatchItemError identifies the request that failed the batch.
+	BatchUpdateProjects(
+		ctx context.Context,
+		request *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error)
+
+	// DeleteProject delete an existing project. The project is only marked as deleted, it is hidden from
+	// the other methods but can still be restored until it is purged.
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request to delete an esiting project
 	// Returns either the result of deleting an existing project or error if something goes wrong.
@@ -38,6 +68,42 @@ type RepositoryContract interface {
 		ctx context.Context,
 		request *DeleteProjectRequest) (*DeleteProjectResponse, error)
 
+	// BatchDeleteProjects deletes existing projects in a single transaction, either all the projects are deleted
+	// or none of them is.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete existing projects
+	// Returns either the results of deleting the projects in the order of the requests or error if something
+	// goes wrong. The BatchItemError identifies the request that failed the batch.
+	BatchDeleteProjects(
+		ctx context.Context,
+		request *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error)
+
+	// RestoreProject restores a deleted project that is not purged yet
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to restore a deleted project
+	// Returns either the result of restoring a deleted project or error if something goes wrong.
+	RestoreProject(
+		ctx context.Context,
+		request *RestoreProjectRequest) (*RestoreProjectResponse, error)
+
+	// UpdateProjectState changes the state of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to change the state of an existing project
+	// Returns either the result of changing the state of an existing project or error if something goes wrong.
+	UpdateProjectState(
+		ctx context.Context,
+		request *UpdateProjectStateRequest) (*UpdateProjectStateResponse, error)
+
+	// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+	// time provided in the request. The projects whose events are not published yet are kept until their events
+	// are published, so purging a project never drops its events.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the time the projects must have been deleted before
+	// Returns either the result of purging the deleted projects or error if something goes wrong.
+	PurgeDeletedProjects(
+		ctx context.Context,
+		request *PurgeDeletedProjectsRequest) (*PurgeDeletedProjectsResponse, error)
+
 	// ListProjects returns the list of projects that matched the criteria
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request contains the search criteria
@@ -45,4 +111,357 @@ type RepositoryContract interface {
 	ListProjects(
 		ctx context.Context,
 		request *ListProjectsRequest) (*ListProjectsResponse, error)
+
+	// StreamProjects returns an iterator over all the projects that matched the criteria. The projects are read
+	// from the database while the iterator advances, so they are never loaded into memory all at once.
+	// ctx: Mandatory The reference to the context, cancelling it stops reading the projects
+	// request: Mandatory. The request contains the search criteria
+	// Returns the iterator over the projects that matched the criteria
+	StreamProjects(
+		ctx context.Context,
+		request *StreamProjectsRequest) (*StreamProjectsResponse, error)
+
+	// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the query
+	// Returns the list of projects that matched the query
+	SearchProjects(
+		ctx context.Context,
+		request *SearchProjectsRequest) (*SearchProjectsResponse, error)
+
+	// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the search criteria
+	// Returns the list of deleted projects that matched the criteria
+	ListDeletedProjects(
+		ctx context.Context,
+		request *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error)
+
+	// ReadMember reads a member of an existing or a deleted project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read a member of a project
+	// Returns either the result of reading a member of a project or error if something goes wrong.
+	ReadMember(
+		ctx context.Context,
+		request *ReadMemberRequest) (*ReadMemberResponse, error)
+
+	// ReadEffectiveRole reads the role of a user in an existing or a deleted project, taking the organization
+	// of the project into account
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read the role of a user in a project
+	// Returns either the role of the user in the project or error if something goes wrong.
+	ReadEffectiveRole(
+		ctx context.Context,
+		request *ReadEffectiveRoleRequest) (*ReadEffectiveRoleResponse, error)
+
+	// AddMember adds a new member to an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a new member to an existing project
+	// Returns either the result of adding a new member or error if something goes wrong.
+	AddMember(
+		ctx context.Context,
+		request *AddMemberRequest) (*AddMemberResponse, error)
+
+	// UpdateMemberRole changes the role of an existing member of a project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to change the role of an existing member
+	// Returns either the result of changing the role of an existing member or error if something goes wrong.
+	UpdateMemberRole(
+		ctx context.Context,
+		request *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
+
+	// RemoveMember removes an existing member from a project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove an existing member from a project
+	// Returns either the result of removing an existing member or error if something goes wrong.
+	RemoveMember(
+		ctx context.Context,
+		request *RemoveMemberRequest) (*RemoveMemberResponse, error)
+
+	// ListMembers returns the members of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the members of an existing project
+	// Returns either the members of the project or error if something goes wrong.
+	ListMembers(
+		ctx context.Context,
+		request *ListMembersRequest) (*ListMembersResponse, error)
+
+	// RequestOwnershipTransfer records a pending ownership transfer of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to record a pending ownership transfer
+	// Returns either the result of recording the pending ownership transfer or error if something goes wrong.
+	RequestOwnershipTransfer(
+		ctx context.Context,
+		request *RequestOwnershipTransferRequest) (*RequestOwnershipTransferResponse, error)
+
+	// ReadOwnershipTransfer reads the pending ownership transfer of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read the pending ownership transfer
+	// Returns either the pending ownership transfer or error if something goes wrong.
+	ReadOwnershipTransfer(
+		ctx context.Context,
+		request *ReadOwnershipTransferRequest) (*ReadOwnershipTransferResponse, error)
+
+	// DeleteOwnershipTransfer removes the pending ownership transfer of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove the pending ownership transfer
+	// Returns either the result of removing the pending ownership transfer or error if something goes wrong.
+	DeleteOwnershipTransfer(
+		ctx context.Context,
+		request *DeleteOwnershipTransferRequest) (*DeleteOwnershipTransferResponse, error)
+
+	// TransferProjectOwnership transfers the ownership of an existing project to another user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to transfer the ownership of an existing project
+	// Returns either the result of transferring the ownership or error if something goes wrong.
+	TransferProjectOwnership(
+		ctx context.Context,
+		request *TransferProjectOwnershipRequest) (*TransferProjectOwnershipResponse, error)
+
+	// ListAuditEvents returns the audit trail of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the audit trail of an existing project
+	// Returns either the audit events of the project or error if something goes wrong.
+	ListAuditEvents(
+		ctx context.Context,
+		request *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+
+	// CreateInvitation stores a new invitation to join an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to store a new invitation
+	// Returns either the result of storing the new invitation or error if something goes wrong.
+	CreateInvitation(
+		ctx context.Context,
+		request *CreateInvitationRequest) (*CreateInvitationResponse, error)
+
+	// ReadInvitation reads an invitation by the hash of its token
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read an invitation
+	// Returns either the invitation or error if something goes wrong.
+	ReadInvitation(
+		ctx context.Context,
+		request *ReadInvitationRequest) (*ReadInvitationResponse, error)
+
+	// ListInvitations returns the pending invitations of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the pending invitations of an existing project
+	// Returns either the pending invitations of the project or error if something goes wrong.
+	ListInvitations(
+		ctx context.Context,
+		request *ListInvitationsRequest) (*ListInvitationsResponse, error)
+
+	// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to accept a pending invitation
+	// Returns either the result of accepting the invitation or error if something goes wrong.
+	AcceptInvitation(
+		ctx context.Context,
+		request *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+
+	// DeleteInvitation removes a pending invitation of an existing project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove a pending invitation
+	// Returns either the result of removing the invitation or error if something goes wrong.
+	DeleteInvitation(
+		ctx context.Context,
+		request *DeleteInvitationRequest) (*DeleteInvitationResponse, error)
+
+	// CreateOrganization creates a new organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create a new organization
+	// Returns either the result of creating the new organization or error if something goes wrong.
+	CreateOrganization(
+		ctx context.Context,
+		request *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+
+	// ReadOrganization reads an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read an existing organization
+	// Returns either the result of reading the organization or error if something goes wrong.
+	ReadOrganization(
+		ctx context.Context,
+		request *ReadOrganizationRequest) (*ReadOrganizationResponse, error)
+
+	// UpdateOrganization updates an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to update an existing organization
+	// Returns either the result of updating the organization or error if something goes wrong.
+	UpdateOrganization(
+		ctx context.Context,
+		request *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+
+	// DeleteOrganization deletes an existing organization that has no project
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete an existing organization
+	// Returns either the result of deleting the organization or error if something goes wrong.
+	DeleteOrganization(
+		ctx context.Context,
+		request *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+
+	// ListOrganizations returns the organizations the user is a member of
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the organizations of a user
+	// Returns either the organizations of the user or error if something goes wrong.
+	ListOrganizations(
+		ctx context.Context,
+		request *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+
+	// ReadOrganizationMember reads a member of an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read a member of an existing organization
+	// Returns either the result of reading the member or error if something goes wrong.
+	ReadOrganizationMember(
+		ctx context.Context,
+		request *ReadOrganizationMemberRequest) (*ReadOrganizationMemberResponse, error)
+
+	// AddOrganizationMember adds a new member to an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a new member to an existing organization
+	// Returns either the result of adding the new member or error if something goes wrong.
+	AddOrganizationMember(
+		ctx context.Context,
+		request *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
+
+	// RemoveOrganizationMember removes an existing member from an organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove an existing member from an organization
+	// Returns either the result of removing the member or error if something goes wrong.
+	RemoveOrganizationMember(
+		ctx context.Context,
+		request *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+
+	// ListOrganizationMembers returns the members of an existing organization
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the members of an existing organization
+	// Returns either the members of the organization or error if something goes wrong.
+	ListOrganizationMembers(
+		ctx context.Context,
+		request *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+
+	// MoveProject moves an existing project into a folder of the user or out of its folder
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to move an existing project
+	// Returns either the result of moving the project or error if something goes wrong.
+	MoveProject(
+		ctx context.Context,
+		request *MoveProjectRequest) (*MoveProjectResponse, error)
+
+	// CreateFolder creates a new folder for the user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to create a new folder
+	// Returns either the result of creating the new folder or error if something goes wrong.
+	CreateFolder(
+		ctx context.Context,
+		request *CreateFolderRequest) (*CreateFolderResponse, error)
+
+	// ListFolders returns the folders of the user
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the folders of a user
+	// Returns either the folders of the user or error if something goes wrong.
+	ListFolders(
+		ctx context.Context,
+		request *ListFoldersRequest) (*ListFoldersResponse, error)
+
+	// DeleteFolder deletes an existing folder of the user together with its subfolders
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete an existing folder
+	// Returns either the result of deleting the folder or error if something goes wrong.
+	DeleteFolder(
+		ctx context.Context,
+		request *DeleteFolderRequest) (*DeleteFolderResponse, error)
+
+	// ListOutboxEvents returns the events written to the outbox that are not published yet, the oldest ones first
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the maximum number of events to return
+	// Returns either the events that are not published yet or error if something goes wrong.
+	ListOutboxEvents(
+		ctx context.Context,
+		request *ListOutboxEventsRequest) (*ListOutboxEventsResponse, error)
+
+	// DeleteOutboxEvents removes the published events from the outbox
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the identifiers of the published events
+	// Returns either the result of removing the published events or error if something goes wrong.
+	DeleteOutboxEvents(
+		ctx context.Context,
+		request *DeleteOutboxEventsRequest) (*DeleteOutboxEventsResponse, error)
+
+	// Close releases all the resources held by the repository service, e.g. the database connections
+	// Returns error if something goes wrong
+	Close() error
+}
+
+// ProjectIterator declares the iterator over the projects returned by StreamProjects. The iterator is not safe
+// for concurrent use and must be closed once it is no longer used.
+type ProjectIterator interface {
+	// Next advances the iterator to the next project
+	// ctx: Mandatory The reference to the context, cancelling it stops reading the projects
+	// Returns false when there are no more projects or the iterator failed, Err returns the failure
+	Next(ctx context.Context) bool
+
+	// Project returns the project the iterator is at
+	// Returns the project the last call to Next advanced the iterator to
+	Project() models.ProjectWithCursor
+
+	// Err returns the error the iterator failed with
+	// Returns the error that stopped the iterator or nil if the iterator did not fail
+	Err() error
+
+	// Close releases the resources held by the iterator, e.g. the database cursor
+	// Returns error if something goes wrong
+	Close() error
+}
+
+// ProjectWatcher declares the watcher over the changes of the projects returned by WatchProjects. The watcher
+// is not safe for concurrent use and must be closed once it is no longer used.
+type ProjectWatcher interface {
+	// Next waits for the next change of the projects
+	// ctx: Mandatory The reference to the context, cancelling it stops waiting for the changes
+	// Returns false when the watcher failed or the context is cancelled, Err returns the failure
+	Next(ctx context.Context) bool
+
+	// Event returns the change the watcher is at
+	// Returns the change the last call to Next advanced the watcher to
+	Event() models.ProjectEvent
+
+	// Err returns the error the watcher failed with
+	// Returns the error that stopped the watcher
+	Err() error
+
+	// Close stops watching the changes and releases the resources held by the watcher, e.g. the change stream
+	// Returns error if something goes wrong
+	Close() error
+}
+
+// WatchContract declares the optional capability of the repository services that can notify about the changes
+// of the projects, either from a change feed of the database or from the changes the service itself made
+type WatchContract interface {
+	// WatchProjects watches the changes of the projects the user can see
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the user and the resource version to resume watching from
+	// Returns either the watcher over the changes of the projects or error if something goes wrong.
+	WatchProjects(
+		ctx context.Context,
+		request *WatchProjectsRequest) (*WatchProjectsResponse, error)
+}
+
+// BackupContract declares the optional capability of the repository services that keep the projects in a
+// local data file, to write a consistent copy of the data file while they keep serving requests.
+type BackupContract interface {
+	// Backup writes a consistent snapshot of the data file to the writer
+	// ctx: Mandatory The reference to the context
+	// writer: Mandatory. The writer to write the snapshot to
+	// Returns either the number of bytes written or error if something goes wrong.
+	Backup(
+		ctx context.Context,
+		writer io.Writer) (int64, error)
+}
+
+// MigrationContract declares the optional capability of the repository services that migrate the stored projects
+// when they connect to the database, so the migrations can run at startup instead of during the first request.
+type MigrationContract interface {
+	// Migrate connects to the database and applies the migrations that are not applied yet
+	// ctx: Mandatory The reference to the context the migrations run with
+	// Returns error if something goes wrong.
+	Migrate(ctx context.Context) error
 }
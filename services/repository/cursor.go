@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// idSortField is the field of the models.ProjectSortFields catalogue that holds the project identifier
+const idSortField = "_id"
+
+// SortKey defines one of the keys the projects are sorted by. Value is the value of the key at a cursor, it is
+// a string for the _id and name fields and a time.Time for the createdAt and updatedAt fields.
+type SortKey struct {
+	Field      string
+	Descending bool
+	Value      interface{}
+}
+
+// cursorPayload is the content of the cursors. Sort identifies the keys the cursor was created for, so a cursor
+// cannot be used with a different sort order, and Values contains the values of the keys at the cursor.
+type cursorPayload struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+// SortKeys returns the keys the projects are sorted by, which are the sorting options in order, followed by the
+// project identifier in ascending order unless the projects are already sorted by it, so the projects that share
+// the same values are still sorted in a stable order.
+// sortingOptions: Mandatory. The valid sorting options
+// Returns the keys the projects are sorted by
+func SortKeys(sortingOptions []common.SortingOptionPair) []SortKey {
+	keys := []SortKey{}
+	sortedOnID := false
+
+	for _, sortingOption := range sortingOptions {
+		keys = append(keys, SortKey{Field: sortingOption.Name, Descending: sortingOption.Direction == common.Descending})
+		sortedOnID = sortedOnID || sortingOption.Name == idSortField
+	}
+
+	if !sortedOnID {
+		keys = append(keys, SortKey{Field: idSortField})
+	}
+
+	return keys
+}
+
+// NewCursor returns the cursor that defines the position of the project in the projects sorted by the sorting
+// options. The cursor contains the values of all the sort keys of the project, so the projects can be paginated
+// in any sort order.
+// sortingOptions: Mandatory. The valid sorting options the projects are sorted by
+// projectID: Mandatory. The unique project identifier
+// project: Mandatory. The project to return the cursor of
+// Returns the cursor of the project
+func NewCursor(sortingOptions []common.SortingOptionPair, projectID string, project models.Project) string {
+	keys := SortKeys(sortingOptions)
+	values := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		switch key.Field {
+		case idSortField:
+			values = append(values, projectID)
+		case "name":
+			values = append(values, project.Name)
+		case "createdAt":
+			values = append(values, formatCursorTime(project.CreatedAt))
+		case "updatedAt":
+			values = append(values, formatCursorTime(project.UpdatedAt))
+		}
+	}
+
+	return EncodeCursor(sortSignature(keys), values)
+}
+
+// NewProjectCursor returns the cursor that defines the position of the project in the projects sorted in the
+// default order, which is the order of their identifiers
+// projectID: Mandatory. The unique project identifier
+// Returns the cursor of the project
+func NewProjectCursor(projectID string) string {
+	return NewCursor(nil, projectID, models.Project{})
+}
+
+// ParseCursor returns the keys the projects are sorted by together with their values at the cursor
+// cursor: Mandatory. The cursor returned by NewCursor
+// sortingOptions: Mandatory. The valid sorting options the projects are sorted by
+// Returns the sort keys at the cursor or ArgumentError if the cursor is malformed or was created for different
+// sorting options
+func ParseCursor(cursor string, sortingOptions []common.SortingOptionPair) ([]SortKey, error) {
+	keys := SortKeys(sortingOptions)
+
+	values, err := DecodeCursor(cursor, sortSignature(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) != len(keys) {
+		return nil, commonErrors.NewArgumentError("cursor", "cursor does not match the sorting options")
+	}
+
+	for idx := range keys {
+		switch keys[idx].Field {
+		case "createdAt", "updatedAt":
+			value, err := time.Parse(time.RFC3339Nano, values[idx])
+			if err != nil {
+				return nil, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+			}
+
+			keys[idx].Value = value
+		default:
+			keys[idx].Value = values[idx]
+		}
+	}
+
+	return keys, nil
+}
+
+// EncodeCursor encodes the values of the sort keys at a position into a cursor
+// sort: Mandatory. Identifies the keys the values belong to
+// values: Mandatory. The values of the sort keys
+// Returns the encoded cursor
+func EncodeCursor(sort string, values []string) string {
+	// Marshalling a struct of strings cannot fail
+	payload, _ := json.Marshal(cursorPayload{Sort: sort, Values: values})
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeCursor decodes the values of the sort keys at a position from a cursor
+// cursor: Mandatory. The cursor returned by EncodeCursor
+// sort: Mandatory. Identifies the keys the cursor must have been created for
+// Returns the values of the sort keys or ArgumentError if the cursor is malformed or was created for other keys
+func DecodeCursor(cursor, sort string) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+	}
+
+	var payload cursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+	}
+
+	if payload.Sort != sort {
+		return nil, commonErrors.NewArgumentError("cursor", fmt.Sprintf("cursor was created for the sort order %q", payload.Sort))
+	}
+
+	return payload.Values, nil
+}
+
+// sortSignature returns the string form of the sort keys, for example "name,-createdAt,_id"
+func sortSignature(keys []SortKey) string {
+	fields := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.Descending {
+			fields = append(fields, "-"+key.Field)
+		} else {
+			fields = append(fields, key.Field)
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// formatCursorTime formats the time with all its digits, so the parsed time is equal to the stored one
+func formatCursorTime(value time.Time) string {
+	return value.UTC().Format(time.RFC3339Nano)
+}
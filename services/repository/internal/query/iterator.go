@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+)
+
+type sliceIterator struct {
+	projects []models.ProjectWithCursor
+	index    int
+	err      error
+}
+
+// NewSliceIterator creates an iterator over the projects the repository services that evaluate the list
+// requests in process have already loaded
+// projects: Mandatory. The projects to iterate over, in order
+// Returns the new iterator
+func NewSliceIterator(projects []models.ProjectWithCursor) repository.ProjectIterator {
+	return &sliceIterator{
+		projects: projects,
+		index:    -1,
+	}
+}
+
+// Next advances the iterator to the next project
+// ctx: Mandatory The reference to the context, cancelling it stops the iterator
+// Returns false when there are no more projects or the context is cancelled
+func (iterator *sliceIterator) Next(ctx context.Context) bool {
+	if iterator.err != nil {
+		return false
+	}
+
+	if iterator.err = ctx.Err(); iterator.err != nil {
+		return false
+	}
+
+	if iterator.index+1 >= len(iterator.projects) {
+		return false
+	}
+
+	iterator.index++
+
+	return true
+}
+
+// Project returns the project the iterator is at
+// Returns the project the last call to Next advanced the iterator to
+func (iterator *sliceIterator) Project() models.ProjectWithCursor {
+	return iterator.projects[iterator.index]
+}
+
+// Err returns the error the iterator failed with
+// Returns the cancellation error of the context or nil if the iterator did not fail
+func (iterator *sliceIterator) Err() error {
+	return iterator.err
+}
+
+// Close releases the projects held by the iterator
+// Returns nil as releasing the projects cannot fail
+func (iterator *sliceIterator) Close() error {
+	iterator.projects = nil
+	iterator.index = -1
+
+	return nil
+}
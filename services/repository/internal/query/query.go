@@ -0,0 +1,894 @@
+// Package query implements the filtering, sorting and pagination shared by the repository services that
+// evaluate the list requests in process instead of delegating them to a database server
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Project contains a stored project together with its unique identifier, the email of the user who owns it,
+// the roles of its members, its pending ownership transfer, its audit trail and its pending invitations
+type Project struct {
+	ProjectID         string
+	UserEmail         string
+	Project           models.Project
+	Members           map[string]models.MemberRole
+	OwnershipTransfer *models.OwnershipTransfer
+	AuditEvents       []models.AuditEvent
+	// Invitations maps the emails of the invited users to their pending invitations
+	Invitations map[string]Invitation
+}
+
+// Invitation contains a pending invitation together with the hash of the token it is accepted with
+type Invitation struct {
+	Invitation models.Invitation `json:"invitation"`
+	TokenHash  string            `json:"tokenHash"`
+}
+
+// IdempotencyRecord contains the idempotency key a project was created with, identified by the user and the
+// key, together with the identifier of the created project
+type IdempotencyRecord struct {
+	PayloadHash string    `json:"payloadHash"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	ProjectID   string    `json:"projectID"`
+}
+
+// Organization contains a stored organization together with its unique identifier and the roles of its members
+type Organization struct {
+	OrganizationID string
+	Organization   models.Organization
+	Members        map[string]models.MemberRole
+}
+
+// Folder contains a stored folder together with its unique identifier and the email of the user who owns it
+type Folder struct {
+	FolderID  string
+	UserEmail string
+	Folder    models.Folder
+}
+
+// Copy returns a deep copy of the stored project that does not share its maps and slices with the project
+func (project Project) Copy() Project {
+	project.Project = CopyProject(project.Project)
+
+	members := make(map[string]models.MemberRole, len(project.Members))
+	for userEmail, role := range project.Members {
+		members[userEmail] = role
+	}
+
+	project.Members = members
+
+	if project.Invitations != nil {
+		invitations := make(map[string]Invitation, len(project.Invitations))
+		for email, invitation := range project.Invitations {
+			invitations[email] = invitation
+		}
+
+		project.Invitations = invitations
+	}
+
+	if project.OwnershipTransfer != nil {
+		ownershipTransfer := *project.OwnershipTransfer
+		project.OwnershipTransfer = &ownershipTransfer
+	}
+
+	project.AuditEvents = append([]models.AuditEvent(nil), project.AuditEvents...)
+
+	return project
+}
+
+// IsMember indicates whether the user is a member of the project
+// userEmail: Mandatory. The email of the user
+// Returns true if the user is a member of the project, otherwise returns false
+func (project Project) IsMember(userEmail string) bool {
+	_, ok := project.Members[userEmail]
+
+	return ok
+}
+
+// EffectiveRole returns the role of the user in the project, which is the highest of the role of the user as
+// a member of the project and the role of the user as a member of the organization of the project
+// userEmail: Mandatory. The email of the user
+// organizationRoles: Mandatory. The roles of the user in the organizations the user is a member of
+// Returns the role of the user and true if the user has access to the project, otherwise returns false
+func (project Project) EffectiveRole(userEmail string, organizationRoles map[string]models.MemberRole) (models.MemberRole, bool) {
+	role, isMember := project.Members[userEmail]
+
+	if project.Project.OrganizationID != "" {
+		if organizationRole, ok := organizationRoles[project.Project.OrganizationID]; ok && (!isMember || organizationRole > role) {
+			role, isMember = organizationRole, true
+		}
+	}
+
+	return role, isMember
+}
+
+// CanAccess indicates whether the user is a member of the project, either directly or through the
+// organization of the project
+// userEmail: Mandatory. The email of the user
+// organizationRoles: Mandatory. The roles of the user in the organizations the user is a member of
+// Returns true if the user has access to the project, otherwise returns false
+func (project Project) CanAccess(userEmail string, organizationRoles map[string]models.MemberRole) bool {
+	_, ok := project.EffectiveRole(userEmail, organizationRoles)
+
+	return ok
+}
+
+// OrganizationRoles returns the roles of the user in the organizations the user is a member of
+// organizations: Mandatory. The stored organizations, in any order
+// userEmail: Mandatory. The email of the user
+// Returns the roles of the user mapped by the organization identifiers
+func OrganizationRoles(organizations []Organization, userEmail string) map[string]models.MemberRole {
+	roles := map[string]models.MemberRole{}
+	for _, organization := range organizations {
+		if role, ok := organization.Members[userEmail]; ok {
+			roles[organization.OrganizationID] = role
+		}
+	}
+
+	return roles
+}
+
+// ListOrganizations returns the organizations the user is a member of ordered by their names, the
+// organizations that share the same name are ordered by their identifiers
+// organizations: Mandatory. The stored organizations, in any order
+// userEmail: Mandatory. The email of the user
+// Returns the organizations of the user
+func ListOrganizations(organizations []Organization, userEmail string) []models.OrganizationWithID {
+	result := []models.OrganizationWithID{}
+	for _, organization := range organizations {
+		if _, ok := organization.Members[userEmail]; ok {
+			result = append(result, models.OrganizationWithID{
+				OrganizationID: organization.OrganizationID,
+				Organization:   organization.Organization,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Organization.Name != result[j].Organization.Name {
+			return result[i].Organization.Name < result[j].Organization.Name
+		}
+
+		return result[i].OrganizationID < result[j].OrganizationID
+	})
+
+	return result
+}
+
+// NewMembers returns the members of a newly created project, which only contain its owner
+// userEmail: Mandatory. The email of the user who creates the project
+// Returns the members of the project
+func NewMembers(userEmail string) map[string]models.MemberRole {
+	return map[string]models.MemberRole{userEmail: models.MemberRoleOwner}
+}
+
+// ListMembers returns the members of a project ordered by their emails
+// members: Mandatory. The roles of the members of the project
+// Returns the members of the project
+func ListMembers(members map[string]models.MemberRole) []models.Member {
+	result := make([]models.Member, 0, len(members))
+	for userEmail, role := range members {
+		result = append(result, models.Member{UserEmail: userEmail, Role: role})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UserEmail < result[j].UserEmail
+	})
+
+	return result
+}
+
+// ListInvitations returns the pending invitations of a project ordered by their emails
+// invitations: Mandatory. The pending invitations of the project
+// Returns the pending invitations of the project
+func ListInvitations(invitations map[string]Invitation) []models.Invitation {
+	result := make([]models.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, invitation.Invitation)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Email < result[j].Email
+	})
+
+	return result
+}
+
+// NewIdempotencyRecord returns the record to store for the idempotency key a project was created with
+// key: Mandatory. The idempotency key of the request that created the project
+// projectID: Mandatory. The identifier of the created project
+// Returns the new record
+func NewIdempotencyRecord(key repository.IdempotencyKey, projectID string) IdempotencyRecord {
+	return IdempotencyRecord{
+		PayloadHash: key.PayloadHash,
+		ExpiresAt:   key.ExpiresAt,
+		ProjectID:   projectID,
+	}
+}
+
+// ReplayIdempotencyKey returns the identifier of the project created by the request the idempotency key was
+// first used with, if the key is still remembered
+// record: Optional. The stored record of the key, nil if the key is not stored
+// key: Mandatory. The idempotency key of the request
+// Returns the identifier of the created project and true if the request is a retry of the original request,
+// false if the project must be created, or IdempotencyKeyReusedError if the key was used with a different payload
+func ReplayIdempotencyKey(record *IdempotencyRecord, key repository.IdempotencyKey) (string, bool, error) {
+	if record == nil || !now().Before(record.ExpiresAt) {
+		return "", false, nil
+	}
+
+	if record.PayloadHash != key.PayloadHash {
+		return "", false, models.NewIdempotencyKeyReusedError()
+	}
+
+	return record.ProjectID, true, nil
+}
+
+// NewOutboxEvent returns a copy of the event to write to the outbox together with the change of the project, with
+// the fields managed by the repository services set
+// event: Mandatory. The event provided with the change of the project
+// projectID: Mandatory. The identifier of the changed project
+// Returns the event to write to the outbox
+func NewOutboxEvent(event models.DomainEvent, projectID string) models.DomainEvent {
+	event.ProjectID = projectID
+
+	return event
+}
+
+// NewCascadeEvent returns the event to write to the outbox for one of the projects changed together by a single
+// request, e.g. the projects deleted together with their folder. The event is provided once for all the projects,
+// so the identifier of each copy is derived from the identifiers of the event and the project to stay unique.
+// event: Optional. The event provided with the request
+// projectID: Mandatory. The identifier of the changed project
+// Returns the event to write to the outbox, or nil if no event is provided
+func NewCascadeEvent(event *models.DomainEvent, projectID string) *models.DomainEvent {
+	if event == nil {
+		return nil
+	}
+
+	cascadeEvent := NewOutboxEvent(*event, projectID)
+	cascadeEvent.EventID = event.EventID + "-" + projectID
+
+	return &cascadeEvent
+}
+
+// TransferMembers changes the roles of the members of a project whose ownership is transferred to another
+// user. The previous owner becomes an editor and the new owner is added to the members if needed.
+// members: Mandatory. The roles of the members of the project, which are changed in place
+// fromUserEmail: Mandatory. The email of the previous owner
+// toUserEmail: Mandatory. The email of the new owner
+func TransferMembers(members map[string]models.MemberRole, fromUserEmail, toUserEmail string) {
+	members[fromUserEmail] = models.MemberRoleEditor
+	members[toUserEmail] = models.MemberRoleOwner
+}
+
+// NewProjectID generates a new unique project identifier. ObjectIDs are used so the identifiers and the
+// cursors look and sort exactly like the ones generated by the MongoDB repository service.
+// Returns the new project identifier
+func NewProjectID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// NewOrganizationID generates a new unique organization identifier, which looks like the project identifiers
+// Returns the new organization identifier
+func NewOrganizationID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// NewOrganization returns a copy of the organization to store, with the fields managed by the repository
+// services set for a newly created organization
+// userEmail: Mandatory. The email of the user who creates the organization
+// organization: Mandatory. The organization provided by the user
+// Returns the organization to store
+func NewOrganization(userEmail string, organization models.Organization) models.Organization {
+	createdAt := now()
+
+	return models.Organization{
+		Name:        organization.Name,
+		Description: organization.Description,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		CreatedBy:   userEmail,
+	}
+}
+
+// UpdateOrganization returns a copy of the stored organization updated with the fields the users can change
+// storedOrganization: Mandatory. The currently stored organization
+// organization: Mandatory. The organization that contains the updated details provided by the user
+// Returns the organization to store
+func UpdateOrganization(storedOrganization, organization models.Organization) models.Organization {
+	return models.Organization{
+		Name:        organization.Name,
+		Description: organization.Description,
+		CreatedAt:   storedOrganization.CreatedAt,
+		UpdatedAt:   now(),
+		CreatedBy:   storedOrganization.CreatedBy,
+	}
+}
+
+// NewFolderID generates a new unique folder identifier, which looks like the project identifiers
+// Returns the new folder identifier
+func NewFolderID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// NewFolder returns a copy of the folder to store, with the fields managed by the repository services set for
+// a newly created folder
+// folder: Mandatory. The folder provided by the user
+// Returns the folder to store
+func NewFolder(folder models.Folder) models.Folder {
+	return models.Folder{
+		Name:           folder.Name,
+		ParentFolderID: folder.ParentFolderID,
+		CreatedAt:      now(),
+	}
+}
+
+// ListFolders returns the folders of the user ordered by their names, the folders that share the same name
+// are ordered by their identifiers
+// folders: Mandatory. The stored folders, in any order
+// userEmail: Mandatory. The email of the user
+// Returns the folders of the user
+func ListFolders(folders []Folder, userEmail string) []models.FolderWithID {
+	result := []models.FolderWithID{}
+	for _, folder := range folders {
+		if folder.UserEmail == userEmail {
+			result = append(result, models.FolderWithID{
+				FolderID: folder.FolderID,
+				Folder:   folder.Folder,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Folder.Name != result[j].Folder.Name {
+			return result[i].Folder.Name < result[j].Folder.Name
+		}
+
+		return result[i].FolderID < result[j].FolderID
+	})
+
+	return result
+}
+
+// FolderTree returns the identifiers of the folder of the user and, if requested, the identifiers of all its
+// subfolders at any depth
+// folders: Mandatory. The stored folders, in any order
+// userEmail: Mandatory. The email of the user
+// folderID: Mandatory. The folder at the top of the tree
+// includeSubfolders: Mandatory. Indicates whether the subfolders are included
+// Returns the identifiers of the folders and true if the folder of the user exists, otherwise returns false
+func FolderTree(folders []Folder, userEmail, folderID string, includeSubfolders bool) (map[string]bool, bool) {
+	children := map[string][]string{}
+	found := false
+	for _, folder := range folders {
+		if folder.UserEmail != userEmail {
+			continue
+		}
+
+		if folder.FolderID == folderID {
+			found = true
+		}
+
+		children[folder.Folder.ParentFolderID] = append(children[folder.Folder.ParentFolderID], folder.FolderID)
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	tree := map[string]bool{folderID: true}
+	if !includeSubfolders {
+		return tree, true
+	}
+
+	for pending := []string{folderID}; len(pending) > 0; pending = pending[1:] {
+		for _, childID := range children[pending[0]] {
+			tree[childID] = true
+			pending = append(pending, childID)
+		}
+	}
+
+	return tree, true
+}
+
+// CopyProject returns a copy of the project that does not share its labels and annotations with the project, so
+// the projects handed out by the repository services cannot change the stored projects
+// project: Mandatory. The project to copy
+// Returns the copy of the project
+func CopyProject(project models.Project) models.Project {
+	project.Labels = copyMap(project.Labels)
+	project.Annotations = copyMap(project.Annotations)
+
+	return project
+}
+
+// NewProject returns a copy of the project to store, with the fields managed by the repository services
+// set for a newly created project
+// userEmail: Mandatory. The email of the user who creates the project
+// project: Mandatory. The project provided by the user
+// Returns the project to store
+func NewProject(userEmail string, project models.Project) models.Project {
+	createdAt := now()
+
+	return models.Project{
+		Name:           project.Name,
+		Description:    project.Description,
+		Labels:         copyMap(project.Labels),
+		Annotations:    copyMap(project.Annotations),
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+		CreatedBy:      userEmail,
+		Version:        1,
+		OrganizationID: project.OrganizationID,
+	}
+}
+
+// UpdateProject returns a copy of the stored project updated with the fields the users can change
+// storedProject: Mandatory. The currently stored project
+// project: Mandatory. The project that contains the updated details provided by the user
+// updateMask: Optional. The paths of the fields to update, all the fields the users can change are updated if
+// it is empty
+// Returns the project to store
+func UpdateProject(storedProject, project models.Project, updateMask []string) models.Project {
+	updatedProject := models.Project{
+		Name:           storedProject.Name,
+		Description:    storedProject.Description,
+		Labels:         copyMap(storedProject.Labels),
+		Annotations:    copyMap(storedProject.Annotations),
+		CreatedAt:      storedProject.CreatedAt,
+		UpdatedAt:      now(),
+		CreatedBy:      storedProject.CreatedBy,
+		Version:        storedProject.Version + 1,
+		State:          storedProject.State,
+		OrganizationID: storedProject.OrganizationID,
+		FolderID:       storedProject.FolderID,
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectNamePath) {
+		updatedProject.Name = project.Name
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectDescriptionPath) {
+		updatedProject.Description = project.Description
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectLabelsPath) {
+		updatedProject.Labels = copyMap(project.Labels)
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectAnnotationsPath) {
+		updatedProject.Annotations = copyMap(project.Annotations)
+	}
+
+	return updatedProject
+}
+
+// UpdateProjectState returns a copy of the stored project with the new state
+// storedProject: Mandatory. The currently stored project
+// state: Mandatory. The new state of the project
+// Returns the project to store
+func UpdateProjectState(storedProject models.Project, state models.ProjectState) models.Project {
+	project := storedProject
+	project.State = state
+	project.Version = storedProject.Version + 1
+
+	return project
+}
+
+// MoveProject returns a copy of the stored project moved into the folder
+// storedProject: Mandatory. The currently stored project
+// folderID: Optional. The folder to move the project into, empty moves the project out of its folder
+// Returns the project to store
+func MoveProject(storedProject models.Project, folderID string) models.Project {
+	project := storedProject
+	project.FolderID = folderID
+	project.Version = storedProject.Version + 1
+
+	return project
+}
+
+// TransferProjectOwnership returns a copy of the stored project whose ownership is transferred to another user
+// storedProject: Mandatory. The currently stored project
+// Returns the project to store
+func TransferProjectOwnership(storedProject models.Project) models.Project {
+	project := storedProject
+	project.Version = storedProject.Version + 1
+
+	return project
+}
+
+// DeleteProject returns a copy of the stored project marked as deleted
+// storedProject: Mandatory. The currently stored project
+// Returns the project to store
+func DeleteProject(storedProject models.Project) models.Project {
+	project := storedProject
+	project.DeletedAt = now()
+	project.Version = storedProject.Version + 1
+
+	return project
+}
+
+// RestoreProject returns a copy of the stored deleted project that is not marked as deleted anymore
+// storedProject: Mandatory. The currently stored deleted project
+// Returns the project to store
+func RestoreProject(storedProject models.Project) models.Project {
+	project := storedProject
+	project.DeletedAt = time.Time{}
+	project.Version = storedProject.Version + 1
+
+	return project
+}
+
+// IsDeleted indicates whether the project is marked as deleted
+// project: Mandatory. The stored project
+// Returns true if the project is deleted, otherwise returns false
+func IsDeleted(project models.Project) bool {
+	return !project.DeletedAt.IsZero()
+}
+
+// MatchesVersion checks the version of the stored project against the version an update or a deletion is
+// based on
+// storedProject: Mandatory. The currently stored project
+// expectedVersion: Optional. The version the operation is based on, zero skips the check
+// Returns true if the operation can be applied to the stored project, otherwise returns false
+func MatchesVersion(storedProject models.Project, expectedVersion int64) bool {
+	return expectedVersion == 0 || storedProject.Version == expectedVersion
+}
+
+// ListProjects returns the projects that matched the criteria
+// projects: Mandatory. All the stored projects, in any order
+// organizationRoles: Mandatory. The roles of the user in the organizations the user is a member of
+// folders: Mandatory. All the stored folders, in any order
+// request: Mandatory. The request contains the search criteria
+// Returns the list of projects that matched the criteria or error if something goes wrong
+func ListProjects(
+	projects []Project,
+	organizationRoles map[string]models.MemberRole,
+	folders []Folder,
+	request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
+	response := &repository.ListProjectsResponse{
+		HasPreviousPage: false,
+		HasNextPage:     false,
+	}
+
+	if err := models.ValidateSortingOptions(request.SortingOptions); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("sortingOptions", "", err)
+	}
+
+	if request.Filter != nil {
+		if err := request.Filter.Validate(); err != nil {
+			return nil, commonErrors.NewArgumentErrorWithError("filter", "", err)
+		}
+	}
+
+	var folderIDs map[string]bool
+	if request.FolderID != "" {
+		var ok bool
+		if folderIDs, ok = FolderTree(folders, request.UserEmail, request.FolderID, request.IncludeSubfolders); !ok {
+			// Like an unknown organization, an unknown folder does not match any project
+			return response, nil
+		}
+	}
+
+	projectIDs := map[string]bool{}
+	for _, projectID := range request.ProjectIDs {
+		if _, err := primitive.ObjectIDFromHex(projectID); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the projectID: %s.", projectID), err)
+		}
+
+		projectIDs[projectID] = true
+	}
+
+	var after, before []repository.SortKey
+	if request.Pagination.After != nil {
+		var err error
+		if after, err = repository.ParseCursor(*request.Pagination.After, request.SortingOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Pagination.Before != nil {
+		var err error
+		if before, err = repository.ParseCursor(*request.Pagination.Before, request.SortingOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	filteredProjects := []Project{}
+	for _, project := range projects {
+		if !project.CanAccess(request.UserEmail, organizationRoles) {
+			continue
+		}
+
+		if request.OrganizationID != "" && project.Project.OrganizationID != request.OrganizationID {
+			continue
+		}
+
+		if folderIDs != nil && !folderIDs[project.Project.FolderID] {
+			continue
+		}
+
+		if len(projectIDs) > 0 && !projectIDs[project.ProjectID] {
+			continue
+		}
+
+		if !request.IncludeArchived && project.Project.State == models.ProjectStateArchived {
+			continue
+		}
+
+		if request.Filter != nil && !matchesFilter(project.Project, *request.Filter) {
+			continue
+		}
+
+		filteredProjects = append(filteredProjects, project)
+	}
+
+	response.TotalCount = int64(len(filteredProjects))
+	if response.TotalCount == 0 {
+		// No project matched the filter criteria
+		return response, nil
+	}
+
+	sortProjects(filteredProjects, request.SortingOptions)
+
+	// After and Before are compared against all the sort keys of the projects, so the pages are stable in any
+	// sort order
+	window := []Project{}
+	for _, project := range filteredProjects {
+		if after != nil && compareToCursor(project, after) <= 0 {
+			response.HasPreviousPage = true
+
+			continue
+		}
+
+		if before != nil && compareToCursor(project, before) >= 0 {
+			response.HasNextPage = true
+
+			continue
+		}
+
+		window = append(window, project)
+	}
+
+	if request.Pagination.First != nil && len(window) > *request.Pagination.First {
+		window = window[:*request.Pagination.First]
+		response.HasNextPage = true
+	}
+
+	if request.Pagination.Last != nil && len(window) > *request.Pagination.Last {
+		window = window[len(window)-*request.Pagination.Last:]
+		response.HasPreviousPage = true
+	}
+
+	response.Projects = []models.ProjectWithCursor{}
+	for _, project := range window {
+		response.Projects = append(response.Projects, models.ProjectWithCursor{
+			ProjectID: project.ProjectID,
+			Project:   project.Project,
+			Cursor:    repository.NewCursor(request.SortingOptions, project.ProjectID, project.Project),
+		})
+	}
+
+	return response, nil
+}
+
+// SearchProjects returns the projects that matched the full-text query, the most relevant ones first
+// projects: Mandatory. The stored projects that are not deleted and may match the query, in any order
+// organizationRoles: Mandatory. The roles of the user in the organizations the user is a member of
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query or error if something goes wrong
+func SearchProjects(
+	projects []Project,
+	organizationRoles map[string]models.MemberRole,
+	request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	terms := search.Terms(request.Query)
+	results := []models.ProjectSearchResult{}
+
+	for _, project := range projects {
+		if !project.CanAccess(request.UserEmail, organizationRoles) {
+			continue
+		}
+
+		if !request.IncludeArchived && project.Project.State == models.ProjectStateArchived {
+			continue
+		}
+
+		score := search.Score(terms, project.Project)
+		if score == 0 {
+			continue
+		}
+
+		results = append(results, models.ProjectSearchResult{
+			ProjectID:  project.ProjectID,
+			Project:    project.Project,
+			Score:      score,
+			Highlights: search.Highlights(terms, project.Project),
+		})
+	}
+
+	return search.Paginate(results, request.Pagination)
+}
+
+// matchesFilter indicates whether the project matches the filter expression, which must be valid
+func matchesFilter(project models.Project, filter models.ProjectFilter) bool {
+	if len(filter.Filters) > 0 {
+		for _, nestedFilter := range filter.Filters {
+			matches := matchesFilter(project, nestedFilter)
+			if filter.Operator == models.FilterOperatorOr && matches {
+				return true
+			}
+
+			if filter.Operator == models.FilterOperatorAnd && !matches {
+				return false
+			}
+		}
+
+		return filter.Operator == models.FilterOperatorAnd
+	}
+
+	switch {
+	case filter.Name != nil:
+		return matchesName(project.Name, *filter.Name)
+	case filter.Label != nil:
+		return matchesLabel(project.Labels, *filter.Label)
+	case filter.CreatedAt != nil:
+		return filter.CreatedAt.Contains(project.CreatedAt)
+	case filter.UpdatedAt != nil:
+		return filter.UpdatedAt.Contains(project.UpdatedAt)
+	case filter.State != nil:
+		return project.State == *filter.State
+	}
+
+	return false
+}
+
+func matchesName(name string, condition models.NameCondition) bool {
+	switch condition.Operator {
+	case models.NameOperatorPrefix:
+		return strings.HasPrefix(name, condition.Value)
+	case models.NameOperatorContains:
+		return strings.Contains(name, condition.Value)
+	default:
+		return name == condition.Value
+	}
+}
+
+func matchesLabel(labels map[string]string, selector models.LabelSelector) bool {
+	value, exists := labels[selector.Key]
+
+	switch selector.Operator {
+	case models.LabelOperatorNotIn:
+		return !exists || !containsString(selector.Values, value)
+	case models.LabelOperatorExists:
+		return exists
+	case models.LabelOperatorDoesNotExist:
+		return !exists
+	default:
+		return exists && containsString(selector.Values, value)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortProjects sorts the projects by their identifier, which is the insertion order, and then applies
+// the requested sorting options in order. The sorting options must be valid.
+func sortProjects(projects []Project, sortingOptions []common.SortingOptionPair) {
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].ProjectID < projects[j].ProjectID
+	})
+
+	sort.SliceStable(projects, func(i, j int) bool {
+		for _, sortingOption := range sortingOptions {
+			result := compareSortingValues(projects[i], projects[j], sortingOption.Name)
+			if sortingOption.Direction == common.Descending {
+				result = -result
+			}
+
+			if result != 0 {
+				return result < 0
+			}
+		}
+
+		return false
+	})
+}
+
+// compareSortingValues compares the values of the field of the ProjectSortFields catalogue of the projects
+func compareSortingValues(project, otherProject Project, name string) int {
+	switch name {
+	case "_id":
+		return strings.Compare(project.ProjectID, otherProject.ProjectID)
+	case "name":
+		return strings.Compare(project.Project.Name, otherProject.Project.Name)
+	case "createdAt":
+		return compareTimes(project.Project.CreatedAt, otherProject.Project.CreatedAt)
+	case "updatedAt":
+		return compareTimes(project.Project.UpdatedAt, otherProject.Project.UpdatedAt)
+	default:
+		return 0
+	}
+}
+
+// compareToCursor compares the project to the position defined by the sort keys of a cursor in the sort order
+// of the keys. It returns a negative number if the project comes before the cursor, zero if the project is at
+// the cursor and a positive number if the project comes after the cursor.
+func compareToCursor(project Project, keys []repository.SortKey) int {
+	for _, key := range keys {
+		result := 0
+		switch value := key.Value.(type) {
+		case string:
+			if key.Field == "_id" {
+				result = strings.Compare(project.ProjectID, value)
+			} else {
+				result = strings.Compare(project.Project.Name, value)
+			}
+		case time.Time:
+			if key.Field == "createdAt" {
+				result = compareTimes(project.Project.CreatedAt, value)
+			} else {
+				result = compareTimes(project.Project.UpdatedAt, value)
+			}
+		}
+
+		if key.Descending {
+			result = -result
+		}
+
+		if result != 0 {
+			return result
+		}
+	}
+
+	return 0
+}
+
+func compareTimes(value, otherValue time.Time) int {
+	if value.Before(otherValue) {
+		return -1
+	}
+
+	if value.After(otherValue) {
+		return 1
+	}
+
+	return 0
+}
+
+// now returns the current time, truncated to milliseconds like the time stored by the MongoDB repository service
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// copyMap copies the map, so the stored projects never share a map with the callers
+func copyMap(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	return result
+}
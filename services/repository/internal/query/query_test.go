@@ -0,0 +1,172 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestQuery(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Query Tests")
+}
+
+var _ = Describe("Query Tests", func() {
+	var (
+		userEmail string
+		createdAt time.Time
+		projects  []query.Project
+	)
+
+	BeforeEach(func() {
+		userEmail = cuid.New() + "@test.com"
+		createdAt = time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+		newProject := func(name string, labels map[string]string, state models.ProjectState, age time.Duration) query.Project {
+			return query.Project{
+				ProjectID: query.NewProjectID(),
+				UserEmail: userEmail,
+				Project: models.Project{
+					Name:      name,
+					Labels:    labels,
+					State:     state,
+					CreatedAt: createdAt.Add(-age),
+					UpdatedAt: createdAt,
+				},
+				Members: query.NewMembers(userEmail),
+			}
+		}
+
+		projects = []query.Project{
+			newProject("Payments API", map[string]string{"env": "prod", "tier": "web"}, models.ProjectStateActive, 0),
+			newProject("Payments UI", map[string]string{"env": "staging"}, models.ProjectStateActive, time.Hour),
+			newProject("Billing", nil, models.ProjectStateActive, 2*time.Hour),
+			newProject("Ledger", map[string]string{"tier": "db"}, models.ProjectStateArchived, 3*time.Hour),
+		}
+	})
+
+	listProjects := func(filter models.ProjectFilter) (*repository.ListProjectsResponse, error) {
+		return query.ListProjects(projects, map[string]models.MemberRole{}, []query.Folder{}, &repository.ListProjectsRequest{
+			UserEmail:       userEmail,
+			IncludeArchived: true,
+			Filter:          &filter,
+		})
+	}
+
+	label := func(key string, operator models.LabelOperator, values ...string) models.ProjectFilter {
+		return models.ProjectFilter{Label: &models.LabelSelector{Key: key, Operator: operator, Values: values}}
+	}
+
+	name := func(operator models.NameOperator, value string) models.ProjectFilter {
+		return models.ProjectFilter{Name: &models.NameCondition{Operator: operator, Value: value}}
+	}
+
+	group := func(operator models.FilterOperator, filters ...models.ProjectFilter) models.ProjectFilter {
+		return models.ProjectFilter{Operator: operator, Filters: filters}
+	}
+
+	archived := models.ProjectStateArchived
+
+	table.DescribeTable("the projects are listed with a filter",
+		func(getFilter func() models.ProjectFilter, expectedIndexes []int) {
+			response, err := listProjects(getFilter())
+			Ω(err).Should(BeNil())
+
+			expectedProjectIDs := []string{}
+			for _, idx := range expectedIndexes {
+				expectedProjectIDs = append(expectedProjectIDs, projects[idx].ProjectID)
+			}
+
+			projectIDs := []string{}
+			for _, project := range response.Projects {
+				projectIDs = append(projectIDs, project.ProjectID)
+			}
+
+			Ω(projectIDs).Should(ConsistOf(expectedProjectIDs))
+			Ω(response.TotalCount).Should(Equal(int64(len(expectedIndexes))))
+		},
+		table.Entry("In matches the projects that have the label with one of the values",
+			func() models.ProjectFilter { return label("env", models.LabelOperatorIn, "prod", "qa") }, []int{0}),
+		table.Entry("In matches every listed value",
+			func() models.ProjectFilter { return label("env", models.LabelOperatorIn, "prod", "staging") }, []int{0, 1}),
+		table.Entry("NotIn matches the projects whose label has another value or that do not have the label",
+			func() models.ProjectFilter { return label("env", models.LabelOperatorNotIn, "prod") }, []int{1, 2, 3}),
+		table.Entry("NotIn matches the projects that have no label at all",
+			func() models.ProjectFilter { return label("owner", models.LabelOperatorNotIn, "team") }, []int{0, 1, 2, 3}),
+		table.Entry("Exists matches the projects that have the label regardless of its value",
+			func() models.ProjectFilter { return label("env", models.LabelOperatorExists) }, []int{0, 1}),
+		table.Entry("DoesNotExist matches the projects that do not have the label or have no label at all",
+			func() models.ProjectFilter { return label("env", models.LabelOperatorDoesNotExist) }, []int{2, 3}),
+		table.Entry("DoesNotExist does not match any project if all of them have the label",
+			func() models.ProjectFilter {
+				return group(models.FilterOperatorAnd, label("env", models.LabelOperatorDoesNotExist), label("env", models.LabelOperatorExists))
+			}, []int{}),
+		table.Entry("Equals matches the names case-sensitively",
+			func() models.ProjectFilter { return name(models.NameOperatorEquals, "billing") }, []int{}),
+		table.Entry("Equals matches the whole name",
+			func() models.ProjectFilter { return name(models.NameOperatorEquals, "Billing") }, []int{2}),
+		table.Entry("Prefix matches the start of the names",
+			func() models.ProjectFilter { return name(models.NameOperatorPrefix, "Payments") }, []int{0, 1}),
+		table.Entry("Contains matches any part of the names",
+			func() models.ProjectFilter { return name(models.NameOperatorContains, "UI") }, []int{1}),
+		table.Entry("State matches the projects in the state",
+			func() models.ProjectFilter { return models.ProjectFilter{State: &archived} }, []int{3}),
+		table.Entry("CreatedAt includes the start of the range and excludes its end",
+			func() models.ProjectFilter {
+				return models.ProjectFilter{CreatedAt: &models.TimeRange{From: createdAt.Add(-2 * time.Hour), To: createdAt}}
+			}, []int{1, 2}),
+		table.Entry("CreatedAt without an end matches every later project",
+			func() models.ProjectFilter {
+				return models.ProjectFilter{CreatedAt: &models.TimeRange{From: createdAt.Add(-time.Hour)}}
+			}, []int{0, 1}),
+		table.Entry("UpdatedAt matches the update times",
+			func() models.ProjectFilter {
+				return models.ProjectFilter{UpdatedAt: &models.TimeRange{To: createdAt}}
+			}, []int{}),
+		table.Entry("And matches the projects that match all the nested filters",
+			func() models.ProjectFilter {
+				return group(models.FilterOperatorAnd, name(models.NameOperatorPrefix, "Payments"), label("env", models.LabelOperatorNotIn, "staging"))
+			}, []int{0}),
+		table.Entry("Or matches the projects that match any of the nested filters",
+			func() models.ProjectFilter {
+				return group(models.FilterOperatorOr, label("env", models.LabelOperatorDoesNotExist), label("env", models.LabelOperatorIn, "staging"))
+			}, []int{1, 2, 3}),
+		table.Entry("the groups are nested",
+			func() models.ProjectFilter {
+				return group(
+					models.FilterOperatorOr,
+					group(models.FilterOperatorAnd, label("tier", models.LabelOperatorExists), models.ProjectFilter{State: &archived}),
+					name(models.NameOperatorEquals, "Billing"))
+			}, []int{2, 3}),
+	)
+
+	When("the filter is not valid", func() {
+		It("should return ArgumentError", func() {
+			response, err := listProjects(label("env", models.LabelOperatorNotIn))
+			Ω(response).Should(BeNil())
+			Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+		})
+	})
+
+	When("the archived projects are not included", func() {
+		It("should not match the archived projects", func() {
+			filter := label("env", models.LabelOperatorDoesNotExist)
+			response, err := query.ListProjects(projects, map[string]models.MemberRole{}, []query.Folder{}, &repository.ListProjectsRequest{
+				UserEmail: userEmail,
+				Filter:    &filter,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Projects).Should(HaveLen(1))
+			Ω(response.Projects[0].ProjectID).Should(Equal(projects[2].ProjectID))
+		})
+	})
+})
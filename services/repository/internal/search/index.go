@@ -0,0 +1,69 @@
+package search
+
+import "github.com/decentralized-cloud/project/models"
+
+// Index is an inverted index that maps the words of the name and the description of the projects to the
+// identifiers of the projects that contain them. The index is not safe for concurrent use, the callers must
+// synchronize the access to it.
+type Index struct {
+	// postings maps every word to the identifiers of the projects that contain it
+	postings map[string]map[string]bool
+	// projectTerms maps the identifiers of the indexed projects to their words, so they can be removed
+	projectTerms map[string][]string
+}
+
+// NewIndex creates an empty inverted index
+// Returns the new index
+func NewIndex() *Index {
+	return &Index{
+		postings:     map[string]map[string]bool{},
+		projectTerms: map[string][]string{},
+	}
+}
+
+// Add indexes the words of the project, replacing the words indexed for the project before
+// projectID: Mandatory. The unique project identifier
+// project: Mandatory. The project to index
+func (index *Index) Add(projectID string, project models.Project) {
+	index.Remove(projectID)
+
+	terms := ProjectTerms(project)
+	for _, term := range terms {
+		projectIDs, ok := index.postings[term]
+		if !ok {
+			projectIDs = map[string]bool{}
+			index.postings[term] = projectIDs
+		}
+
+		projectIDs[projectID] = true
+	}
+
+	index.projectTerms[projectID] = terms
+}
+
+// Remove removes the words of the project from the index
+// projectID: Mandatory. The unique project identifier
+func (index *Index) Remove(projectID string) {
+	for _, term := range index.projectTerms[projectID] {
+		delete(index.postings[term], projectID)
+		if len(index.postings[term]) == 0 {
+			delete(index.postings, term)
+		}
+	}
+
+	delete(index.projectTerms, projectID)
+}
+
+// Lookup returns the identifiers of the projects that contain any of the search terms
+// terms: Mandatory. The distinct lower-cased search terms
+// Returns the identifiers of the matched projects
+func (index *Index) Lookup(terms []string) map[string]bool {
+	projectIDs := map[string]bool{}
+	for _, term := range terms {
+		for projectID := range index.postings[term] {
+			projectIDs[projectID] = true
+		}
+	}
+
+	return projectIDs
+}
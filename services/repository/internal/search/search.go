@@ -0,0 +1,288 @@
+// Package search implements the tokenizing, scoring, highlighting and pagination of the full-text project
+// search shared by the repository services, together with the inverted index used by the repository services
+// that evaluate the search requests in process instead of delegating them to a database server
+package search
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+const (
+	// NameField is the name of the project name field in the highlights
+	NameField = "name"
+
+	// DescriptionField is the name of the project description field in the highlights
+	DescriptionField = "description"
+
+	// nameWeight and descriptionWeight define how much a match in the name or the description contributes to
+	// the score of a project. They match the weights of the text index of the MongoDB repository service.
+	nameWeight        = 2
+	descriptionWeight = 1
+
+	// cursorSort identifies the keys the search results are sorted by in their cursors
+	cursorSort = "-score,_id"
+)
+
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// Terms splits the text into the distinct lower-cased words it contains, in the order they first appear.
+// Every character that is neither a letter nor a digit separates two words.
+// text: Mandatory. The text to split
+// Returns the distinct words of the text
+func Terms(text string) []string {
+	terms := []string{}
+	seen := map[string]bool{}
+
+	for _, token := range tokenize(text) {
+		if !seen[token.term] {
+			seen[token.term] = true
+			terms = append(terms, token.term)
+		}
+	}
+
+	return terms
+}
+
+// ProjectTerms returns the distinct words of the name and the description of the project
+// project: Mandatory. The project to return its words
+// Returns the distinct words of the project
+func ProjectTerms(project models.Project) []string {
+	return Terms(project.Name + " " + project.Description)
+}
+
+// Score returns the relevance of the project to the search terms, or zero if the project does not contain any
+// of the terms. Like the MongoDB text search, every term contributes to the score of every field it is found in
+// in proportion to the weight of the field and to how often it is found in the field.
+// terms: Mandatory. The distinct lower-cased search terms
+// project: Mandatory. The project to score
+// Returns the relevance score of the project
+func Score(terms []string, project models.Project) float64 {
+	return scoreField(terms, project.Name, nameWeight) + scoreField(terms, project.Description, descriptionWeight)
+}
+
+// Highlights returns the ranges of the name and the description of the project that matched the search terms.
+// The fields that did not match any of the terms are not returned.
+// terms: Mandatory. The distinct lower-cased search terms
+// project: Mandatory. The project to highlight
+// Returns the highlights of the project
+func Highlights(terms []string, project models.Project) []models.Highlight {
+	highlights := []models.Highlight{}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{name: NameField, value: project.Name},
+		{name: DescriptionField, value: project.Description},
+	} {
+		ranges := []models.TextRange{}
+		for _, token := range tokenize(field.value) {
+			if containsTerm(terms, token.term) {
+				ranges = append(ranges, models.TextRange{Start: token.start, End: token.end})
+			}
+		}
+
+		if len(ranges) > 0 {
+			highlights = append(highlights, models.Highlight{Field: field.name, Ranges: ranges})
+		}
+	}
+
+	return highlights
+}
+
+// Paginate orders the search results by their score, the highest first, and then by their project identifier,
+// sets their cursors and returns the page of the results requested by the pagination. The cursor of a result
+// contains both its score and its project identifier, so the pages stay stable while the results are ranked
+// by their score.
+// results: Mandatory. All the results that matched the search query, in any order
+// pagination: Mandatory. The page of the results to return
+// Returns the requested page of the results or error if something goes wrong
+func Paginate(
+	results []models.ProjectSearchResult,
+	pagination common.Pagination) (*repository.SearchProjectsResponse, error) {
+	response := &repository.SearchProjectsResponse{
+		HasPreviousPage: false,
+		HasNextPage:     false,
+		TotalCount:      int64(len(results)),
+		Projects:        []models.ProjectSearchResult{},
+	}
+
+	var after, before *cursor
+	if pagination.After != nil {
+		parsedCursor, err := parseCursor(*pagination.After)
+		if err != nil {
+			return nil, err
+		}
+
+		after = &parsedCursor
+	}
+
+	if pagination.Before != nil {
+		parsedCursor, err := parseCursor(*pagination.Before)
+		if err != nil {
+			return nil, err
+		}
+
+		before = &parsedCursor
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return newCursor(results[i]).less(newCursor(results[j]))
+	})
+
+	window := []models.ProjectSearchResult{}
+	for _, result := range results {
+		resultCursor := newCursor(result)
+		if after != nil && !after.less(resultCursor) {
+			response.HasPreviousPage = true
+
+			continue
+		}
+
+		if before != nil && !resultCursor.less(*before) {
+			response.HasNextPage = true
+
+			continue
+		}
+
+		result.Cursor = resultCursor.String()
+		window = append(window, result)
+	}
+
+	if pagination.First != nil && len(window) > *pagination.First {
+		window = window[:*pagination.First]
+		response.HasNextPage = true
+	}
+
+	if pagination.Last != nil && len(window) > *pagination.Last {
+		window = window[len(window)-*pagination.Last:]
+		response.HasPreviousPage = true
+	}
+
+	response.Projects = window
+
+	return response, nil
+}
+
+// cursor is the location of a search result in the ordered search results
+type cursor struct {
+	score     float64
+	projectID string
+}
+
+func newCursor(result models.ProjectSearchResult) cursor {
+	return cursor{score: result.Score, projectID: result.ProjectID}
+}
+
+// parseCursor decodes the cursor returned by cursor.String, it returns ArgumentError if the cursor is malformed
+// or is not a search cursor
+func parseCursor(value string) (cursor, error) {
+	values, err := repository.DecodeCursor(value, cursorSort)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	if len(values) != 2 || values[1] == "" {
+		return cursor{}, commonErrors.NewArgumentError("cursor", "cursor is malformed")
+	}
+
+	score, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return cursor{}, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+	}
+
+	return cursor{score: score, projectID: values[1]}, nil
+}
+
+// String returns the opaque form of the cursor, the score is formatted with the fewest digits that represent it
+// exactly, so the parsed cursor compares equal to the result it was created from
+func (c cursor) String() string {
+	return repository.EncodeCursor(cursorSort, []string{strconv.FormatFloat(c.score, 'g', -1, 64), c.projectID})
+}
+
+// less indicates whether the cursor comes before the other cursor. The project identifiers are compared by their
+// length first, so both the fixed-length hexadecimal and the decimal identifiers are ordered correctly.
+func (c cursor) less(other cursor) bool {
+	if c.score != other.score {
+		return c.score > other.score
+	}
+
+	if len(c.projectID) != len(other.projectID) {
+		return len(c.projectID) < len(other.projectID)
+	}
+
+	return c.projectID < other.projectID
+}
+
+func scoreField(terms []string, value string, weight float64) float64 {
+	tokens := tokenize(value)
+	if len(tokens) == 0 {
+		return 0
+	}
+
+	frequencies := map[string]int{}
+	for _, token := range tokens {
+		frequencies[token.term]++
+	}
+
+	score := 0.0
+	for _, term := range terms {
+		if frequency := frequencies[term]; frequency > 0 {
+			score += weight * (0.5 + 0.5*float64(frequency)/float64(len(tokens)))
+		}
+	}
+
+	return score
+}
+
+// tokenize splits the text into its lower-cased words together with their positions, counted in runes
+func tokenize(text string) []token {
+	tokens := []token{}
+	var builder strings.Builder
+	start := -1
+	position := 0
+
+	for _, character := range text {
+		if unicode.IsLetter(character) || unicode.IsDigit(character) {
+			if start < 0 {
+				start = position
+			}
+
+			builder.WriteRune(unicode.ToLower(character))
+		} else if start >= 0 {
+			tokens = append(tokens, token{term: builder.String(), start: start, end: position})
+			builder.Reset()
+			start = -1
+		}
+
+		position++
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, token{term: builder.String(), start: start, end: position})
+	}
+
+	return tokens
+}
+
+func containsTerm(terms []string, term string) bool {
+	for _, value := range terms {
+		if value == term {
+			return true
+		}
+	}
+
+	return false
+}
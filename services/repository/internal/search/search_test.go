@@ -0,0 +1,189 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestSearch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Search Tests")
+}
+
+var _ = Describe("Search Tests", func() {
+	table.DescribeTable("the text is split into terms",
+		func(text string, expectedTerms []string) {
+			Ω(search.Terms(text)).Should(Equal(expectedTerms))
+		},
+		table.Entry("the words are lower-cased and deduplicated", "Payments payments API", []string{"payments", "api"}),
+		table.Entry("every character that is neither a letter nor a digit separates two words", "v2-api_gateway!", []string{"v2", "api", "gateway"}),
+		table.Entry("the non-ASCII letters are part of the words", "Café ZÜRICH", []string{"café", "zürich"}),
+		table.Entry("the text without words has no term", " -- ", []string{}),
+	)
+
+	table.DescribeTable("the projects are scored",
+		func(terms []string, project models.Project, expectedScore float64) {
+			Ω(search.Score(terms, project)).Should(BeNumerically("~", expectedScore, 1e-9))
+		},
+		table.Entry("a project without any of the terms scores zero",
+			[]string{"ledger"}, models.Project{Name: "Payments API", Description: "Card payments"}, 0.0),
+		table.Entry("a term found in the name scores twice as much as in the description",
+			[]string{"payments"}, models.Project{Name: "Payments"}, 2.0),
+		table.Entry("a term found in the description scores the weight of the description",
+			[]string{"payments"}, models.Project{Description: "Payments"}, 1.0),
+		table.Entry("a term found in both fields scores in both",
+			[]string{"payments"}, models.Project{Name: "Payments", Description: "Payments"}, 3.0),
+		table.Entry("a term scores less in a longer field",
+			[]string{"payments"}, models.Project{Name: "Payments API"}, 1.5),
+		table.Entry("a term found more often in a field scores more",
+			[]string{"payments"}, models.Project{Description: "payments refunds payments"}, 0.5+0.5*2.0/3.0),
+		table.Entry("every term found scores",
+			[]string{"payments", "api"}, models.Project{Name: "Payments API"}, 3.0),
+		table.Entry("the terms are matched regardless of the case",
+			[]string{"über"}, models.Project{Name: "ÜBER"}, 2.0),
+	)
+
+	table.DescribeTable("the matched terms are highlighted",
+		func(terms []string, project models.Project, expectedHighlights []models.Highlight) {
+			Ω(search.Highlights(terms, project)).Should(Equal(expectedHighlights))
+		},
+		table.Entry("the fields that did not match are not highlighted",
+			[]string{"ledger"}, models.Project{Name: "Payments", Description: "Card payments"}, []models.Highlight{}),
+		table.Entry("every occurrence of the terms is highlighted in every field",
+			[]string{"payments"},
+			models.Project{Name: "Payments API", Description: "Card payments and payments"},
+			[]models.Highlight{
+				{Field: search.NameField, Ranges: []models.TextRange{{Start: 0, End: 8}}},
+				{Field: search.DescriptionField, Ranges: []models.TextRange{{Start: 5, End: 13}, {Start: 18, End: 26}}},
+			}),
+		table.Entry("the ranges are counted in runes after the multi-byte letters",
+			[]string{"zürich"},
+			models.Project{Name: "Café Zürich"},
+			[]models.Highlight{{Field: search.NameField, Ranges: []models.TextRange{{Start: 5, End: 11}}}}),
+		table.Entry("the ranges are counted in runes in the scripts without spaces between the words",
+			[]string{"プロジェクト"},
+			models.Project{Description: "日本 プロジェクト"},
+			[]models.Highlight{{Field: search.DescriptionField, Ranges: []models.TextRange{{Start: 3, End: 9}}}}),
+		table.Entry("the ranges are counted in runes after the symbols that separate the words",
+			[]string{"launch"},
+			models.Project{Name: "🚀 Launch"},
+			[]models.Highlight{{Field: search.NameField, Ranges: []models.TextRange{{Start: 2, End: 8}}}}),
+	)
+
+	Context("the search results are paginated", func() {
+		var (
+			results []models.ProjectSearchResult
+		)
+
+		BeforeEach(func() {
+			results = []models.ProjectSearchResult{
+				{ProjectID: "b", Score: 1},
+				{ProjectID: "10", Score: 2},
+				{ProjectID: "a", Score: 1},
+				{ProjectID: "9", Score: 2},
+			}
+		})
+
+		projectIDs := func(response []models.ProjectSearchResult) []string {
+			projectIDs := []string{}
+			for _, result := range response {
+				projectIDs = append(projectIDs, result.ProjectID)
+			}
+
+			return projectIDs
+		}
+
+		When("all the results are requested", func() {
+			It("should order the results by their score and then by their project identifiers", func() {
+				response, err := search.Paginate(results, common.Pagination{})
+				Ω(err).Should(BeNil())
+				Ω(response.TotalCount).Should(Equal(int64(4)))
+				Ω(response.HasPreviousPage).Should(BeFalse())
+				Ω(response.HasNextPage).Should(BeFalse())
+				Ω(projectIDs(response.Projects)).Should(Equal([]string{"9", "10", "a", "b"}))
+			})
+		})
+
+		When("the results are requested page by page", func() {
+			It("should return the results after the cursor of the previous page", func() {
+				first := 2
+				response, err := search.Paginate(results, common.Pagination{First: &first})
+				Ω(err).Should(BeNil())
+				Ω(projectIDs(response.Projects)).Should(Equal([]string{"9", "10"}))
+				Ω(response.HasNextPage).Should(BeTrue())
+
+				after := response.Projects[1].Cursor
+				response, err = search.Paginate(results, common.Pagination{After: &after, First: &first})
+				Ω(err).Should(BeNil())
+				Ω(projectIDs(response.Projects)).Should(Equal([]string{"a", "b"}))
+				Ω(response.HasPreviousPage).Should(BeTrue())
+				Ω(response.HasNextPage).Should(BeFalse())
+
+				before := response.Projects[0].Cursor
+				last := 1
+				response, err = search.Paginate(results, common.Pagination{Before: &before, Last: &last})
+				Ω(err).Should(BeNil())
+				Ω(projectIDs(response.Projects)).Should(Equal([]string{"10"}))
+				Ω(response.HasPreviousPage).Should(BeTrue())
+				Ω(response.HasNextPage).Should(BeTrue())
+			})
+		})
+
+		When("the cursor is malformed", func() {
+			It("should return ArgumentError", func() {
+				after := "malformed"
+				response, err := search.Paginate(results, common.Pagination{After: &after})
+				Ω(response).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the projects are indexed", func() {
+		var (
+			index *search.Index
+		)
+
+		BeforeEach(func() {
+			index = search.NewIndex()
+			index.Add("1", models.Project{Name: "Payments API", Description: "Card payments"})
+			index.Add("2", models.Project{Name: "Ledger", Description: "Payments ledger"})
+		})
+
+		When("the index is looked up", func() {
+			It("should return the projects that contain any of the terms", func() {
+				Ω(index.Lookup([]string{"payments"})).Should(Equal(map[string]bool{"1": true, "2": true}))
+				Ω(index.Lookup([]string{"api", "ledger"})).Should(Equal(map[string]bool{"1": true, "2": true}))
+				Ω(index.Lookup([]string{"card"})).Should(Equal(map[string]bool{"1": true}))
+				Ω(index.Lookup([]string{"missing"})).Should(BeEmpty())
+			})
+		})
+
+		When("a project is indexed again", func() {
+			It("should replace the terms indexed for the project before", func() {
+				index.Add("1", models.Project{Name: "Billing"})
+
+				Ω(index.Lookup([]string{"card"})).Should(BeEmpty())
+				Ω(index.Lookup([]string{"billing"})).Should(Equal(map[string]bool{"1": true}))
+				Ω(index.Lookup([]string{"payments"})).Should(Equal(map[string]bool{"2": true}))
+			})
+		})
+
+		When("a project is removed", func() {
+			It("should not return the project anymore", func() {
+				index.Remove("2")
+
+				Ω(index.Lookup([]string{"ledger"})).Should(BeEmpty())
+				Ω(index.Lookup([]string{"payments"})).Should(Equal(map[string]bool{"1": true}))
+			})
+		})
+	})
+})
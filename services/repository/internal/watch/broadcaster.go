@@ -0,0 +1,295 @@
+// Package watch implements the broadcaster the repository services that keep the projects in process notify the
+// watchers of the changes of the projects with
+package watch
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// historySize is the number of the latest changes the broadcaster keeps, so the watchers can resume from the
+// resource versions of these changes
+const historySize = 1000
+
+// maxPendingEvents is the number of events a watcher can fall behind before it is stopped with
+// ResourceVersionExpiredError, so a slow watcher cannot hold an unbounded number of events in memory
+const maxPendingEvents = 1000
+
+// Change defines a change of a project. Before and After are the users who could see the project before and after
+// the change, the users who are in only one of them are notified that the project is added or deleted. The users
+// who are in both of them are only notified if Modified is set, as the changes of the members and the
+// organizations do not change the project itself.
+type Change struct {
+	ProjectID string
+	Project   models.Project
+	Before    map[string]bool
+	After     map[string]bool
+	Modified  bool
+}
+
+// Audience returns the users who can see the project, that are the members of the project and the members of its
+// organization. Nobody can see the deleted projects.
+// project: Mandatory. The stored project
+// organizationMembers: Optional. The members of the organization of the project
+// Returns the emails of the users who can see the project
+func Audience(project query.Project, organizationMembers map[string]models.MemberRole) map[string]bool {
+	audience := map[string]bool{}
+	if query.IsDeleted(project.Project) {
+		return audience
+	}
+
+	for userEmail := range project.Members {
+		audience[userEmail] = true
+	}
+
+	for userEmail := range organizationMembers {
+		audience[userEmail] = true
+	}
+
+	return audience
+}
+
+// Broadcaster delivers the changes of the projects to the watchers of the users who can see them. The changes
+// are numbered in the order they are published, and the resource versions combine the number with the epoch of
+// the broadcaster, so the resource versions of a previous run of the service are recognized as expired.
+type Broadcaster struct {
+	lock     sync.Mutex
+	epoch    string
+	version  uint64
+	history  []change
+	watchers map[*watcher]struct{}
+}
+
+type change struct {
+	Change
+	version uint64
+}
+
+// NewBroadcaster creates new instance of the Broadcaster and returns the instance
+// Returns the new broadcaster
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		epoch:    primitive.NewObjectID().Hex(),
+		watchers: map[*watcher]struct{}{},
+	}
+}
+
+// Publish delivers the changes to the watchers. The changes must be published in the order they are made, so the
+// repository services publish them while they still hold their write lock.
+// changes: Mandatory. The changes of the projects, in the order they were made
+func (broadcaster *Broadcaster) Publish(changes ...Change) {
+	broadcaster.lock.Lock()
+	defer broadcaster.lock.Unlock()
+
+	for _, publishedChange := range changes {
+		broadcaster.version++
+		numberedChange := change{
+			Change:  publishedChange,
+			version: broadcaster.version,
+		}
+
+		broadcaster.history = append(broadcaster.history, numberedChange)
+		if len(broadcaster.history) > historySize {
+			broadcaster.history = broadcaster.history[len(broadcaster.history)-historySize:]
+		}
+
+		for watcher := range broadcaster.watchers {
+			watcher.push(broadcaster.newEvent(numberedChange, watcher.userEmail))
+		}
+	}
+}
+
+// Watch creates a watcher over the changes of the projects the user can see
+// userEmail: Mandatory. The email of the user to watch the changes for
+// resourceVersion: Optional. The resource version to resume watching after, the changes are watched from now on
+// if it is empty
+// Returns either the new watcher or error if something goes wrong
+func (broadcaster *Broadcaster) Watch(userEmail, resourceVersion string) (repository.ProjectWatcher, error) {
+	broadcaster.lock.Lock()
+	defer broadcaster.lock.Unlock()
+
+	newWatcher := &watcher{
+		broadcaster: broadcaster,
+		userEmail:   userEmail,
+		signal:      make(chan struct{}, 1),
+	}
+
+	if resourceVersion != "" {
+		version, err := broadcaster.parseResourceVersion(resourceVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, historyChange := range broadcaster.history {
+			if historyChange.version > version {
+				newWatcher.push(broadcaster.newEvent(historyChange, userEmail))
+			}
+		}
+	}
+
+	broadcaster.watchers[newWatcher] = struct{}{}
+
+	return newWatcher, nil
+}
+
+// parseResourceVersion returns the number of the change the resource version refers to, as long as all the
+// changes after it are still kept. The lock must be held by the caller.
+func (broadcaster *Broadcaster) parseResourceVersion(resourceVersion string) (uint64, error) {
+	separatorIndex := strings.LastIndex(resourceVersion, "-")
+	if separatorIndex < 0 {
+		return 0, commonErrors.NewArgumentError("resourceVersion", "resourceVersion is invalid")
+	}
+
+	version, err := strconv.ParseUint(resourceVersion[separatorIndex+1:], 10, 64)
+	if err != nil {
+		return 0, commonErrors.NewArgumentErrorWithError("resourceVersion", "resourceVersion is invalid", err)
+	}
+
+	if resourceVersion[:separatorIndex] != broadcaster.epoch {
+		return 0, models.NewResourceVersionExpiredError()
+	}
+
+	if version > broadcaster.version {
+		return 0, commonErrors.NewArgumentError("resourceVersion", "resourceVersion is invalid")
+	}
+
+	if version < broadcaster.version-uint64(len(broadcaster.history)) {
+		return 0, models.NewResourceVersionExpiredError()
+	}
+
+	return version, nil
+}
+
+// newEvent returns the event the user is notified of about the change, if any. The lock must be held by the
+// caller.
+func (broadcaster *Broadcaster) newEvent(numberedChange change, userEmail string) *models.ProjectEvent {
+	var eventType models.ProjectEventType
+
+	before, after := numberedChange.Before[userEmail], numberedChange.After[userEmail]
+	switch {
+	case !before && after:
+		eventType = models.ProjectEventTypeAdded
+	case before && !after:
+		eventType = models.ProjectEventTypeDeleted
+	case before && after && numberedChange.Modified:
+		eventType = models.ProjectEventTypeModified
+	default:
+		return nil
+	}
+
+	return &models.ProjectEvent{
+		Type:            eventType,
+		ProjectID:       numberedChange.ProjectID,
+		Project:         numberedChange.Project,
+		ResourceVersion: broadcaster.epoch + "-" + strconv.FormatUint(numberedChange.version, 10),
+	}
+}
+
+// watcher queues the events of its user until they are read
+type watcher struct {
+	broadcaster *Broadcaster
+	userEmail   string
+	lock        sync.Mutex
+	pending     []models.ProjectEvent
+	event       models.ProjectEvent
+	err         error
+	// signal wakes up Next when a new event is queued
+	signal chan struct{}
+}
+
+// push queues the event, or stops the watcher if it fell too far behind
+func (watcher *watcher) push(event *models.ProjectEvent) {
+	if event == nil {
+		return
+	}
+
+	watcher.lock.Lock()
+	defer watcher.lock.Unlock()
+
+	if watcher.err != nil {
+		return
+	}
+
+	if len(watcher.pending) >= maxPendingEvents {
+		watcher.pending = nil
+		watcher.err = models.NewResourceVersionExpiredErrorWithError(errors.New("the watcher fell behind the changes"))
+	} else {
+		watcher.pending = append(watcher.pending, *event)
+	}
+
+	select {
+	case watcher.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Next waits for the next change of the projects
+// ctx: Mandatory The reference to the context, cancelling it stops waiting for the changes
+// Returns false when the watcher fell behind or the context is cancelled
+func (watcher *watcher) Next(ctx context.Context) bool {
+	for {
+		watcher.lock.Lock()
+		if len(watcher.pending) > 0 {
+			watcher.event = watcher.pending[0]
+			watcher.pending = watcher.pending[1:]
+			watcher.lock.Unlock()
+
+			return true
+		}
+
+		if watcher.err != nil {
+			watcher.lock.Unlock()
+
+			return false
+		}
+
+		watcher.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			watcher.lock.Lock()
+			if watcher.err == nil {
+				watcher.err = ctx.Err()
+			}
+			watcher.lock.Unlock()
+
+			return false
+		case <-watcher.signal:
+		}
+	}
+}
+
+// Event returns the change the watcher is at
+// Returns the change the last call to Next advanced the watcher to
+func (watcher *watcher) Event() models.ProjectEvent {
+	return watcher.event
+}
+
+// Err returns the error the watcher failed with
+// Returns the error that stopped the watcher
+func (watcher *watcher) Err() error {
+	watcher.lock.Lock()
+	defer watcher.lock.Unlock()
+
+	return watcher.err
+}
+
+// Close stops delivering the changes to the watcher
+// Returns nil as stopping the watcher cannot fail
+func (watcher *watcher) Close() error {
+	watcher.broadcaster.lock.Lock()
+	defer watcher.broadcaster.lock.Unlock()
+
+	delete(watcher.broadcaster.watchers, watcher)
+
+	return nil
+}
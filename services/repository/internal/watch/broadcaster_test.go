@@ -0,0 +1,253 @@
+package watch_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/decentralized-cloud/project/services/repository/internal/watch"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestWatch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Watch Tests")
+}
+
+var _ = Describe("Watch Tests", func() {
+	const (
+		userEmail      = "user@test.com"
+		otherUserEmail = "other@test.com"
+	)
+
+	var (
+		ctx         context.Context
+		broadcaster *watch.Broadcaster
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		broadcaster = watch.NewBroadcaster()
+	})
+
+	users := func(userEmails ...string) map[string]bool {
+		audience := map[string]bool{}
+		for _, userEmail := range userEmails {
+			audience[userEmail] = true
+		}
+
+		return audience
+	}
+
+	// added returns a change that adds the project for the users, it is published after the change under test so
+	// the watchers always have an event to read, and skipping the change under test is detected without waiting
+	added := func(projectID string, userEmails ...string) watch.Change {
+		return watch.Change{ProjectID: projectID, Before: users(), After: users(userEmails...)}
+	}
+
+	newWatcher := func(userEmail, resourceVersion string) repository.ProjectWatcher {
+		watcher, err := broadcaster.Watch(userEmail, resourceVersion)
+		Ω(err).Should(BeNil())
+
+		return watcher
+	}
+
+	nextEvent := func(watcher repository.ProjectWatcher) models.ProjectEvent {
+		Ω(watcher.Next(ctx)).Should(BeTrue())
+
+		return watcher.Event()
+	}
+
+	table.DescribeTable("the changes are fanned out to the watcher of a user",
+		func(change watch.Change, expectedEventType *models.ProjectEventType) {
+			watcher := newWatcher(userEmail, "")
+			defer func() {
+				_ = watcher.Close()
+			}()
+
+			change.ProjectID = "project"
+			broadcaster.Publish(change, added("sentinel", userEmail))
+
+			event := nextEvent(watcher)
+			if expectedEventType == nil {
+				Ω(event.ProjectID).Should(Equal("sentinel"))
+
+				return
+			}
+
+			Ω(event.ProjectID).Should(Equal("project"))
+			Ω(event.Type).Should(Equal(*expectedEventType))
+			Ω(nextEvent(watcher).ProjectID).Should(Equal("sentinel"))
+		},
+		table.Entry("the user who starts seeing the project is notified that the project is added",
+			watch.Change{Before: users(otherUserEmail), After: users(userEmail, otherUserEmail)},
+			eventType(models.ProjectEventTypeAdded)),
+		table.Entry("the user who stops seeing the project is notified that the project is deleted",
+			watch.Change{Before: users(userEmail), After: users()},
+			eventType(models.ProjectEventTypeDeleted)),
+		table.Entry("the user who keeps seeing the modified project is notified that the project is modified",
+			watch.Change{Before: users(userEmail), After: users(userEmail), Modified: true},
+			eventType(models.ProjectEventTypeModified)),
+		table.Entry("the user who keeps seeing the project is not notified if the project itself is not modified",
+			watch.Change{Before: users(userEmail), After: users(userEmail, otherUserEmail)},
+			nil),
+		table.Entry("the user who cannot see the project is not notified",
+			watch.Change{Before: users(otherUserEmail), After: users(otherUserEmail), Modified: true},
+			nil),
+	)
+
+	When("multiple users watch the changes", func() {
+		It("should deliver every change to the watchers of all the users who can see the project in order", func() {
+			watcher := newWatcher(userEmail, "")
+			anotherWatcher := newWatcher(userEmail, "")
+			otherUserWatcher := newWatcher(otherUserEmail, "")
+
+			broadcaster.Publish(
+				added("first", userEmail),
+				added("second", userEmail, otherUserEmail),
+				watch.Change{ProjectID: "first", Before: users(userEmail), After: users(userEmail), Modified: true})
+
+			for _, userWatcher := range []repository.ProjectWatcher{watcher, anotherWatcher} {
+				firstEvent := nextEvent(userWatcher)
+				secondEvent := nextEvent(userWatcher)
+				thirdEvent := nextEvent(userWatcher)
+
+				Ω([]string{firstEvent.ProjectID, secondEvent.ProjectID, thirdEvent.ProjectID}).Should(Equal([]string{"first", "second", "first"}))
+				Ω(thirdEvent.Type).Should(Equal(models.ProjectEventTypeModified))
+				Ω(firstEvent.ResourceVersion).ShouldNot(Equal(secondEvent.ResourceVersion))
+			}
+
+			event := nextEvent(otherUserWatcher)
+			Ω(event.ProjectID).Should(Equal("second"))
+			Ω(event.Type).Should(Equal(models.ProjectEventTypeAdded))
+		})
+	})
+
+	When("the watcher is closed", func() {
+		It("should not deliver the later changes to the watcher", func() {
+			watcher := newWatcher(userEmail, "")
+			Ω(watcher.Close()).Should(BeNil())
+
+			broadcaster.Publish(added("project", userEmail))
+
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			Ω(watcher.Next(cancelledCtx)).Should(BeFalse())
+			Ω(watcher.Err()).Should(Equal(context.Canceled))
+		})
+	})
+
+	When("the context is cancelled while the watcher waits for a change", func() {
+		It("should stop waiting and return the error of the context", func() {
+			watcher := newWatcher(userEmail, "")
+			timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+
+			Ω(watcher.Next(timeoutCtx)).Should(BeFalse())
+			Ω(watcher.Err()).Should(Equal(context.DeadlineExceeded))
+		})
+	})
+
+	When("the watcher falls too far behind the changes", func() {
+		It("should stop the watcher with ResourceVersionExpiredError", func() {
+			watcher := newWatcher(userEmail, "")
+			for idx := 0; idx <= 1000; idx++ {
+				broadcaster.Publish(added("project", userEmail))
+			}
+
+			Ω(watcher.Next(ctx)).Should(BeFalse())
+			Ω(models.IsResourceVersionExpiredError(watcher.Err())).Should(BeTrue())
+		})
+	})
+
+	Context("the watcher resumes from a resource version", func() {
+		var (
+			resourceVersion string
+		)
+
+		BeforeEach(func() {
+			watcher := newWatcher(userEmail, "")
+			broadcaster.Publish(added("first", userEmail), added("second", otherUserEmail), added("third", userEmail))
+			resourceVersion = nextEvent(watcher).ResourceVersion
+		})
+
+		When("the resource version is kept", func() {
+			It("should replay the changes after the resource version the user can see", func() {
+				watcher := newWatcher(userEmail, resourceVersion)
+				broadcaster.Publish(added("fourth", userEmail))
+
+				Ω(nextEvent(watcher).ProjectID).Should(Equal("third"))
+				Ω(nextEvent(watcher).ProjectID).Should(Equal("fourth"))
+			})
+		})
+
+		When("the resource version is from another run of the service", func() {
+			It("should return ResourceVersionExpiredError", func() {
+				watcher, err := watch.NewBroadcaster().Watch(userEmail, resourceVersion)
+				Ω(watcher).Should(BeNil())
+				Ω(models.IsResourceVersionExpiredError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the resource version is no longer kept", func() {
+			It("should return ResourceVersionExpiredError", func() {
+				for idx := 0; idx < 1000; idx++ {
+					broadcaster.Publish(added("project", otherUserEmail))
+				}
+
+				watcher, err := broadcaster.Watch(userEmail, resourceVersion)
+				Ω(watcher).Should(BeNil())
+				Ω(models.IsResourceVersionExpiredError(err)).Should(BeTrue())
+			})
+		})
+
+		table.DescribeTable("the resource version is malformed",
+			func(resourceVersion string) {
+				watcher, err := broadcaster.Watch(userEmail, resourceVersion)
+				Ω(watcher).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			},
+			table.Entry("without a separator", "malformed"),
+			table.Entry("with a version that is not a number", "epoch-version"),
+		)
+
+		When("the resource version is not published yet", func() {
+			It("should return ArgumentError", func() {
+				epoch := resourceVersion[:strings.LastIndex(resourceVersion, "-")]
+				watcher, err := broadcaster.Watch(userEmail, epoch+"-1000")
+				Ω(watcher).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	table.DescribeTable("the audience of the projects is computed",
+		func(project query.Project, organizationMembers map[string]models.MemberRole, expectedAudience map[string]bool) {
+			Ω(watch.Audience(project, organizationMembers)).Should(Equal(expectedAudience))
+		},
+		table.Entry("the members of the project see the project",
+			query.Project{Members: query.NewMembers(userEmail)},
+			nil,
+			map[string]bool{userEmail: true}),
+		table.Entry("the members of the organization of the project see the project",
+			query.Project{Members: query.NewMembers(userEmail)},
+			map[string]models.MemberRole{otherUserEmail: models.MemberRoleViewer},
+			map[string]bool{userEmail: true, otherUserEmail: true}),
+		table.Entry("nobody sees the deleted project",
+			query.Project{Members: query.NewMembers(userEmail), Project: models.Project{DeletedAt: time.Now()}},
+			map[string]models.MemberRole{otherUserEmail: models.MemberRoleViewer},
+			map[string]bool{}),
+	)
+})
+
+func eventType(value models.ProjectEventType) *models.ProjectEventType {
+	return &value
+}
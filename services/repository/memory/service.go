@@ -0,0 +1,1552 @@
+// Package memory implements in-memory repository services
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
+	"github.com/decentralized-cloud/project/services/repository/internal/watch"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type memoryRepositoryService struct {
+	lock     sync.RWMutex
+	projects map[string]query.Project
+	// names is the unique index of the project names, mapping the owner and the normalized name of every
+	// project to the project identifier
+	names map[nameKey]string
+	// invitations is the index of the pending invitations, mapping the hash of the token of every invitation
+	// to the project identifier
+	invitations   map[string]string
+	organizations map[string]query.Organization
+	folders       map[string]query.Folder
+	// idempotencyKeys maps the emails of the users and the idempotency keys they created the projects with to
+	// the created projects
+	idempotencyKeys map[string]map[string]query.IdempotencyRecord
+	// searchIndex is the inverted index of the words of the names and the descriptions of the projects
+	searchIndex *search.Index
+	broadcaster *watch.Broadcaster
+	// changes are the changes of the projects made by the current request, they are published to the watchers
+	// once the request succeeds
+	changes []watch.Change
+	// outbox are the events written together with the changes of the projects that are not published yet, in
+	// the order they were written
+	outbox []models.DomainEvent
+}
+
+// snapshot is a copy of the projects, their names and the outbox the batches restore when one of their requests
+// fails
+type snapshot struct {
+	projects map[string]query.Project
+	names    map[nameKey]string
+	outbox   []models.DomainEvent
+}
+
+type nameKey struct {
+	userEmail string
+	name      string
+}
+
+// NewMemoryRepositoryService creates new instance of the memoryRepositoryService, setting up all dependencies and returns the instance
+// Returns the new service or error if something goes wrong
+func NewMemoryRepositoryService() (repository.RepositoryContract, error) {
+	return &memoryRepositoryService{
+		projects:        map[string]query.Project{},
+		names:           map[nameKey]string{},
+		invitations:     map[string]string{},
+		organizations:   map[string]query.Organization{},
+		folders:         map[string]query.Folder{},
+		idempotencyKeys: map[string]map[string]query.IdempotencyRecord{},
+		searchIndex:     search.NewIndex(),
+		broadcaster:     watch.NewBroadcaster(),
+	}, nil
+}
+
+// CreateProject creates a new project.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new project
+// Returns either the result of creating new project or error if something goes wrong.
+func (service *memoryRepositoryService) CreateProject(
+	ctx context.Context,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	if request.IdempotencyKey == nil {
+		return service.createProject(request)
+	}
+
+	projectID, replay, err := query.ReplayIdempotencyKey(
+		service.findIdempotencyRecord(request.UserEmail, request.IdempotencyKey.Key),
+		*request.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if replay {
+		// The project created by the original request is deleted, so the retry cannot return it
+		project, ok := service.findProjectByID(projectID)
+		if !ok {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		return &repository.CreateProjectResponse{
+			ProjectID: projectID,
+			Project:   query.CopyProject(project.Project),
+			Cursor:    repository.NewProjectCursor(projectID),
+		}, nil
+	}
+
+	response, err := service.createProject(request)
+	if err != nil {
+		return nil, err
+	}
+
+	service.idempotencyKeys[request.UserEmail][request.IdempotencyKey.Key] = query.NewIdempotencyRecord(
+		*request.IdempotencyKey,
+		response.ProjectID)
+
+	return response, nil
+}
+
+// BatchCreateProjects creates new projects in a single transaction, either all the projects are created or
+// none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns either the results of creating the projects or error if something goes wrong.
+func (service *memoryRepositoryService) BatchCreateProjects(
+	ctx context.Context,
+	request *repository.BatchCreateProjectsRequest) (*repository.BatchCreateProjectsResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	snapshot := service.takeSnapshot()
+	responses := make([]repository.CreateProjectResponse, 0, len(request.Requests))
+
+	for idx := range request.Requests {
+		response, err := service.createProject(&request.Requests[idx])
+		if err != nil {
+			service.restoreSnapshot(snapshot)
+
+			return nil, repository.NewBatchItemError(idx, err)
+		}
+
+		responses = append(responses, *response)
+	}
+
+	return &repository.BatchCreateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// createProject creates the project of the request. The lock must be held by the caller.
+func (service *memoryRepositoryService) createProject(
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	if request.Project.OrganizationID != "" {
+		if _, ok := service.organizations[request.Project.OrganizationID]; !ok {
+			return nil, commonErrors.NewNotFoundError()
+		}
+	}
+
+	key := newNameKey(request.UserEmail, request.Project.Name)
+	if _, ok := service.names[key]; ok {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	projectID := query.NewProjectID()
+	project := query.NewProject(request.UserEmail, request.Project)
+	service.projects[projectID] = query.Project{
+		ProjectID: projectID,
+		UserEmail: request.UserEmail,
+		Project:   project,
+		Members:   query.NewMembers(request.UserEmail),
+	}
+	service.names[key] = projectID
+	service.searchIndex.Add(projectID, project)
+	service.recordChange(nil, service.projects[projectID], true)
+	service.writeEvent(request.Event, projectID)
+
+	return &repository.CreateProjectResponse{
+		ProjectID: projectID,
+		Project:   query.CopyProject(project),
+		Cursor:    repository.NewProjectCursor(projectID),
+	}, nil
+}
+
+// ReadProject read an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing project
+// Returns either the result of reading an existing project or error if something goes wrong.
+func (service *memoryRepositoryService) ReadProject(
+	ctx context.Context,
+	request *repository.ReadProjectRequest) (*repository.ReadProjectResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, false)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadProjectResponse{
+		Project: query.CopyProject(project.Project),
+	}, nil
+}
+
+// UpdateProject update an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing project
+// Returns either the result of updateing an existing project or error if something goes wrong.
+func (service *memoryRepositoryService) UpdateProject(
+	ctx context.Context,
+	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	return service.updateProject(request)
+}
+
+// BatchUpdateProjects updates existing projects in a single transaction, either all the projects are updated
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns either the results of updating the projects or error if something goes wrong.
+func (service *memoryRepositoryService) BatchUpdateProjects(
+	ctx context.Context,
+	request *repository.BatchUpdateProjectsRequest) (*repository.BatchUpdateProjectsResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	snapshot := service.takeSnapshot()
+	responses := make([]repository.UpdateProjectResponse, 0, len(request.Requests))
+
+	for idx := range request.Requests {
+		response, err := service.updateProject(&request.Requests[idx])
+		if err != nil {
+			service.restoreSnapshot(snapshot)
+
+			return nil, repository.NewBatchItemError(idx, err)
+		}
+
+		responses = append(responses, *response)
+	}
+
+	return &repository.BatchUpdateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// updateProject updates the project of the request. The lock must be held by the caller.
+func (service *memoryRepositoryService) updateProject(
+	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
+	if err := models.ValidateUpdateMask(request.UpdateMask); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("updateMask", "", err)
+	}
+
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, false)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if project.Project.State == models.ProjectStateArchived {
+		return nil, models.NewProjectArchivedError()
+	}
+
+	if !query.MatchesVersion(project.Project, request.ExpectedVersion) {
+		return nil, models.NewConflictError()
+	}
+
+	updatedProject := query.UpdateProject(project.Project, request.Project, request.UpdateMask)
+
+	// The project names are unique among the projects of the owner, regardless of the member who renames the project
+	key := newNameKey(project.UserEmail, updatedProject.Name)
+	if projectID, ok := service.names[key]; ok && projectID != project.ProjectID {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	delete(service.names, newNameKey(project.UserEmail, project.Project.Name))
+	project.Project = updatedProject
+	service.projects[project.ProjectID] = project
+	service.names[key] = project.ProjectID
+	service.searchIndex.Add(project.ProjectID, project.Project)
+	service.recordChange(service.audience(project), project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.UpdateProjectResponse{
+		Project: query.CopyProject(project.Project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// DeleteProject delete an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing project
+// Returns either the result of deleting an existing project or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteProject(
+	ctx context.Context,
+	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	return service.deleteProject(request)
+}
+
+// BatchDeleteProjects deletes existing projects in a single transaction, either all the projects are deleted
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns either the results of deleting the projects or error if something goes wrong.
+func (service *memoryRepositoryService) BatchDeleteProjects(
+	ctx context.Context,
+	request *repository.BatchDeleteProjectsRequest) (*repository.BatchDeleteProjectsResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	snapshot := service.takeSnapshot()
+	responses := make([]repository.DeleteProjectResponse, 0, len(request.Requests))
+
+	for idx := range request.Requests {
+		response, err := service.deleteProject(&request.Requests[idx])
+		if err != nil {
+			service.restoreSnapshot(snapshot)
+
+			return nil, repository.NewBatchItemError(idx, err)
+		}
+
+		responses = append(responses, *response)
+	}
+
+	return &repository.BatchDeleteProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// deleteProject deletes the project of the request. The lock must be held by the caller.
+func (service *memoryRepositoryService) deleteProject(
+	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, false)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if !query.MatchesVersion(project.Project, request.ExpectedVersion) {
+		return nil, models.NewConflictError()
+	}
+
+	before := service.audience(project)
+	project.Project = query.DeleteProject(project.Project)
+	service.projects[project.ProjectID] = project
+	delete(service.names, newNameKey(project.UserEmail, project.Project.Name))
+	service.recordChange(before, project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.DeleteProjectResponse{}, nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet
+// context: Optional The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns either the result of restoring a deleted project or error if something goes wrong.
+func (service *memoryRepositoryService) RestoreProject(
+	ctx context.Context,
+	request *repository.RestoreProjectRequest) (*repository.RestoreProjectResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, true)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if !query.MatchesVersion(project.Project, request.ExpectedVersion) {
+		return nil, models.NewConflictError()
+	}
+
+	key := newNameKey(project.UserEmail, project.Project.Name)
+	if _, ok := service.names[key]; ok {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	project.Project = query.RestoreProject(project.Project)
+	service.projects[project.ProjectID] = project
+	service.names[key] = project.ProjectID
+	service.recordChange(nil, project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.RestoreProjectResponse{
+		Project: query.CopyProject(project.Project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// UpdateProjectState changes the state of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the state of an existing project
+// Returns either the result of changing the state of an existing project or error if something goes wrong.
+func (service *memoryRepositoryService) UpdateProjectState(
+	ctx context.Context,
+	request *repository.UpdateProjectStateRequest) (*repository.UpdateProjectStateResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, false)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if !query.MatchesVersion(project.Project, request.ExpectedVersion) {
+		return nil, models.NewConflictError()
+	}
+
+	project.Project = query.UpdateProjectState(project.Project, request.State)
+	service.projects[project.ProjectID] = project
+	service.recordChange(service.audience(project), project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.UpdateProjectStateResponse{
+		Project: query.CopyProject(project.Project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+// time provided in the request. The projects whose events are not published yet are kept until their events
+// are published.
+// context: Optional The reference to the context
+// request: Mandatory. The request contains the time the projects must have been deleted before
+// Returns either the result of purging the deleted projects or error if something goes wrong.
+func (service *memoryRepositoryService) PurgeDeletedProjects(
+	ctx context.Context,
+	request *repository.PurgeDeletedProjectsRequest) (*repository.PurgeDeletedProjectsResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	pendingProjectIDs := map[string]bool{}
+	for _, event := range service.outbox {
+		pendingProjectIDs[event.ProjectID] = true
+	}
+
+	response := &repository.PurgeDeletedProjectsResponse{}
+	for projectID, project := range service.projects {
+		if query.IsDeleted(project.Project) &&
+			project.Project.DeletedAt.Before(request.DeletedBefore) &&
+			!pendingProjectIDs[projectID] {
+			delete(service.projects, projectID)
+			service.searchIndex.Remove(projectID)
+			for _, invitation := range project.Invitations {
+				delete(service.invitations, invitation.TokenHash)
+			}
+
+			response.PurgedCount++
+		}
+	}
+
+	return response, nil
+}
+
+// ListProjects returns the list of projects that matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of projects that matched the criteria
+func (service *memoryRepositoryService) ListProjects(
+	ctx context.Context,
+	request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	return query.ListProjects(service.getProjects(false), service.getOrganizationRoles(request.UserEmail), service.getFolders(), request)
+}
+
+// StreamProjects returns an iterator over all the projects that matched the criteria. The projects are already kept in memory, so they are listed
+// at once and the iterator walks through them.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the iterator over the projects that matched the criteria
+func (service *memoryRepositoryService) StreamProjects(
+	ctx context.Context,
+	request *repository.StreamProjectsRequest) (*repository.StreamProjectsResponse, error) {
+	response, err := service.ListProjects(ctx, request.ToListProjectsRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.StreamProjectsResponse{Projects: query.NewSliceIterator(response.Projects)}, nil
+}
+
+// WatchProjects watches the changes of the projects the user can see, as they are made by the service
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the user and the resource version to resume watching from
+// Returns either the watcher over the changes of the projects or error if something goes wrong.
+func (service *memoryRepositoryService) WatchProjects(
+	ctx context.Context,
+	request *repository.WatchProjectsRequest) (*repository.WatchProjectsResponse, error) {
+	watcher, err := service.broadcaster.Watch(request.UserEmail, request.ResourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.WatchProjectsResponse{Watcher: watcher}, nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *memoryRepositoryService) SearchProjects(
+	ctx context.Context,
+	request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	projects := []query.Project{}
+	for projectID := range service.searchIndex.Lookup(search.Terms(request.Query)) {
+		if project := service.projects[projectID]; !query.IsDeleted(project.Project) {
+			project.Project = query.CopyProject(project.Project)
+			projects = append(projects, project)
+		}
+	}
+
+	return query.SearchProjects(projects, service.getOrganizationRoles(request.UserEmail), request)
+}
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of deleted projects that matched the criteria
+func (service *memoryRepositoryService) ListDeletedProjects(
+	ctx context.Context,
+	request *repository.ListDeletedProjectsRequest) (*repository.ListDeletedProjectsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	response, err := query.ListProjects(service.getProjects(true), service.getOrganizationRoles(request.UserEmail), service.getFolders(), request.ToListProjectsRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	deletedResponse := repository.ListDeletedProjectsResponse(*response)
+
+	return &deletedResponse, nil
+}
+
+// ReadMember reads a member of an existing or a deleted project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of a project
+// Returns either the result of reading a member of a project or error if something goes wrong.
+func (service *memoryRepositoryService) ReadMember(
+	ctx context.Context,
+	request *repository.ReadMemberRequest) (*repository.ReadMemberResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.projects[request.ProjectID]
+	if !ok || !project.IsMember(request.UserEmail) {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      project.Members[request.UserEmail],
+		},
+	}, nil
+}
+
+// ReadEffectiveRole reads the role of a user in an existing or a deleted project, taking the organization
+// of the project into account
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the role of a user in a project
+// Returns either the role of the user in the project or error if something goes wrong.
+func (service *memoryRepositoryService) ReadEffectiveRole(
+	ctx context.Context,
+	request *repository.ReadEffectiveRoleRequest) (*repository.ReadEffectiveRoleResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.projects[request.ProjectID]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	role, ok := project.EffectiveRole(request.UserEmail, service.getOrganizationRoles(request.UserEmail))
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadEffectiveRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// AddMember adds a new member to an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns either the result of adding a new member or error if something goes wrong.
+func (service *memoryRepositoryService) AddMember(
+	ctx context.Context,
+	request *repository.AddMemberRequest) (*repository.AddMemberResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if project.IsMember(request.Member.UserEmail) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	before := service.audience(project)
+	project.Members[request.Member.UserEmail] = request.Member.Role
+	service.recordChange(before, project, false)
+
+	return &repository.AddMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns either the result of changing the role of an existing member or error if something goes wrong.
+func (service *memoryRepositoryService) UpdateMemberRole(
+	ctx context.Context,
+	request *repository.UpdateMemberRoleRequest) (*repository.UpdateMemberRoleResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok || !project.IsMember(request.UserEmail) {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	project.Members[request.UserEmail] = request.Role
+
+	return &repository.UpdateMemberRoleResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      request.Role,
+		},
+	}, nil
+}
+
+// RemoveMember removes an existing member from a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns either the result of removing an existing member or error if something goes wrong.
+func (service *memoryRepositoryService) RemoveMember(
+	ctx context.Context,
+	request *repository.RemoveMemberRequest) (*repository.RemoveMemberResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok || !project.IsMember(request.UserEmail) {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	before := service.audience(project)
+	delete(project.Members, request.UserEmail)
+	service.recordChange(before, project, false)
+
+	return &repository.RemoveMemberResponse{}, nil
+}
+
+// ListMembers returns the members of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns either the members of the project or error if something goes wrong.
+func (service *memoryRepositoryService) ListMembers(
+	ctx context.Context,
+	request *repository.ListMembersRequest) (*repository.ListMembersResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListMembersResponse{
+		Members: query.ListMembers(project.Members),
+	}, nil
+}
+
+// RequestOwnershipTransfer records a pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to record a pending ownership transfer
+// Returns either the result of recording the pending ownership transfer or error if something goes wrong.
+func (service *memoryRepositoryService) RequestOwnershipTransfer(
+	ctx context.Context,
+	request *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	transfer := request.Transfer
+	project.OwnershipTransfer = &transfer
+	project.AuditEvents = append(project.AuditEvents, request.AuditEvent)
+	service.projects[project.ProjectID] = project
+
+	return &repository.RequestOwnershipTransferResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// ReadOwnershipTransfer reads the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the pending ownership transfer
+// Returns either the pending ownership transfer or error if something goes wrong.
+func (service *memoryRepositoryService) ReadOwnershipTransfer(
+	ctx context.Context,
+	request *repository.ReadOwnershipTransferRequest) (*repository.ReadOwnershipTransferResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok || project.OwnershipTransfer == nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOwnershipTransferResponse{
+		Transfer: *project.OwnershipTransfer,
+	}, nil
+}
+
+// DeleteOwnershipTransfer removes the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove the pending ownership transfer
+// Returns either the result of removing the pending ownership transfer or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteOwnershipTransfer(
+	ctx context.Context,
+	request *repository.DeleteOwnershipTransferRequest) (*repository.DeleteOwnershipTransferResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok || project.OwnershipTransfer == nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	project.OwnershipTransfer = nil
+	project.AuditEvents = append(project.AuditEvents, request.AuditEvent)
+	service.projects[project.ProjectID] = project
+
+	return &repository.DeleteOwnershipTransferResponse{}, nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user
+// context: Optional The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns either the result of transferring the ownership or error if something goes wrong.
+func (service *memoryRepositoryService) TransferProjectOwnership(
+	ctx context.Context,
+	request *repository.TransferProjectOwnershipRequest) (*repository.TransferProjectOwnershipResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok || project.UserEmail != request.FromUserEmail {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	key := newNameKey(request.ToUserEmail, project.Project.Name)
+	if projectID, ok := service.names[key]; ok && projectID != project.ProjectID {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	before := service.audience(project)
+	delete(service.names, newNameKey(project.UserEmail, project.Project.Name))
+	query.TransferMembers(project.Members, request.FromUserEmail, request.ToUserEmail)
+	project.UserEmail = request.ToUserEmail
+	project.Project = query.TransferProjectOwnership(project.Project)
+	project.OwnershipTransfer = nil
+	project.AuditEvents = append(project.AuditEvents, request.AuditEvent)
+	service.projects[project.ProjectID] = project
+	service.names[key] = project.ProjectID
+	service.recordChange(before, project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.TransferProjectOwnershipResponse{
+		Project: query.CopyProject(project.Project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// ListAuditEvents returns the audit trail of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns either the audit events of the project or error if something goes wrong.
+func (service *memoryRepositoryService) ListAuditEvents(
+	ctx context.Context,
+	request *repository.ListAuditEventsRequest) (*repository.ListAuditEventsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListAuditEventsResponse{
+		AuditEvents: append([]models.AuditEvent{}, project.AuditEvents...),
+	}, nil
+}
+
+// CreateInvitation stores a new invitation to join an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to store a new invitation
+// Returns either the result of storing the new invitation or error if something goes wrong.
+func (service *memoryRepositoryService) CreateInvitation(
+	ctx context.Context,
+	request *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	project, ok := service.findProjectByID(request.Invitation.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if project.Invitations == nil {
+		project.Invitations = map[string]query.Invitation{}
+	}
+
+	if invitation, ok := project.Invitations[request.Invitation.Email]; ok {
+		delete(service.invitations, invitation.TokenHash)
+	}
+
+	project.Invitations[request.Invitation.Email] = query.Invitation{
+		Invitation: request.Invitation,
+		TokenHash:  request.TokenHash,
+	}
+	service.projects[project.ProjectID] = project
+	service.invitations[request.TokenHash] = project.ProjectID
+
+	return &repository.CreateInvitationResponse{
+		Invitation: request.Invitation,
+	}, nil
+}
+
+// ReadInvitation reads an invitation by the hash of its token
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an invitation
+// Returns either the invitation or error if something goes wrong.
+func (service *memoryRepositoryService) ReadInvitation(
+	ctx context.Context,
+	request *repository.ReadInvitationRequest) (*repository.ReadInvitationResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	projectID, ok := service.invitations[request.TokenHash]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	project, ok := service.findProjectByID(projectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	for _, invitation := range project.Invitations {
+		if invitation.TokenHash == request.TokenHash {
+			return &repository.ReadInvitationResponse{
+				Invitation: invitation.Invitation,
+			}, nil
+		}
+	}
+
+	return nil, commonErrors.NewNotFoundError()
+}
+
+// ListInvitations returns the pending invitations of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns either the pending invitations of the project or error if something goes wrong.
+func (service *memoryRepositoryService) ListInvitations(
+	ctx context.Context,
+	request *repository.ListInvitationsRequest) (*repository.ListInvitationsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListInvitationsResponse{
+		Invitations: query.ListInvitations(project.Invitations),
+	}, nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+// context: Optional The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns either the result of accepting the invitation or error if something goes wrong.
+func (service *memoryRepositoryService) AcceptInvitation(
+	ctx context.Context,
+	request *repository.AcceptInvitationRequest) (*repository.AcceptInvitationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	invitation, ok := project.Invitations[request.Email]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if project.IsMember(request.Email) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	before := service.audience(project)
+	delete(project.Invitations, request.Email)
+	delete(service.invitations, invitation.TokenHash)
+	project.Members[request.Email] = invitation.Invitation.Role
+	service.recordChange(before, project, false)
+
+	return &repository.AcceptInvitationResponse{
+		Member: models.Member{
+			UserEmail: request.Email,
+			Role:      invitation.Invitation.Role,
+		},
+	}, nil
+}
+
+// DeleteInvitation removes a pending invitation of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove a pending invitation
+// Returns either the result of removing the invitation or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteInvitation(
+	ctx context.Context,
+	request *repository.DeleteInvitationRequest) (*repository.DeleteInvitationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	project, ok := service.findProjectByID(request.ProjectID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	invitation, ok := project.Invitations[request.Email]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	delete(project.Invitations, request.Email)
+	delete(service.invitations, invitation.TokenHash)
+
+	return &repository.DeleteInvitationResponse{}, nil
+}
+
+// CreateOrganization creates a new organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns either the result of creating the new organization or error if something goes wrong.
+func (service *memoryRepositoryService) CreateOrganization(
+	ctx context.Context,
+	request *repository.CreateOrganizationRequest) (*repository.CreateOrganizationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	organizationID := query.NewOrganizationID()
+	organization := query.NewOrganization(request.UserEmail, request.Organization)
+	service.organizations[organizationID] = query.Organization{
+		OrganizationID: organizationID,
+		Organization:   organization,
+		Members:        query.NewMembers(request.UserEmail),
+	}
+
+	return &repository.CreateOrganizationResponse{
+		OrganizationID: organizationID,
+		Organization:   organization,
+	}, nil
+}
+
+// ReadOrganization reads an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns either the result of reading the organization or error if something goes wrong.
+func (service *memoryRepositoryService) ReadOrganization(
+	ctx context.Context,
+	request *repository.ReadOrganizationRequest) (*repository.ReadOrganizationResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	organization, ok := service.findOrganization(request.UserEmail, request.OrganizationID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOrganizationResponse{
+		Organization: organization.Organization,
+	}, nil
+}
+
+// UpdateOrganization updates an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns either the result of updating the organization or error if something goes wrong.
+func (service *memoryRepositoryService) UpdateOrganization(
+	ctx context.Context,
+	request *repository.UpdateOrganizationRequest) (*repository.UpdateOrganizationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	organization, ok := service.findOrganization(request.UserEmail, request.OrganizationID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	organization.Organization = query.UpdateOrganization(organization.Organization, request.Organization)
+	service.organizations[organization.OrganizationID] = organization
+
+	return &repository.UpdateOrganizationResponse{
+		Organization: organization.Organization,
+	}, nil
+}
+
+// DeleteOrganization deletes an existing organization that has no project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns either the result of deleting the organization or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteOrganization(
+	ctx context.Context,
+	request *repository.DeleteOrganizationRequest) (*repository.DeleteOrganizationResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if _, ok := service.findOrganization(request.UserEmail, request.OrganizationID); !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	for _, project := range service.projects {
+		if project.Project.OrganizationID == request.OrganizationID {
+			return nil, models.NewOrganizationNotEmptyError()
+		}
+	}
+
+	delete(service.organizations, request.OrganizationID)
+
+	return &repository.DeleteOrganizationResponse{}, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the organizations of a user
+// Returns either the organizations of the user or error if something goes wrong.
+func (service *memoryRepositoryService) ListOrganizations(
+	ctx context.Context,
+	request *repository.ListOrganizationsRequest) (*repository.ListOrganizationsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	return &repository.ListOrganizationsResponse{
+		Organizations: query.ListOrganizations(service.getOrganizations(), request.UserEmail),
+	}, nil
+}
+
+// ReadOrganizationMember reads a member of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of an existing organization
+// Returns either the result of reading the member or error if something goes wrong.
+func (service *memoryRepositoryService) ReadOrganizationMember(
+	ctx context.Context,
+	request *repository.ReadOrganizationMemberRequest) (*repository.ReadOrganizationMemberResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	organization, ok := service.findOrganization(request.UserEmail, request.OrganizationID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOrganizationMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      organization.Members[request.UserEmail],
+		},
+	}, nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns either the result of adding the new member or error if something goes wrong.
+func (service *memoryRepositoryService) AddOrganizationMember(
+	ctx context.Context,
+	request *repository.AddOrganizationMemberRequest) (*repository.AddOrganizationMemberResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	organization, ok := service.organizations[request.OrganizationID]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if _, ok = organization.Members[request.Member.UserEmail]; ok {
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	audiences := service.organizationAudiences(request.OrganizationID)
+	organization.Members[request.Member.UserEmail] = request.Member.Role
+	service.recordOrganizationChanges(audiences)
+
+	return &repository.AddOrganizationMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns either the result of removing the member or error if something goes wrong.
+func (service *memoryRepositoryService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *repository.RemoveOrganizationMemberRequest) (*repository.RemoveOrganizationMemberResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	organization, ok := service.findOrganization(request.UserEmail, request.OrganizationID)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	audiences := service.organizationAudiences(request.OrganizationID)
+	delete(organization.Members, request.UserEmail)
+	service.recordOrganizationChanges(audiences)
+
+	return &repository.RemoveOrganizationMemberResponse{}, nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns either the members of the organization or error if something goes wrong.
+func (service *memoryRepositoryService) ListOrganizationMembers(
+	ctx context.Context,
+	request *repository.ListOrganizationMembersRequest) (*repository.ListOrganizationMembersResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	organization, ok := service.organizations[request.OrganizationID]
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ListOrganizationMembersResponse{
+		Members: query.ListMembers(organization.Members),
+	}, nil
+}
+
+// MoveProject moves an existing project into a folder of the user or out of its folder
+// context: Optional The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns either the result of moving the project or error if something goes wrong.
+func (service *memoryRepositoryService) MoveProject(
+	ctx context.Context,
+	request *repository.MoveProjectRequest) (*repository.MoveProjectResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	project, ok := service.findProject(request.UserEmail, request.ProjectID, false)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	if request.FolderID != "" {
+		if _, ok = service.findFolder(request.UserEmail, request.FolderID); !ok {
+			return nil, commonErrors.NewNotFoundError()
+		}
+	}
+
+	project.Project = query.MoveProject(project.Project, request.FolderID)
+	service.projects[project.ProjectID] = project
+	service.recordChange(service.audience(project), project, true)
+	service.writeEvent(request.Event, project.ProjectID)
+
+	return &repository.MoveProjectResponse{
+		Project: query.CopyProject(project.Project),
+		Cursor:  repository.NewProjectCursor(project.ProjectID),
+	}, nil
+}
+
+// CreateFolder creates a new folder for the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns either the result of creating the new folder or error if something goes wrong.
+func (service *memoryRepositoryService) CreateFolder(
+	ctx context.Context,
+	request *repository.CreateFolderRequest) (*repository.CreateFolderResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	if request.Folder.ParentFolderID != "" {
+		if _, ok := service.findFolder(request.UserEmail, request.Folder.ParentFolderID); !ok {
+			return nil, commonErrors.NewNotFoundError()
+		}
+	}
+
+	folderID := query.NewFolderID()
+	folder := query.NewFolder(request.Folder)
+	service.folders[folderID] = query.Folder{
+		FolderID:  folderID,
+		UserEmail: request.UserEmail,
+		Folder:    folder,
+	}
+
+	return &repository.CreateFolderResponse{
+		FolderID: folderID,
+		Folder:   folder,
+	}, nil
+}
+
+// ListFolders returns the folders of the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the folders of a user
+// Returns either the folders of the user or error if something goes wrong.
+func (service *memoryRepositoryService) ListFolders(
+	ctx context.Context,
+	request *repository.ListFoldersRequest) (*repository.ListFoldersResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	return &repository.ListFoldersResponse{
+		Folders: query.ListFolders(service.getFolders(), request.UserEmail),
+	}, nil
+}
+
+// DeleteFolder deletes an existing folder of the user together with its subfolders
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns either the result of deleting the folder or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteFolder(
+	ctx context.Context,
+	request *repository.DeleteFolderRequest) (*repository.DeleteFolderResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	defer service.publishChanges()
+
+	folderIDs, ok := query.FolderTree(service.getFolders(), request.UserEmail, request.FolderID, true)
+	if !ok {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	organizationRoles := service.getOrganizationRoles(request.UserEmail)
+	projects := []query.Project{}
+	for _, project := range service.projects {
+		if folderIDs[project.Project.FolderID] && !query.IsDeleted(project.Project) && project.CanAccess(request.UserEmail, organizationRoles) {
+			projects = append(projects, project)
+		}
+	}
+
+	if len(projects) > 0 && !request.Cascade {
+		return nil, models.NewFolderNotEmptyError()
+	}
+
+	for _, project := range projects {
+		before := service.audience(project)
+		project.Project = query.DeleteProject(project.Project)
+		service.projects[project.ProjectID] = project
+		delete(service.names, newNameKey(project.UserEmail, project.Project.Name))
+		service.recordChange(before, project, true)
+		service.writeEvent(query.NewCascadeEvent(request.Event, project.ProjectID), project.ProjectID)
+	}
+
+	for projectID, project := range service.projects {
+		if folderIDs[project.Project.FolderID] {
+			project.Project.FolderID = ""
+			service.projects[projectID] = project
+			service.recordChange(service.audience(project), project, true)
+		}
+	}
+
+	for folderID := range folderIDs {
+		delete(service.folders, folderID)
+	}
+
+	return &repository.DeleteFolderResponse{}, nil
+}
+
+// ListOutboxEvents returns the events written to the outbox that are not published yet, the oldest ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the maximum number of events to return
+// Returns either the events that are not published yet or error if something goes wrong.
+func (service *memoryRepositoryService) ListOutboxEvents(
+	ctx context.Context,
+	request *repository.ListOutboxEventsRequest) (*repository.ListOutboxEventsResponse, error) {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	events := service.outbox
+	if request.Limit < len(events) {
+		events = events[:request.Limit]
+	}
+
+	return &repository.ListOutboxEventsResponse{
+		Events: append([]models.DomainEvent{}, events...),
+	}, nil
+}
+
+// DeleteOutboxEvents removes the published events from the outbox
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the identifiers of the published events
+// Returns either the result of removing the published events or error if something goes wrong.
+func (service *memoryRepositoryService) DeleteOutboxEvents(
+	ctx context.Context,
+	request *repository.DeleteOutboxEventsRequest) (*repository.DeleteOutboxEventsResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	published := make(map[string]bool, len(request.EventIDs))
+	for _, eventID := range request.EventIDs {
+		published[eventID] = true
+	}
+
+	outbox := make([]models.DomainEvent, 0, len(service.outbox))
+	for _, event := range service.outbox {
+		if !published[event.EventID] {
+			outbox = append(outbox, event)
+		}
+	}
+
+	service.outbox = outbox
+
+	return &repository.DeleteOutboxEventsResponse{}, nil
+}
+
+// Close releases all the resources held by the repository service
+// Returns error if something goes wrong
+func (service *memoryRepositoryService) Close() error {
+	return nil
+}
+
+func (service *memoryRepositoryService) getProjects(deleted bool) []query.Project {
+	projects := make([]query.Project, 0, len(service.projects))
+	for _, project := range service.projects {
+		if query.IsDeleted(project.Project) == deleted {
+			project.Project = query.CopyProject(project.Project)
+			projects = append(projects, project)
+		}
+	}
+
+	return projects
+}
+
+func (service *memoryRepositoryService) getOrganizations() []query.Organization {
+	organizations := make([]query.Organization, 0, len(service.organizations))
+	for _, organization := range service.organizations {
+		organizations = append(organizations, organization)
+	}
+
+	return organizations
+}
+
+func (service *memoryRepositoryService) getFolders() []query.Folder {
+	folders := make([]query.Folder, 0, len(service.folders))
+	for _, folder := range service.folders {
+		folders = append(folders, folder)
+	}
+
+	return folders
+}
+
+// getOrganizationRoles returns the roles of the user in the organizations the user is a member of
+func (service *memoryRepositoryService) getOrganizationRoles(userEmail string) map[string]models.MemberRole {
+	return query.OrganizationRoles(service.getOrganizations(), userEmail)
+}
+
+// findOrganization returns the organization the user is a member of
+func (service *memoryRepositoryService) findOrganization(userEmail, organizationID string) (query.Organization, bool) {
+	organization, ok := service.organizations[organizationID]
+	if !ok {
+		return organization, false
+	}
+
+	_, ok = organization.Members[userEmail]
+
+	return organization, ok
+}
+
+// findFolder returns the folder of the user
+func (service *memoryRepositoryService) findFolder(userEmail, folderID string) (query.Folder, bool) {
+	folder, ok := service.folders[folderID]
+
+	return folder, ok && folder.UserEmail == userEmail
+}
+
+// findProject returns the project the user is a member of, either directly or through the organization of the
+// project, only if the project is deleted or not as requested
+func (service *memoryRepositoryService) findProject(userEmail, projectID string, deleted bool) (query.Project, bool) {
+	project, ok := service.projects[projectID]
+	if !ok || !project.CanAccess(userEmail, service.getOrganizationRoles(userEmail)) || query.IsDeleted(project.Project) != deleted {
+		return project, false
+	}
+
+	return project, true
+}
+
+// findProjectByID returns the project regardless of its members, only if the project is not deleted
+func (service *memoryRepositoryService) findProjectByID(projectID string) (query.Project, bool) {
+	project, ok := service.projects[projectID]
+	if !ok || query.IsDeleted(project.Project) {
+		return project, false
+	}
+
+	return project, true
+}
+
+// findIdempotencyRecord returns the record of the idempotency key of the user, or nil if the key is not stored.
+// The expired keys of the user are removed, and the keys of the user are initialized if needed. The lock must be
+// held by the caller.
+func (service *memoryRepositoryService) findIdempotencyRecord(userEmail, key string) *query.IdempotencyRecord {
+	records, ok := service.idempotencyKeys[userEmail]
+	if !ok {
+		records = map[string]query.IdempotencyRecord{}
+		service.idempotencyKeys[userEmail] = records
+	}
+
+	now := time.Now()
+	for storedKey, record := range records {
+		if !now.Before(record.ExpiresAt) {
+			delete(records, storedKey)
+		}
+	}
+
+	if record, ok := records[key]; ok {
+		return &record
+	}
+
+	return nil
+}
+
+func newNameKey(userEmail, name string) nameKey {
+	return nameKey{
+		userEmail: userEmail,
+		name:      repository.NormalizeProjectName(name),
+	}
+}
+
+// takeSnapshot copies the projects, their names and the outbox. The members and the invitations of the projects
+// are changed in place, so the projects are deep copied. The lock must be held by the caller.
+func (service *memoryRepositoryService) takeSnapshot() snapshot {
+	projects := make(map[string]query.Project, len(service.projects))
+	for projectID, project := range service.projects {
+		projects[projectID] = project.Copy()
+	}
+
+	names := make(map[nameKey]string, len(service.names))
+	for key, projectID := range service.names {
+		names[key] = projectID
+	}
+
+	return snapshot{
+		projects: projects,
+		names:    names,
+		outbox:   append([]models.DomainEvent{}, service.outbox...),
+	}
+}
+
+// restoreSnapshot restores the projects, their names and the outbox, and re-indexes the projects that were created
+// or changed since the snapshot was taken. The lock must be held by the caller.
+func (service *memoryRepositoryService) restoreSnapshot(snapshot snapshot) {
+	for projectID, project := range service.projects {
+		if storedProject, ok := snapshot.projects[projectID]; !ok {
+			service.searchIndex.Remove(projectID)
+		} else if storedProject.Project.Version != project.Project.Version {
+			service.searchIndex.Add(projectID, storedProject.Project)
+		}
+	}
+
+	service.projects = snapshot.projects
+	service.names = snapshot.names
+	service.outbox = snapshot.outbox
+	service.changes = nil
+}
+
+// writeEvent writes the event, if any, of the change of the project to the outbox. The lock must be held by the
+// caller.
+func (service *memoryRepositoryService) writeEvent(event *models.DomainEvent, projectID string) {
+	if event == nil {
+		return
+	}
+
+	service.outbox = append(service.outbox, query.NewOutboxEvent(*event, projectID))
+}
+
+// audience returns the users who can see the project. The lock must be held by the caller.
+func (service *memoryRepositoryService) audience(project query.Project) map[string]bool {
+	return watch.Audience(project, service.organizations[project.Project.OrganizationID].Members)
+}
+
+// organizationAudiences returns the users who can see the projects of the organization, before its members are
+// changed. The lock must be held by the caller.
+func (service *memoryRepositoryService) organizationAudiences(organizationID string) map[string]map[string]bool {
+	audiences := map[string]map[string]bool{}
+	for projectID, project := range service.projects {
+		if project.Project.OrganizationID == organizationID {
+			audiences[projectID] = service.audience(project)
+		}
+	}
+
+	return audiences
+}
+
+// recordOrganizationChanges records the changes of the projects of the organization whose members were changed.
+// The lock must be held by the caller.
+func (service *memoryRepositoryService) recordOrganizationChanges(audiences map[string]map[string]bool) {
+	for projectID, before := range audiences {
+		service.recordChange(before, service.projects[projectID], false)
+	}
+}
+
+// recordChange records the change of the project, which is published to the watchers once the request succeeds.
+// The lock must be held by the caller.
+func (service *memoryRepositoryService) recordChange(before map[string]bool, project query.Project, modified bool) {
+	service.changes = append(service.changes, watch.Change{
+		ProjectID: project.ProjectID,
+		Project:   query.CopyProject(project.Project),
+		Before:    before,
+		After:     service.audience(project),
+		Modified:  modified,
+	})
+}
+
+// publishChanges publishes the changes made by the request to the watchers, while the lock is still held so the
+// watchers receive the changes in the order they were made. The changes are dropped if the request failed
+// before it recorded them. The lock must be held by the caller.
+func (service *memoryRepositoryService) publishChanges() {
+	if len(service.changes) > 0 {
+		service.broadcaster.Publish(service.changes...)
+		service.changes = nil
+	}
+}
@@ -0,0 +1,49 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/conformance"
+	"github.com/decentralized-cloud/project/services/repository/memory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryRepositoryService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Memory Repository Service Tests")
+}
+
+var _ = Describe("Memory Repository Service Tests", func() {
+	Context("user tries to instantiate RepositoryService", func() {
+		When("NewMemoryRepositoryService is called", func() {
+			It("should instantiate the new RepositoryService", func() {
+				service, err := memory.NewMemoryRepositoryService()
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+			})
+		})
+	})
+})
+
+var _ = conformance.DescribeRepositoryService("Memory Repository Service", func() repository.RepositoryContract {
+	service, err := memory.NewMemoryRepositoryService()
+	Ω(err).Should(BeNil())
+
+	return service
+})
+
+var _ = conformance.DescribeWatchService("Memory Repository Service", func() repository.RepositoryContract {
+	service, err := memory.NewMemoryRepositoryService()
+	Ω(err).Should(BeNil())
+
+	return service
+})
+
+var _ = conformance.DescribeInProcessWatchService("Memory Repository Service", func() repository.RepositoryContract {
+	service, err := memory.NewMemoryRepositoryService()
+	Ω(err).Should(BeNil())
+
+	return service
+})
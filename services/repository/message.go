@@ -2,14 +2,41 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/decentralized-cloud/project/models"
 	"github.com/micro-business/go-core/common"
 )
 
-// CreateProjectRequest contains the request to create a new project
+// CreateProjectRequest contains the request to create a new project. The user becomes the owner of the project.
+// If the organization of the project is provided, it must exist. If the idempotency key is provided and the user
+// already created a project with the same key that is not expired yet, the project is not created again: the
+// original project is returned if the payload hash matches, otherwise IdempotencyKeyReusedError is returned.
+// NotFoundError is returned if the original project is deleted since.
+// If the event is provided, it is written to the outbox together with the project, but not when the original
+// project is returned.
 type CreateProjectRequest struct {
-	UserEmail string
-	Project   models.Project
+	UserEmail      string
+	Project        models.Project
+	IdempotencyKey *IdempotencyKey
+	Event          *models.DomainEvent
+}
+
+// IdempotencyKey is the key the user provided to create a project only once, no matter how many times the
+// request is retried. The keys are unique among the keys of the same user.
+type IdempotencyKey struct {
+	Key string
+	// PayloadHash is the hash of the payload of the request, so the requests that reuse the key with a
+	// different payload can be rejected
+	PayloadHash string
+	ExpiresAt   time.Time
+}
+
+// IsExpired indicates whether the idempotency key is expired at the provided time
+// now: Mandatory. The time to check the expiry of the idempotency key at
+// Returns true if the idempotency key is expired, otherwise returns false
+func (key IdempotencyKey) IsExpired(now time.Time) bool {
+	return !now.Before(key.ExpiresAt)
 }
 
 // CreateProjectResponse contains the result of creating a new project
@@ -30,11 +57,18 @@ type ReadProjectResponse struct {
 	Project models.Project
 }
 
-// UpdateProjectRequest contains the request to update an existing project
+// UpdateProjectRequest contains the request to update an existing project. If ExpectedVersion is not
+// zero, the project is only updated if its current version matches ExpectedVersion. UpdateMask lists the
+// paths of the fields to update, all the fields of models.ProjectUpdatePaths are updated if it is empty.
+// If the event is provided, it is written to the outbox together with the project. The archived projects are
+// not updated, ProjectArchivedError is returned instead.
 type UpdateProjectRequest struct {
-	UserEmail string
-	ProjectID string
-	Project   models.Project
+	UserEmail       string
+	ProjectID       string
+	Project         models.Project
+	ExpectedVersion int64
+	UpdateMask      []string
+	Event           *models.DomainEvent
 }
 
 // UpdateProjectResponse contains the result of updating an existing project
@@ -43,28 +77,570 @@ type UpdateProjectResponse struct {
 	Cursor  string
 }
 
-// DeleteProjectRequest contains the request to delete an existing project
+// DeleteProjectRequest contains the request to delete an existing project. If ExpectedVersion is not
+// zero, the project is only deleted if its current version matches ExpectedVersion. If the event is provided,
+// it is written to the outbox together with the project.
 type DeleteProjectRequest struct {
-	UserEmail string
-	ProjectID string
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
+	Event           *models.DomainEvent
 }
 
 // DeleteProjectResponse contains the result of deleting an existing project
 type DeleteProjectResponse struct {
 }
 
-// ListProjectsRequest contains the filter criteria to look for existing projects
+// BatchCreateProjectsRequest contains the requests to create new projects in a single transaction
+type BatchCreateProjectsRequest struct {
+	Requests []CreateProjectRequest
+}
+
+// BatchCreateProjectsResponse contains the results of creating new projects, in the order of the requests
+type BatchCreateProjectsResponse struct {
+	Responses []CreateProjectResponse
+}
+
+// BatchUpdateProjectsRequest contains the requests to update existing projects in a single transaction
+type BatchUpdateProjectsRequest struct {
+	Requests []UpdateProjectRequest
+}
+
+// BatchUpdateProjectsResponse contains the results of updating existing projects, in the order of the requests
+type BatchUpdateProjectsResponse struct {
+	Responses []UpdateProjectResponse
+}
+
+// BatchDeleteProjectsRequest contains the requests to delete existing projects in a single transaction
+type BatchDeleteProjectsRequest struct {
+	Requests []DeleteProjectRequest
+}
+
+// BatchDeleteProjectsResponse contains the results of deleting existing projects, in the order of the requests
+type BatchDeleteProjectsResponse struct {
+	Responses []DeleteProjectResponse
+}
+
+// RestoreProjectRequest contains the request to restore a deleted project. If ExpectedVersion is not
+// zero, the project is only restored if its current version matches ExpectedVersion. If the event is provided,
+// it is written to the outbox together with the project.
+type RestoreProjectRequest struct {
+	UserEmail       string
+	ProjectID       string
+	ExpectedVersion int64
+	Event           *models.DomainEvent
+}
+
+// RestoreProjectResponse contains the result of restoring a deleted project
+type RestoreProjectResponse struct {
+	Project models.Project
+	Cursor  string
+}
+
+// UpdateProjectStateRequest contains the request to change the state of an existing project. If
+// ExpectedVersion is not zero, the state is only changed if the current version of the project matches
+// ExpectedVersion. If the event is provided, it is written to the outbox together with the project.
+type UpdateProjectStateRequest struct {
+	UserEmail       string
+	ProjectID       string
+	State           models.ProjectState
+	ExpectedVersion int64
+	Event           *models.DomainEvent
+}
+
+// UpdateProjectStateResponse contains the result of changing the state of an existing project
+type UpdateProjectStateResponse struct {
+	Project models.Project
+	Cursor  string
+}
+
+// PurgeDeletedProjectsRequest contains the request to permanently remove the projects of all the users
+// that were deleted before DeletedBefore
+type PurgeDeletedProjectsRequest struct {
+	DeletedBefore time.Time
+}
+
+// PurgeDeletedProjectsResponse contains the result of purging the deleted projects
+type PurgeDeletedProjectsResponse struct {
+	PurgedCount int64
+}
+
+// ListProjectsRequest contains the filter criteria to look for the existing projects the user is a member
+// of, either directly or through the organizations of the projects. The archived projects are only returned
+// if IncludeArchived is true, and only the projects of the organization are returned if OrganizationID is provided.
+// Only the projects in the folder of the user are returned if FolderID is provided, including the projects in
+// its subfolders if IncludeSubfolders is true. Only the projects that match Filter are returned if it is provided,
+// the repository services reject the filters that are not valid.
 type ListProjectsRequest struct {
+	UserEmail         string
+	Pagination        common.Pagination
+	SortingOptions    []common.SortingOptionPair
+	ProjectIDs        []string
+	IncludeArchived   bool
+	OrganizationID    string
+	FolderID          string
+	IncludeSubfolders bool
+	Filter            *models.ProjectFilter
+}
+
+// ListProjectsResponse contains the list of the projects that matched the result
+type ListProjectsResponse struct {
+	HasPreviousPage bool
+	HasNextPage     bool
+	TotalCount      int64
+	Projects        []models.ProjectWithCursor
+}
+
+// StreamProjectsRequest contains the filter criteria to look for all the existing projects the user has access
+// to, with the same meaning as the criteria of ListProjectsRequest. The projects are not paginated.
+type StreamProjectsRequest struct {
+	UserEmail         string
+	SortingOptions    []common.SortingOptionPair
+	ProjectIDs        []string
+	IncludeArchived   bool
+	OrganizationID    string
+	FolderID          string
+	IncludeSubfolders bool
+	Filter            *models.ProjectFilter
+}
+
+// ToListProjectsRequest returns the request to list the projects with the same criteria, without pagination
+// Returns the request to list the projects
+func (request StreamProjectsRequest) ToListProjectsRequest() *ListProjectsRequest {
+	return &ListProjectsRequest{
+		UserEmail:         request.UserEmail,
+		SortingOptions:    request.SortingOptions,
+		ProjectIDs:        request.ProjectIDs,
+		IncludeArchived:   request.IncludeArchived,
+		OrganizationID:    request.OrganizationID,
+		FolderID:          request.FolderID,
+		IncludeSubfolders: request.IncludeSubfolders,
+		Filter:            request.Filter,
+	}
+}
+
+// StreamProjectsResponse contains the iterator over the projects that matched the criteria, in the requested
+// sort order
+type StreamProjectsResponse struct {
+	Projects ProjectIterator
+}
+
+// WatchProjectsRequest contains the user to watch the changes of the visible projects for. ResourceVersion is
+// the resource version of the last event the user received, the changes after it are returned first. The
+// changes are watched from now on if ResourceVersion is empty.
+type WatchProjectsRequest struct {
+	UserEmail       string
+	ResourceVersion string
+}
+
+// WatchProjectsResponse contains the watcher that returns the changes of the projects the user can see
+type WatchProjectsResponse struct {
+	Watcher ProjectWatcher
+}
+
+// SearchProjectsRequest contains the full-text query to look for the projects the user has access to. The
+// projects that contain any of the words of the query in their name or description are returned, the most
+// relevant ones first.
+type SearchProjectsRequest struct {
+	UserEmail       string
+	Query           string
+	Pagination      common.Pagination
+	IncludeArchived bool
+}
+
+// SearchProjectsResponse contains the list of the projects that matched the query, ordered by their relevance
+type SearchProjectsResponse struct {
+	HasPreviousPage bool
+	HasNextPage     bool
+	TotalCount      int64
+	Projects        []models.ProjectSearchResult
+}
+
+// ListDeletedProjectsRequest contains the filter criteria to look for the deleted projects that are not purged yet
+type ListDeletedProjectsRequest struct {
 	UserEmail      string
 	Pagination     common.Pagination
 	SortingOptions []common.SortingOptionPair
 	ProjectIDs     []string
 }
 
-// ListProjectsResponse contains the list of the projects that matched the result
-type ListProjectsResponse struct {
+// ToListProjectsRequest returns the request to list the projects with the same criteria. The deleted projects
+// are listed regardless of their state, so the returned request includes the archived projects.
+// Returns the request to list the projects
+func (request ListDeletedProjectsRequest) ToListProjectsRequest() *ListProjectsRequest {
+	return &ListProjectsRequest{
+		UserEmail:       request.UserEmail,
+		Pagination:      request.Pagination,
+		SortingOptions:  request.SortingOptions,
+		ProjectIDs:      request.ProjectIDs,
+		IncludeArchived: true,
+	}
+}
+
+// ListDeletedProjectsResponse contains the list of the deleted projects that matched the result
+type ListDeletedProjectsResponse struct {
 	HasPreviousPage bool
 	HasNextPage     bool
 	TotalCount      int64
 	Projects        []models.ProjectWithCursor
 }
+
+// ReadMemberRequest contains the request to read a member of a project. The members of the deleted projects
+// can be read until the projects are purged.
+type ReadMemberRequest struct {
+	ProjectID string
+	UserEmail string
+}
+
+// ReadMemberResponse contains the result of reading a member of a project
+type ReadMemberResponse struct {
+	Member models.Member
+}
+
+// ReadEffectiveRoleRequest contains the request to read the role of a user in a project. The roles in the deleted
+// projects can be read until the projects are purged.
+type ReadEffectiveRoleRequest struct {
+	ProjectID string
+	UserEmail string
+}
+
+// ReadEffectiveRoleResponse contains the role of a user in a project, which is the highest of the role of the
+// user as a member of the project and the role of the user as a member of the organization of the project
+type ReadEffectiveRoleResponse struct {
+	Role models.MemberRole
+}
+
+// AddMemberRequest contains the request to add a new member to an existing project
+type AddMemberRequest struct {
+	ProjectID string
+	Member    models.Member
+}
+
+// AddMemberResponse contains the result of adding a new member to an existing project
+type AddMemberResponse struct {
+	Member models.Member
+}
+
+// UpdateMemberRoleRequest contains the request to change the role of an existing member of a project
+type UpdateMemberRoleRequest struct {
+	ProjectID string
+	UserEmail string
+	Role      models.MemberRole
+}
+
+// UpdateMemberRoleResponse contains the result of changing the role of an existing member of a project
+type UpdateMemberRoleResponse struct {
+	Member models.Member
+}
+
+// RemoveMemberRequest contains the request to remove an existing member from a project
+type RemoveMemberRequest struct {
+	ProjectID string
+	UserEmail string
+}
+
+// RemoveMemberResponse contains the result of removing an existing member from a project
+type RemoveMemberResponse struct {
+}
+
+// ListMembersRequest contains the request to list the members of an existing project
+type ListMembersRequest struct {
+	ProjectID string
+}
+
+// ListMembersResponse contains the members of an existing project, ordered by their emails
+type ListMembersResponse struct {
+	Members []models.Member
+}
+
+// RequestOwnershipTransferRequest contains the request to record a pending ownership transfer of an existing
+// project, together with the audit event to record. The pending transfer replaces the existing one, if any.
+type RequestOwnershipTransferRequest struct {
+	ProjectID  string
+	Transfer   models.OwnershipTransfer
+	AuditEvent models.AuditEvent
+}
+
+// RequestOwnershipTransferResponse contains the result of recording a pending ownership transfer
+type RequestOwnershipTransferResponse struct {
+	Transfer models.OwnershipTransfer
+}
+
+// ReadOwnershipTransferRequest contains the request to read the pending ownership transfer of an existing project
+type ReadOwnershipTransferRequest struct {
+	ProjectID string
+}
+
+// ReadOwnershipTransferResponse contains the pending ownership transfer of an existing project
+type ReadOwnershipTransferResponse struct {
+	Transfer models.OwnershipTransfer
+}
+
+// DeleteOwnershipTransferRequest contains the request to remove the pending ownership transfer of an existing
+// project, together with the audit event to record
+type DeleteOwnershipTransferRequest struct {
+	ProjectID  string
+	AuditEvent models.AuditEvent
+}
+
+// DeleteOwnershipTransferResponse contains the result of removing the pending ownership transfer
+type DeleteOwnershipTransferResponse struct {
+}
+
+// TransferProjectOwnershipRequest contains the request to transfer the ownership of an existing project,
+// together with the audit event to record. The ownership is only transferred if FromUserEmail is still the
+// owner of the project. The previous owner stays an editor of the project and the pending ownership
+// transfer, if any, is removed. If the event is provided, it is written to the outbox together with the project.
+type TransferProjectOwnershipRequest struct {
+	ProjectID     string
+	FromUserEmail string
+	ToUserEmail   string
+	AuditEvent    models.AuditEvent
+	Event         *models.DomainEvent
+}
+
+// TransferProjectOwnershipResponse contains the result of transferring the ownership of an existing project
+type TransferProjectOwnershipResponse struct {
+	Project models.Project
+	Cursor  string
+}
+
+// ListAuditEventsRequest contains the request to list the audit trail of an existing project
+type ListAuditEventsRequest struct {
+	ProjectID string
+}
+
+// ListAuditEventsResponse contains the audit events of an existing project, ordered by the time they occurred
+type ListAuditEventsResponse struct {
+	AuditEvents []models.AuditEvent
+}
+
+// CreateInvitationRequest contains the request to store a new invitation to join an existing project, together
+// with the hash of the token the invitation is accepted with. The new invitation replaces the existing
+// invitation of the same email to the same project, if any.
+type CreateInvitationRequest struct {
+	Invitation models.Invitation
+	TokenHash  string
+}
+
+// CreateInvitationResponse contains the result of storing a new invitation
+type CreateInvitationResponse struct {
+	Invitation models.Invitation
+}
+
+// ReadInvitationRequest contains the request to read an invitation by the hash of its token
+type ReadInvitationRequest struct {
+	TokenHash string
+}
+
+// ReadInvitationResponse contains the invitation that matched the token hash
+type ReadInvitationResponse struct {
+	Invitation models.Invitation
+}
+
+// ListInvitationsRequest contains the request to list the pending invitations of an existing project
+type ListInvitationsRequest struct {
+	ProjectID string
+}
+
+// ListInvitationsResponse contains the pending invitations of an existing project, ordered by their emails
+type ListInvitationsResponse struct {
+	Invitations []models.Invitation
+}
+
+// AcceptInvitationRequest contains the request to accept the pending invitation of an email to an existing
+// project. The invitation is removed and the invited user is added to the members of the project with the
+// role of the invitation.
+type AcceptInvitationRequest struct {
+	ProjectID string
+	Email     string
+}
+
+// AcceptInvitationResponse contains the result of accepting a pending invitation
+type AcceptInvitationResponse struct {
+	Member models.Member
+}
+
+// DeleteInvitationRequest contains the request to remove the pending invitation of an email to an existing project
+type DeleteInvitationRequest struct {
+	ProjectID string
+	Email     string
+}
+
+// DeleteInvitationResponse contains the result of removing a pending invitation
+type DeleteInvitationResponse struct {
+}
+
+// CreateOrganizationRequest contains the request to create a new organization. The user becomes the owner of
+// the organization.
+type CreateOrganizationRequest struct {
+	UserEmail    string
+	Organization models.Organization
+}
+
+// CreateOrganizationResponse contains the result of creating a new organization
+type CreateOrganizationResponse struct {
+	OrganizationID string
+	Organization   models.Organization
+}
+
+// ReadOrganizationRequest contains the request to read an existing organization
+type ReadOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+}
+
+// ReadOrganizationResponse contains the result of reading an existing organization
+type ReadOrganizationResponse struct {
+	Organization models.Organization
+}
+
+// UpdateOrganizationRequest contains the request to update an existing organization
+type UpdateOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+	Organization   models.Organization
+}
+
+// UpdateOrganizationResponse contains the result of updating an existing organization
+type UpdateOrganizationResponse struct {
+	Organization models.Organization
+}
+
+// DeleteOrganizationRequest contains the request to delete an existing organization. The organization can only
+// be deleted once all its projects, including the deleted ones, are purged.
+type DeleteOrganizationRequest struct {
+	UserEmail      string
+	OrganizationID string
+}
+
+// DeleteOrganizationResponse contains the result of deleting an existing organization
+type DeleteOrganizationResponse struct {
+}
+
+// ListOrganizationsRequest contains the request to list the organizations the user is a member of
+type ListOrganizationsRequest struct {
+	UserEmail string
+}
+
+// ListOrganizationsResponse contains the organizations the user is a member of, ordered by their names
+type ListOrganizationsResponse struct {
+	Organizations []models.OrganizationWithID
+}
+
+// ReadOrganizationMemberRequest contains the request to read a member of an existing organization
+type ReadOrganizationMemberRequest struct {
+	OrganizationID string
+	UserEmail      string
+}
+
+// ReadOrganizationMemberResponse contains the result of reading a member of an existing organization
+type ReadOrganizationMemberResponse struct {
+	Member models.Member
+}
+
+// AddOrganizationMemberRequest contains the request to add a new member to an existing organization
+type AddOrganizationMemberRequest struct {
+	OrganizationID string
+	Member         models.Member
+}
+
+// AddOrganizationMemberResponse contains the result of adding a new member to an existing organization
+type AddOrganizationMemberResponse struct {
+	Member models.Member
+}
+
+// RemoveOrganizationMemberRequest contains the request to remove an existing member from an organization
+type RemoveOrganizationMemberRequest struct {
+	OrganizationID string
+	UserEmail      string
+}
+
+// RemoveOrganizationMemberResponse contains the result of removing an existing member from an organization
+type RemoveOrganizationMemberResponse struct {
+}
+
+// ListOrganizationMembersRequest contains the request to list the members of an existing organization
+type ListOrganizationMembersRequest struct {
+	OrganizationID string
+}
+
+// ListOrganizationMembersResponse contains the members of an existing organization, ordered by their emails
+type ListOrganizationMembersResponse struct {
+	Members []models.Member
+}
+
+// MoveProjectRequest contains the request to move an existing project into a folder of the user. The project is
+// moved out of its folder if FolderID is empty. If the event is provided, it is written to the outbox together
+// with the project.
+type MoveProjectRequest struct {
+	UserEmail string
+	ProjectID string
+	FolderID  string
+	Event     *models.DomainEvent
+}
+
+// MoveProjectResponse contains the result of moving an existing project
+type MoveProjectResponse struct {
+	Project models.Project
+	Cursor  string
+}
+
+// CreateFolderRequest contains the request to create a new folder for the user
+type CreateFolderRequest struct {
+	UserEmail string
+	Folder    models.Folder
+}
+
+// CreateFolderResponse contains the result of creating a new folder
+type CreateFolderResponse struct {
+	FolderID string
+	Folder   models.Folder
+}
+
+// ListFoldersRequest contains the request to list the folders of the user
+type ListFoldersRequest struct {
+	UserEmail string
+}
+
+// ListFoldersResponse contains the folders of the user, ordered by their names
+type ListFoldersResponse struct {
+	Folders []models.FolderWithID
+}
+
+// DeleteFolderRequest contains the request to delete an existing folder of the user together with its
+// subfolders. The folder can only be deleted if neither the folder nor its subfolders contain a project the user
+// has access to, unless Cascade is true, in which case those projects are deleted too. The deleted projects
+// and the projects the user has no access to anymore are moved out of the deleted folders. If the event is
+// provided, a copy of it is written to the outbox together with every project deleted with the folder.
+type DeleteFolderRequest struct {
+	UserEmail string
+	FolderID  string
+	Cascade   bool
+	Event     *models.DomainEvent
+}
+
+// DeleteFolderResponse contains the result of deleting an existing folder
+type DeleteFolderResponse struct {
+}
+
+// ListOutboxEventsRequest contains the request to list the events written to the outbox that are not published yet
+type ListOutboxEventsRequest struct {
+	Limit int
+}
+
+// ListOutboxEventsResponse contains the events written to the outbox that are not published yet, the oldest
+// ones first
+type ListOutboxEventsResponse struct {
+	Events []models.DomainEvent
+}
+
+// DeleteOutboxEventsRequest contains the identifiers of the published events to remove from the outbox. The
+// identifiers that are not in the outbox are ignored.
+type DeleteOutboxEventsRequest struct {
+	EventIDs []string
+}
+
+// DeleteOutboxEventsResponse contains the result of removing the published events from the outbox
+type DeleteOutboxEventsResponse struct {
+}
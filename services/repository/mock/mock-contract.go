@@ -6,8 +6,10 @@ package mock_repository
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
+	models "github.com/decentralized-cloud/project/models"
 	repository "github.com/decentralized-cloud/project/services/repository"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +37,155 @@ func (m *MockRepositoryContract) EXPECT() *MockRepositoryContractMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockRepositoryContract) AcceptInvitation(ctx context.Context, request *repository.AcceptInvitationRequest) (*repository.AcceptInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, request)
+	ret0, _ := ret[0].(*repository.AcceptInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockRepositoryContractMockRecorder) AcceptInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockRepositoryContract)(nil).AcceptInvitation), ctx, request)
+}
+
+// AddMember mocks base method.
+func (m *MockRepositoryContract) AddMember(ctx context.Context, request *repository.AddMemberRequest) (*repository.AddMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, request)
+	ret0, _ := ret[0].(*repository.AddMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockRepositoryContractMockRecorder) AddMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockRepositoryContract)(nil).AddMember), ctx, request)
+}
+
+// AddOrganizationMember mocks base method.
+func (m *MockRepositoryContract) AddOrganizationMember(ctx context.Context, request *repository.AddOrganizationMemberRequest) (*repository.AddOrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMember", ctx, request)
+	ret0, _ := ret[0].(*repository.AddOrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrganizationMember indicates an expected call of AddOrganizationMember.
+func (mr *MockRepositoryContractMockRecorder) AddOrganizationMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMember", reflect.TypeOf((*MockRepositoryContract)(nil).AddOrganizationMember), ctx, request)
+}
+
+// BatchCreateProjects mocks base method.
+func (m *MockRepositoryContract) BatchCreateProjects(ctx context.Context, request *repository.BatchCreateProjectsRequest) (*repository.BatchCreateProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreateProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.BatchCreateProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCreateProjects indicates an expected call of BatchCreateProjects.
+func (mr *MockRepositoryContractMockRecorder) BatchCreateProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreateProjects", reflect.TypeOf((*MockRepositoryContract)(nil).BatchCreateProjects), ctx, request)
+}
+
+// BatchDeleteProjects mocks base method.
+func (m *MockRepositoryContract) BatchDeleteProjects(ctx context.Context, request *repository.BatchDeleteProjectsRequest) (*repository.BatchDeleteProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.BatchDeleteProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteProjects indicates an expected call of BatchDeleteProjects.
+func (mr *MockRepositoryContractMockRecorder) BatchDeleteProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteProjects", reflect.TypeOf((*MockRepositoryContract)(nil).BatchDeleteProjects), ctx, request)
+}
+
+// BatchUpdateProjects mocks base method.
+func (m *MockRepositoryContract) BatchUpdateProjects(ctx context.Context, request *repository.BatchUpdateProjectsRequest) (*repository.BatchUpdateProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.BatchUpdateProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateProjects indicates an expected call of BatchUpdateProjects.
+func (mr *MockRepositoryContractMockRecorder) BatchUpdateProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateProjects", reflect.TypeOf((*MockRepositoryContract)(nil).BatchUpdateProjects), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockRepositoryContract) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRepositoryContractMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRepositoryContract)(nil).Close))
+}
+
+// CreateFolder mocks base method.
+func (m *MockRepositoryContract) CreateFolder(ctx context.Context, request *repository.CreateFolderRequest) (*repository.CreateFolderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, request)
+	ret0, _ := ret[0].(*repository.CreateFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockRepositoryContractMockRecorder) CreateFolder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockRepositoryContract)(nil).CreateFolder), ctx, request)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockRepositoryContract) CreateInvitation(ctx context.Context, request *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, request)
+	ret0, _ := ret[0].(*repository.CreateInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockRepositoryContractMockRecorder) CreateInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockRepositoryContract)(nil).CreateInvitation), ctx, request)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockRepositoryContract) CreateOrganization(ctx context.Context, request *repository.CreateOrganizationRequest) (*repository.CreateOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, request)
+	ret0, _ := ret[0].(*repository.CreateOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockRepositoryContractMockRecorder) CreateOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockRepositoryContract)(nil).CreateOrganization), ctx, request)
+}
+
 // CreateProject mocks base method.
 func (m *MockRepositoryContract) CreateProject(ctx context.Context, request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +201,81 @@ func (mr *MockRepositoryContractMockRecorder) CreateProject(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockRepositoryContract)(nil).CreateProject), ctx, request)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockRepositoryContract) DeleteFolder(ctx context.Context, request *repository.DeleteFolderRequest) (*repository.DeleteFolderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", ctx, request)
+	ret0, _ := ret[0].(*repository.DeleteFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockRepositoryContractMockRecorder) DeleteFolder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteFolder), ctx, request)
+}
+
+// DeleteInvitation mocks base method.
+func (m *MockRepositoryContract) DeleteInvitation(ctx context.Context, request *repository.DeleteInvitationRequest) (*repository.DeleteInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInvitation", ctx, request)
+	ret0, _ := ret[0].(*repository.DeleteInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInvitation indicates an expected call of DeleteInvitation.
+func (mr *MockRepositoryContractMockRecorder) DeleteInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvitation", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteInvitation), ctx, request)
+}
+
+// DeleteOrganization mocks base method.
+func (m *MockRepositoryContract) DeleteOrganization(ctx context.Context, request *repository.DeleteOrganizationRequest) (*repository.DeleteOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, request)
+	ret0, _ := ret[0].(*repository.DeleteOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *MockRepositoryContractMockRecorder) DeleteOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteOrganization), ctx, request)
+}
+
+// DeleteOutboxEvents mocks base method.
+func (m *MockRepositoryContract) DeleteOutboxEvents(ctx context.Context, request *repository.DeleteOutboxEventsRequest) (*repository.DeleteOutboxEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOutboxEvents", ctx, request)
+	ret0, _ := ret[0].(*repository.DeleteOutboxEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOutboxEvents indicates an expected call of DeleteOutboxEvents.
+func (mr *MockRepositoryContractMockRecorder) DeleteOutboxEvents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutboxEvents", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteOutboxEvents), ctx, request)
+}
+
+// DeleteOwnershipTransfer mocks base method.
+func (m *MockRepositoryContract) DeleteOwnershipTransfer(ctx context.Context, request *repository.DeleteOwnershipTransferRequest) (*repository.DeleteOwnershipTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOwnershipTransfer", ctx, request)
+	ret0, _ := ret[0].(*repository.DeleteOwnershipTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOwnershipTransfer indicates an expected call of DeleteOwnershipTransfer.
+func (mr *MockRepositoryContractMockRecorder) DeleteOwnershipTransfer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOwnershipTransfer", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteOwnershipTransfer), ctx, request)
+}
+
 // DeleteProject mocks base method.
 func (m *MockRepositoryContract) DeleteProject(ctx context.Context, request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +291,126 @@ func (mr *MockRepositoryContractMockRecorder) DeleteProject(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteProject), ctx, request)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockRepositoryContract) ListAuditEvents(ctx context.Context, request *repository.ListAuditEventsRequest) (*repository.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, request)
+	ret0, _ := ret[0].(*repository.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockRepositoryContractMockRecorder) ListAuditEvents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockRepositoryContract)(nil).ListAuditEvents), ctx, request)
+}
+
+// ListDeletedProjects mocks base method.
+func (m *MockRepositoryContract) ListDeletedProjects(ctx context.Context, request *repository.ListDeletedProjectsRequest) (*repository.ListDeletedProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.ListDeletedProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedProjects indicates an expected call of ListDeletedProjects.
+func (mr *MockRepositoryContractMockRecorder) ListDeletedProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedProjects", reflect.TypeOf((*MockRepositoryContract)(nil).ListDeletedProjects), ctx, request)
+}
+
+// ListFolders mocks base method.
+func (m *MockRepositoryContract) ListFolders(ctx context.Context, request *repository.ListFoldersRequest) (*repository.ListFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", ctx, request)
+	ret0, _ := ret[0].(*repository.ListFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockRepositoryContractMockRecorder) ListFolders(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockRepositoryContract)(nil).ListFolders), ctx, request)
+}
+
+// ListInvitations mocks base method.
+func (m *MockRepositoryContract) ListInvitations(ctx context.Context, request *repository.ListInvitationsRequest) (*repository.ListInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, request)
+	ret0, _ := ret[0].(*repository.ListInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockRepositoryContractMockRecorder) ListInvitations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockRepositoryContract)(nil).ListInvitations), ctx, request)
+}
+
+// ListMembers mocks base method.
+func (m *MockRepositoryContract) ListMembers(ctx context.Context, request *repository.ListMembersRequest) (*repository.ListMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, request)
+	ret0, _ := ret[0].(*repository.ListMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockRepositoryContractMockRecorder) ListMembers(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockRepositoryContract)(nil).ListMembers), ctx, request)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockRepositoryContract) ListOrganizationMembers(ctx context.Context, request *repository.ListOrganizationMembersRequest) (*repository.ListOrganizationMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", ctx, request)
+	ret0, _ := ret[0].(*repository.ListOrganizationMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockRepositoryContractMockRecorder) ListOrganizationMembers(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockRepositoryContract)(nil).ListOrganizationMembers), ctx, request)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockRepositoryContract) ListOrganizations(ctx context.Context, request *repository.ListOrganizationsRequest) (*repository.ListOrganizationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx, request)
+	ret0, _ := ret[0].(*repository.ListOrganizationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockRepositoryContractMockRecorder) ListOrganizations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockRepositoryContract)(nil).ListOrganizations), ctx, request)
+}
+
+// ListOutboxEvents mocks base method.
+func (m *MockRepositoryContract) ListOutboxEvents(ctx context.Context, request *repository.ListOutboxEventsRequest) (*repository.ListOutboxEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutboxEvents", ctx, request)
+	ret0, _ := ret[0].(*repository.ListOutboxEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutboxEvents indicates an expected call of ListOutboxEvents.
+func (mr *MockRepositoryContractMockRecorder) ListOutboxEvents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboxEvents", reflect.TypeOf((*MockRepositoryContract)(nil).ListOutboxEvents), ctx, request)
+}
+
 // ListProjects mocks base method.
 func (m *MockRepositoryContract) ListProjects(ctx context.Context, request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +426,126 @@ func (mr *MockRepositoryContractMockRecorder) ListProjects(ctx, request interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockRepositoryContract)(nil).ListProjects), ctx, request)
 }
 
+// MoveProject mocks base method.
+func (m *MockRepositoryContract) MoveProject(ctx context.Context, request *repository.MoveProjectRequest) (*repository.MoveProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProject", ctx, request)
+	ret0, _ := ret[0].(*repository.MoveProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProject indicates an expected call of MoveProject.
+func (mr *MockRepositoryContractMockRecorder) MoveProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProject", reflect.TypeOf((*MockRepositoryContract)(nil).MoveProject), ctx, request)
+}
+
+// PurgeDeletedProjects mocks base method.
+func (m *MockRepositoryContract) PurgeDeletedProjects(ctx context.Context, request *repository.PurgeDeletedProjectsRequest) (*repository.PurgeDeletedProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.PurgeDeletedProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedProjects indicates an expected call of PurgeDeletedProjects.
+func (mr *MockRepositoryContractMockRecorder) PurgeDeletedProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedProjects", reflect.TypeOf((*MockRepositoryContract)(nil).PurgeDeletedProjects), ctx, request)
+}
+
+// ReadEffectiveRole mocks base method.
+func (m *MockRepositoryContract) ReadEffectiveRole(ctx context.Context, request *repository.ReadEffectiveRoleRequest) (*repository.ReadEffectiveRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEffectiveRole", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadEffectiveRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEffectiveRole indicates an expected call of ReadEffectiveRole.
+func (mr *MockRepositoryContractMockRecorder) ReadEffectiveRole(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEffectiveRole", reflect.TypeOf((*MockRepositoryContract)(nil).ReadEffectiveRole), ctx, request)
+}
+
+// ReadInvitation mocks base method.
+func (m *MockRepositoryContract) ReadInvitation(ctx context.Context, request *repository.ReadInvitationRequest) (*repository.ReadInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadInvitation", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadInvitation indicates an expected call of ReadInvitation.
+func (mr *MockRepositoryContractMockRecorder) ReadInvitation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadInvitation", reflect.TypeOf((*MockRepositoryContract)(nil).ReadInvitation), ctx, request)
+}
+
+// ReadMember mocks base method.
+func (m *MockRepositoryContract) ReadMember(ctx context.Context, request *repository.ReadMemberRequest) (*repository.ReadMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMember", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMember indicates an expected call of ReadMember.
+func (mr *MockRepositoryContractMockRecorder) ReadMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMember", reflect.TypeOf((*MockRepositoryContract)(nil).ReadMember), ctx, request)
+}
+
+// ReadOrganization mocks base method.
+func (m *MockRepositoryContract) ReadOrganization(ctx context.Context, request *repository.ReadOrganizationRequest) (*repository.ReadOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOrganization", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOrganization indicates an expected call of ReadOrganization.
+func (mr *MockRepositoryContractMockRecorder) ReadOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOrganization", reflect.TypeOf((*MockRepositoryContract)(nil).ReadOrganization), ctx, request)
+}
+
+// ReadOrganizationMember mocks base method.
+func (m *MockRepositoryContract) ReadOrganizationMember(ctx context.Context, request *repository.ReadOrganizationMemberRequest) (*repository.ReadOrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOrganizationMember", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadOrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOrganizationMember indicates an expected call of ReadOrganizationMember.
+func (mr *MockRepositoryContractMockRecorder) ReadOrganizationMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOrganizationMember", reflect.TypeOf((*MockRepositoryContract)(nil).ReadOrganizationMember), ctx, request)
+}
+
+// ReadOwnershipTransfer mocks base method.
+func (m *MockRepositoryContract) ReadOwnershipTransfer(ctx context.Context, request *repository.ReadOwnershipTransferRequest) (*repository.ReadOwnershipTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOwnershipTransfer", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadOwnershipTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOwnershipTransfer indicates an expected call of ReadOwnershipTransfer.
+func (mr *MockRepositoryContractMockRecorder) ReadOwnershipTransfer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOwnershipTransfer", reflect.TypeOf((*MockRepositoryContract)(nil).ReadOwnershipTransfer), ctx, request)
+}
+
 // ReadProject mocks base method.
 func (m *MockRepositoryContract) ReadProject(ctx context.Context, request *repository.ReadProjectRequest) (*repository.ReadProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +561,141 @@ func (mr *MockRepositoryContractMockRecorder) ReadProject(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadProject", reflect.TypeOf((*MockRepositoryContract)(nil).ReadProject), ctx, request)
 }
 
+// RemoveMember mocks base method.
+func (m *MockRepositoryContract) RemoveMember(ctx context.Context, request *repository.RemoveMemberRequest) (*repository.RemoveMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, request)
+	ret0, _ := ret[0].(*repository.RemoveMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockRepositoryContractMockRecorder) RemoveMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockRepositoryContract)(nil).RemoveMember), ctx, request)
+}
+
+// RemoveOrganizationMember mocks base method.
+func (m *MockRepositoryContract) RemoveOrganizationMember(ctx context.Context, request *repository.RemoveOrganizationMemberRequest) (*repository.RemoveOrganizationMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMember", ctx, request)
+	ret0, _ := ret[0].(*repository.RemoveOrganizationMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrganizationMember indicates an expected call of RemoveOrganizationMember.
+func (mr *MockRepositoryContractMockRecorder) RemoveOrganizationMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMember", reflect.TypeOf((*MockRepositoryContract)(nil).RemoveOrganizationMember), ctx, request)
+}
+
+// RequestOwnershipTransfer mocks base method.
+func (m *MockRepositoryContract) RequestOwnershipTransfer(ctx context.Context, request *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestOwnershipTransfer", ctx, request)
+	ret0, _ := ret[0].(*repository.RequestOwnershipTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestOwnershipTransfer indicates an expected call of RequestOwnershipTransfer.
+func (mr *MockRepositoryContractMockRecorder) RequestOwnershipTransfer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestOwnershipTransfer", reflect.TypeOf((*MockRepositoryContract)(nil).RequestOwnershipTransfer), ctx, request)
+}
+
+// RestoreProject mocks base method.
+func (m *MockRepositoryContract) RestoreProject(ctx context.Context, request *repository.RestoreProjectRequest) (*repository.RestoreProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, request)
+	ret0, _ := ret[0].(*repository.RestoreProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockRepositoryContractMockRecorder) RestoreProject(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockRepositoryContract)(nil).RestoreProject), ctx, request)
+}
+
+// SearchProjects mocks base method.
+func (m *MockRepositoryContract) SearchProjects(ctx context.Context, request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.SearchProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProjects indicates an expected call of SearchProjects.
+func (mr *MockRepositoryContractMockRecorder) SearchProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProjects", reflect.TypeOf((*MockRepositoryContract)(nil).SearchProjects), ctx, request)
+}
+
+// StreamProjects mocks base method.
+func (m *MockRepositoryContract) StreamProjects(ctx context.Context, request *repository.StreamProjectsRequest) (*repository.StreamProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.StreamProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamProjects indicates an expected call of StreamProjects.
+func (mr *MockRepositoryContractMockRecorder) StreamProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProjects", reflect.TypeOf((*MockRepositoryContract)(nil).StreamProjects), ctx, request)
+}
+
+// TransferProjectOwnership mocks base method.
+func (m *MockRepositoryContract) TransferProjectOwnership(ctx context.Context, request *repository.TransferProjectOwnershipRequest) (*repository.TransferProjectOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferProjectOwnership", ctx, request)
+	ret0, _ := ret[0].(*repository.TransferProjectOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferProjectOwnership indicates an expected call of TransferProjectOwnership.
+func (mr *MockRepositoryContractMockRecorder) TransferProjectOwnership(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferProjectOwnership", reflect.TypeOf((*MockRepositoryContract)(nil).TransferProjectOwnership), ctx, request)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockRepositoryContract) UpdateMemberRole(ctx context.Context, request *repository.UpdateMemberRoleRequest) (*repository.UpdateMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, request)
+	ret0, _ := ret[0].(*repository.UpdateMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockRepositoryContractMockRecorder) UpdateMemberRole(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateMemberRole), ctx, request)
+}
+
+// UpdateOrganization mocks base method.
+func (m *MockRepositoryContract) UpdateOrganization(ctx context.Context, request *repository.UpdateOrganizationRequest) (*repository.UpdateOrganizationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, request)
+	ret0, _ := ret[0].(*repository.UpdateOrganizationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganization indicates an expected call of UpdateOrganization.
+func (mr *MockRepositoryContractMockRecorder) UpdateOrganization(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateOrganization), ctx, request)
+}
+
 // UpdateProject mocks base method.
 func (m *MockRepositoryContract) UpdateProject(ctx context.Context, request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +710,289 @@ func (mr *MockRepositoryContractMockRecorder) UpdateProject(ctx, request interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateProject), ctx, request)
 }
+
+// UpdateProjectState mocks base method.
+func (m *MockRepositoryContract) UpdateProjectState(ctx context.Context, request *repository.UpdateProjectStateRequest) (*repository.UpdateProjectStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectState", ctx, request)
+	ret0, _ := ret[0].(*repository.UpdateProjectStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectState indicates an expected call of UpdateProjectState.
+func (mr *MockRepositoryContractMockRecorder) UpdateProjectState(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectState", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateProjectState), ctx, request)
+}
+
+// MockProjectIterator is a mock of ProjectIterator interface.
+type MockProjectIterator struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectIteratorMockRecorder
+}
+
+// MockProjectIteratorMockRecorder is the mock recorder for MockProjectIterator.
+type MockProjectIteratorMockRecorder struct {
+	mock *MockProjectIterator
+}
+
+// NewMockProjectIterator creates a new mock instance.
+func NewMockProjectIterator(ctrl *gomock.Controller) *MockProjectIterator {
+	mock := &MockProjectIterator{ctrl: ctrl}
+	mock.recorder = &MockProjectIteratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectIterator) EXPECT() *MockProjectIteratorMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockProjectIterator) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockProjectIteratorMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProjectIterator)(nil).Close))
+}
+
+// Err mocks base method.
+func (m *MockProjectIterator) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockProjectIteratorMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockProjectIterator)(nil).Err))
+}
+
+// Next mocks base method.
+func (m *MockProjectIterator) Next(ctx context.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockProjectIteratorMockRecorder) Next(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockProjectIterator)(nil).Next), ctx)
+}
+
+// Project mocks base method.
+func (m *MockProjectIterator) Project() models.ProjectWithCursor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Project")
+	ret0, _ := ret[0].(models.ProjectWithCursor)
+	return ret0
+}
+
+// Project indicates an expected call of Project.
+func (mr *MockProjectIteratorMockRecorder) Project() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Project", reflect.TypeOf((*MockProjectIterator)(nil).Project))
+}
+
+// MockProjectWatcher is a mock of ProjectWatcher interface.
+type MockProjectWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectWatcherMockRecorder
+}
+
+// MockProjectWatcherMockRecorder is the mock recorder for MockProjectWatcher.
+type MockProjectWatcherMockRecorder struct {
+	mock *MockProjectWatcher
+}
+
+// NewMockProjectWatcher creates a new mock instance.
+func NewMockProjectWatcher(ctrl *gomock.Controller) *MockProjectWatcher {
+	mock := &MockProjectWatcher{ctrl: ctrl}
+	mock.recorder = &MockProjectWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectWatcher) EXPECT() *MockProjectWatcherMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockProjectWatcher) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockProjectWatcherMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProjectWatcher)(nil).Close))
+}
+
+// Err mocks base method.
+func (m *MockProjectWatcher) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockProjectWatcherMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockProjectWatcher)(nil).Err))
+}
+
+// Event mocks base method.
+func (m *MockProjectWatcher) Event() models.ProjectEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Event")
+	ret0, _ := ret[0].(models.ProjectEvent)
+	return ret0
+}
+
+// Event indicates an expected call of Event.
+func (mr *MockProjectWatcherMockRecorder) Event() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockProjectWatcher)(nil).Event))
+}
+
+// Next mocks base method.
+func (m *MockProjectWatcher) Next(ctx context.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockProjectWatcherMockRecorder) Next(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockProjectWatcher)(nil).Next), ctx)
+}
+
+// MockWatchContract is a mock of WatchContract interface.
+type MockWatchContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchContractMockRecorder
+}
+
+// MockWatchContractMockRecorder is the mock recorder for MockWatchContract.
+type MockWatchContractMockRecorder struct {
+	mock *MockWatchContract
+}
+
+// NewMockWatchContract creates a new mock instance.
+func NewMockWatchContract(ctrl *gomock.Controller) *MockWatchContract {
+	mock := &MockWatchContract{ctrl: ctrl}
+	mock.recorder = &MockWatchContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchContract) EXPECT() *MockWatchContractMockRecorder {
+	return m.recorder
+}
+
+// WatchProjects mocks base method.
+func (m *MockWatchContract) WatchProjects(ctx context.Context, request *repository.WatchProjectsRequest) (*repository.WatchProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProjects", ctx, request)
+	ret0, _ := ret[0].(*repository.WatchProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchProjects indicates an expected call of WatchProjects.
+func (mr *MockWatchContractMockRecorder) WatchProjects(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProjects", reflect.TypeOf((*MockWatchContract)(nil).WatchProjects), ctx, request)
+}
+
+// MockBackupContract is a mock of BackupContract interface.
+type MockBackupContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupContractMockRecorder
+}
+
+// MockBackupContractMockRecorder is the mock recorder for MockBackupContract.
+type MockBackupContractMockRecorder struct {
+	mock *MockBackupContract
+}
+
+// NewMockBackupContract creates a new mock instance.
+func NewMockBackupContract(ctrl *gomock.Controller) *MockBackupContract {
+	mock := &MockBackupContract{ctrl: ctrl}
+	mock.recorder = &MockBackupContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupContract) EXPECT() *MockBackupContractMockRecorder {
+	return m.recorder
+}
+
+// Backup mocks base method.
+func (m *MockBackupContract) Backup(ctx context.Context, writer io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", ctx, writer)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockBackupContractMockRecorder) Backup(ctx, writer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockBackupContract)(nil).Backup), ctx, writer)
+}
+
+// MockMigrationContract is a mock of MigrationContract interface.
+type MockMigrationContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrationContractMockRecorder
+}
+
+// MockMigrationContractMockRecorder is the mock recorder for MockMigrationContract.
+type MockMigrationContractMockRecorder struct {
+	mock *MockMigrationContract
+}
+
+// NewMockMigrationContract creates a new mock instance.
+func NewMockMigrationContract(ctrl *gomock.Controller) *MockMigrationContract {
+	mock := &MockMigrationContract{ctrl: ctrl}
+	mock.recorder = &MockMigrationContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrationContract) EXPECT() *MockMigrationContractMockRecorder {
+	return m.recorder
+}
+
+// Migrate mocks base method.
+func (m *MockMigrationContract) Migrate(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockMigrationContractMockRecorder) Migrate(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockMigrationContract)(nil).Migrate), ctx)
+}
@@ -0,0 +1,98 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// streamBatchSize is the number of projects the database cursors of StreamProjects fetch at once, so at most
+// one batch of projects is kept in memory while the projects are streamed
+const streamBatchSize = 100
+
+// streamedProject is a project read by StreamProjects together with its identifier
+type streamedProject struct {
+	ID      primitive.ObjectID `bson:"_id"`
+	Project project            `bson:",inline"`
+}
+
+// projectIterator iterates over the projects returned by the database cursor of StreamProjects. The iterator
+// without a cursor does not return any project.
+type projectIterator struct {
+	cursor         *mongo.Cursor
+	sortingOptions []common.SortingOptionPair
+	project        models.ProjectWithCursor
+	err            error
+}
+
+// Next advances the iterator to the next project, fetching the next batch of projects when needed
+// ctx: Mandatory The reference to the context, cancelling it stops the database cursor
+// Returns false when there are no more projects or the iterator failed
+func (iterator *projectIterator) Next(ctx context.Context) bool {
+	if iterator.cursor == nil || iterator.err != nil {
+		return false
+	}
+
+	// The cursor returns the projects of the fetched batch without checking the context
+	if err := ctx.Err(); err != nil {
+		iterator.err = err
+
+		return false
+	}
+
+	if !iterator.cursor.Next(ctx) {
+		if err := iterator.cursor.Err(); err != nil {
+			iterator.err = commonErrors.NewUnknownErrorWithError("failed to read the projects", err)
+		}
+
+		return false
+	}
+
+	var document streamedProject
+	if err := iterator.cursor.Decode(&document); err != nil {
+		iterator.err = commonErrors.NewUnknownErrorWithError("failed to decode the project", err)
+
+		return false
+	}
+
+	projectID := document.ID.Hex()
+	iterator.project = models.ProjectWithCursor{
+		ProjectID: projectID,
+		Project:   mapFromInternalProject(document.Project),
+	}
+	iterator.project.Cursor = repository.NewCursor(iterator.sortingOptions, projectID, iterator.project.Project)
+
+	return true
+}
+
+// Project returns the project the iterator is at
+// Returns the project the last call to Next advanced the iterator to
+func (iterator *projectIterator) Project() models.ProjectWithCursor {
+	return iterator.project
+}
+
+// Err returns the error the iterator failed with
+// Returns the error that stopped the iterator or nil if the iterator did not fail
+func (iterator *projectIterator) Err() error {
+	return iterator.err
+}
+
+// Close kills the database cursor, so the server releases it without waiting for it to time out
+// Returns error if something goes wrong
+func (iterator *projectIterator) Close() error {
+	if iterator.cursor == nil {
+		return nil
+	}
+
+	// The cursor is closed with a fresh context, as the context of the stream may already be cancelled
+	if err := iterator.cursor.Close(context.Background()); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the database cursor", err)
+	}
+
+	return nil
+}
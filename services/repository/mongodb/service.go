@@ -3,11 +3,19 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/decentralized-cloud/project/models"
 	"github.com/decentralized-cloud/project/services/configuration"
 	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
 	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,15 +24,118 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// sortingFields maps the fields of the models.ProjectSortFields catalogue to the fields of the project
+// documents. Only the listed fields can be used to sort the projects, so the internal fields are never exposed.
+var sortingFields = map[string]string{
+	"_id":       "_id",
+	"name":      "name",
+	"createdAt": "createdAt",
+	"updatedAt": "updatedAt",
+}
+
+// organizationsCollectionName is the name of the collection that stores the organizations, in the same
+// database as the projects
+const organizationsCollectionName = "organizations"
+
+// foldersCollectionName is the name of the collection that stores the folders, in the same database as the
+// projects
+const foldersCollectionName = "folders"
+
+// idempotencyKeysCollectionName is the name of the collection that stores the idempotency keys the projects
+// were created with, in the same database as the projects
+const idempotencyKeysCollectionName = "idempotencyKeys"
+
+// migrationTimeout is how long the migrations can take when they run on the first request instead of at startup,
+// so they do not depend on the deadline of the request
+const migrationTimeout = 10 * time.Minute
+
 type project struct {
-	UserEmail string `bson:"userEmail" json:"userEmail"`
-	Name      string `bson:"name" json:"name"`
+	UserEmail      string              `bson:"userEmail" json:"userEmail"`
+	Name           string              `bson:"name" json:"name"`
+	NormalizedName string              `bson:"normalizedName" json:"normalizedName"`
+	Description    string              `bson:"description" json:"description"`
+	Labels         map[string]string   `bson:"labels" json:"labels"`
+	Annotations    map[string]string   `bson:"annotations" json:"annotations"`
+	CreatedAt      time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time           `bson:"updatedAt" json:"updatedAt"`
+	CreatedBy      string              `bson:"createdBy" json:"createdBy"`
+	Version        int64               `bson:"version" json:"version"`
+	DeletedAt      *time.Time          `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
+	State          models.ProjectState `bson:"state" json:"state"`
+	Members        []member            `bson:"members" json:"members"`
+	// OwnershipTransfer is only set while an ownership transfer of the project waits to be accepted or declined
+	OwnershipTransfer *models.OwnershipTransfer `bson:"ownershipTransfer,omitempty" json:"ownershipTransfer,omitempty"`
+	AuditEvents       []models.AuditEvent       `bson:"auditEvents,omitempty" json:"auditEvents,omitempty"`
+	Invitations       []invitation              `bson:"invitations,omitempty" json:"invitations,omitempty"`
+	OrganizationID    string                    `bson:"organizationID,omitempty" json:"organizationID,omitempty"`
+	FolderID          string                    `bson:"folderID,omitempty" json:"folderID,omitempty"`
+	// Outbox keeps the events written together with the changes of the project that are not published yet.
+	// The events are kept in the project document, so they are written atomically with the project without
+	// requiring a transaction.
+	Outbox []models.DomainEvent `bson:"outbox,omitempty" json:"outbox,omitempty"`
+}
+
+// projectWithID is a project together with its identifier, which is generated before the project is inserted
+type projectWithID struct {
+	ID      primitive.ObjectID `bson:"_id"`
+	Project project            `bson:",inline"`
+}
+
+// searchResult is a project that matched a text search together with its identifier and its text score
+type searchResult struct {
+	ID      primitive.ObjectID `bson:"_id"`
+	Score   float64            `bson:"score"`
+	Project project            `bson:",inline"`
+}
+
+type organization struct {
+	Name        string    `bson:"name" json:"name"`
+	Description string    `bson:"description" json:"description"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt" json:"updatedAt"`
+	CreatedBy   string    `bson:"createdBy" json:"createdBy"`
+	Members     []member  `bson:"members" json:"members"`
+}
+
+type folder struct {
+	UserEmail      string    `bson:"userEmail" json:"userEmail"`
+	Name           string    `bson:"name" json:"name"`
+	ParentFolderID string    `bson:"parentFolderID,omitempty" json:"parentFolderID,omitempty"`
+	CreatedAt      time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+type idempotencyKey struct {
+	UserEmail   string    `bson:"userEmail" json:"userEmail"`
+	Key         string    `bson:"key" json:"key"`
+	PayloadHash string    `bson:"payloadHash" json:"payloadHash"`
+	ExpiresAt   time.Time `bson:"expiresAt" json:"expiresAt"`
+	ProjectID   string    `bson:"projectID" json:"projectID"`
+}
+
+type member struct {
+	UserEmail string            `bson:"userEmail" json:"userEmail"`
+	Role      models.MemberRole `bson:"role" json:"role"`
+}
+
+type invitation struct {
+	Email     string            `bson:"email" json:"email"`
+	Role      models.MemberRole `bson:"role" json:"role"`
+	InvitedBy string            `bson:"invitedBy" json:"invitedBy"`
+	TokenHash string            `bson:"tokenHash" json:"tokenHash"`
+	CreatedAt time.Time         `bson:"createdAt" json:"createdAt"`
+	ExpiresAt time.Time         `bson:"expiresAt" json:"expiresAt"`
 }
 
 type mongodbRepositoryService struct {
 	connectionString       string
 	databaseName           string
 	databaseCollectionName string
+	maxPoolSize            uint64
+	connectTimeout         time.Duration
+	serverSelectionTimeout time.Duration
+	clientLock             sync.RWMutex
+	client                 *mongo.Client
+	migrated               bool
 }
 
 // NewMongodbRepositoryService creates new instance of the mongodbRepositoryService, setting up all dependencies and returns the instance
@@ -50,38 +161,146 @@ func NewMongodbRepositoryService(
 		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database collection name", err)
 	}
 
+	maxPoolSize, err := configurationService.GetDatabaseMaxPoolSize()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database max pool size", err)
+	}
+
+	connectTimeout, err := configurationService.GetDatabaseConnectTimeout()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database connect timeout", err)
+	}
+
+	serverSelectionTimeout, err := configurationService.GetDatabaseServerSelectionTimeout()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database server selection timeout", err)
+	}
+
 	return &mongodbRepositoryService{
 		connectionString:       connectionString,
 		databaseName:           databaseName,
 		databaseCollectionName: databaseCollectionName,
+		maxPoolSize:            maxPoolSize,
+		connectTimeout:         connectTimeout,
+		serverSelectionTimeout: serverSelectionTimeout,
 	}, nil
 }
 
-// CreateProject creates a new project.
+// CreateProject creates a new project. If the request has an idempotency key, the key is claimed in the same
+// transaction the project is inserted in, so the key is never left claimed for a project that was not created.
+// The transactions require the MongoDB deployment to be a replica set or a sharded cluster.
 // context: Optional The reference to the context
 // request: Mandatory. The request to create a new project
 // Returns either the result of creating new project or error if something goes wrong.
 func (service *mongodbRepositoryService) CreateProject(
 	ctx context.Context,
 	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
-	client, collection, err := service.createClientAndCollection(ctx)
-	if err != nil {
+	if request.IdempotencyKey == nil {
+		return service.createProject(ctx, request)
+	}
+
+	var response *repository.CreateProjectResponse
+	if err := service.runTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		response, err = service.createProject(ctx, request)
+
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
-	defer disconnect(ctx, client)
+	return response, nil
+}
 
-	insertResult, err := collection.InsertOne(ctx, mapToInternalProject(request.UserEmail, request.Project))
+// createProject creates the project of the request, either on its own or as part of a transaction. The
+// idempotency key of the request, if any, must be claimed in the transaction the project is inserted in.
+func (service *mongodbRepositoryService) createProject(
+	ctx context.Context,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	collection, err := service.getCollection(ctx)
 	if err != nil {
-		return nil, commonErrors.NewUnknownErrorWithError("failed to create project", err)
+		return nil, err
+	}
+
+	if request.Project.OrganizationID != "" {
+		exists, err := service.organizationExists(ctx, request.Project.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, commonErrors.NewNotFoundError()
+		}
+	}
+
+	objectID := primitive.NewObjectID()
+	projectID := objectID.Hex()
+
+	if request.IdempotencyKey != nil {
+		response, err := service.claimIdempotencyKey(ctx, collection, request, projectID)
+		if err != nil || response != nil {
+			return response, err
+		}
+	}
+
+	createdAt := now()
+	newProject := mapToInternalProject(request.UserEmail, request.Project)
+	newProject.CreatedAt = createdAt
+	newProject.UpdatedAt = createdAt
+	newProject.CreatedBy = request.UserEmail
+	newProject.Version = 1
+	newProject.Members = []member{{UserEmail: request.UserEmail, Role: models.MemberRoleOwner}}
+
+	if request.Event != nil {
+		newProject.Outbox = []models.DomainEvent{query.NewOutboxEvent(*request.Event, projectID)}
 	}
 
-	projectID := insertResult.InsertedID.(primitive.ObjectID).Hex()
+	if _, err = collection.InsertOne(ctx, projectWithID{ID: objectID, Project: newProject}); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, commonErrors.NewAlreadyExistsError()
+		}
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create project", err)
+	}
 
 	return &repository.CreateProjectResponse{
 		ProjectID: projectID,
-		Project:   request.Project,
-		Cursor:    projectID,
+		Project:   mapFromInternalProject(newProject),
+		Cursor:    repository.NewProjectCursor(projectID),
+	}, nil
+}
+
+// BatchCreateProjects creates new projects in a single transaction, either all the projects are created or
+// none of them is. The transactions require the MongoDB deployment to be a replica set or a sharded cluster.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns either the results of creating the projects or error if something goes wrong.
+func (service *mongodbRepositoryService) BatchCreateProjects(
+	ctx context.Context,
+	request *repository.BatchCreateProjectsRequest) (*repository.BatchCreateProjectsResponse, error) {
+	var responses []repository.CreateProjectResponse
+
+	if err := service.runBatch(
+		ctx,
+		len(request.Requests),
+		func() {
+			responses = make([]repository.CreateProjectResponse, 0, len(request.Requests))
+		},
+		func(ctx context.Context, idx int) error {
+			response, err := service.createProject(ctx, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			responses = append(responses, *response)
+
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchCreateProjectsResponse{
+		Responses: responses,
 	}, nil
 }
 
@@ -92,15 +311,18 @@ func (service *mongodbRepositoryService) CreateProject(
 func (service *mongodbRepositoryService) ReadProject(
 	ctx context.Context,
 	request *repository.ReadProjectRequest) (*repository.ReadProjectResponse, error) {
-	client, collection, err := service.createClientAndCollection(ctx)
+	collection, err := service.getCollection(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer disconnect(ctx, client)
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
 
 	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
-	filter := bson.D{{Key: "_id", Value: ObjectID}, {Key: "userEmail", Value: request.UserEmail}}
+	filter := buildProjectFilter(ObjectID, accessCondition, false, 0)
 	var project project
 
 	err = collection.FindOne(ctx, filter).Decode(&project)
@@ -122,30 +344,87 @@ func (service *mongodbRepositoryService) ReadProject(
 func (service *mongodbRepositoryService) UpdateProject(
 	ctx context.Context,
 	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
-	client, collection, err := service.createClientAndCollection(ctx)
+	if err := models.ValidateUpdateMask(request.UpdateMask); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("updateMask", "", err)
+	}
+
+	collection, err := service.getCollection(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer disconnect(ctx, client)
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
 
 	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
-	filter := bson.D{{Key: "_id", Value: ObjectID}, {Key: "userEmail", Value: request.UserEmail}}
 
-	newProject := bson.M{"$set": bson.M{"name": request.Project.Name}}
-	response, err := collection.UpdateOne(ctx, filter, newProject)
+	// The archived projects are read-only, the state is part of the filter so a project archived concurrently is
+	// never updated
+	filter := append(
+		buildProjectFilter(ObjectID, accessCondition, false, request.ExpectedVersion),
+		bson.E{Key: "state", Value: bson.M{"$ne": models.ProjectStateArchived}})
 
-	if err != nil {
-		return nil, commonErrors.NewUnknownErrorWithError("failed to update project", err)
+	newProject := bson.M{"$set": buildUpdateFields(request.Project, request.UpdateMask), "$inc": bson.M{
+		"version": 1,
+	}}
+
+	if request.Event != nil {
+		newProject["$push"] = bson.M{"outbox": query.NewOutboxEvent(*request.Event, request.ProjectID)}
 	}
 
-	if response.MatchedCount == 0 {
-		return nil, commonErrors.NewNotFoundError()
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		newProject,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, getUnmatchedUpdateError(ctx, collection, ObjectID, accessCondition, request.ExpectedVersion)
+	} else if isDuplicateKeyError(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project", err)
 	}
 
 	return &repository.UpdateProjectResponse{
-		Project: request.Project,
-		Cursor:  request.ProjectID,
+		Project: mapFromInternalProject(project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// BatchUpdateProjects updates existing projects in a single transaction, either all the projects are updated or
+// none of them is. The transactions require the MongoDB deployment to be a replica set or a sharded cluster.
+// context: Optional The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns either the results of updating the projects or error if something goes wrong.
+func (service *mongodbRepositoryService) BatchUpdateProjects(
+	ctx context.Context,
+	request *repository.BatchUpdateProjectsRequest) (*repository.BatchUpdateProjectsResponse, error) {
+	var responses []repository.UpdateProjectResponse
+
+	if err := service.runBatch(
+		ctx,
+		len(request.Requests),
+		func() {
+			responses = make([]repository.UpdateProjectResponse, 0, len(request.Requests))
+		},
+		func(ctx context.Context, idx int) error {
+			response, err := service.UpdateProject(ctx, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			responses = append(responses, *response)
+
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchUpdateProjectsResponse{
+		Responses: responses,
 	}, nil
 }
 
@@ -156,27 +435,195 @@ func (service *mongodbRepositoryService) UpdateProject(
 func (service *mongodbRepositoryService) DeleteProject(
 	ctx context.Context,
 	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
-	client, collection, err := service.createClientAndCollection(ctx)
+	collection, err := service.getCollection(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer disconnect(ctx, client)
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
 
 	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
-	filter := bson.D{{Key: "_id", Value: ObjectID}, {Key: "userEmail", Value: request.UserEmail}}
-	response, err := collection.DeleteOne(ctx, filter)
+	filter := buildProjectFilter(ObjectID, accessCondition, false, request.ExpectedVersion)
+
+	update := mongo.Pipeline{buildDeleteStage(now())}
+	if request.Event != nil {
+		update = append(update, buildPushOutboxStage(query.NewOutboxEvent(*request.Event, request.ProjectID)))
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("failed to delete project", err)
 	}
 
-	if response.DeletedCount == 0 {
-		return nil, commonErrors.NewNotFoundError()
+	if response.MatchedCount == 0 {
+		return nil, getUnmatchedProjectError(ctx, collection, ObjectID, accessCondition, false, request.ExpectedVersion)
 	}
 
 	return &repository.DeleteProjectResponse{}, nil
 }
 
+// BatchDeleteProjects deletes existing projects in a single transaction, either all the projects are deleted or
+// none of them is. The transactions require the MongoDB deployment to be a replica set or a sharded cluster.
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns either the results of deleting the projects or error if something goes wrong.
+func (service *mongodbRepositoryService) BatchDeleteProjects(
+	ctx context.Context,
+	request *repository.BatchDeleteProjectsRequest) (*repository.BatchDeleteProjectsResponse, error) {
+	var responses []repository.DeleteProjectResponse
+
+	if err := service.runBatch(
+		ctx,
+		len(request.Requests),
+		func() {
+			responses = make([]repository.DeleteProjectResponse, 0, len(request.Requests))
+		},
+		func(ctx context.Context, idx int) error {
+			response, err := service.DeleteProject(ctx, &request.Requests[idx])
+			if err != nil {
+				return err
+			}
+
+			responses = append(responses, *response)
+
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchDeleteProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet
+// context: Optional The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns either the result of restoring a deleted project or error if something goes wrong.
+func (service *mongodbRepositoryService) RestoreProject(
+	ctx context.Context,
+	request *repository.RestoreProjectRequest) (*repository.RestoreProjectResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := buildProjectFilter(ObjectID, accessCondition, true, request.ExpectedVersion)
+
+	update := bson.M{
+		"$unset":  bson.M{"deletedAt": ""},
+		"$rename": bson.M{"deletedNormalizedName": "normalizedName"},
+		"$inc":    bson.M{"version": 1},
+	}
+
+	if request.Event != nil {
+		update["$push"] = bson.M{"outbox": query.NewOutboxEvent(*request.Event, request.ProjectID)}
+	}
+
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, getUnmatchedProjectError(ctx, collection, ObjectID, accessCondition, true, request.ExpectedVersion)
+	} else if isDuplicateKeyError(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to restore project", err)
+	}
+
+	return &repository.RestoreProjectResponse{
+		Project: mapFromInternalProject(project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// UpdateProjectState changes the state of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the state of an existing project
+// Returns either the result of changing the state of an existing project or error if something goes wrong.
+func (service *mongodbRepositoryService) UpdateProjectState(
+	ctx context.Context,
+	request *repository.UpdateProjectStateRequest) (*repository.UpdateProjectStateResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := buildProjectFilter(ObjectID, accessCondition, false, request.ExpectedVersion)
+
+	update := bson.M{
+		"$set": bson.M{"state": request.State},
+		"$inc": bson.M{"version": 1},
+	}
+
+	if request.Event != nil {
+		update["$push"] = bson.M{"outbox": query.NewOutboxEvent(*request.Event, request.ProjectID)}
+	}
+
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, getUnmatchedProjectError(ctx, collection, ObjectID, accessCondition, false, request.ExpectedVersion)
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project state", err)
+	}
+
+	return &repository.UpdateProjectStateResponse{
+		Project: mapFromInternalProject(project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+// time provided in the request. The projects whose events are not published yet are kept until their events
+// are published.
+// context: Optional The reference to the context
+// request: Mandatory. The request contains the time the projects must have been deleted before
+// Returns either the result of purging the deleted projects or error if something goes wrong.
+func (service *mongodbRepositoryService) PurgeDeletedProjects(
+	ctx context.Context,
+	request *repository.PurgeDeletedProjectsRequest) (*repository.PurgeDeletedProjectsResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The events are kept in the project documents, which are only removed once their events are published
+	response, err := collection.DeleteMany(ctx, bson.M{
+		"deletedAt":      bson.M{"$lt": request.DeletedBefore},
+		"outbox.eventID": bson.M{"$exists": false},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to purge the deleted projects", err)
+	}
+
+	return &repository.PurgeDeletedProjectsResponse{
+		PurgedCount: response.DeletedCount,
+	}, nil
+}
+
 // ListProjects returns the list of projects that matched the criteria
 // ctx: Mandatory The reference to the context
 // request: Mandatory. The request contains the search criteria
@@ -184,38 +631,56 @@ func (service *mongodbRepositoryService) DeleteProject(
 func (service *mongodbRepositoryService) ListProjects(
 	ctx context.Context,
 	request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
+	return service.listProjects(ctx, request, false)
+}
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of deleted projects that matched the criteria
+func (service *mongodbRepositoryService) ListDeletedProjects(
+	ctx context.Context,
+	request *repository.ListDeletedProjectsRequest) (*repository.ListDeletedProjectsResponse, error) {
+	response, err := service.listProjects(ctx, request.ToListProjectsRequest(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedResponse := repository.ListDeletedProjectsResponse(*response)
+
+	return &deletedResponse, nil
+}
+
+// listProjects returns either the deleted projects or the ones that are not deleted that matched the criteria
+func (service *mongodbRepositoryService) listProjects(
+	ctx context.Context,
+	request *repository.ListProjectsRequest,
+	deleted bool) (*repository.ListProjectsResponse, error) {
 	response := &repository.ListProjectsResponse{
 		HasPreviousPage: false,
 		HasNextPage:     false,
 	}
 
-	ids := []primitive.ObjectID{}
-	for _, projectID := range request.ProjectIDs {
-		objectID, err := primitive.ObjectIDFromHex(projectID)
-		if err != nil {
-			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the projectID: %s.", projectID), err)
-		}
-
-		ids = append(ids, objectID)
+	if err := models.ValidateSortingOptions(request.SortingOptions); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("sortingOptions", "", err)
 	}
 
-	filter := bson.M{}
-	if len(request.ProjectIDs) > 0 {
-		filter["_id"] = bson.M{"$in": ids}
+	conditions, found, err := service.buildListConditions(ctx, request, deleted)
+	if err != nil {
+		return nil, err
 	}
 
-	filter["$and"] = []interface{}{
-		bson.M{"userEmail": bson.M{"$eq": request.UserEmail}},
+	if !found {
+		// Like an unknown organization, an unknown folder does not match any project
+		return response, nil
 	}
 
-	client, collection, err := service.createClientAndCollection(ctx)
+	collection, err := service.getCollection(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer disconnect(ctx, client)
-
-	response.TotalCount, err = collection.CountDocuments(ctx, filter)
+	response.TotalCount, err = collection.CountDocuments(ctx, bson.M{"$and": conditions})
 	if err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the number of projects that match the filter criteria", err)
 	}
@@ -225,66 +690,61 @@ func (service *mongodbRepositoryService) ListProjects(
 		return response, nil
 	}
 
+	// After and Before are compared against all the sort keys of the projects, so the pages are stable in any
+	// sort order. The pages on the other side of the cursors exist if any project was left out by the cursors.
 	if request.Pagination.After != nil {
-		after := *request.Pagination.After
-		objectID, err := primitive.ObjectIDFromHex(after)
+		keys, err := repository.ParseCursor(*request.Pagination.After, request.SortingOptions)
 		if err != nil {
-			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the After: %s.", after), err)
+			return nil, err
 		}
 
-		if len(filter) > 0 {
-			filter["$and"] = []interface{}{
-				bson.M{"_id": bson.M{"$gt": objectID}},
-			}
-		} else {
-			filter["_id"] = bson.M{"$gt": objectID}
+		condition, err := buildCursorCondition(keys, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.HasPreviousPage, err = exists(ctx, collection, append(conditions, bson.M{"$nor": []interface{}{condition}})); err != nil {
+			return nil, err
 		}
+
+		conditions = append(conditions, condition)
 	}
 
 	if request.Pagination.Before != nil {
-		before := *request.Pagination.Before
-		objectID, err := primitive.ObjectIDFromHex(before)
+		keys, err := repository.ParseCursor(*request.Pagination.Before, request.SortingOptions)
 		if err != nil {
-			return nil, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the Before: %s.", before), err)
+			return nil, err
 		}
 
-		if len(filter) > 0 {
-			filter["$and"] = []interface{}{
-				bson.M{"_id": bson.M{"$lt": objectID}},
-			}
-		} else {
-			filter["_id"] = bson.M{"$lt": objectID}
+		condition, err := buildCursorCondition(keys, false)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	findOptions := options.Find()
 
-	if request.Pagination.First != nil {
-		findOptions.SetLimit(int64(*request.Pagination.First))
-	}
+		if response.HasNextPage, err = exists(ctx, collection, append(conditions, bson.M{"$nor": []interface{}{condition}})); err != nil {
+			return nil, err
+		}
 
-	if request.Pagination.Last != nil {
-		findOptions.SetLimit(int64(*request.Pagination.Last))
+		conditions = append(conditions, condition)
 	}
 
-	if len(request.SortingOptions) > 0 {
-		var sortOptionPairs bson.D
+	filter := bson.M{"$and": conditions}
 
-		for _, sortingOption := range request.SortingOptions {
-			direction := 1
-			if sortingOption.Direction == common.Descending {
-				direction = -1
-			}
+	// Last without First returns the last projects of the result, so the sort order is inverted to let
+	// the database apply the limit, and the fetched projects are reversed back afterward.
+	// One more project than requested is fetched to find out whether there are more projects to return.
+	reverse := request.Pagination.First == nil && request.Pagination.Last != nil
+	findOptions := options.Find().SetSort(buildSortOptionPairs(request.SortingOptions, reverse))
 
-			sortOptionPairs = append(
-				sortOptionPairs,
-				bson.E{
-					Key:   sortingOption.Name,
-					Value: direction,
-				})
-		}
+	limit := -1
+	if request.Pagination.First != nil {
+		limit = *request.Pagination.First
+	} else if request.Pagination.Last != nil {
+		limit = *request.Pagination.Last
+	}
 
-		findOptions.SetSort(sortOptionPairs)
+	if limit >= 0 {
+		findOptions.SetLimit(int64(limit + 1))
 	}
 
 	cursor, err := collection.Find(ctx, filter, findOptions)
@@ -311,51 +771,2668 @@ func (service *mongodbRepositoryService) ListProjects(
 		projectWithCursor := models.ProjectWithCursor{
 			ProjectID: projectID,
 			Project:   mapFromInternalProject(project),
-			Cursor:    projectID,
 		}
+		projectWithCursor.Cursor = repository.NewCursor(request.SortingOptions, projectID, projectWithCursor.Project)
 
 		projects = append(projects, projectWithCursor)
 	}
 
-	response.Projects = projects
-	if (request.Pagination.After != nil && request.Pagination.First != nil && int64(*request.Pagination.First) < response.TotalCount) ||
-		(request.Pagination.Before != nil && request.Pagination.Last != nil && int64(*request.Pagination.Last) < response.TotalCount) {
-		response.HasNextPage = true
-		response.HasPreviousPage = true
-	} else if request.Pagination.After == nil && request.Pagination.First != nil && int64(*request.Pagination.First) < response.TotalCount {
-		response.HasNextPage = true
-		response.HasPreviousPage = false
-	} else if request.Pagination.Before == nil && request.Pagination.Last != nil && int64(*request.Pagination.Last) < response.TotalCount {
-		response.HasNextPage = false
+	if limit >= 0 && len(projects) > limit {
+		projects = projects[:limit]
+
+		if reverse {
+			response.HasPreviousPage = true
+		} else {
+			response.HasNextPage = true
+		}
+	}
+
+	if reverse {
+		for i, j := 0, len(projects)-1; i < j; i, j = i+1, j-1 {
+			projects[i], projects[j] = projects[j], projects[i]
+		}
+	}
+
+	if request.Pagination.First != nil && request.Pagination.Last != nil && len(projects) > *request.Pagination.Last {
+		projects = projects[len(projects)-*request.Pagination.Last:]
 		response.HasPreviousPage = true
 	}
 
+	response.Projects = projects
+
 	return response, nil
 }
 
-func (service *mongodbRepositoryService) createClientAndCollection(ctx context.Context) (*mongo.Client, *mongo.Collection, error) {
-	clientOptions := options.Client().ApplyURI(service.connectionString)
-	client, err := mongo.Connect(ctx, clientOptions)
+// StreamProjects returns an iterator over all the projects that matched the criteria. The projects are read
+// from the database cursor in batches while the iterator advances.
+// ctx: Mandatory The reference to the context, cancelling it stops the database cursor
+// request: Mandatory. The request contains the search criteria
+// Returns the iterator over the projects that matched the criteria
+func (service *mongodbRepositoryService) StreamProjects(
+	ctx context.Context,
+	request *repository.StreamProjectsRequest) (*repository.StreamProjectsResponse, error) {
+	if err := models.ValidateSortingOptions(request.SortingOptions); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("sortingOptions", "", err)
+	}
+
+	conditions, found, err := service.buildListConditions(ctx, request.ToListProjectsRequest(), false)
 	if err != nil {
-		return nil, nil, commonErrors.NewUnknownErrorWithError("could not connect to mongodb database", err)
+		return nil, err
 	}
 
-	return client, client.Database(service.databaseName).Collection(service.databaseCollectionName), nil
-}
+	if !found {
+		// Like an unknown organization, an unknown folder does not match any project
+		return &repository.StreamProjectsResponse{Projects: &projectIterator{}}, nil
+	}
 
-func disconnect(ctx context.Context, client *mongo.Client) {
-	_ = client.Disconnect(ctx)
-}
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func mapToInternalProject(email string, from models.Project) project {
-	return project{
-		UserEmail: email,
-		Name:      from.Name,
+	findOptions := options.Find().
+		SetSort(buildSortOptionPairs(request.SortingOptions, false)).
+		SetBatchSize(streamBatchSize)
+
+	cursor, err := collection.Find(ctx, bson.M{"$and": conditions}, findOptions)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection", err)
 	}
+
+	return &repository.StreamProjectsResponse{
+		Projects: &projectIterator{
+			cursor:         cursor,
+			sortingOptions: request.SortingOptions,
+		},
+	}, nil
 }
 
-func mapFromInternalProject(from project) models.Project {
-	return models.Project{
-		Name: from.Name,
+// WatchProjects watches the changes of the projects the user can see from the change stream of the projects
+// collection, which requires MongoDB to run as a replica set. The resource versions are the resume tokens of the
+// change stream. The organizations of the user are looked up once when watching starts, and the members removed
+// from a project are not notified, as the change stream only carries the project after the change.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the user and the resource version to resume watching from
+// Returns either the watcher over the changes of the projects or error if something goes wrong.
+func (service *mongodbRepositoryService) WatchProjects(
+	ctx context.Context,
+	request *repository.WatchProjectsRequest) (*repository.WatchProjectsResponse, error) {
+	changeStreamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if request.ResourceVersion != "" {
+		resumeToken, err := decodeResourceVersion(request.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		changeStreamOptions.SetResumeAfter(resumeToken)
+	}
+
+	organizationIDs, err := service.findOrganizationIDs(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+		"operationType": bson.M{"$in": bson.A{"insert", "update", "replace"}},
+		"$or": bson.A{
+			bson.M{"fullDocument.members.userEmail": request.UserEmail},
+			bson.M{"fullDocument.organizationID": bson.M{"$in": organizationIDs}},
+		},
+	}}}}
+
+	stream, err := collection.Watch(ctx, pipeline, changeStreamOptions)
+	if err != nil {
+		if isHistoryLostError(err) {
+			return nil, models.NewResourceVersionExpiredErrorWithError(err)
+		}
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to watch the projects", err)
+	}
+
+	return &repository.WatchProjectsResponse{
+		Watcher: &projectWatcher{stream: stream},
+	}, nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *mongodbRepositoryService) SearchProjects(
+	ctx context.Context,
+	request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	// The query is reduced to its words, so the phrases and the negations of the MongoDB text search syntax are
+	// never interpreted and any of the words matches
+	terms := search.Terms(request.Query)
+	if len(terms) == 0 {
+		return search.Paginate([]models.ProjectSearchResult{}, request.Pagination)
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := []interface{}{
+		bson.D{accessCondition},
+		bson.M{"deletedAt": bson.M{"$exists": false}},
+	}
+
+	if !request.IncludeArchived {
+		conditions = append(conditions, bson.M{"state": bson.M{"$ne": models.ProjectStateArchived}})
+	}
+
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The matched projects are ranked and paginated in process, as the cursors contain the text scores
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"$text": bson.M{"$search": strings.Join(terms, " ")}, "$and": conditions},
+		options.Find().SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	results := []models.ProjectSearchResult{}
+	for cursor.Next(ctx) {
+		var result searchResult
+		if err := cursor.Decode(&result); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the project", err)
+		}
+
+		project := mapFromInternalProject(result.Project)
+		results = append(results, models.ProjectSearchResult{
+			ProjectID:  result.ID.Hex(),
+			Project:    project,
+			Score:      result.Score,
+			Highlights: search.Highlights(terms, project),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to search the projects", err)
+	}
+
+	return search.Paginate(results, request.Pagination)
+}
+
+// ReadMember reads a member of an existing or a deleted project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of a project
+// Returns either the result of reading a member of a project or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadMember(
+	ctx context.Context,
+	request *repository.ReadMemberRequest) (*repository.ReadMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{"_id": ObjectID, "members.userEmail": request.UserEmail}
+	var project project
+
+	err = collection.FindOne(ctx, filter).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve member", err)
+	}
+
+	for _, member := range project.Members {
+		if member.UserEmail == request.UserEmail {
+			return &repository.ReadMemberResponse{
+				Member: mapFromInternalMember(member),
+			}, nil
+		}
+	}
+
+	return nil, commonErrors.NewNotFoundError()
+}
+
+// ReadEffectiveRole reads the role of a user in an existing or a deleted project, taking the organization
+// of the project into account
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the role of a user in a project
+// Returns either the role of the user in the project or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadEffectiveRole(
+	ctx context.Context,
+	request *repository.ReadEffectiveRoleRequest) (*repository.ReadEffectiveRoleResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	var project project
+
+	err = collection.FindOne(ctx, bson.M{"_id": ObjectID}).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the role", err)
+	}
+
+	role, found := findMemberRole(project.Members, request.UserEmail)
+
+	if project.OrganizationID != "" {
+		organizationObjectID, _ := primitive.ObjectIDFromHex(project.OrganizationID)
+		var organization organization
+
+		err = service.getOrganizationsCollection(collection).FindOne(
+			ctx,
+			bson.M{"_id": organizationObjectID, "members.userEmail": request.UserEmail}).Decode(&organization)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the role", err)
+		}
+
+		if organizationRole, ok := findMemberRole(organization.Members, request.UserEmail); ok && (!found || organizationRole > role) {
+			role, found = organizationRole, true
+		}
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadEffectiveRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// AddMember adds a new member to an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns either the result of adding a new member or error if something goes wrong.
+func (service *mongodbRepositoryService) AddMember(
+	ctx context.Context,
+	request *repository.AddMemberRequest) (*repository.AddMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":               ObjectID,
+		"deletedAt":         bson.M{"$exists": false},
+		"members.userEmail": bson.M{"$ne": request.Member.UserEmail},
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"members": member{UserEmail: request.Member.UserEmail, Role: request.Member.Role}},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add member", err)
+	}
+
+	if response.MatchedCount == 0 {
+		// The project either does not exist, or the user is already a member of the project
+		count, err := collection.CountDocuments(ctx, bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}})
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the project exists", err)
+		}
+
+		if count == 0 {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.AddMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns either the result of changing the role of an existing member or error if something goes wrong.
+func (service *mongodbRepositoryService) UpdateMemberRole(
+	ctx context.Context,
+	request *repository.UpdateMemberRoleRequest) (*repository.UpdateMemberRoleResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := buildProjectFilter(ObjectID, memberCondition(request.UserEmail), false, 0)
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"members.$.role": request.Role},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update member role", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.UpdateMemberRoleResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      request.Role,
+		},
+	}, nil
+}
+
+// RemoveMember removes an existing member from a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns either the result of removing an existing member or error if something goes wrong.
+func (service *mongodbRepositoryService) RemoveMember(
+	ctx context.Context,
+	request *repository.RemoveMemberRequest) (*repository.RemoveMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := buildProjectFilter(ObjectID, memberCondition(request.UserEmail), false, 0)
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"members": bson.M{"userEmail": request.UserEmail}},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove member", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveMemberResponse{}, nil
+}
+
+// ListMembers returns the members of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns either the members of the project or error if something goes wrong.
+func (service *mongodbRepositoryService) ListMembers(
+	ctx context.Context,
+	request *repository.ListMembersRequest) (*repository.ListMembersResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}}
+	var project project
+
+	err = collection.FindOne(ctx, filter).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list members", err)
+	}
+
+	members := make([]models.Member, 0, len(project.Members))
+	for _, member := range project.Members {
+		members = append(members, mapFromInternalMember(member))
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserEmail < members[j].UserEmail
+	})
+
+	return &repository.ListMembersResponse{
+		Members: members,
+	}, nil
+}
+
+// RequestOwnershipTransfer records a pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to record a pending ownership transfer
+// Returns either the result of recording the pending ownership transfer or error if something goes wrong.
+func (service *mongodbRepositoryService) RequestOwnershipTransfer(
+	ctx context.Context,
+	request *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}}
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$set":  bson.M{"ownershipTransfer": request.Transfer},
+		"$push": bson.M{"auditEvents": request.AuditEvent},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to request ownership transfer", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RequestOwnershipTransferResponse{
+		Transfer: request.Transfer,
+	}, nil
+}
+
+// ReadOwnershipTransfer reads the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the pending ownership transfer
+// Returns either the pending ownership transfer or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadOwnershipTransfer(
+	ctx context.Context,
+	request *repository.ReadOwnershipTransferRequest) (*repository.ReadOwnershipTransferResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":               ObjectID,
+		"deletedAt":         bson.M{"$exists": false},
+		"ownershipTransfer": bson.M{"$exists": true},
+	}
+	var project project
+
+	err = collection.FindOne(ctx, filter).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read ownership transfer", err)
+	}
+
+	transfer := *project.OwnershipTransfer
+	transfer.RequestedAt = transfer.RequestedAt.UTC()
+	transfer.ExpiresAt = transfer.ExpiresAt.UTC()
+
+	return &repository.ReadOwnershipTransferResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// DeleteOwnershipTransfer removes the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove the pending ownership transfer
+// Returns either the result of removing the pending ownership transfer or error if something goes wrong.
+func (service *mongodbRepositoryService) DeleteOwnershipTransfer(
+	ctx context.Context,
+	request *repository.DeleteOwnershipTransferRequest) (*repository.DeleteOwnershipTransferResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":               ObjectID,
+		"deletedAt":         bson.M{"$exists": false},
+		"ownershipTransfer": bson.M{"$exists": true},
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$unset": bson.M{"ownershipTransfer": ""},
+		"$push":  bson.M{"auditEvents": request.AuditEvent},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete ownership transfer", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteOwnershipTransferResponse{}, nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user
+// context: Optional The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns either the result of transferring the ownership or error if something goes wrong.
+func (service *mongodbRepositoryService) TransferProjectOwnership(
+	ctx context.Context,
+	request *repository.TransferProjectOwnershipRequest) (*repository.TransferProjectOwnershipResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":       ObjectID,
+		"userEmail": request.FromUserEmail,
+		"deletedAt": bson.M{"$exists": false},
+	}
+
+	// The previous and the new owners are removed from the members and added back with their new roles.
+	// The values provided by the users are wrapped in $literal, so they are never evaluated as expressions.
+	userEmails := bson.A{request.FromUserEmail, request.ToUserEmail}
+	transferredMembers := bson.A{
+		member{UserEmail: request.FromUserEmail, Role: models.MemberRoleEditor},
+		member{UserEmail: request.ToUserEmail, Role: models.MemberRoleOwner},
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"userEmail": bson.M{"$literal": request.ToUserEmail},
+			"members": bson.M{"$concatArrays": bson.A{
+				bson.M{"$filter": bson.M{
+					"input": "$members",
+					"as":    "member",
+					"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$member.userEmail", bson.M{"$literal": userEmails}}}}},
+				}},
+				bson.M{"$literal": transferredMembers},
+			}},
+			"version": buildNextVersion(),
+			"auditEvents": bson.M{"$concatArrays": bson.A{
+				bson.M{"$ifNull": bson.A{"$auditEvents", bson.A{}}},
+				bson.M{"$literal": bson.A{request.AuditEvent}},
+			}},
+		}}},
+		{{Key: "$unset", Value: "ownershipTransfer"}},
+	}
+
+	if request.Event != nil {
+		update = append(update, buildPushOutboxStage(query.NewOutboxEvent(*request.Event, request.ProjectID)))
+	}
+
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if isDuplicateKeyError(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to transfer project ownership", err)
+	}
+
+	return &repository.TransferProjectOwnershipResponse{
+		Project: mapFromInternalProject(project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// ListAuditEvents returns the audit trail of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns either the audit events of the project or error if something goes wrong.
+func (service *mongodbRepositoryService) ListAuditEvents(
+	ctx context.Context,
+	request *repository.ListAuditEventsRequest) (*repository.ListAuditEventsResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}}
+	var project project
+
+	err = collection.FindOne(ctx, filter).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list audit events", err)
+	}
+
+	auditEvents := make([]models.AuditEvent, 0, len(project.AuditEvents))
+	for _, auditEvent := range project.AuditEvents {
+		auditEvent.OccurredAt = auditEvent.OccurredAt.UTC()
+		auditEvents = append(auditEvents, auditEvent)
+	}
+
+	return &repository.ListAuditEventsResponse{
+		AuditEvents: auditEvents,
+	}, nil
+}
+
+// CreateInvitation stores a new invitation to join an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to store a new invitation
+// Returns either the result of storing the new invitation or error if something goes wrong.
+func (service *mongodbRepositoryService) CreateInvitation(
+	ctx context.Context,
+	request *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.Invitation.ProjectID)
+	filter := bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}}
+	newInvitation := invitation{
+		Email:     request.Invitation.Email,
+		Role:      request.Invitation.Role,
+		InvitedBy: request.Invitation.InvitedBy,
+		TokenHash: request.TokenHash,
+		CreatedAt: request.Invitation.CreatedAt,
+		ExpiresAt: request.Invitation.ExpiresAt,
+	}
+
+	// The existing invitation of the same email, if any, is replaced by the new one. The values provided by
+	// the users are wrapped in $literal, so they are never evaluated as expressions.
+	response, err := collection.UpdateOne(
+		ctx,
+		filter,
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"invitations": bson.M{"$concatArrays": bson.A{
+					bson.M{"$filter": bson.M{
+						"input": bson.M{"$ifNull": bson.A{"$invitations", bson.A{}}},
+						"as":    "invitation",
+						"cond":  bson.M{"$ne": bson.A{"$$invitation.email", bson.M{"$literal": request.Invitation.Email}}},
+					}},
+					bson.M{"$literal": bson.A{newInvitation}},
+				}},
+			}}},
+		})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create invitation", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.CreateInvitationResponse{
+		Invitation: request.Invitation,
+	}, nil
+}
+
+// ReadInvitation reads an invitation by the hash of its token
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an invitation
+// Returns either the invitation or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadInvitation(
+	ctx context.Context,
+	request *repository.ReadInvitationRequest) (*repository.ReadInvitationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"invitations.tokenHash": request.TokenHash, "deletedAt": bson.M{"$exists": false}}
+	var project struct {
+		ID          primitive.ObjectID `bson:"_id"`
+		Invitations []invitation       `bson:"invitations"`
+	}
+
+	err = collection.FindOne(
+		ctx,
+		filter,
+		options.FindOne().SetProjection(bson.M{"invitations": 1})).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read invitation", err)
+	}
+
+	for _, invitation := range project.Invitations {
+		if invitation.TokenHash == request.TokenHash {
+			return &repository.ReadInvitationResponse{
+				Invitation: mapFromInternalInvitation(project.ID.Hex(), invitation),
+			}, nil
+		}
+	}
+
+	return nil, commonErrors.NewNotFoundError()
+}
+
+// ListInvitations returns the pending invitations of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns either the pending invitations of the project or error if something goes wrong.
+func (service *mongodbRepositoryService) ListInvitations(
+	ctx context.Context,
+	request *repository.ListInvitationsRequest) (*repository.ListInvitationsResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{"_id": ObjectID, "deletedAt": bson.M{"$exists": false}}
+	var project project
+
+	err = collection.FindOne(ctx, filter).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list invitations", err)
+	}
+
+	invitations := make([]models.Invitation, 0, len(project.Invitations))
+	for _, invitation := range project.Invitations {
+		invitations = append(invitations, mapFromInternalInvitation(request.ProjectID, invitation))
+	}
+
+	sort.Slice(invitations, func(i, j int) bool {
+		return invitations[i].Email < invitations[j].Email
+	})
+
+	return &repository.ListInvitationsResponse{
+		Invitations: invitations,
+	}, nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+// context: Optional The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns either the result of accepting the invitation or error if something goes wrong.
+func (service *mongodbRepositoryService) AcceptInvitation(
+	ctx context.Context,
+	request *repository.AcceptInvitationRequest) (*repository.AcceptInvitationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":               ObjectID,
+		"deletedAt":         bson.M{"$exists": false},
+		"invitations.email": request.Email,
+		"members.userEmail": bson.M{"$ne": request.Email},
+	}
+
+	// The invitation is moved to the members in a single update, both expressions are evaluated against the
+	// document before the update
+	email := bson.M{"$literal": request.Email}
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"members": bson.M{"$concatArrays": bson.A{
+					"$members",
+					bson.M{"$map": bson.M{
+						"input": bson.M{"$filter": bson.M{
+							"input": "$invitations",
+							"as":    "invitation",
+							"cond":  bson.M{"$eq": bson.A{"$$invitation.email", email}},
+						}},
+						"as": "invitation",
+						"in": bson.M{"userEmail": "$$invitation.email", "role": "$$invitation.role"},
+					}},
+				}},
+				"invitations": bson.M{"$filter": bson.M{
+					"input": "$invitations",
+					"as":    "invitation",
+					"cond":  bson.M{"$ne": bson.A{"$$invitation.email", email}},
+				}},
+			}}},
+		}).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		// The project either does not exist, has no invitation for the user, or the user is already a member
+		count, err := collection.CountDocuments(ctx, bson.M{
+			"_id":               ObjectID,
+			"deletedAt":         bson.M{"$exists": false},
+			"invitations.email": request.Email,
+		})
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the invitation exists", err)
+		}
+
+		if count == 0 {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to accept invitation", err)
+	}
+
+	for _, invitation := range project.Invitations {
+		if invitation.Email == request.Email {
+			return &repository.AcceptInvitationResponse{
+				Member: models.Member{
+					UserEmail: request.Email,
+					Role:      invitation.Role,
+				},
+			}, nil
+		}
+	}
+
+	return nil, commonErrors.NewNotFoundError()
+}
+
+// DeleteInvitation removes a pending invitation of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove a pending invitation
+// Returns either the result of removing the invitation or error if something goes wrong.
+func (service *mongodbRepositoryService) DeleteInvitation(
+	ctx context.Context,
+	request *repository.DeleteInvitationRequest) (*repository.DeleteInvitationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+	filter := bson.M{
+		"_id":               ObjectID,
+		"deletedAt":         bson.M{"$exists": false},
+		"invitations.email": request.Email,
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"invitations": bson.M{"email": request.Email}},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete invitation", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteInvitationResponse{}, nil
+}
+
+// CreateOrganization creates a new organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns either the result of creating the new organization or error if something goes wrong.
+func (service *mongodbRepositoryService) CreateOrganization(
+	ctx context.Context,
+	request *repository.CreateOrganizationRequest) (*repository.CreateOrganizationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := now()
+	newOrganization := organization{
+		Name:        request.Organization.Name,
+		Description: request.Organization.Description,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		CreatedBy:   request.UserEmail,
+		Members:     []member{{UserEmail: request.UserEmail, Role: models.MemberRoleOwner}},
+	}
+
+	insertResult, err := service.getOrganizationsCollection(collection).InsertOne(ctx, newOrganization)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create organization", err)
+	}
+
+	return &repository.CreateOrganizationResponse{
+		OrganizationID: insertResult.InsertedID.(primitive.ObjectID).Hex(),
+		Organization:   mapFromInternalOrganization(newOrganization),
+	}, nil
+}
+
+// ReadOrganization reads an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns either the result of reading the organization or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadOrganization(
+	ctx context.Context,
+	request *repository.ReadOrganizationRequest) (*repository.ReadOrganizationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	var organization organization
+
+	err = service.getOrganizationsCollection(collection).FindOne(
+		ctx,
+		bson.D{{Key: "_id", Value: ObjectID}, memberCondition(request.UserEmail)}).Decode(&organization)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization", err)
+	}
+
+	return &repository.ReadOrganizationResponse{
+		Organization: mapFromInternalOrganization(organization),
+	}, nil
+}
+
+// UpdateOrganization updates an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns either the result of updating the organization or error if something goes wrong.
+func (service *mongodbRepositoryService) UpdateOrganization(
+	ctx context.Context,
+	request *repository.UpdateOrganizationRequest) (*repository.UpdateOrganizationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	var organization organization
+
+	err = service.getOrganizationsCollection(collection).FindOneAndUpdate(
+		ctx,
+		bson.D{{Key: "_id", Value: ObjectID}, memberCondition(request.UserEmail)},
+		bson.M{"$set": bson.M{
+			"name":        request.Organization.Name,
+			"description": request.Organization.Description,
+			"updatedAt":   now(),
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&organization)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update organization", err)
+	}
+
+	return &repository.UpdateOrganizationResponse{
+		Organization: mapFromInternalOrganization(organization),
+	}, nil
+}
+
+// DeleteOrganization deletes an existing organization that has no project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns either the result of deleting the organization or error if something goes wrong.
+func (service *mongodbRepositoryService) DeleteOrganization(
+	ctx context.Context,
+	request *repository.DeleteOrganizationRequest) (*repository.DeleteOrganizationResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	filter := bson.D{{Key: "_id", Value: ObjectID}, memberCondition(request.UserEmail)}
+	organizations := service.getOrganizationsCollection(collection)
+
+	count, err := organizations.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the organization exists", err)
+	}
+
+	if count == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	// The deleted projects that are not purged yet still belong to the organization
+	count, err = collection.CountDocuments(ctx, bson.M{"organizationID": request.OrganizationID})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the organization has projects", err)
+	}
+
+	if count > 0 {
+		return nil, models.NewOrganizationNotEmptyError()
+	}
+
+	response, err := organizations.DeleteOne(ctx, filter)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete organization", err)
+	}
+
+	if response.DeletedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteOrganizationResponse{}, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the organizations of a user
+// Returns either the organizations of the user or error if something goes wrong.
+func (service *mongodbRepositoryService) ListOrganizations(
+	ctx context.Context,
+	request *repository.ListOrganizationsRequest) (*repository.ListOrganizationsResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := service.getOrganizationsCollection(collection).Find(
+		ctx,
+		bson.D{memberCondition(request.UserEmail)},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	organizations := []models.OrganizationWithID{}
+	for cursor.Next(ctx) {
+		var organization struct {
+			ID           primitive.ObjectID `bson:"_id"`
+			organization `bson:",inline"`
+		}
+
+		if err = cursor.Decode(&organization); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the organization", err)
+		}
+
+		organizations = append(organizations, models.OrganizationWithID{
+			OrganizationID: organization.ID.Hex(),
+			Organization:   mapFromInternalOrganization(organization.organization),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+	}
+
+	return &repository.ListOrganizationsResponse{
+		Organizations: organizations,
+	}, nil
+}
+
+// ReadOrganizationMember reads a member of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of an existing organization
+// Returns either the result of reading the member or error if something goes wrong.
+func (service *mongodbRepositoryService) ReadOrganizationMember(
+	ctx context.Context,
+	request *repository.ReadOrganizationMemberRequest) (*repository.ReadOrganizationMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	var organization organization
+
+	err = service.getOrganizationsCollection(collection).FindOne(
+		ctx,
+		bson.D{{Key: "_id", Value: ObjectID}, memberCondition(request.UserEmail)}).Decode(&organization)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization member", err)
+	}
+
+	role, found := findMemberRole(organization.Members, request.UserEmail)
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadOrganizationMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      role,
+		},
+	}, nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns either the result of adding the new member or error if something goes wrong.
+func (service *mongodbRepositoryService) AddOrganizationMember(
+	ctx context.Context,
+	request *repository.AddOrganizationMemberRequest) (*repository.AddOrganizationMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	organizations := service.getOrganizationsCollection(collection)
+
+	response, err := organizations.UpdateOne(
+		ctx,
+		bson.M{"_id": ObjectID, "members.userEmail": bson.M{"$ne": request.Member.UserEmail}},
+		bson.M{"$push": bson.M{"members": member{UserEmail: request.Member.UserEmail, Role: request.Member.Role}}})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add organization member", err)
+	}
+
+	if response.MatchedCount == 0 {
+		// The organization either does not exist, or the user is already a member of the organization
+		exists, err := service.organizationExists(ctx, request.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		return nil, commonErrors.NewAlreadyExistsError()
+	}
+
+	return &repository.AddOrganizationMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns either the result of removing the member or error if something goes wrong.
+func (service *mongodbRepositoryService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *repository.RemoveOrganizationMemberRequest) (*repository.RemoveOrganizationMemberResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+
+	response, err := service.getOrganizationsCollection(collection).UpdateOne(
+		ctx,
+		bson.D{{Key: "_id", Value: ObjectID}, memberCondition(request.UserEmail)},
+		bson.M{"$pull": bson.M{"members": bson.M{"userEmail": request.UserEmail}}})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove organization member", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveOrganizationMemberResponse{}, nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns either the members of the organization or error if something goes wrong.
+func (service *mongodbRepositoryService) ListOrganizationMembers(
+	ctx context.Context,
+	request *repository.ListOrganizationMembersRequest) (*repository.ListOrganizationMembersResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.OrganizationID)
+	var organization organization
+
+	err = service.getOrganizationsCollection(collection).FindOne(ctx, bson.M{"_id": ObjectID}).Decode(&organization)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organization members", err)
+	}
+
+	members := make([]models.Member, 0, len(organization.Members))
+	for _, member := range organization.Members {
+		members = append(members, mapFromInternalMember(member))
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserEmail < members[j].UserEmail
+	})
+
+	return &repository.ListOrganizationMembersResponse{
+		Members: members,
+	}, nil
+}
+
+// MoveProject moves an existing project into a folder of the user or out of its folder
+// context: Optional The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns either the result of moving the project or error if something goes wrong.
+func (service *mongodbRepositoryService) MoveProject(
+	ctx context.Context,
+	request *repository.MoveProjectRequest) (*repository.MoveProjectResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{
+		"$unset": bson.M{"folderID": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+
+	if request.FolderID != "" {
+		_, found, err := service.findFolderTree(ctx, request.UserEmail, request.FolderID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		update = bson.M{
+			"$set": bson.M{"folderID": request.FolderID},
+			"$inc": bson.M{"version": 1},
+		}
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.ProjectID)
+
+	if request.Event != nil {
+		update["$push"] = bson.M{"outbox": query.NewOutboxEvent(*request.Event, request.ProjectID)}
+	}
+
+	var project project
+	err = collection.FindOneAndUpdate(
+		ctx,
+		buildProjectFilter(ObjectID, accessCondition, false, 0),
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&project)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to move project", err)
+	}
+
+	return &repository.MoveProjectResponse{
+		Project: mapFromInternalProject(project),
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// CreateFolder creates a new folder for the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns either the result of creating the new folder or error if something goes wrong.
+func (service *mongodbRepositoryService) CreateFolder(
+	ctx context.Context,
+	request *repository.CreateFolderRequest) (*repository.CreateFolderResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Folder.ParentFolderID != "" {
+		_, found, err := service.findFolderTree(ctx, request.UserEmail, request.Folder.ParentFolderID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, commonErrors.NewNotFoundError()
+		}
+	}
+
+	newFolder := folder{
+		UserEmail:      request.UserEmail,
+		Name:           request.Folder.Name,
+		ParentFolderID: request.Folder.ParentFolderID,
+		CreatedAt:      now(),
+	}
+
+	insertResult, err := service.getFoldersCollection(collection).InsertOne(ctx, newFolder)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create folder", err)
+	}
+
+	return &repository.CreateFolderResponse{
+		FolderID: insertResult.InsertedID.(primitive.ObjectID).Hex(),
+		Folder:   mapFromInternalFolder(newFolder),
+	}, nil
+}
+
+// ListFolders returns the folders of the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the folders of a user
+// Returns either the folders of the user or error if something goes wrong.
+func (service *mongodbRepositoryService) ListFolders(
+	ctx context.Context,
+	request *repository.ListFoldersRequest) (*repository.ListFoldersResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := service.getFoldersCollection(collection).Find(
+		ctx,
+		bson.M{"userEmail": request.UserEmail},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list folders", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	folders := []models.FolderWithID{}
+	for cursor.Next(ctx) {
+		var folder struct {
+			ID     primitive.ObjectID `bson:"_id"`
+			folder `bson:",inline"`
+		}
+
+		if err = cursor.Decode(&folder); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the folder", err)
+		}
+
+		folders = append(folders, models.FolderWithID{
+			FolderID: folder.ID.Hex(),
+			Folder:   mapFromInternalFolder(folder.folder),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list folders", err)
+	}
+
+	return &repository.ListFoldersResponse{
+		Folders: folders,
+	}, nil
+}
+
+// DeleteFolder deletes an existing folder of the user together with its subfolders
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns either the result of deleting the folder or error if something goes wrong.
+func (service *mongodbRepositoryService) DeleteFolder(
+	ctx context.Context,
+	request *repository.DeleteFolderRequest) (*repository.DeleteFolderResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folderIDs, found, err := service.findFolderTree(ctx, request.UserEmail, request.FolderID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the projects the user has access to and are not deleted yet prevent the folder from being deleted
+	hexIDs := mapToHexIDs(folderIDs)
+	projectsFilter := bson.D{
+		{Key: "folderID", Value: bson.M{"$in": hexIDs}},
+		accessCondition,
+		{Key: "deletedAt", Value: bson.M{"$exists": false}},
+	}
+
+	if request.Cascade {
+		update := mongo.Pipeline{buildDeleteStage(now())}
+		if request.Event != nil {
+			update = append(update, buildPushCascadeOutboxStage(*request.Event))
+		}
+
+		if _, err = collection.UpdateMany(ctx, projectsFilter, update); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to delete the projects of the folder", err)
+		}
+	} else {
+		count, err := collection.CountDocuments(ctx, projectsFilter)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the folder is empty", err)
+		}
+
+		if count > 0 {
+			return nil, models.NewFolderNotEmptyError()
+		}
+	}
+
+	if _, err = collection.UpdateMany(
+		ctx,
+		bson.M{"folderID": bson.M{"$in": hexIDs}},
+		bson.M{"$unset": bson.M{"folderID": ""}}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to move the projects out of the folder", err)
+	}
+
+	if _, err = service.getFoldersCollection(collection).DeleteMany(ctx, bson.M{"_id": bson.M{"$in": folderIDs}}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete folder", err)
+	}
+
+	return &repository.DeleteFolderResponse{}, nil
+}
+
+// ListOutboxEvents returns the events written to the outbox that are not published yet, ordered by the time
+// they occurred
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the maximum number of events to return
+// Returns either the events that are not published yet or error if something goes wrong.
+func (service *mongodbRepositoryService) ListOutboxEvents(
+	ctx context.Context,
+	request *repository.ListOutboxEventsRequest) (*repository.ListOutboxEventsResponse, error) {
+	// MongoDB rejects the aggregations with a limit that is not positive
+	if request.Limit <= 0 {
+		return &repository.ListOutboxEventsResponse{
+			Events: []models.DomainEvent{},
+		}, nil
+	}
+
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The events of the same project keep the order they were written in, even if they occurred at the same time
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"outbox.eventID": bson.M{"$exists": true}}}},
+		{{Key: "$unwind", Value: bson.M{"path": "$outbox", "includeArrayIndex": "outboxIndex"}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "outbox.occurredAt", Value: 1},
+			{Key: "_id", Value: 1},
+			{Key: "outboxIndex", Value: 1},
+		}}},
+		{{Key: "$limit", Value: request.Limit}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$outbox"}}},
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+	}
+
+	events := []models.DomainEvent{}
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+	}
+
+	return &repository.ListOutboxEventsResponse{
+		Events: events,
+	}, nil
+}
+
+// DeleteOutboxEvents removes the published events from the outbox
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the identifiers of the published events
+// Returns either the result of removing the published events or error if something goes wrong.
+func (service *mongodbRepositoryService) DeleteOutboxEvents(
+	ctx context.Context,
+	request *repository.DeleteOutboxEventsRequest) (*repository.DeleteOutboxEventsResponse, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := bson.M{"$in": request.EventIDs}
+	if _, err = collection.UpdateMany(
+		ctx,
+		bson.M{"outbox.eventID": eventIDs},
+		bson.M{"$pull": bson.M{"outbox": bson.M{"eventID": eventIDs}}}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete the outbox events", err)
+	}
+
+	return &repository.DeleteOutboxEventsResponse{}, nil
+}
+
+// Close disconnects the shared MongoDB client and releases all the pooled connections
+// Returns error if something goes wrong
+func (service *mongodbRepositoryService) Close() error {
+	service.clientLock.Lock()
+	defer service.clientLock.Unlock()
+
+	if service.client == nil {
+		return nil
+	}
+
+	err := service.client.Disconnect(context.Background())
+	service.client = nil
+
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to disconnect from mongodb database", err)
+	}
+
+	return nil
+}
+
+func (service *mongodbRepositoryService) getCollection(ctx context.Context) (*mongo.Collection, error) {
+	client, err := service.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Database(service.databaseName).Collection(service.databaseCollectionName), nil
+}
+
+// getOrganizationsCollection returns the collection that stores the organizations, next to the given
+// collection of the projects
+func (service *mongodbRepositoryService) getOrganizationsCollection(projects *mongo.Collection) *mongo.Collection {
+	return projects.Database().Collection(organizationsCollectionName)
+}
+
+// getFoldersCollection returns the collection that stores the folders, next to the given collection of the
+// projects
+func (service *mongodbRepositoryService) getFoldersCollection(projects *mongo.Collection) *mongo.Collection {
+	return projects.Database().Collection(foldersCollectionName)
+}
+
+// getIdempotencyKeysCollection returns the collection that stores the idempotency keys, next to the given
+// collection of the projects
+func (service *mongodbRepositoryService) getIdempotencyKeysCollection(projects *mongo.Collection) *mongo.Collection {
+	return projects.Database().Collection(idempotencyKeysCollectionName)
+}
+
+// claimIdempotencyKey stores the idempotency key of the request for the project that is about to be created,
+// unless the user already created a project with the same key that is not expired yet. The key must be claimed
+// in the transaction the project is inserted in, the concurrent requests with the same key conflict on the key
+// and their transactions are retried, so only one of them claims it.
+// Returns nil if the key is claimed and the project must be created, the originally created project if the
+// request is a retry, or error if the key was used with a different payload or something goes wrong
+func (service *mongodbRepositoryService) claimIdempotencyKey(
+	ctx context.Context,
+	collection *mongo.Collection,
+	request *repository.CreateProjectRequest,
+	projectID string) (*repository.CreateProjectResponse, error) {
+	keys := service.getIdempotencyKeysCollection(collection)
+	filter := bson.M{"userEmail": request.UserEmail, "key": request.IdempotencyKey.Key}
+
+	var record *query.IdempotencyRecord
+	var storedKey idempotencyKey
+	if err := keys.FindOne(ctx, filter).Decode(&storedKey); err == nil {
+		record = &query.IdempotencyRecord{
+			PayloadHash: storedKey.PayloadHash,
+			ExpiresAt:   storedKey.ExpiresAt,
+			ProjectID:   storedKey.ProjectID,
+		}
+	} else if err != mongo.ErrNoDocuments {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the idempotency key", err)
+	}
+
+	originalProjectID, replay, err := query.ReplayIdempotencyKey(record, *request.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !replay {
+		// The key is either not stored or expired, in which case it is claimed again
+		if _, err = keys.UpdateOne(
+			ctx,
+			filter,
+			bson.M{"$set": bson.M{
+				"payloadHash": request.IdempotencyKey.PayloadHash,
+				"expiresAt":   request.IdempotencyKey.ExpiresAt,
+				"projectID":   projectID,
+			}},
+			options.Update().SetUpsert(true)); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to store the idempotency key", err)
+		}
+
+		return nil, nil
+	}
+
+	// The key is stored together with the project, so the project is only not found if it is deleted or purged
+	var originalProject project
+	objectID, _ := primitive.ObjectIDFromHex(originalProjectID)
+	err = collection.FindOne(ctx, bson.M{"_id": objectID, "deletedAt": bson.M{"$exists": false}}).Decode(&originalProject)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the project", err)
+	}
+
+	return &repository.CreateProjectResponse{
+		ProjectID: originalProjectID,
+		Project:   mapFromInternalProject(originalProject),
+		Cursor:    repository.NewProjectCursor(originalProjectID),
+	}, nil
+}
+
+// findFolderTree returns the identifier of the folder of the user and, if requested, the identifiers of all
+// its subfolders at any depth. The subfolders are looked up one level at a time, as MongoDB cannot follow the
+// parent folders in the same filter.
+func (service *mongodbRepositoryService) findFolderTree(
+	ctx context.Context,
+	userEmail string,
+	folderID string,
+	includeSubfolders bool) ([]primitive.ObjectID, bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		// A malformed identifier cannot match any folder
+		return nil, false, nil
+	}
+
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	folders := service.getFoldersCollection(collection)
+	count, err := folders.CountDocuments(ctx, bson.M{"_id": objectID, "userEmail": userEmail})
+	if err != nil {
+		return nil, false, commonErrors.NewUnknownErrorWithError("failed to check whether the folder exists", err)
+	}
+
+	if count == 0 {
+		return nil, false, nil
+	}
+
+	folderIDs := []primitive.ObjectID{objectID}
+	if !includeSubfolders {
+		return folderIDs, true, nil
+	}
+
+	for parentFolderIDs := folderIDs; len(parentFolderIDs) > 0; {
+		cursor, err := folders.Find(
+			ctx,
+			bson.M{"userEmail": userEmail, "parentFolderID": bson.M{"$in": mapToHexIDs(parentFolderIDs)}},
+			options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, false, commonErrors.NewUnknownErrorWithError("failed to retrieve the subfolders", err)
+		}
+
+		parentFolderIDs = []primitive.ObjectID{}
+		for cursor.Next(ctx) {
+			var subfolder struct {
+				ID primitive.ObjectID `bson:"_id"`
+			}
+
+			if err = cursor.Decode(&subfolder); err != nil {
+				cursor.Close(ctx)
+
+				return nil, false, commonErrors.NewUnknownErrorWithError("failed to decode the folder", err)
+			}
+
+			parentFolderIDs = append(parentFolderIDs, subfolder.ID)
+		}
+
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return nil, false, commonErrors.NewUnknownErrorWithError("failed to retrieve the subfolders", err)
+		}
+
+		folderIDs = append(folderIDs, parentFolderIDs...)
+	}
+
+	return folderIDs, true, nil
+}
+
+// organizationExists returns true if the organization exists regardless of its members
+func (service *mongodbRepositoryService) organizationExists(ctx context.Context, organizationID string) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(organizationID)
+	if err != nil {
+		// A malformed identifier cannot match any organization
+		return false, nil
+	}
+
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.getOrganizationsCollection(collection).CountDocuments(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError("failed to check whether the organization exists", err)
+	}
+
+	return count > 0, nil
+}
+
+// buildAccessCondition returns the condition that matches the projects the user is a member of, either
+// directly or through the organizations of the projects. The organizations of the user are looked up first,
+// as MongoDB cannot join them in the same filter.
+func (service *mongodbRepositoryService) buildAccessCondition(ctx context.Context, userEmail string) (bson.E, error) {
+	organizationIDs, err := service.findOrganizationIDs(ctx, userEmail)
+	if err != nil {
+		return bson.E{}, err
+	}
+
+	if len(organizationIDs) == 0 {
+		return memberCondition(userEmail), nil
+	}
+
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{memberCondition(userEmail)},
+		bson.M{"organizationID": bson.M{"$in": organizationIDs}},
+	}}, nil
+}
+
+// findOrganizationIDs returns the identifiers of the organizations the user is a member of
+func (service *mongodbRepositoryService) findOrganizationIDs(ctx context.Context, userEmail string) (bson.A, error) {
+	collection, err := service.getCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := service.getOrganizationsCollection(collection).Find(
+		ctx,
+		bson.D{memberCondition(userEmail)},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the organizations of the user", err)
+	}
+
+	defer cursor.Close(ctx)
+
+	organizationIDs := bson.A{}
+	for cursor.Next(ctx) {
+		var organization struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+
+		if err = cursor.Decode(&organization); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the organization", err)
+		}
+
+		organizationIDs = append(organizationIDs, organization.ID.Hex())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the organizations of the user", err)
+	}
+
+	return organizationIDs, nil
+}
+
+// Migrate connects to the database and applies the migrations that are not applied yet, so the first request
+// does not have to wait for them
+// ctx: Mandatory The reference to the context the migrations run with
+// Returns error if something goes wrong.
+func (service *mongodbRepositoryService) Migrate(ctx context.Context) error {
+	service.clientLock.Lock()
+	defer service.clientLock.Unlock()
+
+	if service.migrated {
+		return nil
+	}
+
+	client := service.client
+	if client == nil {
+		var err error
+		if client, err = service.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := service.migrate(ctx, client); err != nil {
+		if service.client == nil {
+			_ = client.Disconnect(ctx)
+		}
+
+		return err
+	}
+
+	service.client = client
+	service.migrated = true
+
+	return nil
+}
+
+// getClient returns the client shared by all the requests, connecting to the database on the first call.
+// The client owns a connection pool that lives until Close is called. If the migrations did not run at
+// startup, they run on the first call with their own context, so they do not fail with the request.
+func (service *mongodbRepositoryService) getClient(ctx context.Context) (*mongo.Client, error) {
+	service.clientLock.RLock()
+	client := service.client
+	service.clientLock.RUnlock()
+
+	if client != nil {
+		return client, nil
+	}
+
+	service.clientLock.Lock()
+	defer service.clientLock.Unlock()
+
+	if service.client != nil {
+		return service.client, nil
+	}
+
+	client, err := service.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !service.migrated {
+		migrationCtx, cancel := context.WithTimeout(context.Background(), migrationTimeout)
+		err = service.migrate(migrationCtx, client)
+		cancel()
+
+		if err != nil {
+			_ = client.Disconnect(ctx)
+
+			return nil, err
+		}
+
+		service.migrated = true
+	}
+
+	service.client = client
+
+	return client, nil
+}
+
+// connect creates a new client with its own connection pool
+func (service *mongodbRepositoryService) connect(ctx context.Context) (*mongo.Client, error) {
+	clientOptions := options.Client().
+		ApplyURI(service.connectionString).
+		SetMaxPoolSize(service.maxPoolSize).
+		SetConnectTimeout(service.connectTimeout).
+		SetServerSelectionTimeout(service.serverSelectionTimeout)
+
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("could not connect to mongodb database", err)
+	}
+
+	return client, nil
+}
+
+// migrate brings the stored projects and the indexes up to date. Every migration is a no-op once it is applied.
+func (service *mongodbRepositoryService) migrate(ctx context.Context, client *mongo.Client) error {
+	if err := service.addMissingOwners(ctx, client); err != nil {
+		return err
+	}
+
+	if err := service.addMissingNormalizedNames(ctx, client); err != nil {
+		return err
+	}
+
+	if err := service.findDuplicateNames(ctx, client); err != nil {
+		return err
+	}
+
+	return service.createIndexes(ctx, client)
+}
+
+// createIndexes creates the unique index that prevents a user from having multiple projects with the same
+// name, together with the indexes the queries use. The unique index covers every document, as the normalized
+// names are backfilled before it is created and the deleted projects keep a placeholder unique to them.
+// Creating an existing index is a no-op.
+func (service *mongodbRepositoryService) createIndexes(ctx context.Context, client *mongo.Client) error {
+	collection := client.Database(service.databaseName).Collection(service.databaseCollectionName)
+
+	if _, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "userEmail", Value: 1},
+				{Key: "normalizedName", Value: 1},
+			},
+			Options: options.Index().
+				SetName("userEmail_normalizedName").
+				SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "members.userEmail", Value: 1}},
+			Options: options.Index().SetName("members_userEmail"),
+		},
+		{
+			Keys:    bson.D{{Key: "invitations.tokenHash", Value: 1}},
+			Options: options.Index().SetName("invitations_tokenHash"),
+		},
+		{
+			Keys: bson.D{{Key: "organizationID", Value: 1}},
+			Options: options.Index().
+				SetName("organizationID").
+				SetPartialFilterExpression(bson.M{"organizationID": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "folderID", Value: 1}},
+			Options: options.Index().
+				SetName("folderID").
+				SetPartialFilterExpression(bson.M{"folderID": bson.M{"$exists": true}}),
+		},
+		{
+			// The text search does not stem the words, so the words match the same way in every language
+			Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().
+				SetName("name_description_text").
+				SetWeights(bson.M{"name": 2, "description": 1}).
+				SetDefaultLanguage("none"),
+		},
+		{
+			Keys: bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().
+				SetName("deletedAt").
+				SetPartialFilterExpression(bson.M{"deletedAt": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{Key: "outbox.eventID", Value: 1}},
+			Options: options.Index().SetName("outbox_eventID").SetSparse(true),
+		},
+	}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the project indexes", err)
+	}
+
+	if _, err := client.Database(service.databaseName).Collection(organizationsCollectionName).Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "members.userEmail", Value: 1}},
+			Options: options.Index().SetName("members_userEmail"),
+		}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the organization indexes", err)
+	}
+
+	if _, err := client.Database(service.databaseName).Collection(foldersCollectionName).Indexes().CreateOne(
+		ctx,
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "userEmail", Value: 1}, {Key: "parentFolderID", Value: 1}},
+			Options: options.Index().SetName("userEmail_parentFolderID"),
+		}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the folder indexes", err)
+	}
+
+	if _, err := client.Database(service.databaseName).Collection(idempotencyKeysCollectionName).Indexes().CreateMany(
+		ctx,
+		[]mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "userEmail", Value: 1}, {Key: "key", Value: 1}},
+				Options: options.Index().SetName("userEmail_key").SetUnique(true),
+			},
+			{
+				// MongoDB removes the expired keys in the background, the keys that are not removed yet are
+				// treated as if they do not exist
+				Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+				Options: options.Index().SetName("expiresAt").SetExpireAfterSeconds(0),
+			},
+		}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the idempotency key indexes", err)
+	}
+
+	return nil
+}
+
+// addMissingOwners makes the users who created the projects stored before the projects had members the
+// owners of their projects. The projects that already have members are not touched, so it is a no-op once
+// all the projects are updated.
+func (service *mongodbRepositoryService) addMissingOwners(ctx context.Context, client *mongo.Client) error {
+	collection := client.Database(service.databaseName).Collection(service.databaseCollectionName)
+
+	if _, err := collection.UpdateMany(
+		ctx,
+		bson.M{"members": bson.M{"$exists": false}},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{"members": bson.A{bson.M{"userEmail": "$userEmail", "role": models.MemberRoleOwner}}}}},
+		}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to add the owners of the projects as their members", err)
+	}
+
+	return nil
+}
+
+// addMissingNormalizedNames computes the normalized names of the projects stored before the project names had to be
+// unique, and replaces the normalized names of the deleted projects with the placeholders unique to them. The
+// projects that already have a normalized name are not touched, so it is a no-op once all the projects are updated.
+// It fails with the identifiers of the projects whose names are already used by other projects of the same users,
+// so they can be renamed before the service starts.
+func (service *mongodbRepositoryService) addMissingNormalizedNames(ctx context.Context, client *mongo.Client) error {
+	collection := client.Database(service.databaseName).Collection(service.databaseCollectionName)
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"deletedAt": bson.M{"$exists": false}, "normalizedName": bson.M{"$exists": false}},
+			bson.M{"deletedAt": bson.M{"$exists": true}, "deletedNormalizedName": bson.M{"$exists": false}},
+		}},
+		options.Find().SetProjection(bson.M{"name": 1, "deletedAt": 1}))
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to find the projects without normalized names", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var duplicateProjectIDs []string
+
+	for cursor.Next(ctx) {
+		var legacyProject struct {
+			ID        primitive.ObjectID `bson:"_id"`
+			Name      string             `bson:"name"`
+			DeletedAt *time.Time         `bson:"deletedAt"`
+		}
+
+		if err = cursor.Decode(&legacyProject); err != nil {
+			return commonErrors.NewUnknownErrorWithError("failed to decode the project without normalized name", err)
+		}
+
+		field := "normalizedName"
+		if legacyProject.DeletedAt != nil {
+			field = "deletedNormalizedName"
+		}
+
+		_, err = collection.UpdateOne(
+			ctx,
+			bson.M{"_id": legacyProject.ID, field: bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{field: repository.NormalizeProjectName(legacyProject.Name)}})
+		if isDuplicateKeyError(err) {
+			duplicateProjectIDs = append(duplicateProjectIDs, legacyProject.ID.Hex())
+
+			continue
+		} else if err != nil {
+			return commonErrors.NewUnknownErrorWithError("failed to add the normalized name of the project", err)
+		}
+	}
+
+	if err = cursor.Err(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to find the projects without normalized names", err)
+	}
+
+	if len(duplicateProjectIDs) > 0 {
+		return commonErrors.NewUnknownError(fmt.Sprintf(
+			"the names of the projects %s are already used by other projects of the same users, rename them and restart the service",
+			strings.Join(duplicateProjectIDs, ", ")))
+	}
+
+	if _, err = collection.UpdateMany(
+		ctx,
+		bson.M{"deletedAt": bson.M{"$exists": true}, "normalizedName": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{"normalizedName": buildDeletedNamePlaceholder()}}}}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to replace the normalized names of the deleted projects", err)
+	}
+
+	return nil
+}
+
+// findDuplicateNames fails with the identifiers of the projects that have the same name as other projects of the
+// same users, as the unique index can not be created until they are renamed
+func (service *mongodbRepositoryService) findDuplicateNames(ctx context.Context, client *mongo.Client) error {
+	collection := client.Database(service.databaseName).Collection(service.databaseCollectionName)
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":        bson.M{"userEmail": "$userEmail", "normalizedName": "$normalizedName"},
+			"projectIDs": bson.M{"$push": bson.M{"$toString": "$_id"}},
+			"count":      bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	})
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to find the projects with duplicate names", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var duplicates []string
+
+	for cursor.Next(ctx) {
+		var duplicate struct {
+			ProjectIDs []string `bson:"projectIDs"`
+		}
+
+		if err = cursor.Decode(&duplicate); err != nil {
+			return commonErrors.NewUnknownErrorWithError("failed to decode the projects with duplicate names", err)
+		}
+
+		duplicates = append(duplicates, strings.Join(duplicate.ProjectIDs, ", "))
+	}
+
+	if err = cursor.Err(); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to find the projects with duplicate names", err)
+	}
+
+	if len(duplicates) > 0 {
+		return commonErrors.NewUnknownError(fmt.Sprintf(
+			"the projects [%s] have the same names as other projects of the same users, rename them and restart the service",
+			strings.Join(duplicates, "], [")))
+	}
+
+	return nil
+}
+
+// memberCondition returns the condition that matches the documents the user is a direct member of
+func memberCondition(userEmail string) bson.E {
+	return bson.E{Key: "members.userEmail", Value: userEmail}
+}
+
+// findMemberRole returns the role of the user among the members
+func findMemberRole(members []member, userEmail string) (models.MemberRole, bool) {
+	for _, member := range members {
+		if member.UserEmail == userEmail {
+			return member.Role, true
+		}
+	}
+
+	return models.MemberRoleViewer, false
+}
+
+// buildUpdateFields returns the fields the update mask applies, so the fields the update mask does not list keep
+// their stored values
+func buildUpdateFields(project models.Project, updateMask []string) bson.M {
+	fields := bson.M{"updatedAt": now()}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectNamePath) {
+		fields["name"] = project.Name
+		fields["normalizedName"] = repository.NormalizeProjectName(project.Name)
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectDescriptionPath) {
+		fields["description"] = project.Description
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectLabelsPath) {
+		fields["labels"] = project.Labels
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectAnnotationsPath) {
+		fields["annotations"] = project.Annotations
+	}
+
+	return fields
+}
+
+// buildDeleteStage returns the stage of the update pipeline that deletes the projects. The normalized name is
+// moved aside and replaced with a placeholder unique to the project, so the unique index releases the name of the
+// deleted project while it still covers every document. A normalized name never starts with a white space, so the
+// placeholder never conflicts with the name of a project.
+func buildDeleteStage(deletedAt time.Time) bson.D {
+	return bson.D{{Key: "$set", Value: bson.M{
+		"deletedAt":             deletedAt,
+		"deletedNormalizedName": "$normalizedName",
+		"normalizedName":        buildDeletedNamePlaceholder(),
+		"version":               buildNextVersion(),
+	}}}
+}
+
+// buildNextVersion returns the expression that increments the version of the project. The projects stored
+// before the versions were introduced have no version, which is treated as zero.
+func buildNextVersion() bson.M {
+	return bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}}
+}
+
+// buildDeletedNamePlaceholder returns the expression that computes the placeholder a deleted project keeps in
+// place of its normalized name
+func buildDeletedNamePlaceholder() bson.M {
+	return bson.M{"$concat": bson.A{" ", bson.M{"$toString": "$_id"}}}
+}
+
+// buildPushOutboxStage returns the stage of the update pipeline that appends the event to the outbox of the project
+func buildPushOutboxStage(event models.DomainEvent) bson.D {
+	return bson.D{{Key: "$set", Value: bson.M{
+		"outbox": bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$outbox", bson.A{}}},
+			bson.A{bson.M{"$literal": event}},
+		}},
+	}}}
+}
+
+// buildPushCascadeOutboxStage returns the stage of the update pipeline that appends a copy of the event to the outbox
+// of every project the request changes. Like query.NewCascadeEvent, the identifier of each copy is derived from the
+// identifiers of the event and the project, as the event is provided once for all the projects.
+func buildPushCascadeOutboxStage(event models.DomainEvent) bson.D {
+	projectID := bson.M{"$toString": "$_id"}
+
+	return bson.D{{Key: "$set", Value: bson.M{
+		"outbox": bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$outbox", bson.A{}}},
+			bson.A{bson.M{
+				"eventID":    bson.M{"$concat": bson.A{bson.M{"$literal": event.EventID + "-"}, projectID}},
+				"type":       bson.M{"$literal": event.Type},
+				"projectID":  projectID,
+				"userEmail":  bson.M{"$literal": event.UserEmail},
+				"occurredAt": bson.M{"$literal": event.OccurredAt},
+			}},
+		}},
+	}}}
+}
+
+// buildProjectFilter returns the filter that matches the project the access condition allows if it is deleted or
+// not as requested, only if its version matches the expected version. Zero expected version matches the project
+// regardless of its version.
+func buildProjectFilter(objectID primitive.ObjectID, accessCondition bson.E, deleted bool, expectedVersion int64) bson.D {
+	filter := bson.D{
+		{Key: "_id", Value: objectID},
+		accessCondition,
+		{Key: "deletedAt", Value: bson.M{"$exists": deleted}},
+	}
+
+	if expectedVersion != 0 {
+		filter = append(filter, bson.E{Key: "version", Value: expectedVersion})
+	}
+
+	return filter
+}
+
+// runBatch runs the requests of a batch in a single transaction, which is only committed if all the requests
+// succeed. The error of the failed request is wrapped in a BatchItemError. The transaction is retried on the
+// transient errors, so reset is called before the requests are run every time.
+func (service *mongodbRepositoryService) runBatch(
+	ctx context.Context,
+	count int,
+	reset func(),
+	run func(ctx context.Context, idx int) error) error {
+	return service.runTransaction(ctx, func(ctx context.Context) error {
+		reset()
+
+		for idx := 0; idx < count; idx++ {
+			if err := run(ctx, idx); err != nil {
+				return repository.NewBatchItemError(idx, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// runTransaction runs the function in a single transaction, which is only committed if the function succeeds.
+// The transaction is retried on the transient errors, so the function may run more than once. The error the
+// function fails with is returned as is.
+func (service *mongodbRepositoryService) runTransaction(ctx context.Context, run func(ctx context.Context) error) error {
+	client, err := service.getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to start the session", err)
+	}
+
+	defer session.EndSession(ctx)
+
+	var runErr error
+	_, err = session.WithTransaction(ctx, func(sessionContext mongo.SessionContext) (interface{}, error) {
+		runErr = run(sessionContext)
+
+		return nil, runErr
+	})
+
+	if runErr != nil {
+		return runErr
+	} else if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to run the transaction", err)
+	}
+
+	return nil
+}
+
+// getUnmatchedProjectError returns the error to report when an update or a deletion did not match any project.
+// The project either does not exist, or it exists but its version does not match the expected version.
+func getUnmatchedProjectError(
+	ctx context.Context,
+	collection *mongo.Collection,
+	objectID primitive.ObjectID,
+	accessCondition bson.E,
+	deleted bool,
+	expectedVersion int64) error {
+	if expectedVersion == 0 {
+		return commonErrors.NewNotFoundError()
+	}
+
+	count, err := collection.CountDocuments(ctx, buildProjectFilter(objectID, accessCondition, deleted, 0))
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to check whether the project exists", err)
+	}
+
+	if count == 0 {
+		return commonErrors.NewNotFoundError()
+	}
+
+	return models.NewConflictError()
+}
+
+// getUnmatchedUpdateError returns the error to report when an update did not match any project. The project
+// either does not exist, is archived, or its version does not match the expected version.
+func getUnmatchedUpdateError(
+	ctx context.Context,
+	collection *mongo.Collection,
+	objectID primitive.ObjectID,
+	accessCondition bson.E,
+	expectedVersion int64) error {
+	count, err := collection.CountDocuments(ctx, append(
+		buildProjectFilter(objectID, accessCondition, false, 0),
+		bson.E{Key: "state", Value: models.ProjectStateArchived}))
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to check whether the project is archived", err)
+	}
+
+	if count != 0 {
+		return models.NewProjectArchivedError()
+	}
+
+	return getUnmatchedProjectError(ctx, collection, objectID, accessCondition, false, expectedVersion)
+}
+
+// isDuplicateKeyError returns true if the write failed because it violated a unique index, which only
+// happens when the project name is already used by another project of the same user, or when the idempotency
+// key is already claimed by another request of the same user
+func isDuplicateKeyError(err error) bool {
+	const duplicateKeyErrorCode = 11000
+
+	var writeException mongo.WriteException
+	if errors.As(err, &writeException) {
+		for _, writeError := range writeException.WriteErrors {
+			if writeError.Code == duplicateKeyErrorCode {
+				return true
+			}
+		}
+	}
+
+	var commandError mongo.CommandError
+
+	return errors.As(err, &commandError) && commandError.Code == duplicateKeyErrorCode
+}
+
+// buildListConditions returns the conditions the projects listed by the request must match
+// Returns the conditions, false if no project can match the request or error if something goes wrong
+func (service *mongodbRepositoryService) buildListConditions(
+	ctx context.Context,
+	request *repository.ListProjectsRequest,
+	deleted bool) ([]interface{}, bool, error) {
+	ids := []primitive.ObjectID{}
+	for _, projectID := range request.ProjectIDs {
+		objectID, err := primitive.ObjectIDFromHex(projectID)
+		if err != nil {
+			return nil, false, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the projectID: %s.", projectID), err)
+		}
+
+		ids = append(ids, objectID)
+	}
+
+	accessCondition, err := service.buildAccessCondition(ctx, request.UserEmail)
+	if err != nil {
+		return nil, false, err
+	}
+
+	conditions := []interface{}{
+		bson.D{accessCondition},
+		// The deleted projects are the only documents with the deletedAt field
+		bson.M{"deletedAt": bson.M{"$exists": deleted}},
+	}
+
+	if len(request.ProjectIDs) > 0 {
+		conditions = append(conditions, bson.M{"_id": bson.M{"$in": ids}})
+	}
+
+	if request.OrganizationID != "" {
+		conditions = append(conditions, bson.M{"organizationID": request.OrganizationID})
+	}
+
+	if request.FolderID != "" {
+		folderIDs, found, err := service.findFolderTree(ctx, request.UserEmail, request.FolderID, request.IncludeSubfolders)
+		if err != nil || !found {
+			return nil, false, err
+		}
+
+		conditions = append(conditions, bson.M{"folderID": bson.M{"$in": mapToHexIDs(folderIDs)}})
+	}
+
+	if !request.IncludeArchived {
+		conditions = append(conditions, bson.M{"state": bson.M{"$ne": models.ProjectStateArchived}})
+	}
+
+	if request.Filter != nil {
+		condition, err := buildFilterCondition(*request.Filter)
+		if err != nil {
+			return nil, false, err
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, true, nil
+}
+
+// buildCursorCondition returns the condition that matches the projects that come after the position defined by
+// the sort keys of a cursor in the sort order of the keys, or before the position if after is false
+func buildCursorCondition(keys []repository.SortKey, after bool) (bson.M, error) {
+	alternatives := []interface{}{}
+
+	// A project comes after the cursor if it has the same values as the cursor for the leading keys and comes
+	// after the cursor for the next key
+	for idx := range keys {
+		condition := bson.M{}
+		for position, key := range keys[:idx+1] {
+			value := key.Value
+			if key.Field == "_id" {
+				objectID, err := primitive.ObjectIDFromHex(value.(string))
+				if err != nil {
+					return nil, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+				}
+
+				value = objectID
+			}
+
+			if position == idx {
+				if key.Descending == after {
+					value = bson.M{"$lt": value}
+				} else {
+					value = bson.M{"$gt": value}
+				}
+			}
+
+			condition[sortingFields[key.Field]] = value
+		}
+
+		alternatives = append(alternatives, condition)
+	}
+
+	return bson.M{"$or": alternatives}, nil
+}
+
+// exists returns whether any document matches all the conditions
+func exists(ctx context.Context, collection *mongo.Collection, conditions []interface{}) (bool, error) {
+	count, err := collection.CountDocuments(ctx, bson.M{"$and": conditions}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError("failed to find out whether any project matches the filter criteria", err)
+	}
+
+	return count > 0, nil
+}
+
+// buildSortOptionPairs converts the sorting options, which must be valid, to the MongoDB sort document. The
+// identifier is always appended as the last key, so projects that share the same values are still returned in
+// a stable order.
+func buildSortOptionPairs(sortingOptions []common.SortingOptionPair, reverse bool) bson.D {
+	sortOptionPairs := bson.D{}
+	sortedOnID := false
+
+	for _, sortingOption := range sortingOptions {
+		direction := 1
+		if sortingOption.Direction == common.Descending {
+			direction = -1
+		}
+
+		if reverse {
+			direction = -direction
+		}
+
+		field := sortingFields[sortingOption.Name]
+		sortedOnID = sortedOnID || field == "_id"
+		sortOptionPairs = append(
+			sortOptionPairs,
+			bson.E{
+				Key:   field,
+				Value: direction,
+			})
+	}
+
+	if !sortedOnID {
+		direction := 1
+		if reverse {
+			direction = -1
+		}
+
+		sortOptionPairs = append(sortOptionPairs, bson.E{Key: "_id", Value: direction})
+	}
+
+	return sortOptionPairs
+}
+
+// now returns the current time in UTC, truncated to milliseconds as MongoDB stores the time with
+// millisecond precision, so the time returned on create matches the time read later
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func mapToInternalProject(email string, from models.Project) project {
+	return project{
+		UserEmail:      email,
+		Name:           from.Name,
+		NormalizedName: repository.NormalizeProjectName(from.Name),
+		Description:    from.Description,
+		Labels:         from.Labels,
+		Annotations:    from.Annotations,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+		CreatedBy:      from.CreatedBy,
+		Version:        from.Version,
+		OrganizationID: from.OrganizationID,
+		FolderID:       from.FolderID,
+	}
+}
+
+func mapFromInternalProject(from project) models.Project {
+	project := models.Project{
+		Name:           from.Name,
+		Description:    from.Description,
+		Labels:         from.Labels,
+		Annotations:    from.Annotations,
+		CreatedAt:      from.CreatedAt.UTC(),
+		UpdatedAt:      from.UpdatedAt.UTC(),
+		CreatedBy:      from.CreatedBy,
+		Version:        from.Version,
+		State:          from.State,
+		OrganizationID: from.OrganizationID,
+		FolderID:       from.FolderID,
+	}
+
+	if from.DeletedAt != nil {
+		project.DeletedAt = from.DeletedAt.UTC()
+	}
+
+	return project
+}
+
+// buildFilterCondition translates the filter expression to a MongoDB query. The values provided by the users
+// never become field names or operators: the names are matched with escaped regular expressions, and the
+// labels are matched with aggregation expressions that take the label keys and values as literals, as the
+// label keys may contain dots that would otherwise be treated as nested field paths.
+func buildFilterCondition(filter models.ProjectFilter) (bson.M, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("filter", "", err)
+	}
+
+	return translateFilter(filter), nil
+}
+
+func translateFilter(filter models.ProjectFilter) bson.M {
+	if len(filter.Filters) > 0 {
+		operator := "$and"
+		if filter.Operator == models.FilterOperatorOr {
+			operator = "$or"
+		}
+
+		conditions := bson.A{}
+		for _, nestedFilter := range filter.Filters {
+			conditions = append(conditions, translateFilter(nestedFilter))
+		}
+
+		return bson.M{operator: conditions}
+	}
+
+	switch {
+	case filter.Name != nil:
+		switch filter.Name.Operator {
+		case models.NameOperatorPrefix:
+			return bson.M{"name": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Name.Value)}}
+		case models.NameOperatorContains:
+			return bson.M{"name": primitive.Regex{Pattern: regexp.QuoteMeta(filter.Name.Value)}}
+		default:
+			return bson.M{"name": bson.M{"$eq": filter.Name.Value}}
+		}
+	case filter.Label != nil:
+		return bson.M{"$expr": translateLabelSelector(*filter.Label)}
+	case filter.CreatedAt != nil:
+		return bson.M{"createdAt": translateTimeRange(*filter.CreatedAt)}
+	case filter.UpdatedAt != nil:
+		return bson.M{"updatedAt": translateTimeRange(*filter.UpdatedAt)}
+	default:
+		return bson.M{"state": *filter.State}
+	}
+}
+
+func translateLabelSelector(selector models.LabelSelector) bson.M {
+	labels := bson.M{"$objectToArray": bson.M{"$ifNull": bson.A{"$labels", bson.M{}}}}
+	key := bson.M{"$literal": selector.Key}
+
+	switch selector.Operator {
+	case models.LabelOperatorExists, models.LabelOperatorDoesNotExist:
+		exists := bson.M{"$in": bson.A{key, bson.M{"$map": bson.M{"input": labels, "in": "$$this.k"}}}}
+		if selector.Operator == models.LabelOperatorDoesNotExist {
+			return bson.M{"$not": bson.A{exists}}
+		}
+
+		return exists
+	default:
+		in := bson.M{"$anyElementTrue": bson.A{bson.M{"$map": bson.M{
+			"input": labels,
+			"in": bson.M{"$and": bson.A{
+				bson.M{"$eq": bson.A{"$$this.k", key}},
+				bson.M{"$in": bson.A{"$$this.v", bson.M{"$literal": selector.Values}}},
+			}},
+		}}}}
+
+		// The projects without the label match NotIn too
+		if selector.Operator == models.LabelOperatorNotIn {
+			return bson.M{"$not": bson.A{in}}
+		}
+
+		return in
+	}
+}
+
+func translateTimeRange(timeRange models.TimeRange) bson.M {
+	condition := bson.M{}
+	if !timeRange.From.IsZero() {
+		condition["$gte"] = timeRange.From
+	}
+
+	if !timeRange.To.IsZero() {
+		condition["$lt"] = timeRange.To
+	}
+
+	return condition
+}
+
+func mapFromInternalFolder(from folder) models.Folder {
+	return models.Folder{
+		Name:           from.Name,
+		ParentFolderID: from.ParentFolderID,
+		CreatedAt:      from.CreatedAt.UTC(),
+	}
+}
+
+// mapToHexIDs converts the object identifiers to the hex strings the documents use to reference each other
+func mapToHexIDs(objectIDs []primitive.ObjectID) bson.A {
+	hexIDs := bson.A{}
+	for _, objectID := range objectIDs {
+		hexIDs = append(hexIDs, objectID.Hex())
+	}
+
+	return hexIDs
+}
+
+func mapFromInternalOrganization(from organization) models.Organization {
+	return models.Organization{
+		Name:        from.Name,
+		Description: from.Description,
+		CreatedAt:   from.CreatedAt.UTC(),
+		UpdatedAt:   from.UpdatedAt.UTC(),
+		CreatedBy:   from.CreatedBy,
+	}
+}
+
+func mapFromInternalInvitation(projectID string, from invitation) models.Invitation {
+	return models.Invitation{
+		ProjectID: projectID,
+		Email:     from.Email,
+		Role:      from.Role,
+		InvitedBy: from.InvitedBy,
+		CreatedAt: from.CreatedAt.UTC(),
+		ExpiresAt: from.ExpiresAt.UTC(),
+	}
+}
+
+func mapFromInternalMember(from member) models.Member {
+	return models.Member{
+		UserEmail: from.UserEmail,
+		Role:      from.Role,
 	}
 }
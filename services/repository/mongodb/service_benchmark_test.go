@@ -0,0 +1,119 @@
+package mongodb_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/mongodb"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	benchmarkDatabaseName           = "project"
+	benchmarkDatabaseCollectionName = "project"
+)
+
+// BenchmarkReadProjectWithPooledClient measures ReadProject served by the repository service
+// that keeps a single MongoDB client for its whole lifetime
+func BenchmarkReadProjectWithPooledClient(b *testing.B) {
+	ctx := context.Background()
+	sut := createBenchmarkRepositoryService(b)
+	defer func() {
+		_ = sut.Close()
+	}()
+
+	userEmail := cuid.New() + "@test.com"
+	createResponse, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+		UserEmail: userEmail,
+		Project:   models.Project{Name: cuid.New()},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	request := &repository.ReadProjectRequest{UserEmail: userEmail, ProjectID: createResponse.ProjectID}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := sut.ReadProject(ctx, request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkReadProjectWithClientPerCall measures the same read when a new MongoDB client is
+// connected and disconnected for every call, which is how the repository used to behave
+func BenchmarkReadProjectWithClientPerCall(b *testing.B) {
+	ctx := context.Background()
+	sut := createBenchmarkRepositoryService(b)
+	defer func() {
+		_ = sut.Close()
+	}()
+
+	userEmail := cuid.New() + "@test.com"
+	createResponse, err := sut.CreateProject(ctx, &repository.CreateProjectRequest{
+		UserEmail: userEmail,
+		Project:   models.Project{Name: cuid.New()},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	objectID, _ := primitive.ObjectIDFromHex(createResponse.ProjectID)
+	filter := bson.D{{Key: "_id", Value: objectID}, {Key: "userEmail", Value: userEmail}}
+	clientOptions := options.Client().ApplyURI(getBenchmarkConnectionString())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		client, err := mongo.Connect(ctx, clientOptions)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		var project bson.M
+		err = client.Database(benchmarkDatabaseName).Collection(benchmarkDatabaseCollectionName).FindOne(ctx, filter).Decode(&project)
+		_ = client.Disconnect(ctx)
+
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func createBenchmarkRepositoryService(b *testing.B) repository.RepositoryContract {
+	mockCtrl := gomock.NewController(b)
+	mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+	mockConfigurationService.EXPECT().GetDatabaseConnectionString().Return(getBenchmarkConnectionString(), nil)
+	mockConfigurationService.EXPECT().GetDatabaseName().Return(benchmarkDatabaseName, nil)
+	mockConfigurationService.EXPECT().GetDatabaseCollectionName().Return(benchmarkDatabaseCollectionName, nil)
+	mockConfigurationService.EXPECT().GetDatabaseMaxPoolSize().Return(uint64(100), nil)
+	mockConfigurationService.EXPECT().GetDatabaseConnectTimeout().Return(10*time.Second, nil)
+	mockConfigurationService.EXPECT().GetDatabaseServerSelectionTimeout().Return(10*time.Second, nil)
+
+	sut, err := mongodb.NewMongodbRepositoryService(mockConfigurationService)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return sut
+}
+
+func getBenchmarkConnectionString() string {
+	connectionString := os.Getenv("DATABASE_CONNECTION_STRING")
+	if strings.Trim(connectionString, " ") == "" {
+		connectionString = "mongodb://mongodb:27017"
+	}
+
+	return connectionString
+}
@@ -2,22 +2,26 @@ package mongodb_test
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"math/rand"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/decentralized-cloud/project/models"
 	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
 	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/conformance"
 	"github.com/decentralized-cloud/project/services/repository/mongodb"
 	"github.com/golang/mock/gomock"
 	"github.com/lucsky/cuid"
-	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func TestMongodbRepositoryService(t *testing.T) {
@@ -34,29 +38,8 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 	)
 
 	BeforeEach(func() {
-		connectionString := os.Getenv("DATABASE_CONNECTION_STRING")
-		if strings.Trim(connectionString, " ") == "" {
-			connectionString = "mongodb://mongodb:27017"
-		}
-
 		mockCtrl = gomock.NewController(GinkgoT())
-		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
-		mockConfigurationService.
-			EXPECT().
-			GetDatabaseConnectionString().
-			Return(connectionString, nil)
-
-		mockConfigurationService.
-			EXPECT().
-			GetDatabaseName().
-			Return("project", nil)
-
-		mockConfigurationService.
-			EXPECT().
-			GetDatabaseCollectionName().
-			Return("project", nil)
-
-		sut, _ = mongodb.NewMongodbRepositoryService(mockConfigurationService)
+		sut, _ = mongodb.NewMongodbRepositoryService(newMockConfigurationService(mockCtrl))
 		ctx = context.Background()
 		createRequest = repository.CreateProjectRequest{
 			UserEmail: cuid.New() + "@test.com",
@@ -66,6 +49,7 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 	})
 
 	AfterEach(func() {
+		_ = sut.Close()
 		mockCtrl.Finish()
 	})
 
@@ -88,6 +72,21 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 					GetDatabaseCollectionName().
 					Return(cuid.New(), nil)
 
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseMaxPoolSize().
+					Return(uint64(rand.Intn(100)+1), nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseConnectTimeout().
+					Return(time.Duration(rand.Intn(30)+1)*time.Second, nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseServerSelectionTimeout().
+					Return(time.Duration(rand.Intn(30)+1)*time.Second, nil)
+
 				service, err := mongodb.NewMongodbRepositoryService(mockConfigurationService)
 				Ω(err).Should(BeNil())
 				Ω(service).ShouldNot(BeNil())
@@ -95,354 +94,211 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 		})
 	})
 
-	Context("user going to create a new project", func() {
-		When("create project is called", func() {
-			It("should create the new project", func() {
+	Context("user migrates the database at startup", func() {
+		When("Migrate is called", func() {
+			It("should apply the migrations once and serve the requests", func() {
+				migrationService, ok := sut.(repository.MigrationContract)
+				Ω(ok).Should(BeTrue())
+
+				Ω(migrationService.Migrate(ctx)).Should(BeNil())
+				Ω(migrationService.Migrate(ctx)).Should(BeNil())
+
 				response, err := sut.CreateProject(ctx, &createRequest)
 				Ω(err).Should(BeNil())
-				Ω(response.ProjectID).ShouldNot(BeNil())
-				Ω(response.Cursor).Should(Equal(response.ProjectID))
-				assertProject(response.Project, createRequest.Project)
+				Ω(response.ProjectID).ShouldNot(BeEmpty())
 			})
 		})
-	})
-
-	Context("project already exists", func() {
-		var (
-			projectID string
-		)
 
-		BeforeEach(func() {
-			response, _ := sut.CreateProject(ctx, &createRequest)
-			projectID = response.ProjectID
-		})
+		When("the projects stored before the project names had to be unique are migrated", func() {
+			var (
+				collectionName string
+				collection     *mongo.Collection
+			)
 
-		When("user reads a project by Id", func() {
-			It("should return a project", func() {
-				response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
-				Ω(err).Should(BeNil())
-				assertProject(response.Project, createRequest.Project)
+			BeforeEach(func() {
+				collectionName = cuid.New()
+				collection = newLegacyCollection(ctx, collectionName)
 			})
-		})
 
-		When("user updates the existing project", func() {
-			It("should update the project information", func() {
-				updateRequest := repository.UpdateProjectRequest{
-					UserEmail: createRequest.UserEmail,
-					ProjectID: projectID,
-					Project: models.Project{
-						Name: cuid.New(),
-					}}
+			AfterEach(func() {
+				_ = collection.Drop(ctx)
+				_ = collection.Database().Client().Disconnect(ctx)
+			})
 
-				updateResponse, err := sut.UpdateProject(ctx, &updateRequest)
+			It("should compute the normalized names, so the names stay unique", func() {
+				userEmail := cuid.New() + "@test.com"
+				deletedAt := time.Now()
+				_, err := collection.InsertMany(ctx, []interface{}{
+					bson.M{"userEmail": userEmail, "name": "Legacy  Project"},
+					bson.M{"userEmail": userEmail, "name": "Deleted Project", "deletedAt": deletedAt},
+					bson.M{"userEmail": userEmail, "name": "Another Deleted Project", "deletedAt": deletedAt},
+				})
 				Ω(err).Should(BeNil())
-				Ω(updateResponse.Cursor).Should(Equal(projectID))
-				assertProject(updateResponse.Project, updateRequest.Project)
 
-				readResponse, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
+				service, err := mongodb.NewMongodbRepositoryService(newMockConfigurationServiceWithCollection(mockCtrl, collectionName))
 				Ω(err).Should(BeNil())
-				assertProject(readResponse.Project, updateRequest.Project)
-			})
-		})
-
-		When("user deletes the project", func() {
-			It("should delete the project", func() {
-				_, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
+				defer func() {
+					_ = service.Close()
+				}()
+
+				Ω(service.(repository.MigrationContract).Migrate(ctx)).Should(BeNil())
+
+				_, err = service.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   models.Project{Name: " legacy project"},
+				})
+				Ω(commonErrors.IsAlreadyExistsError(err)).Should(BeTrue())
+
+				_, err = service.CreateProject(ctx, &repository.CreateProjectRequest{
+					UserEmail: userEmail,
+					Project:   models.Project{Name: "Deleted Project"},
+				})
 				Ω(err).Should(BeNil())
-
-				response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
-				Ω(err).Should(HaveOccurred())
-				Ω(response).Should(BeNil())
-
-				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
-
-				var notFoundErr commonErrors.NotFoundError
-				_ = errors.As(err, &notFoundErr)
 			})
-		})
-	})
-
-	Context("project does not exist", func() {
-		var (
-			projectID string
-		)
 
-		BeforeEach(func() {
-			projectID = cuid.New()
-		})
-
-		When("user reads the project", func() {
-			It("should return NotFoundError", func() {
-				response, err := sut.ReadProject(ctx, &repository.ReadProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
-				Ω(err).Should(HaveOccurred())
-				Ω(response).Should(BeNil())
-
-				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
-
-				var notFoundErr commonErrors.NotFoundError
-				_ = errors.As(err, &notFoundErr)
-			})
-		})
-
-		When("user tries to update the project", func() {
-			It("should return NotFoundError", func() {
-				updateRequest := repository.UpdateProjectRequest{
-					UserEmail: createRequest.UserEmail,
-					ProjectID: projectID,
-					Project: models.Project{
-						Name: cuid.New(),
-					}}
-
-				response, err := sut.UpdateProject(ctx, &updateRequest)
-				Ω(err).Should(HaveOccurred())
-				Ω(response).Should(BeNil())
-
-				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
-
-				var notFoundErr commonErrors.NotFoundError
-				_ = errors.As(err, &notFoundErr)
-			})
-		})
-
-		When("user tries to delete the project", func() {
-			It("should return NotFoundError", func() {
-				response, err := sut.DeleteProject(ctx, &repository.DeleteProjectRequest{UserEmail: createRequest.UserEmail, ProjectID: projectID})
-				Ω(err).Should(HaveOccurred())
-				Ω(response).Should(BeNil())
-
-				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
-
-				var notFoundErr commonErrors.NotFoundError
-				_ = errors.As(err, &notFoundErr)
-			})
-		})
-	})
-
-	Context("project already exists", func() {
-		var (
-			projectIDs []string
-		)
-
-		BeforeEach(func() {
-			projectIDs = []string{}
-
-			for i := 0; i < 10; i++ {
-				projectName := fmt.Sprintf("%s%d", "Name", i)
-				createRequest.Project.Name = projectName
-				response, _ := sut.CreateProject(ctx, &createRequest)
-				projectIDs = append(projectIDs, response.ProjectID)
-			}
-		})
+			It("should count the versions of the projects stored without one when their ownership is transferred", func() {
+				userEmail := cuid.New() + "@test.com"
+				response, err := collection.InsertOne(ctx, bson.M{"userEmail": userEmail, "name": cuid.New()})
+				Ω(err).Should(BeNil())
 
-		When("user ListProjectses for projects with selected project Ids and first 10 projects", func() {
-			It("should return first 10 projects", func() {
-				first := 10
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: nil,
-						First: &first,
+				service, err := mongodb.NewMongodbRepositoryService(newMockConfigurationServiceWithCollection(mockCtrl, collectionName))
+				Ω(err).Should(BeNil())
+				defer func() {
+					_ = service.Close()
+				}()
+
+				Ω(service.(repository.MigrationContract).Migrate(ctx)).Should(BeNil())
+
+				toUserEmail := cuid.New() + "@test.com"
+				transferResponse, err := service.TransferProjectOwnership(ctx, &repository.TransferProjectOwnershipRequest{
+					ProjectID:     response.InsertedID.(primitive.ObjectID).Hex(),
+					FromUserEmail: userEmail,
+					ToUserEmail:   toUserEmail,
+					AuditEvent: models.AuditEvent{
+						Action:          models.AuditActionOwnershipTransferred,
+						UserEmail:       userEmail,
+						TargetUserEmail: toUserEmail,
+						OccurredAt:      time.Now(),
 					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
-
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
+				})
 				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(10))
-				for i := 0; i < 10; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
+				Ω(transferResponse.Project.Version).Should(Equal(int64(1)))
 			})
-		})
 
-		When("user ListProjectses for projects with selected project Ids and first 5 projects", func() {
-			It("should return first 5 projects", func() {
-				first := 5
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: nil,
-						First: &first,
-					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
+			It("should report the projects that have the same names", func() {
+				userEmail := cuid.New() + "@test.com"
+				response, err := collection.InsertMany(ctx, []interface{}{
+					bson.M{"userEmail": userEmail, "name": "Legacy Project"},
+					bson.M{"userEmail": userEmail, "name": "legacy  project"},
+				})
+				Ω(err).Should(BeNil())
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
+				service, err := mongodb.NewMongodbRepositoryService(newMockConfigurationServiceWithCollection(mockCtrl, collectionName))
 				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(5))
-				for i := 0; i < 5; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
-			})
-		})
+				defer func() {
+					_ = service.Close()
+				}()
 
-		When("user ListProjectses for projects with selected project Ids with After parameter provided.", func() {
-			It("should return first 9 projects after provided project id", func() {
-				first := 9
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: &projectIDs[0],
-						First: &first,
-					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
+				err = service.(repository.MigrationContract).Migrate(ctx)
+				Ω(err).Should(HaveOccurred())
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
-				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(9))
-				for i := 1; i < 10; i++ {
-					Ω(response.Projects[i-1].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i-1].Project.Name).Should(Equal(projectName))
+				for _, insertedID := range response.InsertedIDs {
+					Ω(err.Error()).Should(ContainSubstring(insertedID.(primitive.ObjectID).Hex()))
 				}
 			})
 		})
+	})
 
-		When("user ListProjectses for projects with selected project Ids with After parameter provided.", func() {
-			It("should return first 5 projects after provided project id", func() {
-				first := 5
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: &projectIDs[0],
-						First: &first,
-					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
-
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
-				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(5))
-				for i := 1; i < 5; i++ {
-					Ω(response.Projects[i-1].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i-1].Project.Name).Should(Equal(projectName))
-				}
+	Context("user closes the repository service", func() {
+		When("Close is called before any request is served", func() {
+			It("should return no error", func() {
+				Ω(sut.Close()).Should(BeNil())
 			})
 		})
 
-		When("user ListProjectses for projects with selected project Ids and last 10 projects", func() {
-			It("should return first 10 projects", func() {
-				last := 10
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						Before: nil,
-						Last:   &last,
-					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
+		When("Close is called after requests are served", func() {
+			It("should reconnect on the next request", func() {
+				_, err := sut.CreateProject(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+				Ω(sut.Close()).Should(BeNil())
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
+				response, err := sut.CreateProject(ctx, &createRequest)
 				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(10))
-				for i := 0; i < 10; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
+				Ω(response.ProjectID).ShouldNot(BeEmpty())
 			})
 		})
+	})
+})
 
-		When("user ListProjectses for projects with selected project Ids with Before parameter provided.", func() {
-			It("should return first 9 projects before provided project id", func() {
-				last := 9
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						Before: &projectIDs[9],
-						Last:   &last,
-					},
-					SortingOptions: []common.SortingOptionPair{},
-				}
+var _ = conformance.DescribeRepositoryService("Mongodb Repository Service", func() repository.RepositoryContract {
+	service, err := mongodb.NewMongodbRepositoryService(newMockConfigurationService(gomock.NewController(GinkgoT())))
+	Ω(err).Should(BeNil())
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
-				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(9))
-				for i := 0; i < 9; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
-			})
-		})
+	return service
+})
 
-		When("user ListProjectses for projects with selected project Ids and first 10 projects with ascending order on name property", func() {
-			It("should return first 10 projects in adcending order on name field", func() {
-				first := 10
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: nil,
-						First: &first,
-					},
-					SortingOptions: []common.SortingOptionPair{
-						{Name: "name", Direction: common.Ascending},
-					},
-				}
+// The changes are watched from the change stream of the projects collection, so MongoDB must run as a replica set
+var _ = conformance.DescribeWatchService("Mongodb Repository Service", func() repository.RepositoryContract {
+	service, err := mongodb.NewMongodbRepositoryService(newMockConfigurationService(gomock.NewController(GinkgoT())))
+	Ω(err).Should(BeNil())
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
-				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(10))
-				for i := 0; i < 10; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[i]))
-					projectName := fmt.Sprintf("%s%d", "Name", i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
-			})
-		})
+	return service
+})
 
-		When("user ListProjectses for projects with selected project Ids and first 10 projects with descending order on name property", func() {
-			It("should return first 10 projects in descending order on name field", func() {
-				first := 10
-				ListProjectsRequest := repository.ListProjectsRequest{
-					UserEmail:  createRequest.UserEmail,
-					ProjectIDs: projectIDs,
-					Pagination: common.Pagination{
-						After: nil,
-						First: &first,
-					},
-					SortingOptions: []common.SortingOptionPair{
-						{Name: "name", Direction: common.Descending},
-					},
-				}
+func newMockConfigurationService(mockCtrl *gomock.Controller) *configurationMock.MockConfigurationContract {
+	return newMockConfigurationServiceWithCollection(mockCtrl, "project")
+}
 
-				response, err := sut.ListProjects(ctx, &ListProjectsRequest)
-				Ω(err).Should(BeNil())
-				Ω(response.Projects).ShouldNot(BeNil())
-				Ω(len(response.Projects)).Should(Equal(10))
-				for i := 0; i < 10; i++ {
-					Ω(response.Projects[i].ProjectID).Should(Equal(projectIDs[9-i]))
-					projectName := fmt.Sprintf("%s%d", "Name", 9-i)
-					Ω(response.Projects[i].Project.Name).Should(Equal(projectName))
-				}
-			})
-		})
+func newMockConfigurationServiceWithCollection(
+	mockCtrl *gomock.Controller,
+	collectionName string) *configurationMock.MockConfigurationContract {
+	mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectionString().
+		Return(getConnectionString(), nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseName().
+		Return("project", nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseCollectionName().
+		Return(collectionName, nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseMaxPoolSize().
+		Return(uint64(10), nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectTimeout().
+		Return(10*time.Second, nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseServerSelectionTimeout().
+		Return(10*time.Second, nil)
+
+	return mockConfigurationService
+}
 
-	})
+func getConnectionString() string {
+	connectionString := os.Getenv("DATABASE_CONNECTION_STRING")
+	if strings.Trim(connectionString, " ") == "" {
+		connectionString = "mongodb://mongodb:27017"
+	}
 
-})
+	return connectionString
+}
+
+// newLegacyCollection returns the collection to store the projects the way the previous versions stored them in
+func newLegacyCollection(ctx context.Context, collectionName string) *mongo.Collection {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(getConnectionString()))
+	Ω(err).Should(BeNil())
 
-func assertProject(project, expectedProject models.Project) {
-	Ω(project).ShouldNot(BeNil())
-	Ω(project.Name).Should(Equal(expectedProject.Name))
+	return client.Database("project").Collection(collectionName)
 }
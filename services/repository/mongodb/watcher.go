@@ -0,0 +1,177 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/decentralized-cloud/project/models"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// changeEvent is an event of the change stream of the projects collection. The identifier of the event is the
+// resume token the change stream can be resumed after.
+type changeEvent struct {
+	ResumeToken   bson.Raw `bson:"_id"`
+	OperationType string   `bson:"operationType"`
+	DocumentKey   struct {
+		ID primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument      *project           `bson:"fullDocument"`
+	UpdateDescription *updateDescription `bson:"updateDescription"`
+}
+
+type updateDescription struct {
+	UpdatedFields bson.M   `bson:"updatedFields"`
+	RemovedFields []string `bson:"removedFields"`
+}
+
+// projectWatcher maps the events of the change stream of WatchProjects to the changes of the projects
+type projectWatcher struct {
+	stream *mongo.ChangeStream
+	event  models.ProjectEvent
+	err    error
+}
+
+// Next waits for the next change of the projects, skipping the events that do not change a visible project
+// ctx: Mandatory The reference to the context, cancelling it stops waiting for the changes
+// Returns false when the change stream failed or the context is cancelled
+func (watcher *projectWatcher) Next(ctx context.Context) bool {
+	if watcher.err != nil {
+		return false
+	}
+
+	for watcher.stream.Next(ctx) {
+		var event changeEvent
+		if err := watcher.stream.Decode(&event); err != nil {
+			watcher.err = commonErrors.NewUnknownErrorWithError("failed to decode the change of the project", err)
+
+			return false
+		}
+
+		eventType, ok := mapChangeEventType(event)
+		if !ok {
+			continue
+		}
+
+		watcher.event = models.ProjectEvent{
+			Type:            eventType,
+			ProjectID:       event.DocumentKey.ID.Hex(),
+			Project:         mapFromInternalProject(*event.FullDocument),
+			ResourceVersion: base64.RawURLEncoding.EncodeToString(event.ResumeToken),
+		}
+
+		return true
+	}
+
+	if err := ctx.Err(); err != nil {
+		watcher.err = err
+	} else if err = watcher.stream.Err(); isHistoryLostError(err) {
+		watcher.err = models.NewResourceVersionExpiredErrorWithError(err)
+	} else if err != nil {
+		watcher.err = commonErrors.NewUnknownErrorWithError("failed to watch the projects", err)
+	} else {
+		watcher.err = commonErrors.NewUnknownError("the change stream of the projects was invalidated")
+	}
+
+	return false
+}
+
+// Event returns the change the watcher is at
+// Returns the change the last call to Next advanced the watcher to
+func (watcher *projectWatcher) Event() models.ProjectEvent {
+	return watcher.event
+}
+
+// Err returns the error the watcher failed with
+// Returns the error that stopped the watcher
+func (watcher *projectWatcher) Err() error {
+	return watcher.err
+}
+
+// Close closes the change stream, so the server releases its cursor without waiting for it to time out
+// Returns error if something goes wrong
+func (watcher *projectWatcher) Close() error {
+	// The change stream is closed with a fresh context, as the context of the stream may already be cancelled
+	if err := watcher.stream.Close(context.Background()); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to close the change stream", err)
+	}
+
+	return nil
+}
+
+// mapChangeEventType maps the change of the project document to the change of the project. The projects are
+// deleted and restored by setting and removing deletedAt, and the other changes of the deleted projects are
+// skipped, as nobody can see them. Removing the published events from the outbox does not change the project,
+// so it is skipped too.
+// Returns the type of the change, or false if the change must be skipped
+func mapChangeEventType(event changeEvent) (models.ProjectEventType, bool) {
+	// The project is already purged when the change is looked up
+	if event.FullDocument == nil || isOutboxChange(event.UpdateDescription) {
+		return models.ProjectEventTypeModified, false
+	}
+
+	if event.OperationType == "insert" {
+		return models.ProjectEventTypeAdded, true
+	}
+
+	deleted := event.FullDocument.DeletedAt != nil
+	if event.UpdateDescription != nil {
+		if _, ok := event.UpdateDescription.UpdatedFields["deletedAt"]; ok && deleted {
+			return models.ProjectEventTypeDeleted, true
+		}
+
+		for _, field := range event.UpdateDescription.RemovedFields {
+			if field == "deletedAt" && !deleted {
+				return models.ProjectEventTypeAdded, true
+			}
+		}
+	}
+
+	return models.ProjectEventTypeModified, !deleted
+}
+
+// isOutboxChange returns true if the update only changed the outbox of the project document
+func isOutboxChange(description *updateDescription) bool {
+	if description == nil || len(description.UpdatedFields) == 0 || len(description.RemovedFields) != 0 {
+		return false
+	}
+
+	for field := range description.UpdatedFields {
+		if field != "outbox" && !strings.HasPrefix(field, "outbox.") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeResourceVersion returns the resume token the resource version encodes
+func decodeResourceVersion(resourceVersion string) (bson.Raw, error) {
+	resumeToken, err := base64.RawURLEncoding.DecodeString(resourceVersion)
+	if err == nil {
+		err = bson.Raw(resumeToken).Validate()
+	}
+
+	if err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("resourceVersion", "resourceVersion is invalid", err)
+	}
+
+	return resumeToken, nil
+}
+
+// isHistoryLostError returns true if the change stream cannot be resumed because the oplog no longer contains the
+// resume token
+func isHistoryLostError(err error) bool {
+	const changeStreamFatalErrorCode = 280
+	const changeStreamHistoryLostErrorCode = 286
+
+	var commandError mongo.CommandError
+
+	return errors.As(err, &commandError) &&
+		(commandError.Code == changeStreamFatalErrorCode || commandError.Code == changeStreamHistoryLostErrorCode)
+}
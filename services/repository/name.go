@@ -0,0 +1,13 @@
+// Package repository implements different repository services required by the project service
+package repository
+
+import "strings"
+
+// NormalizeProjectName returns the form of the project name that must be unique among the projects of a user.
+// The leading and trailing white spaces are removed, the white spaces in between are collapsed to a single
+// space and the name is lower-cased, so "My Project" and " my  PROJECT" are considered to be the same name.
+// name: Mandatory. The project name to normalize
+// Returns the normalized project name
+func NormalizeProjectName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/jackc/pgx/v4"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// projectIterator iterates over the rows returned by the query of StreamProjects. The rows are read from the
+// connection while the iterator advances. The iterator without rows does not return any project.
+type projectIterator struct {
+	rows           pgx.Rows
+	sortingOptions []common.SortingOptionPair
+	project        models.ProjectWithCursor
+	err            error
+}
+
+// Next advances the iterator to the next project
+// ctx: Mandatory The reference to the context, cancelling it stops the query
+// Returns false when there are no more projects or the iterator failed
+func (iterator *projectIterator) Next(ctx context.Context) bool {
+	if iterator.rows == nil || iterator.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		iterator.err = err
+
+		return false
+	}
+
+	if !iterator.rows.Next() {
+		if err := iterator.rows.Err(); err != nil {
+			iterator.err = commonErrors.NewUnknownErrorWithError("failed to read the projects", err)
+		}
+
+		return false
+	}
+
+	id, project, err := scanProject(iterator.rows)
+	if err != nil {
+		iterator.err = commonErrors.NewUnknownErrorWithError("failed to decode the project", err)
+
+		return false
+	}
+
+	projectID := formatProjectID(id)
+	iterator.project = models.ProjectWithCursor{
+		ProjectID: projectID,
+		Project:   project,
+		Cursor:    repository.NewCursor(iterator.sortingOptions, projectID, project),
+	}
+
+	return true
+}
+
+// Project returns the project the iterator is at
+// Returns the project the last call to Next advanced the iterator to
+func (iterator *projectIterator) Project() models.ProjectWithCursor {
+	return iterator.project
+}
+
+// Err returns the error the iterator failed with
+// Returns the error that stopped the iterator or nil if the iterator did not fail
+func (iterator *projectIterator) Err() error {
+	return iterator.err
+}
+
+// Close closes the rows, which releases the connection back to the pool
+// Returns nil as closing the rows cannot fail
+func (iterator *projectIterator) Close() error {
+	if iterator.rows != nil {
+		iterator.rows.Close()
+	}
+
+	return nil
+}
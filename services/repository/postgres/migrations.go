@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// migrationsLockID is the key of the advisory lock that prevents multiple instances of the service
+// from migrating the same database at the same time
+const migrationsLockID = 7345812093
+
+// migrations contains the statements that create the database schema. Every statement is applied once,
+// in order, and its index plus one is recorded as the schema version. Existing statements must never be
+// changed, new statements should be appended to the end of the list instead.
+var migrations = []string{
+	`CREATE TABLE projects (
+		id         BIGSERIAL PRIMARY KEY,
+		user_email TEXT NOT NULL,
+		name       TEXT NOT NULL
+	)`,
+	`CREATE INDEX projects_user_email_id_idx ON projects (user_email, id)`,
+	`ALTER TABLE projects
+		ADD COLUMN description TEXT NOT NULL DEFAULT '',
+		ADD COLUMN labels      JSONB NOT NULL DEFAULT '{}',
+		ADD COLUMN annotations JSONB NOT NULL DEFAULT '{}',
+		ADD COLUMN created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+		ADD COLUMN updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+		ADD COLUMN created_by  TEXT NOT NULL DEFAULT ''`,
+	`UPDATE projects SET created_by = user_email`,
+	`ALTER TABLE projects ADD COLUMN normalized_name TEXT`,
+	// The existing projects that share the same name are left without a normalized name, except for the
+	// oldest one, so the unique index can be created. They get one the next time they are renamed.
+	`UPDATE projects SET normalized_name = names.normalized_name
+	FROM (
+		SELECT DISTINCT ON (user_email, normalized_name) id, normalized_name
+		FROM (SELECT id, user_email, lower(btrim(regexp_replace(name, '\s+', ' ', 'g'))) AS normalized_name FROM projects) AS normalized
+		ORDER BY user_email, normalized_name, id
+	) AS names
+	WHERE projects.id = names.id`,
+	`CREATE UNIQUE INDEX projects_user_email_normalized_name_idx ON projects (user_email, normalized_name)`,
+	`ALTER TABLE projects ADD COLUMN version BIGINT NOT NULL DEFAULT 1`,
+	`ALTER TABLE projects ADD COLUMN deleted_at TIMESTAMPTZ`,
+	`CREATE INDEX projects_deleted_at_idx ON projects (deleted_at) WHERE deleted_at IS NOT NULL`,
+	// The deleted projects release their names, so the unique index only covers the projects that are not deleted
+	`DROP INDEX projects_user_email_normalized_name_idx`,
+	`CREATE UNIQUE INDEX projects_user_email_normalized_name_idx ON projects (user_email, normalized_name) WHERE deleted_at IS NULL`,
+	`ALTER TABLE projects ADD COLUMN state SMALLINT NOT NULL DEFAULT 0`,
+	`CREATE TABLE project_members (
+		project_id BIGINT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		user_email TEXT NOT NULL,
+		role       SMALLINT NOT NULL,
+		PRIMARY KEY (project_id, user_email)
+	)`,
+	`CREATE INDEX project_members_user_email_idx ON project_members (user_email)`,
+	// The users who created the existing projects become their owners, the role 2 is the owner role
+	`INSERT INTO project_members (project_id, user_email, role) SELECT id, user_email, 2 FROM projects`,
+	`CREATE TABLE project_ownership_transfers (
+		project_id      BIGINT PRIMARY KEY REFERENCES projects (id) ON DELETE CASCADE,
+		from_user_email TEXT NOT NULL,
+		to_user_email   TEXT NOT NULL,
+		requested_at    TIMESTAMPTZ NOT NULL,
+		expires_at      TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE TABLE project_audit_events (
+		id                BIGSERIAL PRIMARY KEY,
+		project_id        BIGINT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		action            SMALLINT NOT NULL,
+		user_email        TEXT NOT NULL,
+		target_user_email TEXT NOT NULL,
+		occurred_at       TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX project_audit_events_project_id_idx ON project_audit_events (project_id, occurred_at, id)`,
+	`CREATE TABLE project_invitations (
+		project_id BIGINT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		email      TEXT NOT NULL,
+		role       SMALLINT NOT NULL,
+		invited_by TEXT NOT NULL,
+		token_hash TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		PRIMARY KEY (project_id, email)
+	)`,
+	`CREATE UNIQUE INDEX project_invitations_token_hash_idx ON project_invitations (token_hash)`,
+	`CREATE TABLE organizations (
+		id          BIGSERIAL PRIMARY KEY,
+		name        TEXT NOT NULL,
+		description TEXT NOT NULL,
+		created_at  TIMESTAMPTZ NOT NULL,
+		updated_at  TIMESTAMPTZ NOT NULL,
+		created_by  TEXT NOT NULL
+	)`,
+	`CREATE TABLE organization_members (
+		organization_id BIGINT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+		user_email      TEXT NOT NULL,
+		role            SMALLINT NOT NULL,
+		PRIMARY KEY (organization_id, user_email)
+	)`,
+	`CREATE INDEX organization_members_user_email_idx ON organization_members (user_email)`,
+	// The organizations cannot be deleted while any project, including the deleted ones, belongs to them
+	`ALTER TABLE projects ADD COLUMN organization_id BIGINT REFERENCES organizations (id)`,
+	`CREATE INDEX projects_organization_id_idx ON projects (organization_id) WHERE organization_id IS NOT NULL`,
+	// Deleting a folder deletes its subfolders and moves the projects out of the deleted folders
+	`CREATE TABLE folders (
+		id               BIGSERIAL PRIMARY KEY,
+		user_email       TEXT NOT NULL,
+		name             TEXT NOT NULL,
+		parent_folder_id BIGINT REFERENCES folders (id) ON DELETE CASCADE,
+		created_at       TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX folders_user_email_idx ON folders (user_email)`,
+	`CREATE INDEX folders_parent_folder_id_idx ON folders (parent_folder_id) WHERE parent_folder_id IS NOT NULL`,
+	`ALTER TABLE projects ADD COLUMN folder_id BIGINT REFERENCES folders (id) ON DELETE SET NULL`,
+	`CREATE INDEX projects_folder_id_idx ON projects (folder_id) WHERE folder_id IS NOT NULL`,
+	// The full-text search matches the words of the names and the descriptions without stemming them
+	`CREATE INDEX projects_search_idx ON projects USING GIN (to_tsvector('simple', name || ' ' || description))`,
+	// The idempotency keys are forgotten together with the projects they created once the projects are purged
+	`CREATE TABLE project_idempotency_keys (
+		user_email      TEXT NOT NULL,
+		idempotency_key TEXT NOT NULL,
+		payload_hash    TEXT NOT NULL,
+		project_id      BIGINT NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+		expires_at      TIMESTAMPTZ NOT NULL,
+		PRIMARY KEY (user_email, idempotency_key)
+	)`,
+	// The events are kept until they are published, and the projects they were written with are not purged before
+	`CREATE TABLE project_outbox (
+		id          BIGSERIAL PRIMARY KEY,
+		event_id    TEXT NOT NULL,
+		type        TEXT NOT NULL,
+		project_id  BIGINT NOT NULL,
+		user_email  TEXT NOT NULL,
+		occurred_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX project_outbox_event_id_idx ON project_outbox (event_id)`,
+	`CREATE INDEX project_outbox_project_id_idx ON project_outbox (project_id)`,
+}
+
+// migrate brings the database schema up to date by applying the migrations that are not applied yet
+// ctx: Mandatory The reference to the context
+// pool: Mandatory. The connection pool to the database to migrate
+// Returns error if something goes wrong
+func migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create the schema migrations table", err)
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to start the schema migrations transaction", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLockID); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to acquire the schema migrations lock", err)
+	}
+
+	var version int
+	if err = tx.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to read the current schema version", err)
+	}
+
+	for idx := version; idx < len(migrations); idx++ {
+		if err = applyMigration(ctx, tx, idx+1, migrations[idx]); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to commit the schema migrations", err)
+	}
+
+	return nil
+}
+
+func applyMigration(ctx context.Context, tx pgx.Tx, version int, statement string) error {
+	if _, err := tx.Exec(ctx, statement); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to apply the schema migration", err)
+	}
+
+	if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to record the schema version", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,3002 @@
+// Package postgres implements PostgreSQL repository services
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/project/models"
+	"github.com/decentralized-cloud/project/services/configuration"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/internal/query"
+	"github.com/decentralized-cloud/project/services/repository/internal/search"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/micro-business/go-core/common"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// sortingColumns maps the fields of the models.ProjectSortFields catalogue to the table columns. Only the
+// listed columns can be used to sort the projects, so the sorting options never reach the SQL statements
+// unchecked.
+var sortingColumns = map[string]string{
+	"_id":       "id",
+	"name":      "name",
+	"createdAt": "created_at",
+	"updatedAt": "updated_at",
+}
+
+// uniqueViolationCode is the SQLSTATE PostgreSQL returns when a statement violates a unique index
+const uniqueViolationCode = "23505"
+
+// foreignKeyViolationCode is the SQLSTATE PostgreSQL returns when a statement violates a foreign key
+const foreignKeyViolationCode = "23503"
+
+// projectColumns are the columns read by the queries that return projects, in the order scanProject expects
+const projectColumns = "id, name, description, labels, annotations, created_at, updated_at, created_by, version, deleted_at, state, organization_id, folder_id"
+
+// searchDocument is the text the full-text project search matches the queries against. It must match the
+// expression of the projects_search_idx index, so the index is used by the searches.
+const searchDocument = "to_tsvector('simple', name || ' ' || description)"
+
+// organizationColumns are the columns read by the queries that return organizations, in the order
+// scanOrganization expects
+const organizationColumns = "id, name, description, created_at, updated_at, created_by"
+
+// folderColumns are the columns read by the queries that return folders, in the order scanFolder expects
+const folderColumns = "id, name, parent_folder_id, created_at"
+
+// querier is implemented by both the connection pool and the transactions, so the statements of the single
+// operations can also run as part of the transactions of the batches
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+type postgresRepositoryService struct {
+	connectionString string
+	maxPoolSize      uint64
+	connectTimeout   time.Duration
+	poolLock         sync.RWMutex
+	pool             *pgxpool.Pool
+}
+
+// NewPostgresRepositoryService creates new instance of the postgresRepositoryService, setting up all dependencies and returns the instance
+// Returns the new service or error if something goes wrong
+func NewPostgresRepositoryService(
+	configurationService configuration.ConfigurationContract) (repository.RepositoryContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	connectionString, err := configurationService.GetDatabaseConnectionString()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get connection string to postgres", err)
+	}
+
+	maxPoolSize, err := configurationService.GetDatabaseMaxPoolSize()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database max pool size", err)
+	}
+
+	connectTimeout, err := configurationService.GetDatabaseConnectTimeout()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database connect timeout", err)
+	}
+
+	return &postgresRepositoryService{
+		connectionString: connectionString,
+		maxPoolSize:      maxPoolSize,
+		connectTimeout:   connectTimeout,
+	}, nil
+}
+
+// CreateProject creates a new project.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new project
+// Returns either the result of creating new project or error if something goes wrong.
+func (service *postgresRepositoryService) CreateProject(
+	ctx context.Context,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.IdempotencyKey == nil {
+		return service.createProject(ctx, pool, request)
+	}
+
+	return service.createProjectOnce(ctx, pool, request)
+}
+
+// BatchCreateProjects creates new projects in a single transaction, either all the projects are created or
+// none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns either the results of creating the projects or error if something goes wrong.
+func (service *postgresRepositoryService) BatchCreateProjects(
+	ctx context.Context,
+	request *repository.BatchCreateProjectsRequest) (*repository.BatchCreateProjectsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]repository.CreateProjectResponse, 0, len(request.Requests))
+	if err = service.runBatch(ctx, pool, len(request.Requests), func(tx pgx.Tx, idx int) error {
+		response, err := service.createProject(ctx, tx, &request.Requests[idx])
+		if err != nil {
+			return err
+		}
+
+		responses = append(responses, *response)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchCreateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// createProject creates the project of the request, either on its own or as part of a transaction
+func (service *postgresRepositoryService) createProject(
+	ctx context.Context,
+	db querier,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	var organizationID *int64
+	if request.Project.OrganizationID != "" {
+		id, err := parseOrganizationID(request.Project.OrganizationID)
+		if err != nil {
+			// A malformed identifier cannot match any organization
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		organizationID = &id
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	id, project, err := scanProject(db.QueryRow(
+		ctx,
+		`WITH created AS (
+			INSERT INTO projects (user_email, name, normalized_name, description, labels, annotations, created_at, updated_at, created_by, organization_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $1, $9)
+			RETURNING `+projectColumns+`
+		), owner AS (
+			INSERT INTO project_members (project_id, user_email, role) SELECT id, $1, $8 FROM created
+		), outbox AS (
+			`+outboxStatement("created", 10)+`
+		)
+		SELECT `+projectColumns+` FROM created`,
+		append([]interface{}{
+			request.UserEmail,
+			request.Project.Name,
+			repository.NormalizeProjectName(request.Project.Name),
+			request.Project.Description,
+			mapOrEmpty(request.Project.Labels),
+			mapOrEmpty(request.Project.Annotations),
+			createdAt,
+			int16(models.MemberRoleOwner),
+			organizationID,
+		}, outboxArguments(request.Event)...)...))
+	if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if isForeignKeyViolation(err) {
+		// The organization of the project does not exist
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create project", err)
+	}
+
+	projectID := formatProjectID(id)
+
+	return &repository.CreateProjectResponse{
+		ProjectID: projectID,
+		Project:   project,
+		Cursor:    repository.NewProjectCursor(projectID),
+	}, nil
+}
+
+// createProjectOnce creates the project of the request together with its idempotency key in a single
+// transaction, unless the user already created a project with the same key, in which case the originally
+// created project is returned
+func (service *postgresRepositoryService) createProjectOnce(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	request *repository.CreateProjectRequest) (*repository.CreateProjectResponse, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to start the transaction", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// The concurrent requests with the same key wait until the transaction that holds the lock is finished
+	if _, err = tx.Exec(
+		ctx,
+		"SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))",
+		request.UserEmail,
+		request.IdempotencyKey.Key); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to lock the idempotency key", err)
+	}
+
+	if _, err = tx.Exec(
+		ctx,
+		"DELETE FROM project_idempotency_keys WHERE user_email = $1 AND expires_at <= $2",
+		request.UserEmail,
+		time.Now().UTC()); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove the expired idempotency keys", err)
+	}
+
+	var record query.IdempotencyRecord
+	var id int64
+
+	err = tx.QueryRow(
+		ctx,
+		"SELECT payload_hash, expires_at, project_id FROM project_idempotency_keys WHERE user_email = $1 AND idempotency_key = $2",
+		request.UserEmail,
+		request.IdempotencyKey.Key).Scan(&record.PayloadHash, &record.ExpiresAt, &id)
+	if err == nil {
+		record.ProjectID = formatProjectID(id)
+
+		projectID, replay, err := query.ReplayIdempotencyKey(&record, *request.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if replay {
+			// The project created by the original request is deleted, so the retry cannot return it
+			_, project, err := scanProject(tx.QueryRow(
+				ctx,
+				"SELECT "+projectColumns+" FROM projects WHERE id = $1 AND deleted_at IS NULL",
+				id))
+			if err == pgx.ErrNoRows {
+				return nil, commonErrors.NewNotFoundError()
+			} else if err != nil {
+				return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve project", err)
+			}
+
+			return &repository.CreateProjectResponse{
+				ProjectID: projectID,
+				Project:   project,
+				Cursor:    repository.NewProjectCursor(projectID),
+			}, nil
+		}
+	} else if err != pgx.ErrNoRows {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the idempotency key", err)
+	}
+
+	response, err := service.createProject(ctx, tx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ = parseProjectID(response.ProjectID)
+	if _, err = tx.Exec(
+		ctx,
+		`INSERT INTO project_idempotency_keys (user_email, idempotency_key, payload_hash, project_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_email, idempotency_key) DO UPDATE
+		SET payload_hash = EXCLUDED.payload_hash, project_id = EXCLUDED.project_id, expires_at = EXCLUDED.expires_at`,
+		request.UserEmail,
+		request.IdempotencyKey.Key,
+		request.IdempotencyKey.PayloadHash,
+		id,
+		request.IdempotencyKey.ExpiresAt); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to store the idempotency key", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to commit the transaction", err)
+	}
+
+	return response, nil
+}
+
+// ReadProject read an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing project
+// Returns either the result of reading an existing project or error if something goes wrong.
+func (service *postgresRepositoryService) ReadProject(
+	ctx context.Context,
+	request *repository.ReadProjectRequest) (*repository.ReadProjectResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		// A malformed identifier cannot match any project
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, project, err := scanProject(pool.QueryRow(
+		ctx,
+		"SELECT "+projectColumns+" FROM projects WHERE id = $1 AND "+isMemberCondition("$2")+" AND deleted_at IS NULL",
+		id,
+		request.UserEmail))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve project", err)
+	}
+
+	return &repository.ReadProjectResponse{
+		Project: project,
+	}, nil
+}
+
+// UpdateProject update an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing project
+// Returns either the result of updateing an existing project or error if something goes wrong.
+func (service *postgresRepositoryService) UpdateProject(
+	ctx context.Context,
+	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.updateProject(ctx, pool, request)
+}
+
+// BatchUpdateProjects updates existing projects in a single transaction, either all the projects are updated
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns either the results of updating the projects or error if something goes wrong.
+func (service *postgresRepositoryService) BatchUpdateProjects(
+	ctx context.Context,
+	request *repository.BatchUpdateProjectsRequest) (*repository.BatchUpdateProjectsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]repository.UpdateProjectResponse, 0, len(request.Requests))
+	if err = service.runBatch(ctx, pool, len(request.Requests), func(tx pgx.Tx, idx int) error {
+		response, err := service.updateProject(ctx, tx, &request.Requests[idx])
+		if err != nil {
+			return err
+		}
+
+		responses = append(responses, *response)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchUpdateProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// updateProject updates the project of the request, either on its own or as part of a transaction
+func (service *postgresRepositoryService) updateProject(
+	ctx context.Context,
+	db querier,
+	request *repository.UpdateProjectRequest) (*repository.UpdateProjectResponse, error) {
+	if err := models.ValidateUpdateMask(request.UpdateMask); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("updateMask", "", err)
+	}
+
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	assignments, args := buildUpdateAssignments(request.Project, request.UpdateMask)
+	args = append(args, id, request.UserEmail, request.ExpectedVersion, int16(models.ProjectStateArchived))
+	idParameter := fmt.Sprintf("$%d", len(args)-3)
+	userEmailParameter := fmt.Sprintf("$%d", len(args)-2)
+	versionParameter := fmt.Sprintf("$%d", len(args)-1)
+	archivedParameter := fmt.Sprintf("$%d", len(args))
+	outboxParameter := len(args) + 1
+	args = append(args, outboxArguments(request.Event)...)
+
+	_, project, err := scanProject(db.QueryRow(
+		ctx,
+		`WITH updated AS (
+			UPDATE projects SET `+assignments+`, version = version + 1
+			WHERE id = `+idParameter+` AND `+isMemberCondition(userEmailParameter)+` AND deleted_at IS NULL
+			AND state <> `+archivedParameter+`
+			AND (`+versionParameter+`::BIGINT = 0 OR version = `+versionParameter+`)
+			RETURNING `+projectColumns+`
+		), outbox AS (
+			`+outboxStatement("updated", outboxParameter)+`
+		)
+		SELECT `+projectColumns+` FROM updated`,
+		args...))
+	if err == pgx.ErrNoRows {
+		return nil, service.getUnmatchedUpdateError(ctx, db, id, request.UserEmail, request.ExpectedVersion)
+	} else if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project", err)
+	}
+
+	return &repository.UpdateProjectResponse{
+		Project: project,
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// DeleteProject delete an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing project
+// Returns either the result of deleting an existing project or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteProject(
+	ctx context.Context,
+	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.deleteProject(ctx, pool, request)
+}
+
+// BatchDeleteProjects deletes existing projects in a single transaction, either all the projects are deleted
+// or none of them is.
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns either the results of deleting the projects or error if something goes wrong.
+func (service *postgresRepositoryService) BatchDeleteProjects(
+	ctx context.Context,
+	request *repository.BatchDeleteProjectsRequest) (*repository.BatchDeleteProjectsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]repository.DeleteProjectResponse, 0, len(request.Requests))
+	if err = service.runBatch(ctx, pool, len(request.Requests), func(tx pgx.Tx, idx int) error {
+		response, err := service.deleteProject(ctx, tx, &request.Requests[idx])
+		if err != nil {
+			return err
+		}
+
+		responses = append(responses, *response)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &repository.BatchDeleteProjectsResponse{
+		Responses: responses,
+	}, nil
+}
+
+// deleteProject deletes the project of the request, either on its own or as part of a transaction
+func (service *postgresRepositoryService) deleteProject(
+	ctx context.Context,
+	db querier,
+	request *repository.DeleteProjectRequest) (*repository.DeleteProjectResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	commandTag, err := db.Exec(
+		ctx,
+		`WITH deleted AS (
+			UPDATE projects SET deleted_at = $4, version = version + 1
+			WHERE id = $1 AND `+isMemberCondition("$2")+` AND deleted_at IS NULL AND ($3::BIGINT = 0 OR version = $3)
+			RETURNING id
+		), outbox AS (
+			`+outboxStatement("deleted", 5)+`
+		)
+		SELECT id FROM deleted`,
+		append([]interface{}{
+			id,
+			request.UserEmail,
+			request.ExpectedVersion,
+			time.Now().UTC().Truncate(time.Millisecond),
+		}, outboxArguments(request.Event)...)...)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete project", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, service.getUnmatchedProjectError(ctx, db, id, request.UserEmail, false, request.ExpectedVersion)
+	}
+
+	return &repository.DeleteProjectResponse{}, nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet
+// context: Optional The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns either the result of restoring a deleted project or error if something goes wrong.
+func (service *postgresRepositoryService) RestoreProject(
+	ctx context.Context,
+	request *repository.RestoreProjectRequest) (*repository.RestoreProjectResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, project, err := scanProject(pool.QueryRow(
+		ctx,
+		`WITH restored AS (
+			UPDATE projects SET deleted_at = NULL, version = version + 1
+			WHERE id = $1 AND `+isMemberCondition("$2")+` AND deleted_at IS NOT NULL AND ($3::BIGINT = 0 OR version = $3)
+			RETURNING `+projectColumns+`
+		), outbox AS (
+			`+outboxStatement("restored", 4)+`
+		)
+		SELECT `+projectColumns+` FROM restored`,
+		append([]interface{}{
+			id,
+			request.UserEmail,
+			request.ExpectedVersion,
+		}, outboxArguments(request.Event)...)...))
+	if err == pgx.ErrNoRows {
+		return nil, service.getUnmatchedProjectError(ctx, pool, id, request.UserEmail, true, request.ExpectedVersion)
+	} else if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to restore project", err)
+	}
+
+	return &repository.RestoreProjectResponse{
+		Project: project,
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// UpdateProjectState changes the state of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the state of an existing project
+// Returns either the result of changing the state of an existing project or error if something goes wrong.
+func (service *postgresRepositoryService) UpdateProjectState(
+	ctx context.Context,
+	request *repository.UpdateProjectStateRequest) (*repository.UpdateProjectStateResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, project, err := scanProject(pool.QueryRow(
+		ctx,
+		`WITH updated AS (
+			UPDATE projects SET state = $4, version = version + 1
+			WHERE id = $1 AND `+isMemberCondition("$2")+` AND deleted_at IS NULL AND ($3::BIGINT = 0 OR version = $3)
+			RETURNING `+projectColumns+`
+		), outbox AS (
+			`+outboxStatement("updated", 5)+`
+		)
+		SELECT `+projectColumns+` FROM updated`,
+		append([]interface{}{
+			id,
+			request.UserEmail,
+			request.ExpectedVersion,
+			int16(request.State),
+		}, outboxArguments(request.Event)...)...))
+	if err == pgx.ErrNoRows {
+		return nil, service.getUnmatchedProjectError(ctx, pool, id, request.UserEmail, false, request.ExpectedVersion)
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update project state", err)
+	}
+
+	return &repository.UpdateProjectStateResponse{
+		Project: project,
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// PurgeDeletedProjects permanently removes the projects of all the users that were deleted before the
+// time provided in the request. The projects whose events are not published yet are kept until their events
+// are published.
+// context: Optional The reference to the context
+// request: Mandatory. The request contains the time the projects must have been deleted before
+// Returns either the result of purging the deleted projects or error if something goes wrong.
+func (service *postgresRepositoryService) PurgeDeletedProjects(
+	ctx context.Context,
+	request *repository.PurgeDeletedProjectsRequest) (*repository.PurgeDeletedProjectsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`DELETE FROM projects
+		WHERE deleted_at < $1
+		AND NOT EXISTS (SELECT 1 FROM project_outbox WHERE project_outbox.project_id = projects.id)`,
+		request.DeletedBefore)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to purge the deleted projects", err)
+	}
+
+	return &repository.PurgeDeletedProjectsResponse{
+		PurgedCount: commandTag.RowsAffected(),
+	}, nil
+}
+
+// ListProjects returns the list of projects that matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of projects that matched the criteria
+func (service *postgresRepositoryService) ListProjects(
+	ctx context.Context,
+	request *repository.ListProjectsRequest) (*repository.ListProjectsResponse, error) {
+	return service.listProjects(ctx, request, false)
+}
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the search criteria
+// Returns the list of deleted projects that matched the criteria
+func (service *postgresRepositoryService) ListDeletedProjects(
+	ctx context.Context,
+	request *repository.ListDeletedProjectsRequest) (*repository.ListDeletedProjectsResponse, error) {
+	response, err := service.listProjects(ctx, request.ToListProjectsRequest(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedResponse := repository.ListDeletedProjectsResponse(*response)
+
+	return &deletedResponse, nil
+}
+
+// listProjects returns either the deleted projects or the ones that are not deleted that matched the criteria
+func (service *postgresRepositoryService) listProjects(
+	ctx context.Context,
+	request *repository.ListProjectsRequest,
+	deleted bool) (*repository.ListProjectsResponse, error) {
+	response := &repository.ListProjectsResponse{
+		HasPreviousPage: false,
+		HasNextPage:     false,
+	}
+
+	if err := models.ValidateSortingOptions(request.SortingOptions); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("sortingOptions", "", err)
+	}
+
+	var after, before []repository.SortKey
+	if request.Pagination.After != nil {
+		var err error
+		if after, err = repository.ParseCursor(*request.Pagination.After, request.SortingOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Pagination.Before != nil {
+		var err error
+		if before, err = repository.ParseCursor(*request.Pagination.Before, request.SortingOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, found, err := buildListFilter(request, deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		// A malformed organization or folder identifier cannot match any project
+		return response, nil
+	}
+
+	err = pool.QueryRow(ctx, "SELECT COUNT(*) FROM projects WHERE "+filter.String(), filter.arguments...).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the number of projects that match the filter criteria", err)
+	}
+
+	if response.TotalCount == 0 {
+		// No project matched the filter criteria
+		return response, nil
+	}
+
+	// After and Before are compared against all the sort keys of the projects, so the pages are stable in any
+	// sort order. The pages on the other side of the cursors exist if any project was left out by the cursors.
+	if after != nil {
+		condition, arguments, err := buildCursorCondition(after, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.HasPreviousPage, err = service.exists(ctx, pool, filter.with("NOT "+condition, arguments...)); err != nil {
+			return nil, err
+		}
+
+		filter.add(condition, arguments...)
+	}
+
+	if before != nil {
+		condition, arguments, err := buildCursorCondition(before, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.HasNextPage, err = service.exists(ctx, pool, filter.with("NOT "+condition, arguments...)); err != nil {
+			return nil, err
+		}
+
+		filter.add(condition, arguments...)
+	}
+
+	// Last without First returns the last projects of the result, so the sort order is inverted to let
+	// the database apply the limit, and the fetched projects are reversed back afterward.
+	// One more project than requested is fetched to find out whether there are more projects to return.
+	reverse := request.Pagination.First == nil && request.Pagination.Last != nil
+	query := "SELECT " + projectColumns + " FROM projects WHERE " + filter.String() + " ORDER BY " + buildOrderBy(request.SortingOptions, reverse)
+
+	limit := -1
+	if request.Pagination.First != nil {
+		limit = *request.Pagination.First
+	} else if request.Pagination.Last != nil {
+		limit = *request.Pagination.Last
+	}
+
+	if limit >= 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit+1)
+	}
+
+	rows, err := pool.Query(ctx, query, filter.arguments...)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to query the projects", err)
+	}
+
+	defer rows.Close()
+
+	projects := []models.ProjectWithCursor{}
+	for rows.Next() {
+		id, project, err := scanProject(rows)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the project", err)
+		}
+
+		projectID := formatProjectID(id)
+		projects = append(projects, models.ProjectWithCursor{
+			ProjectID: projectID,
+			Project:   project,
+			Cursor:    repository.NewCursor(request.SortingOptions, projectID, project),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the projects", err)
+	}
+
+	if limit >= 0 && len(projects) > limit {
+		projects = projects[:limit]
+
+		if reverse {
+			response.HasPreviousPage = true
+		} else {
+			response.HasNextPage = true
+		}
+	}
+
+	if reverse {
+		for i, j := 0, len(projects)-1; i < j; i, j = i+1, j-1 {
+			projects[i], projects[j] = projects[j], projects[i]
+		}
+	}
+
+	if request.Pagination.First != nil && request.Pagination.Last != nil && len(projects) > *request.Pagination.Last {
+		projects = projects[len(projects)-*request.Pagination.Last:]
+		response.HasPreviousPage = true
+	}
+
+	response.Projects = projects
+
+	return response, nil
+}
+
+// StreamProjects returns an iterator over all the projects that matched the criteria. The rows of the query are
+// read from the connection while the iterator advances.
+// ctx: Mandatory The reference to the context, cancelling it stops the query
+// request: Mandatory. The request contains the search criteria
+// Returns the iterator over the projects that matched the criteria
+func (service *postgresRepositoryService) StreamProjects(
+	ctx context.Context,
+	request *repository.StreamProjectsRequest) (*repository.StreamProjectsResponse, error) {
+	if err := models.ValidateSortingOptions(request.SortingOptions); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("sortingOptions", "", err)
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, found, err := buildListFilter(request.ToListProjectsRequest(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		// A malformed organization or folder identifier cannot match any project
+		return &repository.StreamProjectsResponse{Projects: &projectIterator{}}, nil
+	}
+
+	query := "SELECT " + projectColumns + " FROM projects WHERE " + filter.String() + " ORDER BY " + buildOrderBy(request.SortingOptions, false)
+
+	rows, err := pool.Query(ctx, query, filter.arguments...)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to query the projects", err)
+	}
+
+	return &repository.StreamProjectsResponse{
+		Projects: &projectIterator{
+			rows:           rows,
+			sortingOptions: request.SortingOptions,
+		},
+	}, nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *postgresRepositoryService) SearchProjects(
+	ctx context.Context,
+	request *repository.SearchProjectsRequest) (*repository.SearchProjectsResponse, error) {
+	// The query is reduced to its words, so the operators of the PostgreSQL text search syntax are never
+	// interpreted and any of the words matches
+	terms := search.Terms(request.Query)
+	if len(terms) == 0 {
+		return search.Paginate([]models.ProjectSearchResult{}, request.Pagination)
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := newQueryFilter(isMemberCondition("?"), request.UserEmail, request.UserEmail)
+	filter.add("deleted_at IS NULL")
+	filter.add(searchDocument+" @@ to_tsquery('simple', ?)", strings.Join(terms, " | "))
+
+	if !request.IncludeArchived {
+		filter.add("state <> ?", int16(models.ProjectStateArchived))
+	}
+
+	rows, err := pool.Query(ctx, "SELECT "+projectColumns+" FROM projects WHERE "+filter.String(), filter.arguments...)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to search the projects", err)
+	}
+
+	defer rows.Close()
+
+	// The index finds the matched projects, which are scored, ranked and paginated in process like the
+	// projects of the in-process repository services, as the cursors contain the scores
+	results := []models.ProjectSearchResult{}
+	for rows.Next() {
+		id, project, err := scanProject(rows)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the project", err)
+		}
+
+		score := search.Score(terms, project)
+		if score == 0 {
+			// The PostgreSQL parser keeps some words together, like the host names, that are split here
+			continue
+		}
+
+		results = append(results, models.ProjectSearchResult{
+			ProjectID:  formatProjectID(id),
+			Project:    project,
+			Score:      score,
+			Highlights: search.Highlights(terms, project),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the projects", err)
+	}
+
+	return search.Paginate(results, request.Pagination)
+}
+
+// ReadMember reads a member of an existing or a deleted project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of a project
+// Returns either the result of reading a member of a project or error if something goes wrong.
+func (service *postgresRepositoryService) ReadMember(
+	ctx context.Context,
+	request *repository.ReadMemberRequest) (*repository.ReadMemberResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var role int16
+	err = pool.QueryRow(
+		ctx,
+		"SELECT role FROM project_members WHERE project_id = $1 AND user_email = $2",
+		id,
+		request.UserEmail).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve member", err)
+	}
+
+	return &repository.ReadMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      models.MemberRole(role),
+		},
+	}, nil
+}
+
+// ReadEffectiveRole reads the role of a user in an existing or a deleted project, taking the organization
+// of the project into account
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the role of a user in a project
+// Returns either the role of the user in the project or error if something goes wrong.
+func (service *postgresRepositoryService) ReadEffectiveRole(
+	ctx context.Context,
+	request *repository.ReadEffectiveRoleRequest) (*repository.ReadEffectiveRoleResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// GREATEST ignores the missing roles, and returns NULL only if the user has no role at all
+	var role *int16
+	err = pool.QueryRow(
+		ctx,
+		`SELECT GREATEST(
+			(SELECT role FROM project_members WHERE project_id = projects.id AND user_email = $2),
+			(SELECT role FROM organization_members WHERE organization_id = projects.organization_id AND user_email = $2))
+		FROM projects WHERE id = $1`,
+		id,
+		request.UserEmail).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the role", err)
+	}
+
+	if role == nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.ReadEffectiveRoleResponse{
+		Role: models.MemberRole(*role),
+	}, nil
+}
+
+// AddMember adds a new member to an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns either the result of adding a new member or error if something goes wrong.
+func (service *postgresRepositoryService) AddMember(
+	ctx context.Context,
+	request *repository.AddMemberRequest) (*repository.AddMemberResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`INSERT INTO project_members (project_id, user_email, role)
+		SELECT id, $2, $3 FROM projects WHERE id = $1 AND deleted_at IS NULL`,
+		id,
+		request.Member.UserEmail,
+		int16(request.Member.Role))
+	if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add member", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.AddMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns either the result of changing the role of an existing member or error if something goes wrong.
+func (service *postgresRepositoryService) UpdateMemberRole(
+	ctx context.Context,
+	request *repository.UpdateMemberRoleRequest) (*repository.UpdateMemberRoleResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`UPDATE project_members SET role = $3
+		WHERE project_id = $1 AND user_email = $2 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+		id,
+		request.UserEmail,
+		int16(request.Role))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update member role", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.UpdateMemberRoleResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      request.Role,
+		},
+	}, nil
+}
+
+// RemoveMember removes an existing member from a project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns either the result of removing an existing member or error if something goes wrong.
+func (service *postgresRepositoryService) RemoveMember(
+	ctx context.Context,
+	request *repository.RemoveMemberRequest) (*repository.RemoveMemberResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`DELETE FROM project_members
+		WHERE project_id = $1 AND user_email = $2 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+		id,
+		request.UserEmail)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove member", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveMemberResponse{}, nil
+}
+
+// ListMembers returns the members of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns either the members of the project or error if something goes wrong.
+func (service *postgresRepositoryService) ListMembers(
+	ctx context.Context,
+	request *repository.ListMembersRequest) (*repository.ListMembersResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := service.exists(ctx, pool, newQueryFilter("id = ? AND deleted_at IS NULL", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	rows, err := pool.Query(ctx, "SELECT user_email, role FROM project_members WHERE project_id = $1 ORDER BY user_email", id)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list members", err)
+	}
+
+	defer rows.Close()
+
+	members := []models.Member{}
+	for rows.Next() {
+		var userEmail string
+		var role int16
+		if err = rows.Scan(&userEmail, &role); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list members", err)
+		}
+
+		members = append(members, models.Member{
+			UserEmail: userEmail,
+			Role:      models.MemberRole(role),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list members", err)
+	}
+
+	return &repository.ListMembersResponse{
+		Members: members,
+	}, nil
+}
+
+// RequestOwnershipTransfer records a pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to record a pending ownership transfer
+// Returns either the result of recording the pending ownership transfer or error if something goes wrong.
+func (service *postgresRepositoryService) RequestOwnershipTransfer(
+	ctx context.Context,
+	request *repository.RequestOwnershipTransferRequest) (*repository.RequestOwnershipTransferResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		commandTag, err := tx.Exec(
+			ctx,
+			`INSERT INTO project_ownership_transfers (project_id, from_user_email, to_user_email, requested_at, expires_at)
+			SELECT id, $2, $3, $4, $5 FROM projects WHERE id = $1 AND deleted_at IS NULL
+			ON CONFLICT (project_id) DO UPDATE SET
+				from_user_email = EXCLUDED.from_user_email,
+				to_user_email = EXCLUDED.to_user_email,
+				requested_at = EXCLUDED.requested_at,
+				expires_at = EXCLUDED.expires_at`,
+			id,
+			request.Transfer.FromUserEmail,
+			request.Transfer.ToUserEmail,
+			request.Transfer.RequestedAt,
+			request.Transfer.ExpiresAt)
+		if err != nil || commandTag.RowsAffected() == 0 {
+			return err
+		}
+
+		found = true
+
+		return insertAuditEvent(ctx, tx, id, request.AuditEvent)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to request ownership transfer", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RequestOwnershipTransferResponse{
+		Transfer: request.Transfer,
+	}, nil
+}
+
+// ReadOwnershipTransfer reads the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to read the pending ownership transfer
+// Returns either the pending ownership transfer or error if something goes wrong.
+func (service *postgresRepositoryService) ReadOwnershipTransfer(
+	ctx context.Context,
+	request *repository.ReadOwnershipTransferRequest) (*repository.ReadOwnershipTransferResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer models.OwnershipTransfer
+	err = pool.QueryRow(
+		ctx,
+		`SELECT from_user_email, to_user_email, requested_at, expires_at FROM project_ownership_transfers
+		WHERE project_id = $1 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+		id).Scan(
+		&transfer.FromUserEmail,
+		&transfer.ToUserEmail,
+		&transfer.RequestedAt,
+		&transfer.ExpiresAt)
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve ownership transfer", err)
+	}
+
+	transfer.RequestedAt = transfer.RequestedAt.UTC()
+	transfer.ExpiresAt = transfer.ExpiresAt.UTC()
+
+	return &repository.ReadOwnershipTransferResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// DeleteOwnershipTransfer removes the pending ownership transfer of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove the pending ownership transfer
+// Returns either the result of removing the pending ownership transfer or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteOwnershipTransfer(
+	ctx context.Context,
+	request *repository.DeleteOwnershipTransferRequest) (*repository.DeleteOwnershipTransferResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found bool
+
+	if err = pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		commandTag, err := tx.Exec(
+			ctx,
+			`DELETE FROM project_ownership_transfers
+			WHERE project_id = $1 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+			id)
+		if err != nil || commandTag.RowsAffected() == 0 {
+			return err
+		}
+
+		found = true
+
+		return insertAuditEvent(ctx, tx, id, request.AuditEvent)
+	}); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete ownership transfer", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteOwnershipTransferResponse{}, nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user
+// context: Optional The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns either the result of transferring the ownership or error if something goes wrong.
+func (service *postgresRepositoryService) TransferProjectOwnership(
+	ctx context.Context,
+	request *repository.TransferProjectOwnershipRequest) (*repository.TransferProjectOwnershipResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var project models.Project
+	var found bool
+
+	err = pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		// The name of the project stays unique among the projects of the new owner, the unique index rejects the transfer otherwise
+		_, project, err = scanProject(tx.QueryRow(
+			ctx,
+			`WITH transferred AS (
+				UPDATE projects SET user_email = $3, version = version + 1
+				WHERE id = $1 AND user_email = $2 AND deleted_at IS NULL
+				RETURNING `+projectColumns+`
+			), outbox AS (
+				`+outboxStatement("transferred", 4)+`
+			)
+			SELECT `+projectColumns+` FROM transferred`,
+			append([]interface{}{
+				id,
+				request.FromUserEmail,
+				request.ToUserEmail,
+			}, outboxArguments(request.Event)...)...))
+		if err == pgx.ErrNoRows {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		found = true
+		if _, err = tx.Exec(
+			ctx,
+			`INSERT INTO project_members (project_id, user_email, role) VALUES ($1, $2, $4), ($1, $3, $5)
+			ON CONFLICT (project_id, user_email) DO UPDATE SET role = EXCLUDED.role`,
+			id,
+			request.FromUserEmail,
+			request.ToUserEmail,
+			int16(models.MemberRoleEditor),
+			int16(models.MemberRoleOwner)); err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(ctx, "DELETE FROM project_ownership_transfers WHERE project_id = $1", id); err != nil {
+			return err
+		}
+
+		return insertAuditEvent(ctx, tx, id, request.AuditEvent)
+	})
+	if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to transfer project ownership", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.TransferProjectOwnershipResponse{
+		Project: project,
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// ListAuditEvents returns the audit trail of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns either the audit events of the project or error if something goes wrong.
+func (service *postgresRepositoryService) ListAuditEvents(
+	ctx context.Context,
+	request *repository.ListAuditEventsRequest) (*repository.ListAuditEventsResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := service.exists(ctx, pool, newQueryFilter("id = ? AND deleted_at IS NULL", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		`SELECT action, user_email, target_user_email, occurred_at FROM project_audit_events
+		WHERE project_id = $1 ORDER BY occurred_at, id`,
+		id)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list audit events", err)
+	}
+
+	defer rows.Close()
+
+	auditEvents := []models.AuditEvent{}
+	for rows.Next() {
+		var auditEvent models.AuditEvent
+		var action int16
+		if err = rows.Scan(&action, &auditEvent.UserEmail, &auditEvent.TargetUserEmail, &auditEvent.OccurredAt); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list audit events", err)
+		}
+
+		auditEvent.Action = models.AuditAction(action)
+		auditEvent.OccurredAt = auditEvent.OccurredAt.UTC()
+		auditEvents = append(auditEvents, auditEvent)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list audit events", err)
+	}
+
+	return &repository.ListAuditEventsResponse{
+		AuditEvents: auditEvents,
+	}, nil
+}
+
+// CreateInvitation stores a new invitation to join an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to store a new invitation
+// Returns either the result of storing the new invitation or error if something goes wrong.
+func (service *postgresRepositoryService) CreateInvitation(
+	ctx context.Context,
+	request *repository.CreateInvitationRequest) (*repository.CreateInvitationResponse, error) {
+	id, err := parseProjectID(request.Invitation.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`INSERT INTO project_invitations (project_id, email, role, invited_by, token_hash, created_at, expires_at)
+		SELECT id, $2, $3, $4, $5, $6, $7 FROM projects WHERE id = $1 AND deleted_at IS NULL
+		ON CONFLICT (project_id, email) DO UPDATE SET
+			role = EXCLUDED.role,
+			invited_by = EXCLUDED.invited_by,
+			token_hash = EXCLUDED.token_hash,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at`,
+		id,
+		request.Invitation.Email,
+		int16(request.Invitation.Role),
+		request.Invitation.InvitedBy,
+		request.TokenHash,
+		request.Invitation.CreatedAt,
+		request.Invitation.ExpiresAt)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create invitation", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.CreateInvitationResponse{
+		Invitation: request.Invitation,
+	}, nil
+}
+
+// ReadInvitation reads an invitation by the hash of its token
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an invitation
+// Returns either the invitation or error if something goes wrong.
+func (service *postgresRepositoryService) ReadInvitation(
+	ctx context.Context,
+	request *repository.ReadInvitationRequest) (*repository.ReadInvitationResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := scanInvitation(pool.QueryRow(
+		ctx,
+		`SELECT project_id, email, role, invited_by, created_at, expires_at FROM project_invitations
+		WHERE token_hash = $1 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+		request.TokenHash))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve invitation", err)
+	}
+
+	return &repository.ReadInvitationResponse{
+		Invitation: invitation,
+	}, nil
+}
+
+// ListInvitations returns the pending invitations of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns either the pending invitations of the project or error if something goes wrong.
+func (service *postgresRepositoryService) ListInvitations(
+	ctx context.Context,
+	request *repository.ListInvitationsRequest) (*repository.ListInvitationsResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := service.exists(ctx, pool, newQueryFilter("id = ? AND deleted_at IS NULL", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		`SELECT project_id, email, role, invited_by, created_at, expires_at FROM project_invitations
+		WHERE project_id = $1 ORDER BY email`,
+		id)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list invitations", err)
+	}
+
+	defer rows.Close()
+
+	invitations := []models.Invitation{}
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list invitations", err)
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list invitations", err)
+	}
+
+	return &repository.ListInvitationsResponse{
+		Invitations: invitations,
+	}, nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+// context: Optional The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns either the result of accepting the invitation or error if something goes wrong.
+func (service *postgresRepositoryService) AcceptInvitation(
+	ctx context.Context,
+	request *repository.AcceptInvitationRequest) (*repository.AcceptInvitationResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var role int16
+	var found bool
+
+	// The invitation is only removed if the invited user can be added to the members, otherwise the unique
+	// violation rolls back the transaction
+	err = pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(
+			ctx,
+			`DELETE FROM project_invitations
+			WHERE project_id = $1 AND email = $2 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)
+			RETURNING role`,
+			id,
+			request.Email).Scan(&role)
+		if err == pgx.ErrNoRows {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		found = true
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO project_members (project_id, user_email, role) VALUES ($1, $2, $3)",
+			id,
+			request.Email,
+			role)
+
+		return err
+	})
+	if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to accept invitation", err)
+	}
+
+	if !found {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.AcceptInvitationResponse{
+		Member: models.Member{
+			UserEmail: request.Email,
+			Role:      models.MemberRole(role),
+		},
+	}, nil
+}
+
+// DeleteInvitation removes a pending invitation of an existing project
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove a pending invitation
+// Returns either the result of removing the invitation or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteInvitation(
+	ctx context.Context,
+	request *repository.DeleteInvitationRequest) (*repository.DeleteInvitationResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`DELETE FROM project_invitations
+		WHERE project_id = $1 AND email = $2 AND project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)`,
+		id,
+		request.Email)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete invitation", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteInvitationResponse{}, nil
+}
+
+// CreateOrganization creates a new organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns either the result of creating the new organization or error if something goes wrong.
+func (service *postgresRepositoryService) CreateOrganization(
+	ctx context.Context,
+	request *repository.CreateOrganizationRequest) (*repository.CreateOrganizationResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	id, organization, err := scanOrganization(pool.QueryRow(
+		ctx,
+		`WITH created AS (
+			INSERT INTO organizations (name, description, created_at, updated_at, created_by)
+			VALUES ($1, $2, $3, $3, $4)
+			RETURNING `+organizationColumns+`
+		), owner AS (
+			INSERT INTO organization_members (organization_id, user_email, role) SELECT id, $4, $5 FROM created
+		)
+		SELECT `+organizationColumns+` FROM created`,
+		request.Organization.Name,
+		request.Organization.Description,
+		createdAt,
+		request.UserEmail,
+		int16(models.MemberRoleOwner)))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create organization", err)
+	}
+
+	return &repository.CreateOrganizationResponse{
+		OrganizationID: formatOrganizationID(id),
+		Organization:   organization,
+	}, nil
+}
+
+// ReadOrganization reads an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns either the result of reading the organization or error if something goes wrong.
+func (service *postgresRepositoryService) ReadOrganization(
+	ctx context.Context,
+	request *repository.ReadOrganizationRequest) (*repository.ReadOrganizationResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, organization, err := scanOrganization(pool.QueryRow(
+		ctx,
+		"SELECT "+organizationColumns+" FROM organizations WHERE id = $1 AND "+isOrganizationMemberCondition("$2"),
+		id,
+		request.UserEmail))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization", err)
+	}
+
+	return &repository.ReadOrganizationResponse{
+		Organization: organization,
+	}, nil
+}
+
+// UpdateOrganization updates an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns either the result of updating the organization or error if something goes wrong.
+func (service *postgresRepositoryService) UpdateOrganization(
+	ctx context.Context,
+	request *repository.UpdateOrganizationRequest) (*repository.UpdateOrganizationResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, organization, err := scanOrganization(pool.QueryRow(
+		ctx,
+		`UPDATE organizations SET name = $1, description = $2, updated_at = $3
+		WHERE id = $4 AND `+isOrganizationMemberCondition("$5")+`
+		RETURNING `+organizationColumns,
+		request.Organization.Name,
+		request.Organization.Description,
+		time.Now().UTC().Truncate(time.Millisecond),
+		id,
+		request.UserEmail))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update organization", err)
+	}
+
+	return &repository.UpdateOrganizationResponse{
+		Organization: organization,
+	}, nil
+}
+
+// DeleteOrganization deletes an existing organization that has no project
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns either the result of deleting the organization or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteOrganization(
+	ctx context.Context,
+	request *repository.DeleteOrganizationRequest) (*repository.DeleteOrganizationResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		"DELETE FROM organizations WHERE id = $1 AND "+isOrganizationMemberCondition("$2"),
+		id,
+		request.UserEmail)
+	if isForeignKeyViolation(err) {
+		// The projects that belong to the organization, including the deleted ones, still reference it
+		return nil, models.NewOrganizationNotEmptyError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete organization", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.DeleteOrganizationResponse{}, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the organizations of a user
+// Returns either the organizations of the user or error if something goes wrong.
+func (service *postgresRepositoryService) ListOrganizations(
+	ctx context.Context,
+	request *repository.ListOrganizationsRequest) (*repository.ListOrganizationsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		"SELECT "+organizationColumns+" FROM organizations WHERE "+isOrganizationMemberCondition("$1")+" ORDER BY name, id",
+		request.UserEmail)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+	}
+
+	defer rows.Close()
+
+	organizations := []models.OrganizationWithID{}
+	for rows.Next() {
+		id, organization, err := scanOrganization(rows)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+		}
+
+		organizations = append(organizations, models.OrganizationWithID{
+			OrganizationID: formatOrganizationID(id),
+			Organization:   organization,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organizations", err)
+	}
+
+	return &repository.ListOrganizationsResponse{
+		Organizations: organizations,
+	}, nil
+}
+
+// ReadOrganizationMember reads a member of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to read a member of an existing organization
+// Returns either the result of reading the member or error if something goes wrong.
+func (service *postgresRepositoryService) ReadOrganizationMember(
+	ctx context.Context,
+	request *repository.ReadOrganizationMemberRequest) (*repository.ReadOrganizationMemberResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var role int16
+	err = pool.QueryRow(
+		ctx,
+		"SELECT role FROM organization_members WHERE organization_id = $1 AND user_email = $2",
+		id,
+		request.UserEmail).Scan(&role)
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve organization member", err)
+	}
+
+	return &repository.ReadOrganizationMemberResponse{
+		Member: models.Member{
+			UserEmail: request.UserEmail,
+			Role:      models.MemberRole(role),
+		},
+	}, nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns either the result of adding the new member or error if something goes wrong.
+func (service *postgresRepositoryService) AddOrganizationMember(
+	ctx context.Context,
+	request *repository.AddOrganizationMemberRequest) (*repository.AddOrganizationMemberResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		`INSERT INTO organization_members (organization_id, user_email, role)
+		SELECT id, $2, $3 FROM organizations WHERE id = $1`,
+		id,
+		request.Member.UserEmail,
+		int16(request.Member.Role))
+	if isUniqueViolation(err) {
+		return nil, commonErrors.NewAlreadyExistsError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to add organization member", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.AddOrganizationMemberResponse{
+		Member: request.Member,
+	}, nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns either the result of removing the member or error if something goes wrong.
+func (service *postgresRepositoryService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *repository.RemoveOrganizationMemberRequest) (*repository.RemoveOrganizationMemberResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := pool.Exec(
+		ctx,
+		"DELETE FROM organization_members WHERE organization_id = $1 AND user_email = $2",
+		id,
+		request.UserEmail)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to remove organization member", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.RemoveOrganizationMemberResponse{}, nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns either the members of the organization or error if something goes wrong.
+func (service *postgresRepositoryService) ListOrganizationMembers(
+	ctx context.Context,
+	request *repository.ListOrganizationMembersRequest) (*repository.ListOrganizationMembersResponse, error) {
+	id, err := parseOrganizationID(request.OrganizationID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	if err = pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM organizations WHERE id = $1)", id).Scan(&exists); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the organization exists", err)
+	}
+
+	if !exists {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		"SELECT user_email, role FROM organization_members WHERE organization_id = $1 ORDER BY user_email",
+		id)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organization members", err)
+	}
+
+	defer rows.Close()
+
+	members := []models.Member{}
+	for rows.Next() {
+		var userEmail string
+		var role int16
+		if err = rows.Scan(&userEmail, &role); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list organization members", err)
+		}
+
+		members = append(members, models.Member{
+			UserEmail: userEmail,
+			Role:      models.MemberRole(role),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list organization members", err)
+	}
+
+	return &repository.ListOrganizationMembersResponse{
+		Members: members,
+	}, nil
+}
+
+// MoveProject moves an existing project into a folder of the user or out of its folder
+// context: Optional The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns either the result of moving the project or error if something goes wrong.
+func (service *postgresRepositoryService) MoveProject(
+	ctx context.Context,
+	request *repository.MoveProjectRequest) (*repository.MoveProjectResponse, error) {
+	id, err := parseProjectID(request.ProjectID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	var folderID *int64
+	if request.FolderID != "" {
+		parsedFolderID, err := parseFolderID(request.FolderID)
+		if err != nil {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		folderID = &parsedFolderID
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, project, err := scanProject(pool.QueryRow(
+		ctx,
+		`WITH moved AS (
+			UPDATE projects SET folder_id = $3, version = version + 1
+			WHERE id = $1 AND `+isMemberCondition("$2")+` AND deleted_at IS NULL
+			AND ($3::BIGINT IS NULL OR $3 IN (SELECT id FROM folders WHERE user_email = $2))
+			RETURNING `+projectColumns+`
+		), outbox AS (
+			`+outboxStatement("moved", 4)+`
+		)
+		SELECT `+projectColumns+` FROM moved`,
+		append([]interface{}{
+			id,
+			request.UserEmail,
+			folderID,
+		}, outboxArguments(request.Event)...)...))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to move project", err)
+	}
+
+	return &repository.MoveProjectResponse{
+		Project: project,
+		Cursor:  repository.NewProjectCursor(request.ProjectID),
+	}, nil
+}
+
+// CreateFolder creates a new folder for the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns either the result of creating the new folder or error if something goes wrong.
+func (service *postgresRepositoryService) CreateFolder(
+	ctx context.Context,
+	request *repository.CreateFolderRequest) (*repository.CreateFolderResponse, error) {
+	var parentFolderID *int64
+	if request.Folder.ParentFolderID != "" {
+		parsedFolderID, err := parseFolderID(request.Folder.ParentFolderID)
+		if err != nil {
+			return nil, commonErrors.NewNotFoundError()
+		}
+
+		parentFolderID = &parsedFolderID
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The folder is only inserted if its parent folder belongs to the user
+	id, folder, err := scanFolder(pool.QueryRow(
+		ctx,
+		`INSERT INTO folders (user_email, name, parent_folder_id, created_at)
+		SELECT $1::TEXT, $2::TEXT, $3::BIGINT, $4::TIMESTAMPTZ
+		WHERE $3::BIGINT IS NULL OR $3 IN (SELECT id FROM folders WHERE user_email = $1)
+		RETURNING `+folderColumns,
+		request.UserEmail,
+		request.Folder.Name,
+		parentFolderID,
+		time.Now().UTC().Truncate(time.Millisecond)))
+	if err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create folder", err)
+	}
+
+	return &repository.CreateFolderResponse{
+		FolderID: formatFolderID(id),
+		Folder:   folder,
+	}, nil
+}
+
+// ListFolders returns the folders of the user
+// context: Optional The reference to the context
+// request: Mandatory. The request to list the folders of a user
+// Returns either the folders of the user or error if something goes wrong.
+func (service *postgresRepositoryService) ListFolders(
+	ctx context.Context,
+	request *repository.ListFoldersRequest) (*repository.ListFoldersResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		"SELECT "+folderColumns+" FROM folders WHERE user_email = $1 ORDER BY name, id",
+		request.UserEmail)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list folders", err)
+	}
+
+	defer rows.Close()
+
+	folders := []models.FolderWithID{}
+	for rows.Next() {
+		id, folder, err := scanFolder(rows)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list folders", err)
+		}
+
+		folders = append(folders, models.FolderWithID{
+			FolderID: formatFolderID(id),
+			Folder:   folder,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list folders", err)
+	}
+
+	return &repository.ListFoldersResponse{
+		Folders: folders,
+	}, nil
+}
+
+// DeleteFolder deletes an existing folder of the user together with its subfolders
+// context: Optional The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns either the result of deleting the folder or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteFolder(
+	ctx context.Context,
+	request *repository.DeleteFolderRequest) (*repository.DeleteFolderResponse, error) {
+	id, err := parseFolderID(request.FolderID)
+	if err != nil {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to start the transaction", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// Locking the folder blocks the projects from being moved into it until it is deleted
+	var lockedID int64
+	if err = tx.QueryRow(ctx, "SELECT id FROM folders WHERE id = $1 AND user_email = $2 FOR UPDATE", id, request.UserEmail).Scan(&lockedID); err == pgx.ErrNoRows {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve folder", err)
+	}
+
+	// The foreign key moves the projects out of the deleted folders, only the projects the user has access
+	// to and are not deleted yet prevent the folder from being deleted
+	projectsCondition := "folder_id IN (" + folderTreeQuery("$1", "$2", true) + ") AND " + isMemberCondition("$2") + " AND deleted_at IS NULL"
+	if request.Cascade {
+		if _, err = tx.Exec(
+			ctx,
+			`WITH deleted AS (
+				UPDATE projects SET deleted_at = $3, version = version + 1 WHERE `+projectsCondition+`
+				RETURNING id
+			), outbox AS (
+				`+cascadeOutboxStatement("deleted", 4)+`
+			)
+			SELECT id FROM deleted`,
+			append([]interface{}{
+				id,
+				request.UserEmail,
+				time.Now().UTC().Truncate(time.Millisecond),
+			}, outboxArguments(request.Event)...)...); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to delete the projects of the folder", err)
+		}
+	} else {
+		var notEmpty bool
+		if err = tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE "+projectsCondition+")", id, request.UserEmail).Scan(&notEmpty); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to check whether the folder is empty", err)
+		}
+
+		if notEmpty {
+			return nil, models.NewFolderNotEmptyError()
+		}
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM folders WHERE id = $1", id); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete folder", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete folder", err)
+	}
+
+	return &repository.DeleteFolderResponse{}, nil
+}
+
+// ListOutboxEvents returns the events written to the outbox that are not published yet, the oldest ones first
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the maximum number of events to return
+// Returns either the events that are not published yet or error if something goes wrong.
+func (service *postgresRepositoryService) ListOutboxEvents(
+	ctx context.Context,
+	request *repository.ListOutboxEventsRequest) (*repository.ListOutboxEventsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pool.Query(
+		ctx,
+		"SELECT event_id, type, project_id, user_email, occurred_at FROM project_outbox ORDER BY id LIMIT $1",
+		request.Limit)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+	}
+
+	defer rows.Close()
+
+	events := []models.DomainEvent{}
+	for rows.Next() {
+		var event models.DomainEvent
+		var eventType string
+		var projectID int64
+
+		if err = rows.Scan(&event.EventID, &eventType, &projectID, &event.UserEmail, &event.OccurredAt); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+		}
+
+		event.Type = models.DomainEventType(eventType)
+		event.ProjectID = formatProjectID(projectID)
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the outbox events", err)
+	}
+
+	return &repository.ListOutboxEventsResponse{
+		Events: events,
+	}, nil
+}
+
+// DeleteOutboxEvents removes the published events from the outbox
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the identifiers of the published events
+// Returns either the result of removing the published events or error if something goes wrong.
+func (service *postgresRepositoryService) DeleteOutboxEvents(
+	ctx context.Context,
+	request *repository.DeleteOutboxEventsRequest) (*repository.DeleteOutboxEventsResponse, error) {
+	pool, err := service.getPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = pool.Exec(ctx, "DELETE FROM project_outbox WHERE event_id = ANY($1)", request.EventIDs); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to delete the outbox events", err)
+	}
+
+	return &repository.DeleteOutboxEventsResponse{}, nil
+}
+
+// Close closes the connection pool and releases all the database connections
+// Returns error if something goes wrong
+func (service *postgresRepositoryService) Close() error {
+	service.poolLock.Lock()
+	defer service.poolLock.Unlock()
+
+	if service.pool == nil {
+		return nil
+	}
+
+	service.pool.Close()
+	service.pool = nil
+
+	return nil
+}
+
+// getPool returns the connection pool shared by all the requests, connecting to the database and
+// migrating the database schema on the first call. The pool lives until Close is called.
+func (service *postgresRepositoryService) getPool(ctx context.Context) (*pgxpool.Pool, error) {
+	service.poolLock.RLock()
+	pool := service.pool
+	service.poolLock.RUnlock()
+
+	if pool != nil {
+		return pool, nil
+	}
+
+	service.poolLock.Lock()
+	defer service.poolLock.Unlock()
+
+	if service.pool != nil {
+		return service.pool, nil
+	}
+
+	config, err := pgxpool.ParseConfig(service.connectionString)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to parse the postgres connection string", err)
+	}
+
+	config.MaxConns = int32(service.maxPoolSize)
+	config.ConnConfig.ConnectTimeout = service.connectTimeout
+
+	pool, err = pgxpool.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("could not connect to postgres database", err)
+	}
+
+	if err = migrate(ctx, pool); err != nil {
+		pool.Close()
+
+		return nil, err
+	}
+
+	service.pool = pool
+
+	return pool, nil
+}
+
+func (service *postgresRepositoryService) exists(ctx context.Context, db querier, filter queryFilter) (bool, error) {
+	var exists bool
+	if err := db.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE "+filter.String()+")", filter.arguments...).Scan(&exists); err != nil {
+		return false, commonErrors.NewUnknownErrorWithError("failed to check whether the projects exist", err)
+	}
+
+	return exists, nil
+}
+
+// getUnmatchedProjectError returns the error to report when an update or a deletion did not match any project.
+// The project either does not exist, or it exists but its version does not match the expected version.
+func (service *postgresRepositoryService) getUnmatchedProjectError(
+	ctx context.Context,
+	db querier,
+	id int64,
+	userEmail string,
+	deleted bool,
+	expectedVersion int64) error {
+	if expectedVersion == 0 {
+		return commonErrors.NewNotFoundError()
+	}
+
+	filter := newQueryFilter("id = ? AND "+isMemberCondition("?"), id, userEmail, userEmail)
+	if deleted {
+		filter.add("deleted_at IS NOT NULL")
+	} else {
+		filter.add("deleted_at IS NULL")
+	}
+
+	exists, err := service.exists(ctx, db, filter)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return commonErrors.NewNotFoundError()
+	}
+
+	return models.NewConflictError()
+}
+
+// getUnmatchedUpdateError returns the error to report when an update did not match any project. The project
+// either does not exist, is archived, or its version does not match the expected version.
+func (service *postgresRepositoryService) getUnmatchedUpdateError(
+	ctx context.Context,
+	db querier,
+	id int64,
+	userEmail string,
+	expectedVersion int64) error {
+	filter := newQueryFilter("id = ? AND "+isMemberCondition("?"), id, userEmail, userEmail)
+	filter.add("deleted_at IS NULL")
+	filter.add("state = ?", int16(models.ProjectStateArchived))
+
+	archived, err := service.exists(ctx, db, filter)
+	if err != nil {
+		return err
+	}
+
+	if archived {
+		return models.NewProjectArchivedError()
+	}
+
+	return service.getUnmatchedProjectError(ctx, db, id, userEmail, false, expectedVersion)
+}
+
+// runBatch runs the requests of a batch in a single transaction, which is only committed if all the requests
+// succeed. The error of the failed request is wrapped in a BatchItemError.
+func (service *postgresRepositoryService) runBatch(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	count int,
+	run func(tx pgx.Tx, idx int) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to start the transaction", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for idx := 0; idx < count; idx++ {
+		if err = run(tx, idx); err != nil {
+			return repository.NewBatchItemError(idx, err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to commit the transaction", err)
+	}
+
+	return nil
+}
+
+// buildListFilter returns the filter the projects listed by the request must match
+// Returns the filter, false if no project can match the request or error if something goes wrong
+func buildListFilter(request *repository.ListProjectsRequest, deleted bool) (queryFilter, bool, error) {
+	ids := []int64{}
+	for _, projectID := range request.ProjectIDs {
+		id, err := parseProjectID(projectID)
+		if err != nil {
+			return queryFilter{}, false, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to decode the projectID: %s.", projectID), err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	filter := newQueryFilter(isMemberCondition("?"), request.UserEmail, request.UserEmail)
+	if deleted {
+		filter.add("deleted_at IS NOT NULL")
+	} else {
+		filter.add("deleted_at IS NULL")
+	}
+
+	if len(ids) > 0 {
+		filter.add("id = ANY(?)", ids)
+	}
+
+	if request.OrganizationID != "" {
+		organizationID, err := parseOrganizationID(request.OrganizationID)
+		if err != nil {
+			// A malformed identifier cannot match any organization
+			return queryFilter{}, false, nil
+		}
+
+		filter.add("organization_id = ?", organizationID)
+	}
+
+	if request.FolderID != "" {
+		folderID, err := parseFolderID(request.FolderID)
+		if err != nil {
+			// A malformed identifier cannot match any folder
+			return queryFilter{}, false, nil
+		}
+
+		filter.add("folder_id IN ("+folderTreeQuery("?", "?", request.IncludeSubfolders)+")", folderID, request.UserEmail)
+	}
+
+	if !request.IncludeArchived {
+		filter.add("state <> ?", int16(models.ProjectStateArchived))
+	}
+
+	if request.Filter != nil {
+		condition, arguments, err := buildFilterCondition(*request.Filter)
+		if err != nil {
+			return queryFilter{}, false, err
+		}
+
+		filter.add(condition, arguments...)
+	}
+
+	return filter, true, nil
+}
+
+// buildUpdateAssignments returns the assignments of the fields the update mask applies together with their
+// values, which are bound to the parameters $1 to $n in order, so the fields the update mask does not list keep
+// their stored values
+func buildUpdateAssignments(project models.Project, updateMask []string) (string, []interface{}) {
+	assignments := []string{}
+	args := []interface{}{}
+
+	assign := func(column string, value interface{}) {
+		args = append(args, value)
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectNamePath) {
+		assign("name", project.Name)
+		assign("normalized_name", repository.NormalizeProjectName(project.Name))
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectDescriptionPath) {
+		assign("description", project.Description)
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectLabelsPath) {
+		assign("labels", mapOrEmpty(project.Labels))
+	}
+
+	if models.UpdateMaskContains(updateMask, models.ProjectAnnotationsPath) {
+		assign("annotations", mapOrEmpty(project.Annotations))
+	}
+
+	assign("updated_at", time.Now().UTC().Truncate(time.Millisecond))
+
+	return strings.Join(assignments, ", "), args
+}
+
+// buildCursorCondition returns the condition that matches the projects that come after the position defined by
+// the sort keys of a cursor in the sort order of the keys, or before the position if after is false
+func buildCursorCondition(keys []repository.SortKey, after bool) (string, []interface{}, error) {
+	alternatives := []string{}
+	arguments := []interface{}{}
+
+	// A project comes after the cursor if it has the same values as the cursor for the leading keys and comes
+	// after the cursor for the next key
+	for idx := range keys {
+		conditions := []string{}
+		for position, key := range keys[:idx+1] {
+			value := key.Value
+			if key.Field == "_id" {
+				id, err := parseProjectID(value.(string))
+				if err != nil {
+					return "", nil, commonErrors.NewArgumentErrorWithError("cursor", "cursor is malformed", err)
+				}
+
+				value = id
+			}
+
+			operator := "="
+			if position == idx {
+				if key.Descending == after {
+					operator = "<"
+				} else {
+					operator = ">"
+				}
+			}
+
+			conditions = append(conditions, sortingColumns[key.Field]+" "+operator+" ?")
+			arguments = append(arguments, value)
+		}
+
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", arguments, nil
+}
+
+// buildOrderBy converts the sorting options to the ORDER BY clause, ignoring the options on unknown columns.
+// The identifier is always appended as the last column, so projects that share the same values are still
+// returned in a stable order.
+func buildOrderBy(sortingOptions []common.SortingOptionPair, reverse bool) string {
+	orderBy := []string{}
+	sortedOnID := false
+
+	for _, sortingOption := range sortingOptions {
+		column, ok := sortingColumns[sortingOption.Name]
+		if !ok {
+			continue
+		}
+
+		orderBy = append(orderBy, column+" "+getSortDirection(sortingOption.Direction == common.Descending, reverse))
+		sortedOnID = sortedOnID || column == "id"
+	}
+
+	if !sortedOnID {
+		orderBy = append(orderBy, "id "+getSortDirection(false, reverse))
+	}
+
+	return strings.Join(orderBy, ", ")
+}
+
+func getSortDirection(descending, reverse bool) string {
+	if descending != reverse {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+func scanProject(row pgx.Row) (int64, models.Project, error) {
+	var id int64
+	var project models.Project
+	var deletedAt *time.Time
+	var state int16
+	var organizationID, folderID *int64
+
+	if err := row.Scan(
+		&id,
+		&project.Name,
+		&project.Description,
+		&project.Labels,
+		&project.Annotations,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&project.CreatedBy,
+		&project.Version,
+		&deletedAt,
+		&state,
+		&organizationID,
+		&folderID); err != nil {
+		return 0, project, err
+	}
+
+	project.State = models.ProjectState(state)
+	if organizationID != nil {
+		project.OrganizationID = formatOrganizationID(*organizationID)
+	}
+
+	if folderID != nil {
+		project.FolderID = formatFolderID(*folderID)
+	}
+
+	project.CreatedAt = project.CreatedAt.UTC()
+	project.UpdatedAt = project.UpdatedAt.UTC()
+	if deletedAt != nil {
+		project.DeletedAt = deletedAt.UTC()
+	}
+
+	return id, project, nil
+}
+
+func scanOrganization(row pgx.Row) (int64, models.Organization, error) {
+	var id int64
+	var organization models.Organization
+
+	if err := row.Scan(
+		&id,
+		&organization.Name,
+		&organization.Description,
+		&organization.CreatedAt,
+		&organization.UpdatedAt,
+		&organization.CreatedBy); err != nil {
+		return 0, organization, err
+	}
+
+	organization.CreatedAt = organization.CreatedAt.UTC()
+	organization.UpdatedAt = organization.UpdatedAt.UTC()
+
+	return id, organization, nil
+}
+
+func scanFolder(row pgx.Row) (int64, models.Folder, error) {
+	var id int64
+	var folder models.Folder
+	var parentFolderID *int64
+
+	if err := row.Scan(
+		&id,
+		&folder.Name,
+		&parentFolderID,
+		&folder.CreatedAt); err != nil {
+		return 0, folder, err
+	}
+
+	if parentFolderID != nil {
+		folder.ParentFolderID = formatFolderID(*parentFolderID)
+	}
+
+	folder.CreatedAt = folder.CreatedAt.UTC()
+
+	return id, folder, nil
+}
+
+func scanInvitation(row pgx.Row) (models.Invitation, error) {
+	var invitation models.Invitation
+	var projectID int64
+	var role int16
+
+	if err := row.Scan(
+		&projectID,
+		&invitation.Email,
+		&role,
+		&invitation.InvitedBy,
+		&invitation.CreatedAt,
+		&invitation.ExpiresAt); err != nil {
+		return invitation, err
+	}
+
+	invitation.ProjectID = formatProjectID(projectID)
+	invitation.Role = models.MemberRole(role)
+	invitation.CreatedAt = invitation.CreatedAt.UTC()
+	invitation.ExpiresAt = invitation.ExpiresAt.UTC()
+
+	return invitation, nil
+}
+
+// outboxStatement returns the statement that writes the event, if any, of the projects returned by the source to
+// the outbox. The values of the event are the parameters starting from the first parameter, in the order
+// outboxArguments returns them, the statement writes nothing if they are NULL.
+func outboxStatement(source string, firstParameter int) string {
+	return buildOutboxStatement(source, firstParameter, fmt.Sprintf("$%d::TEXT", firstParameter))
+}
+
+// cascadeOutboxStatement returns the statement that writes a copy of the event, if any, for every project returned
+// by the source, like outboxStatement does. Like query.NewCascadeEvent, the identifier of each copy is derived from
+// the identifiers of the event and the project, as the event is provided once for all the projects.
+func cascadeOutboxStatement(source string, firstParameter int) string {
+	return buildOutboxStatement(source, firstParameter, fmt.Sprintf("$%d::TEXT || '-' || id::TEXT", firstParameter))
+}
+
+// buildOutboxStatement returns the statement that writes the event to the outbox with the identifier the expression
+// computes for every project returned by the source
+func buildOutboxStatement(source string, firstParameter int, eventIDExpression string) string {
+	return fmt.Sprintf(
+		`INSERT INTO project_outbox (event_id, type, project_id, user_email, occurred_at)
+		SELECT %[6]s, $%[3]d::TEXT, id, $%[4]d::TEXT, $%[5]d::TIMESTAMPTZ FROM %[1]s WHERE $%[2]d::TEXT IS NOT NULL`,
+		source,
+		firstParameter,
+		firstParameter+1,
+		firstParameter+2,
+		firstParameter+3,
+		eventIDExpression)
+}
+
+// outboxArguments returns the values of the event to write to the outbox in the order outboxStatement expects
+// them, or NULLs if there is no event to write
+func outboxArguments(event *models.DomainEvent) []interface{} {
+	if event == nil {
+		return []interface{}{nil, nil, nil, nil}
+	}
+
+	return []interface{}{event.EventID, string(event.Type), event.UserEmail, event.OccurredAt}
+}
+
+// insertAuditEvent appends the audit event to the audit trail of the project in the transaction
+func insertAuditEvent(ctx context.Context, tx pgx.Tx, id int64, auditEvent models.AuditEvent) error {
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO project_audit_events (project_id, action, user_email, target_user_email, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		id,
+		int16(auditEvent.Action),
+		auditEvent.UserEmail,
+		auditEvent.TargetUserEmail,
+		auditEvent.OccurredAt)
+
+	return err
+}
+
+// isMemberCondition returns the condition that matches the projects the user is a member of, either directly
+// or through the organization of the project. The placeholder appears twice in the condition, so a ?
+// placeholder needs the email of the user twice in the arguments.
+// placeholder: Mandatory. The placeholder of the email of the user
+// Returns the condition on the projects table
+func isMemberCondition(placeholder string) string {
+	return "(id IN (SELECT project_id FROM project_members WHERE user_email = " + placeholder + ")" +
+		" OR organization_id IN (SELECT organization_id FROM organization_members WHERE user_email = " + placeholder + "))"
+}
+
+// isOrganizationMemberCondition returns the condition that matches the organizations the user is a member of
+// placeholder: Mandatory. The placeholder of the email of the user
+// Returns the condition on the organizations table
+func isOrganizationMemberCondition(placeholder string) string {
+	return "id IN (SELECT organization_id FROM organization_members WHERE user_email = " + placeholder + ")"
+}
+
+// folderTreeQuery returns the query that selects the identifier of the folder of the user and, if requested,
+// the identifiers of all its subfolders at any depth
+// folderPlaceholder: Mandatory. The placeholder of the folder identifier
+// userPlaceholder: Mandatory. The placeholder of the email of the user, which comes after the folder identifier
+// includeSubfolders: Mandatory. Indicates whether the subfolders are included
+// Returns the query on the folders table
+func folderTreeQuery(folderPlaceholder, userPlaceholder string, includeSubfolders bool) string {
+	query := "SELECT id FROM folders WHERE id = " + folderPlaceholder + " AND user_email = " + userPlaceholder
+	if !includeSubfolders {
+		return query
+	}
+
+	return "WITH RECURSIVE tree AS (" + query +
+		" UNION ALL SELECT folders.id FROM folders JOIN tree ON folders.parent_folder_id = tree.id) SELECT id FROM tree"
+}
+
+// isUniqueViolation returns true if the statement failed because it violated a unique index, which only
+// happens when the project name is already used by another project of the same user, or when the user is
+// already a member of the project
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// isForeignKeyViolation returns true if the statement failed because it violated a foreign key, which only
+// happens when a project is created in an organization that does not exist, or when an organization that
+// still has projects is deleted
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
+
+// mapOrEmpty returns an empty map instead of nil, so the JSONB columns always contain a JSON object
+func mapOrEmpty(values map[string]string) map[string]string {
+	if values == nil {
+		return map[string]string{}
+	}
+
+	return values
+}
+
+func parseProjectID(projectID string) (int64, error) {
+	return strconv.ParseInt(projectID, 10, 64)
+}
+
+func formatProjectID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func parseOrganizationID(organizationID string) (int64, error) {
+	return strconv.ParseInt(organizationID, 10, 64)
+}
+
+func formatOrganizationID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func parseFolderID(folderID string) (int64, error) {
+	return strconv.ParseInt(folderID, 10, 64)
+}
+
+func formatFolderID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// buildFilterCondition translates the filter expression to a condition that uses ? as the placeholder of its
+// arguments. Only the column names and operators are written into the condition, the values provided by the
+// users are always passed as arguments, and the label keys are looked up with ->> rather than the ? operator so
+// they cannot be confused with the placeholders.
+func buildFilterCondition(filter models.ProjectFilter) (string, []interface{}, error) {
+	if err := filter.Validate(); err != nil {
+		return "", nil, commonErrors.NewArgumentErrorWithError("filter", "", err)
+	}
+
+	condition, arguments := translateFilter(filter)
+
+	return condition, arguments, nil
+}
+
+func translateFilter(filter models.ProjectFilter) (string, []interface{}) {
+	if len(filter.Filters) > 0 {
+		separator := " AND "
+		if filter.Operator == models.FilterOperatorOr {
+			separator = " OR "
+		}
+
+		conditions := []string{}
+		arguments := []interface{}{}
+		for _, nestedFilter := range filter.Filters {
+			condition, nestedArguments := translateFilter(nestedFilter)
+			conditions = append(conditions, condition)
+			arguments = append(arguments, nestedArguments...)
+		}
+
+		return "(" + strings.Join(conditions, separator) + ")", arguments
+	}
+
+	switch {
+	case filter.Name != nil:
+		switch filter.Name.Operator {
+		case models.NameOperatorPrefix:
+			return "strpos(name, ?) = 1", []interface{}{filter.Name.Value}
+		case models.NameOperatorContains:
+			return "strpos(name, ?) > 0", []interface{}{filter.Name.Value}
+		default:
+			return "name = ?", []interface{}{filter.Name.Value}
+		}
+	case filter.Label != nil:
+		switch filter.Label.Operator {
+		case models.LabelOperatorNotIn:
+			// The projects without the label match too, and comparing the missing label results in NULL
+			return "COALESCE(labels ->> ? <> ALL(?), TRUE)", []interface{}{filter.Label.Key, filter.Label.Values}
+		case models.LabelOperatorExists:
+			return "labels ->> ? IS NOT NULL", []interface{}{filter.Label.Key}
+		case models.LabelOperatorDoesNotExist:
+			return "labels ->> ? IS NULL", []interface{}{filter.Label.Key}
+		default:
+			return "labels ->> ? = ANY(?)", []interface{}{filter.Label.Key, filter.Label.Values}
+		}
+	case filter.CreatedAt != nil:
+		return translateTimeRange("created_at", *filter.CreatedAt)
+	case filter.UpdatedAt != nil:
+		return translateTimeRange("updated_at", *filter.UpdatedAt)
+	default:
+		return "state = ?", []interface{}{int16(*filter.State)}
+	}
+}
+
+func translateTimeRange(column string, timeRange models.TimeRange) (string, []interface{}) {
+	conditions := []string{}
+	arguments := []interface{}{}
+
+	if !timeRange.From.IsZero() {
+		conditions = append(conditions, column+" >= ?")
+		arguments = append(arguments, timeRange.From)
+	}
+
+	if !timeRange.To.IsZero() {
+		conditions = append(conditions, column+" < ?")
+		arguments = append(arguments, timeRange.To)
+	}
+
+	return "(" + strings.Join(conditions, " AND ") + ")", arguments
+}
+
+// queryFilter builds the WHERE clause of a query from conditions that use ? as the placeholder of their
+// argument, numbering the placeholders the way PostgreSQL expects
+type queryFilter struct {
+	conditions []string
+	arguments  []interface{}
+}
+
+func newQueryFilter(condition string, arguments ...interface{}) queryFilter {
+	filter := queryFilter{}
+	filter.add(condition, arguments...)
+
+	return filter
+}
+
+// add appends the condition to the filter. The condition must contain one placeholder per argument.
+func (filter *queryFilter) add(condition string, arguments ...interface{}) {
+	for _, argument := range arguments {
+		filter.arguments = append(filter.arguments, argument)
+		condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(filter.arguments)), 1)
+	}
+
+	filter.conditions = append(filter.conditions, condition)
+}
+
+// with returns a copy of the filter with the extra condition, leaving the filter untouched
+func (filter queryFilter) with(condition string, arguments ...interface{}) queryFilter {
+	result := queryFilter{
+		conditions: append([]string{}, filter.conditions...),
+		arguments:  append([]interface{}{}, filter.arguments...),
+	}
+	result.add(condition, arguments...)
+
+	return result
+}
+
+func (filter queryFilter) String() string {
+	return strings.Join(filter.conditions, " AND ")
+}
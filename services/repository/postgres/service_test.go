@@ -0,0 +1,116 @@
+package postgres_test
+
+import (
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/project/services/configuration/mock"
+	"github.com/decentralized-cloud/project/services/repository"
+	"github.com/decentralized-cloud/project/services/repository/conformance"
+	"github.com/decentralized-cloud/project/services/repository/postgres"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var connectionString string
+
+// The specs run against the database POSTGRES_CONNECTION_STRING points to, and are skipped if it is not set
+func TestPostgresRepositoryService(t *testing.T) {
+	connectionString = os.Getenv("POSTGRES_CONNECTION_STRING")
+	if strings.Trim(connectionString, " ") == "" {
+		t.Skip("POSTGRES_CONNECTION_STRING is not set")
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Postgres Repository Service Tests")
+}
+
+var _ = Describe("Postgres Repository Service Tests", func() {
+	var (
+		mockCtrl *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate RepositoryService", func() {
+		When("configuration service is not provided and NewPostgresRepositoryService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := postgres.NewPostgresRepositoryService(nil)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("all dependencies are resolved and NewPostgresRepositoryService is called", func() {
+			It("should instantiate the new RepositoryService", func() {
+				mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseConnectionString().
+					Return(cuid.New(), nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseMaxPoolSize().
+					Return(uint64(rand.Intn(100)+1), nil)
+
+				mockConfigurationService.
+					EXPECT().
+					GetDatabaseConnectTimeout().
+					Return(time.Duration(rand.Intn(30)+1)*time.Second, nil)
+
+				service, err := postgres.NewPostgresRepositoryService(mockConfigurationService)
+				Ω(err).Should(BeNil())
+				Ω(service).ShouldNot(BeNil())
+			})
+		})
+	})
+
+	Context("user closes the repository service", func() {
+		When("Close is called before any request is served", func() {
+			It("should return no error", func() {
+				service, err := postgres.NewPostgresRepositoryService(newMockConfigurationService(mockCtrl))
+				Ω(err).Should(BeNil())
+				Ω(service.Close()).Should(BeNil())
+			})
+		})
+	})
+})
+
+var _ = conformance.DescribeRepositoryService("Postgres Repository Service", func() repository.RepositoryContract {
+	service, err := postgres.NewPostgresRepositoryService(newMockConfigurationService(gomock.NewController(GinkgoT())))
+	Ω(err).Should(BeNil())
+
+	return service
+})
+
+func newMockConfigurationService(mockCtrl *gomock.Controller) *configurationMock.MockConfigurationContract {
+	mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectionString().
+		Return(connectionString, nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseMaxPoolSize().
+		Return(uint64(10), nil)
+
+	mockConfigurationService.
+		EXPECT().
+		GetDatabaseConnectTimeout().
+		Return(10*time.Second, nil)
+
+	return mockConfigurationService
+}
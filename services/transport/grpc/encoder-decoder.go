@@ -3,6 +3,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	projectGRPCContract "github.com/decentralized-cloud/project/contract/grpc/go"
 	"github.com/decentralized-cloud/project/models"
@@ -10,6 +11,7 @@ import (
 	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"github.com/thoas/go-funk"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // decodeCreateProjectRequest decodes CreateProject request message from GRPC object to business object
@@ -22,9 +24,9 @@ func decodeCreateProjectRequest(
 	castedRequest := request.(*projectGRPCContract.CreateProjectRequest)
 
 	return &business.CreateProjectRequest{
-		Project: models.Project{
-			Name: castedRequest.Project.Name,
-		}}, nil
+		Project:        mapProjectFromGRPC(castedRequest.Project),
+		IdempotencyKey: castedRequest.IdempotencyKey,
+	}, nil
 }
 
 // encodeCreateProjectResponse encodes CreateProject response from business object to GRPC object
@@ -40,15 +42,13 @@ func encodeCreateProjectResponse(
 		return &projectGRPCContract.CreateProjectResponse{
 			Error:     projectGRPCContract.Error_NO_ERROR,
 			ProjectID: castedResponse.ProjectID,
-			Project: &projectGRPCContract.Project{
-				Name: castedResponse.Project.Name,
-			},
-			Cursor: castedResponse.Cursor,
+			Project:   mapProjectToGRPC(castedResponse.Project),
+			Cursor:    castedResponse.Cursor,
 		}, nil
 	}
 
 	return &projectGRPCContract.CreateProjectResponse{
-		Error:        mapError(castedResponse.Err),
+		Error:        mapOrganizationError(castedResponse.Err),
 		ErrorMessage: castedResponse.Err.Error(),
 	}, nil
 }
@@ -78,10 +78,8 @@ func encodeReadProjectResponse(
 
 	if castedResponse.Err == nil {
 		return &projectGRPCContract.ReadProjectResponse{
-			Error: projectGRPCContract.Error_NO_ERROR,
-			Project: &projectGRPCContract.Project{
-				Name: castedResponse.Project.Name,
-			},
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
 		}, nil
 	}
 
@@ -101,10 +99,11 @@ func decodeUpdateProjectRequest(
 	castedRequest := request.(*projectGRPCContract.UpdateProjectRequest)
 
 	return &business.UpdateProjectRequest{
-		ProjectID: castedRequest.ProjectID,
-		Project: models.Project{
-			Name: castedRequest.Project.Name,
-		}}, nil
+		ProjectID:       castedRequest.ProjectID,
+		Project:         mapProjectFromGRPC(castedRequest.Project),
+		ExpectedVersion: castedRequest.ExpectedVersion,
+		UpdateMask:      castedRequest.UpdateMask.GetPaths(),
+	}, nil
 }
 
 // encodeUpdateProjectResponse encodes UpdateProject response from business object to GRPC object
@@ -118,11 +117,9 @@ func encodeUpdateProjectResponse(
 
 	if castedResponse.Err == nil {
 		return &projectGRPCContract.UpdateProjectResponse{
-			Error: projectGRPCContract.Error_NO_ERROR,
-			Project: &projectGRPCContract.Project{
-				Name: castedResponse.Project.Name,
-			},
-			Cursor: castedResponse.Cursor,
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
 		}, nil
 	}
 
@@ -142,7 +139,8 @@ func decodeDeleteProjectRequest(
 	castedRequest := request.(*projectGRPCContract.DeleteProjectRequest)
 
 	return &business.DeleteProjectRequest{
-		ProjectID: castedRequest.ProjectID,
+		ProjectID:       castedRequest.ProjectID,
+		ExpectedVersion: castedRequest.ExpectedVersion,
 	}, nil
 }
 
@@ -166,57 +164,187 @@ func encodeDeleteProjectResponse(
 	}, nil
 }
 
-// decodeListProjectsRequest decodes ListProjects request message from GRPC object to business object
+// decodeBatchCreateProjectsRequest decodes BatchCreateProjects request message from GRPC object to business object
 // context: Optional The reference to the context
 // request: Mandatory. The reference to the GRPC request
-// Returns either the decoded request or error if something goes wrongw
-func decodeListProjectsRequest(
+// Returns either the decoded request or error if something goes wrong
+func decodeBatchCreateProjectsRequest(
 	ctx context.Context,
 	request interface{}) (interface{}, error) {
-	castedRequest := request.(*projectGRPCContract.ListProjectsRequest)
-	sortingOptions := []common.SortingOptionPair{}
+	castedRequest := request.(*projectGRPCContract.BatchCreateProjectsRequest)
+	projects := make([]models.Project, 0, len(castedRequest.Projects))
 
-	if len(castedRequest.SortingOptions) > 0 {
-		sortingOptions = funk.Map(
-			castedRequest.SortingOptions,
-			func(sortingOption *projectGRPCContract.SortingOptionPair) common.SortingOptionPair {
-				direction := common.Ascending
+	for _, project := range castedRequest.Projects {
+		projects = append(projects, mapProjectFromGRPC(project))
+	}
 
-				if sortingOption.Direction == projectGRPCContract.SortingDirection_DESCENDING {
-					direction = common.Descending
-				}
+	return &business.BatchCreateProjectsRequest{
+		Projects:     projects,
+		AllOrNothing: castedRequest.AllOrNothing,
+	}, nil
+}
 
-				return common.SortingOptionPair{
-					Name:      sortingOption.Name,
-					Direction: direction,
-				}
-			}).([]common.SortingOptionPair)
+// encodeBatchCreateProjectsResponse encodes BatchCreateProjects response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeBatchCreateProjectsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.BatchCreateProjectsResponse)
+
+	if castedResponse.Err != nil {
+		return &projectGRPCContract.BatchCreateProjectsResponse{
+			Error:        mapError(castedResponse.Err),
+			ErrorMessage: castedResponse.Err.Error(),
+		}, nil
 	}
 
-	pagination := common.Pagination{}
+	results := make([]*projectGRPCContract.CreateProjectResponse, 0, len(castedResponse.Results))
+	for _, result := range castedResponse.Results {
+		encodedResult, _ := encodeCreateProjectResponse(ctx, &business.CreateProjectResponse{
+			Err:       result.Err,
+			ProjectID: result.ProjectID,
+			Project:   result.Project,
+			Cursor:    result.Cursor,
+		})
+		results = append(results, encodedResult.(*projectGRPCContract.CreateProjectResponse))
+	}
+
+	return &projectGRPCContract.BatchCreateProjectsResponse{
+		Error:   projectGRPCContract.Error_NO_ERROR,
+		Results: results,
+	}, nil
+}
+
+// decodeBatchUpdateProjectsRequest decodes BatchUpdateProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeBatchUpdateProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.BatchUpdateProjectsRequest)
+	projects := make([]business.BatchUpdateProjectItem, 0, len(castedRequest.Projects))
 
-	if castedRequest.Pagination.HasAfter {
-		pagination.After = &castedRequest.Pagination.After
+	for _, project := range castedRequest.Projects {
+		projects = append(projects, business.BatchUpdateProjectItem{
+			ProjectID:       project.ProjectID,
+			Project:         mapProjectFromGRPC(project.Project),
+			ExpectedVersion: project.ExpectedVersion,
+			UpdateMask:      project.UpdateMask.GetPaths(),
+		})
 	}
 
-	if castedRequest.Pagination.HasFirst {
-		first := int(castedRequest.Pagination.First)
-		pagination.First = &first
+	return &business.BatchUpdateProjectsRequest{
+		Projects:     projects,
+		AllOrNothing: castedRequest.AllOrNothing,
+	}, nil
+}
+
+// encodeBatchUpdateProjectsResponse encodes BatchUpdateProjects response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeBatchUpdateProjectsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.BatchUpdateProjectsResponse)
+
+	if castedResponse.Err != nil {
+		return &projectGRPCContract.BatchUpdateProjectsResponse{
+			Error:        mapError(castedResponse.Err),
+			ErrorMessage: castedResponse.Err.Error(),
+		}, nil
 	}
 
-	if castedRequest.Pagination.HasBefore {
-		pagination.Before = &castedRequest.Pagination.Before
+	results := make([]*projectGRPCContract.UpdateProjectResponse, 0, len(castedResponse.Results))
+	for _, result := range castedResponse.Results {
+		encodedResult, _ := encodeUpdateProjectResponse(ctx, &business.UpdateProjectResponse{
+			Err:     result.Err,
+			Project: result.Project,
+			Cursor:  result.Cursor,
+		})
+		results = append(results, encodedResult.(*projectGRPCContract.UpdateProjectResponse))
 	}
 
-	if castedRequest.Pagination.HasLast {
-		last := int(castedRequest.Pagination.Last)
-		pagination.Last = &last
+	return &projectGRPCContract.BatchUpdateProjectsResponse{
+		Error:   projectGRPCContract.Error_NO_ERROR,
+		Results: results,
+	}, nil
+}
+
+// decodeBatchDeleteProjectsRequest decodes BatchDeleteProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeBatchDeleteProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.BatchDeleteProjectsRequest)
+	projects := make([]business.BatchDeleteProjectItem, 0, len(castedRequest.Projects))
+
+	for _, project := range castedRequest.Projects {
+		projects = append(projects, business.BatchDeleteProjectItem{
+			ProjectID:       project.ProjectID,
+			ExpectedVersion: project.ExpectedVersion,
+		})
+	}
+
+	return &business.BatchDeleteProjectsRequest{
+		Projects:     projects,
+		AllOrNothing: castedRequest.AllOrNothing,
+	}, nil
+}
+
+// encodeBatchDeleteProjectsResponse encodes BatchDeleteProjects response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeBatchDeleteProjectsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.BatchDeleteProjectsResponse)
+
+	if castedResponse.Err != nil {
+		return &projectGRPCContract.BatchDeleteProjectsResponse{
+			Error:        mapError(castedResponse.Err),
+			ErrorMessage: castedResponse.Err.Error(),
+		}, nil
 	}
 
+	results := make([]*projectGRPCContract.DeleteProjectResponse, 0, len(castedResponse.Results))
+	for _, result := range castedResponse.Results {
+		encodedResult, _ := encodeDeleteProjectResponse(ctx, &business.DeleteProjectResponse{
+			Err: result.Err,
+		})
+		results = append(results, encodedResult.(*projectGRPCContract.DeleteProjectResponse))
+	}
+
+	return &projectGRPCContract.BatchDeleteProjectsResponse{
+		Error:   projectGRPCContract.Error_NO_ERROR,
+		Results: results,
+	}, nil
+}
+
+// decodeListProjectsRequest decodes ListProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrongw
+func decodeListProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListProjectsRequest)
+
 	return &business.ListProjectsRequest{
-		Pagination:     pagination,
-		ProjectIDs:     castedRequest.ProjectIDs,
-		SortingOptions: sortingOptions,
+		Pagination:        mapPaginationFromGRPC(castedRequest.Pagination),
+		ProjectIDs:        castedRequest.ProjectIDs,
+		SortingOptions:    mapSortingOptionsFromGRPC(castedRequest.SortingOptions),
+		IncludeArchived:   castedRequest.IncludeArchived,
+		OrganizationID:    castedRequest.OrganizationID,
+		FolderID:          castedRequest.FolderID,
+		IncludeSubfolders: castedRequest.IncludeSubfolders,
+		Filter:            mapProjectFilterFromGRPC(castedRequest.Filter),
 	}, nil
 }
 
@@ -234,15 +362,7 @@ func encodeListProjectsResponse(
 			HasPreviousPage: castedResponse.HasPreviousPage,
 			HasNextPage:     castedResponse.HasNextPage,
 			TotalCount:      castedResponse.TotalCount,
-			Projects: funk.Map(castedResponse.Projects, func(project models.ProjectWithCursor) *projectGRPCContract.ProjectWithCursor {
-				return &projectGRPCContract.ProjectWithCursor{
-					ProjectID: project.ProjectID,
-					Project: &projectGRPCContract.Project{
-						Name: project.Project.Name,
-					},
-					Cursor: project.Cursor,
-				}
-			}).([]*projectGRPCContract.ProjectWithCursor),
+			Projects:        mapProjectsWithCursorToGRPC(castedResponse.Projects),
 		}, nil
 	}
 
@@ -252,22 +372,1525 @@ func encodeListProjectsResponse(
 	}, nil
 }
 
-func mapError(err error) projectGRPCContract.Error {
-	if commonErrors.IsUnknownError(err) {
-		return projectGRPCContract.Error_UNKNOWN
+// decodeStreamProjectsRequest decodes StreamProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeStreamProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.StreamProjectsRequest)
+
+	return &business.StreamProjectsRequest{
+		SortingOptions:    mapSortingOptionsFromGRPC(castedRequest.SortingOptions),
+		ProjectIDs:        castedRequest.ProjectIDs,
+		IncludeArchived:   castedRequest.IncludeArchived,
+		OrganizationID:    castedRequest.OrganizationID,
+		FolderID:          castedRequest.FolderID,
+		IncludeSubfolders: castedRequest.IncludeSubfolders,
+		Filter:            mapProjectFilterFromGRPC(castedRequest.Filter),
+	}, nil
+}
+
+// encodeStreamProjectsResponse encodes one of the projects streamed by StreamProjects from business object to
+// GRPC object
+// project: Mandatory. The reference to the streamed project
+// Returns the encoded response
+func encodeStreamProjectsResponse(project models.ProjectWithCursor) *projectGRPCContract.StreamProjectsResponse {
+	return &projectGRPCContract.StreamProjectsResponse{
+		Error:   projectGRPCContract.Error_NO_ERROR,
+		Project: mapProjectsWithCursorToGRPC([]models.ProjectWithCursor{project})[0],
 	}
+}
 
-	if commonErrors.IsAlreadyExistsError(err) {
-		return projectGRPCContract.Error_PROJECT_ALREADY_EXISTS
+// encodeStreamProjectsError encodes the error that ended StreamProjects from business object to GRPC object
+// err: Mandatory. The error that ended the stream
+// Returns the encoded response
+func encodeStreamProjectsError(err error) *projectGRPCContract.StreamProjectsResponse {
+	return &projectGRPCContract.StreamProjectsResponse{
+		Error:        mapError(err),
+		ErrorMessage: err.Error(),
 	}
+}
 
-	if commonErrors.IsNotFoundError(err) {
-		return projectGRPCContract.Error_PROJECT_NOT_FOUND
+// decodeWatchProjectsRequest decodes WatchProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeWatchProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.WatchProjectsRequest)
+
+	return &business.WatchProjectsRequest{
+		ResourceVersion: castedRequest.ResourceVersion,
+	}, nil
+}
+
+// encodeWatchProjectsResponse encodes one of the changes streamed by WatchProjects from business object to GRPC
+// object
+// event: Mandatory. The reference to the change of the project
+// Returns the encoded response
+func encodeWatchProjectsResponse(event models.ProjectEvent) *projectGRPCContract.WatchProjectsResponse {
+	return &projectGRPCContract.WatchProjectsResponse{
+		Error:           projectGRPCContract.Error_NO_ERROR,
+		Type:            projectGRPCContract.ProjectEventType(event.Type),
+		ProjectID:       event.ProjectID,
+		Project:         mapProjectToGRPC(event.Project),
+		ResourceVersion: event.ResourceVersion,
 	}
+}
 
-	if commonErrors.IsArgumentNilError(err) || commonErrors.IsArgumentError(err) {
-		return projectGRPCContract.Error_BAD_REQUEST
+// encodeWatchProjectsError encodes the error that ended WatchProjects from business object to GRPC object
+// err: Mandatory. The error that ended the stream
+// Returns the encoded response
+func encodeWatchProjectsError(err error) *projectGRPCContract.WatchProjectsResponse {
+	return &projectGRPCContract.WatchProjectsResponse{
+		Error:        mapError(err),
+		ErrorMessage: err.Error(),
 	}
+}
 
-	return projectGRPCContract.Error_UNKNOWN
+// decodeListProjectSortFieldsRequest decodes ListProjectSortFields request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListProjectSortFieldsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListProjectSortFieldsRequest{}, nil
+}
+
+// encodeListProjectSortFieldsResponse encodes ListProjectSortFields response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListProjectSortFieldsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListProjectSortFieldsResponse)
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListProjectSortFieldsResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+			Fields: funk.Map(castedResponse.Fields, func(field models.ProjectSortField) *projectGRPCContract.ProjectSortField {
+				return &projectGRPCContract.ProjectSortField{
+					Name:        field.Name,
+					Description: field.Description,
+				}
+			}).([]*projectGRPCContract.ProjectSortField),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListProjectSortFieldsResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeSearchProjectsRequest decodes SearchProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeSearchProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.SearchProjectsRequest)
+
+	return &business.SearchProjectsRequest{
+		Query:           castedRequest.Query,
+		Pagination:      mapPaginationFromGRPC(castedRequest.Pagination),
+		IncludeArchived: castedRequest.IncludeArchived,
+	}, nil
+}
+
+// encodeSearchProjectsResponse encodes SearchProjects response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeSearchProjectsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.SearchProjectsResponse)
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.SearchProjectsResponse{
+			Error:           projectGRPCContract.Error_NO_ERROR,
+			HasPreviousPage: castedResponse.HasPreviousPage,
+			HasNextPage:     castedResponse.HasNextPage,
+			TotalCount:      castedResponse.TotalCount,
+			Projects:        mapProjectSearchResultsToGRPC(castedResponse.Projects),
+		}, nil
+	}
+
+	return &projectGRPCContract.SearchProjectsResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeArchiveProjectRequest decodes ArchiveProject request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeArchiveProjectRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ArchiveProjectRequest)
+
+	return &business.ArchiveProjectRequest{
+		ProjectID:       castedRequest.ProjectID,
+		ExpectedVersion: castedRequest.ExpectedVersion,
+	}, nil
+}
+
+// encodeArchiveProjectResponse encodes ArchiveProject response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeArchiveProjectResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ArchiveProjectResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ArchiveProjectResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
+		}, nil
+	}
+
+	return &projectGRPCContract.ArchiveProjectResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUnarchiveProjectRequest decodes UnarchiveProject request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUnarchiveProjectRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.UnarchiveProjectRequest)
+
+	return &business.UnarchiveProjectRequest{
+		ProjectID:       castedRequest.ProjectID,
+		ExpectedVersion: castedRequest.ExpectedVersion,
+	}, nil
+}
+
+// encodeUnarchiveProjectResponse encodes UnarchiveProject response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUnarchiveProjectResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UnarchiveProjectResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.UnarchiveProjectResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
+		}, nil
+	}
+
+	return &projectGRPCContract.UnarchiveProjectResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRestoreProjectRequest decodes RestoreProject request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRestoreProjectRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.RestoreProjectRequest)
+
+	return &business.RestoreProjectRequest{
+		ProjectID:       castedRequest.ProjectID,
+		ExpectedVersion: castedRequest.ExpectedVersion,
+	}, nil
+}
+
+// encodeRestoreProjectResponse encodes RestoreProject response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRestoreProjectResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RestoreProjectResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.RestoreProjectResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
+		}, nil
+	}
+
+	return &projectGRPCContract.RestoreProjectResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListDeletedProjectsRequest decodes ListDeletedProjects request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListDeletedProjectsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListDeletedProjectsRequest)
+
+	return &business.ListDeletedProjectsRequest{
+		Pagination:     mapPaginationFromGRPC(castedRequest.Pagination),
+		ProjectIDs:     castedRequest.ProjectIDs,
+		SortingOptions: mapSortingOptionsFromGRPC(castedRequest.SortingOptions),
+	}, nil
+}
+
+// encodeListDeletedProjectsResponse encodes ListDeletedProjects response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListDeletedProjectsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListDeletedProjectsResponse)
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListDeletedProjectsResponse{
+			Error:           projectGRPCContract.Error_NO_ERROR,
+			HasPreviousPage: castedResponse.HasPreviousPage,
+			HasNextPage:     castedResponse.HasNextPage,
+			TotalCount:      castedResponse.TotalCount,
+			Projects:        mapProjectsWithCursorToGRPC(castedResponse.Projects),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListDeletedProjectsResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeAddMemberRequest decodes AddMember request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAddMemberRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.AddMemberRequest)
+
+	return &business.AddMemberRequest{
+		ProjectID:   castedRequest.ProjectID,
+		MemberEmail: castedRequest.GetMember().GetUserEmail(),
+		Role:        models.MemberRole(castedRequest.GetMember().GetRole()),
+	}, nil
+}
+
+// encodeAddMemberResponse encodes AddMember response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAddMemberResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AddMemberResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.AddMemberResponse{
+			Error:  projectGRPCContract.Error_NO_ERROR,
+			Member: mapMemberToGRPC(castedResponse.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.AddMemberResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRemoveMemberRequest decodes RemoveMember request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRemoveMemberRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.RemoveMemberRequest)
+
+	return &business.RemoveMemberRequest{
+		ProjectID:   castedRequest.ProjectID,
+		MemberEmail: castedRequest.UserEmail,
+	}, nil
+}
+
+// encodeRemoveMemberResponse encodes RemoveMember response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRemoveMemberResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RemoveMemberResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.RemoveMemberResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.RemoveMemberResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUpdateMemberRoleRequest decodes UpdateMemberRole request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUpdateMemberRoleRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.UpdateMemberRoleRequest)
+
+	return &business.UpdateMemberRoleRequest{
+		ProjectID:   castedRequest.ProjectID,
+		MemberEmail: castedRequest.UserEmail,
+		Role:        models.MemberRole(castedRequest.Role),
+	}, nil
+}
+
+// encodeUpdateMemberRoleResponse encodes UpdateMemberRole response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUpdateMemberRoleResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UpdateMemberRoleResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.UpdateMemberRoleResponse{
+			Error:  projectGRPCContract.Error_NO_ERROR,
+			Member: mapMemberToGRPC(castedResponse.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.UpdateMemberRoleResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListMembersRequest decodes ListMembers request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListMembersRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListMembersRequest)
+
+	return &business.ListMembersRequest{
+		ProjectID: castedRequest.ProjectID,
+	}, nil
+}
+
+// encodeListMembersResponse encodes ListMembers response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListMembersResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListMembersResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListMembersResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Members: funk.Map(castedResponse.Members, mapMemberToGRPC).([]*projectGRPCContract.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListMembersResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeTransferProjectOwnershipRequest decodes TransferProjectOwnership request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeTransferProjectOwnershipRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.TransferProjectOwnershipRequest)
+
+	return &business.TransferProjectOwnershipRequest{
+		ProjectID:         castedRequest.ProjectID,
+		NewOwnerEmail:     castedRequest.NewOwnerEmail,
+		RequireAcceptance: castedRequest.RequireAcceptance,
+	}, nil
+}
+
+// encodeTransferProjectOwnershipResponse encodes TransferProjectOwnership response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeTransferProjectOwnershipResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.TransferProjectOwnershipResponse)
+
+	if castedResponse.Err != nil {
+		return &projectGRPCContract.TransferProjectOwnershipResponse{
+			Error:        mapError(castedResponse.Err),
+			ErrorMessage: castedResponse.Err.Error(),
+		}, nil
+	}
+
+	if castedResponse.PendingTransfer != nil {
+		return &projectGRPCContract.TransferProjectOwnershipResponse{
+			Error:           projectGRPCContract.Error_NO_ERROR,
+			PendingTransfer: mapOwnershipTransferToGRPC(*castedResponse.PendingTransfer),
+		}, nil
+	}
+
+	return &projectGRPCContract.TransferProjectOwnershipResponse{
+		Error:   projectGRPCContract.Error_NO_ERROR,
+		Project: mapProjectToGRPC(castedResponse.Project),
+		Cursor:  castedResponse.Cursor,
+	}, nil
+}
+
+// decodeAcceptProjectOwnershipTransferRequest decodes AcceptProjectOwnershipTransfer request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAcceptProjectOwnershipTransferRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.AcceptProjectOwnershipTransferRequest)
+
+	return &business.AcceptProjectOwnershipTransferRequest{
+		ProjectID: castedRequest.ProjectID,
+	}, nil
+}
+
+// encodeAcceptProjectOwnershipTransferResponse encodes AcceptProjectOwnershipTransfer response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAcceptProjectOwnershipTransferResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AcceptProjectOwnershipTransferResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.AcceptProjectOwnershipTransferResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
+		}, nil
+	}
+
+	return &projectGRPCContract.AcceptProjectOwnershipTransferResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeDeclineProjectOwnershipTransferRequest decodes DeclineProjectOwnershipTransfer request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeDeclineProjectOwnershipTransferRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.DeclineProjectOwnershipTransferRequest)
+
+	return &business.DeclineProjectOwnershipTransferRequest{
+		ProjectID: castedRequest.ProjectID,
+	}, nil
+}
+
+// encodeDeclineProjectOwnershipTransferResponse encodes DeclineProjectOwnershipTransfer response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeDeclineProjectOwnershipTransferResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.DeclineProjectOwnershipTransferResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.DeclineProjectOwnershipTransferResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.DeclineProjectOwnershipTransferResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListProjectAuditEventsRequest decodes ListProjectAuditEvents request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListProjectAuditEventsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListProjectAuditEventsRequest)
+
+	return &business.ListProjectAuditEventsRequest{
+		ProjectID: castedRequest.ProjectID,
+	}, nil
+}
+
+// encodeListProjectAuditEventsResponse encodes ListProjectAuditEvents response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListProjectAuditEventsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListProjectAuditEventsResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListProjectAuditEventsResponse{
+			Error:       projectGRPCContract.Error_NO_ERROR,
+			AuditEvents: funk.Map(castedResponse.AuditEvents, mapAuditEventToGRPC).([]*projectGRPCContract.AuditEvent),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListProjectAuditEventsResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeCreateInvitationRequest decodes CreateInvitation request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeCreateInvitationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.CreateInvitationRequest)
+
+	return &business.CreateInvitationRequest{
+		ProjectID: castedRequest.ProjectID,
+		Email:     castedRequest.Email,
+		Role:      models.MemberRole(castedRequest.Role),
+	}, nil
+}
+
+// encodeCreateInvitationResponse encodes CreateInvitation response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeCreateInvitationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.CreateInvitationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.CreateInvitationResponse{
+			Error:      projectGRPCContract.Error_NO_ERROR,
+			Invitation: mapInvitationToGRPC(castedResponse.Invitation),
+		}, nil
+	}
+
+	return &projectGRPCContract.CreateInvitationResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListInvitationsRequest decodes ListInvitations request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListInvitationsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListInvitationsRequest)
+
+	return &business.ListInvitationsRequest{
+		ProjectID: castedRequest.ProjectID,
+	}, nil
+}
+
+// encodeListInvitationsResponse encodes ListInvitations response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListInvitationsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListInvitationsResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListInvitationsResponse{
+			Error:       projectGRPCContract.Error_NO_ERROR,
+			Invitations: funk.Map(castedResponse.Invitations, mapInvitationToGRPC).([]*projectGRPCContract.Invitation),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListInvitationsResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeAcceptInvitationRequest decodes AcceptInvitation request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAcceptInvitationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.AcceptInvitationRequest)
+
+	return &business.AcceptInvitationRequest{
+		Token: castedRequest.Token,
+	}, nil
+}
+
+// encodeAcceptInvitationResponse encodes AcceptInvitation response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAcceptInvitationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AcceptInvitationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.AcceptInvitationResponse{
+			Error:     projectGRPCContract.Error_NO_ERROR,
+			ProjectID: castedResponse.ProjectID,
+			Member:    mapMemberToGRPC(castedResponse.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.AcceptInvitationResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRevokeInvitationRequest decodes RevokeInvitation request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRevokeInvitationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.RevokeInvitationRequest)
+
+	return &business.RevokeInvitationRequest{
+		ProjectID: castedRequest.ProjectID,
+		Email:     castedRequest.Email,
+	}, nil
+}
+
+// encodeRevokeInvitationResponse encodes RevokeInvitation response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRevokeInvitationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RevokeInvitationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.RevokeInvitationResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.RevokeInvitationResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeCreateOrganizationRequest decodes CreateOrganization request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeCreateOrganizationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.CreateOrganizationRequest)
+
+	return &business.CreateOrganizationRequest{
+		Organization: mapOrganizationFromGRPC(castedRequest.Organization),
+	}, nil
+}
+
+// encodeCreateOrganizationResponse encodes CreateOrganization response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeCreateOrganizationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.CreateOrganizationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.CreateOrganizationResponse{
+			Error:          projectGRPCContract.Error_NO_ERROR,
+			OrganizationID: castedResponse.OrganizationID,
+			Organization:   mapOrganizationToGRPC(castedResponse.Organization),
+		}, nil
+	}
+
+	return &projectGRPCContract.CreateOrganizationResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeReadOrganizationRequest decodes ReadOrganization request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeReadOrganizationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ReadOrganizationRequest)
+
+	return &business.ReadOrganizationRequest{
+		OrganizationID: castedRequest.OrganizationID,
+	}, nil
+}
+
+// encodeReadOrganizationResponse encodes ReadOrganization response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeReadOrganizationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ReadOrganizationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ReadOrganizationResponse{
+			Error:        projectGRPCContract.Error_NO_ERROR,
+			Organization: mapOrganizationToGRPC(castedResponse.Organization),
+		}, nil
+	}
+
+	return &projectGRPCContract.ReadOrganizationResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUpdateOrganizationRequest decodes UpdateOrganization request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUpdateOrganizationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.UpdateOrganizationRequest)
+
+	return &business.UpdateOrganizationRequest{
+		OrganizationID: castedRequest.OrganizationID,
+		Organization:   mapOrganizationFromGRPC(castedRequest.Organization),
+	}, nil
+}
+
+// encodeUpdateOrganizationResponse encodes UpdateOrganization response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUpdateOrganizationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UpdateOrganizationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.UpdateOrganizationResponse{
+			Error:        projectGRPCContract.Error_NO_ERROR,
+			Organization: mapOrganizationToGRPC(castedResponse.Organization),
+		}, nil
+	}
+
+	return &projectGRPCContract.UpdateOrganizationResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeDeleteOrganizationRequest decodes DeleteOrganization request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeDeleteOrganizationRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.DeleteOrganizationRequest)
+
+	return &business.DeleteOrganizationRequest{
+		OrganizationID: castedRequest.OrganizationID,
+	}, nil
+}
+
+// encodeDeleteOrganizationResponse encodes DeleteOrganization response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeDeleteOrganizationResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.DeleteOrganizationResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.DeleteOrganizationResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.DeleteOrganizationResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListOrganizationsRequest decodes ListOrganizations request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListOrganizationsRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListOrganizationsRequest{}, nil
+}
+
+// encodeListOrganizationsResponse encodes ListOrganizations response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListOrganizationsResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListOrganizationsResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListOrganizationsResponse{
+			Error:         projectGRPCContract.Error_NO_ERROR,
+			Organizations: funk.Map(castedResponse.Organizations, mapOrganizationWithIDToGRPC).([]*projectGRPCContract.OrganizationWithID),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListOrganizationsResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeAddOrganizationMemberRequest decodes AddOrganizationMember request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAddOrganizationMemberRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.AddOrganizationMemberRequest)
+
+	return &business.AddOrganizationMemberRequest{
+		OrganizationID: castedRequest.OrganizationID,
+		MemberEmail:    castedRequest.GetMember().GetUserEmail(),
+		Role:           models.MemberRole(castedRequest.GetMember().GetRole()),
+	}, nil
+}
+
+// encodeAddOrganizationMemberResponse encodes AddOrganizationMember response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAddOrganizationMemberResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AddOrganizationMemberResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.AddOrganizationMemberResponse{
+			Error:  projectGRPCContract.Error_NO_ERROR,
+			Member: mapMemberToGRPC(castedResponse.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.AddOrganizationMemberResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRemoveOrganizationMemberRequest decodes RemoveOrganizationMember request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRemoveOrganizationMemberRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.RemoveOrganizationMemberRequest)
+
+	return &business.RemoveOrganizationMemberRequest{
+		OrganizationID: castedRequest.OrganizationID,
+		MemberEmail:    castedRequest.UserEmail,
+	}, nil
+}
+
+// encodeRemoveOrganizationMemberResponse encodes RemoveOrganizationMember response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRemoveOrganizationMemberResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RemoveOrganizationMemberResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.RemoveOrganizationMemberResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.RemoveOrganizationMemberResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListOrganizationMembersRequest decodes ListOrganizationMembers request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListOrganizationMembersRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.ListOrganizationMembersRequest)
+
+	return &business.ListOrganizationMembersRequest{
+		OrganizationID: castedRequest.OrganizationID,
+	}, nil
+}
+
+// encodeListOrganizationMembersResponse encodes ListOrganizationMembers response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListOrganizationMembersResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListOrganizationMembersResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListOrganizationMembersResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Members: funk.Map(castedResponse.Members, mapMemberToGRPC).([]*projectGRPCContract.Member),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListOrganizationMembersResponse{
+		Error:        mapOrganizationError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeCreateFolderRequest decodes CreateFolder request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeCreateFolderRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.CreateFolderRequest)
+
+	return &business.CreateFolderRequest{
+		Folder: mapFolderFromGRPC(castedRequest.Folder),
+	}, nil
+}
+
+// encodeCreateFolderResponse encodes CreateFolder response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeCreateFolderResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.CreateFolderResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.CreateFolderResponse{
+			Error:    projectGRPCContract.Error_NO_ERROR,
+			FolderID: castedResponse.FolderID,
+			Folder:   mapFolderToGRPC(castedResponse.Folder),
+		}, nil
+	}
+
+	return &projectGRPCContract.CreateFolderResponse{
+		Error:        mapFolderError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListFoldersRequest decodes ListFolders request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListFoldersRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListFoldersRequest{}, nil
+}
+
+// encodeListFoldersResponse encodes ListFolders response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListFoldersResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListFoldersResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.ListFoldersResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Folders: funk.Map(castedResponse.Folders, mapFolderWithIDToGRPC).([]*projectGRPCContract.FolderWithID),
+		}, nil
+	}
+
+	return &projectGRPCContract.ListFoldersResponse{
+		Error:        mapFolderError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeDeleteFolderRequest decodes DeleteFolder request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeDeleteFolderRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.DeleteFolderRequest)
+
+	return &business.DeleteFolderRequest{
+		FolderID: castedRequest.FolderID,
+		Cascade:  castedRequest.Cascade,
+	}, nil
+}
+
+// encodeDeleteFolderResponse encodes DeleteFolder response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeDeleteFolderResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.DeleteFolderResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.DeleteFolderResponse{
+			Error: projectGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &projectGRPCContract.DeleteFolderResponse{
+		Error:        mapFolderError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeMoveProjectRequest decodes MoveProject request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeMoveProjectRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*projectGRPCContract.MoveProjectRequest)
+
+	return &business.MoveProjectRequest{
+		ProjectID: castedRequest.ProjectID,
+		FolderID:  castedRequest.FolderID,
+	}, nil
+}
+
+// encodeMoveProjectResponse encodes MoveProject response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeMoveProjectResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.MoveProjectResponse)
+
+	if castedResponse.Err == nil {
+		return &projectGRPCContract.MoveProjectResponse{
+			Error:   projectGRPCContract.Error_NO_ERROR,
+			Project: mapProjectToGRPC(castedResponse.Project),
+			Cursor:  castedResponse.Cursor,
+		}, nil
+	}
+
+	// The project and the folder are not told apart, so the users cannot find out whether a project exists by
+	// moving it into one of their folders
+	return &projectGRPCContract.MoveProjectResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+func mapError(err error) projectGRPCContract.Error {
+	if commonErrors.IsUnknownError(err) {
+		return projectGRPCContract.Error_UNKNOWN
+	}
+
+	if commonErrors.IsAlreadyExistsError(err) {
+		return projectGRPCContract.Error_PROJECT_ALREADY_EXISTS
+	}
+
+	if commonErrors.IsNotFoundError(err) {
+		return projectGRPCContract.Error_PROJECT_NOT_FOUND
+	}
+
+	if models.IsConflictError(err) {
+		return projectGRPCContract.Error_CONFLICT
+	}
+
+	if models.IsProjectArchivedError(err) {
+		return projectGRPCContract.Error_PROJECT_ARCHIVED
+	}
+
+	if models.IsPermissionDeniedError(err) {
+		return projectGRPCContract.Error_PERMISSION_DENIED
+	}
+
+	if models.IsOrganizationNotEmptyError(err) {
+		return projectGRPCContract.Error_ORGANIZATION_NOT_EMPTY
+	}
+
+	if models.IsFolderNotEmptyError(err) {
+		return projectGRPCContract.Error_FOLDER_NOT_EMPTY
+	}
+
+	if models.IsBatchAbortedError(err) {
+		return projectGRPCContract.Error_BATCH_ABORTED
+	}
+
+	if models.IsIdempotencyKeyReusedError(err) {
+		return projectGRPCContract.Error_IDEMPOTENCY_KEY_REUSED
+	}
+
+	if models.IsResourceVersionExpiredError(err) {
+		return projectGRPCContract.Error_RESOURCE_VERSION_EXPIRED
+	}
+
+	if models.IsUnimplementedError(err) {
+		return projectGRPCContract.Error_UNIMPLEMENTED
+	}
+
+	if commonErrors.IsArgumentNilError(err) || commonErrors.IsArgumentError(err) {
+		return projectGRPCContract.Error_BAD_REQUEST
+	}
+
+	return projectGRPCContract.Error_UNKNOWN
+}
+
+// mapOrganizationError maps the errors of the operations where the missing entity is the organization rather
+// than the project
+func mapOrganizationError(err error) projectGRPCContract.Error {
+	if commonErrors.IsNotFoundError(err) {
+		return projectGRPCContract.Error_ORGANIZATION_NOT_FOUND
+	}
+
+	return mapError(err)
+}
+
+// mapFolderError maps the errors of the operations where the missing entity is the folder rather than the
+// project
+func mapFolderError(err error) projectGRPCContract.Error {
+	if commonErrors.IsNotFoundError(err) {
+		return projectGRPCContract.Error_FOLDER_NOT_FOUND
+	}
+
+	return mapError(err)
+}
+
+// mapProjectFromGRPC maps the project received from the users to the business object. The fields that are
+// managed by the service are ignored.
+func mapProjectFromGRPC(project *projectGRPCContract.Project) models.Project {
+	return models.Project{
+		Name:           project.GetName(),
+		Description:    project.GetDescription(),
+		Labels:         project.GetLabels(),
+		Annotations:    project.GetAnnotations(),
+		OrganizationID: project.GetOrganizationID(),
+	}
+}
+
+func mapProjectToGRPC(project models.Project) *projectGRPCContract.Project {
+	return &projectGRPCContract.Project{
+		Name:           project.Name,
+		Description:    project.Description,
+		Labels:         project.Labels,
+		Annotations:    project.Annotations,
+		CreatedAt:      mapTimeToGRPC(project.CreatedAt),
+		UpdatedAt:      mapTimeToGRPC(project.UpdatedAt),
+		CreatedBy:      project.CreatedBy,
+		Version:        project.Version,
+		DeletedAt:      mapTimeToGRPC(project.DeletedAt),
+		State:          projectGRPCContract.ProjectState(project.State),
+		OrganizationID: project.OrganizationID,
+		FolderID:       project.FolderID,
+	}
+}
+
+// mapOrganizationFromGRPC maps the organization received from the users to the business object. The fields
+// that are managed by the service are ignored.
+func mapOrganizationFromGRPC(organization *projectGRPCContract.Organization) models.Organization {
+	return models.Organization{
+		Name:        organization.GetName(),
+		Description: organization.GetDescription(),
+	}
+}
+
+func mapOrganizationToGRPC(organization models.Organization) *projectGRPCContract.Organization {
+	return &projectGRPCContract.Organization{
+		Name:        organization.Name,
+		Description: organization.Description,
+		CreatedAt:   mapTimeToGRPC(organization.CreatedAt),
+		UpdatedAt:   mapTimeToGRPC(organization.UpdatedAt),
+		CreatedBy:   organization.CreatedBy,
+	}
+}
+
+func mapOrganizationWithIDToGRPC(organization models.OrganizationWithID) *projectGRPCContract.OrganizationWithID {
+	return &projectGRPCContract.OrganizationWithID{
+		OrganizationID: organization.OrganizationID,
+		Organization:   mapOrganizationToGRPC(organization.Organization),
+	}
+}
+
+// mapFolderFromGRPC maps the folder received from the users to the business object. The fields that are
+// managed by the service are ignored.
+func mapFolderFromGRPC(folder *projectGRPCContract.Folder) models.Folder {
+	return models.Folder{
+		Name:           folder.GetName(),
+		ParentFolderID: folder.GetParentFolderID(),
+	}
+}
+
+func mapFolderToGRPC(folder models.Folder) *projectGRPCContract.Folder {
+	return &projectGRPCContract.Folder{
+		Name:           folder.Name,
+		ParentFolderID: folder.ParentFolderID,
+		CreatedAt:      mapTimeToGRPC(folder.CreatedAt),
+	}
+}
+
+func mapFolderWithIDToGRPC(folder models.FolderWithID) *projectGRPCContract.FolderWithID {
+	return &projectGRPCContract.FolderWithID{
+		FolderID: folder.FolderID,
+		Folder:   mapFolderToGRPC(folder.Folder),
+	}
+}
+
+func mapMemberToGRPC(member models.Member) *projectGRPCContract.Member {
+	return &projectGRPCContract.Member{
+		UserEmail: member.UserEmail,
+		Role:      projectGRPCContract.MemberRole(member.Role),
+	}
+}
+
+func mapOwnershipTransferToGRPC(transfer models.OwnershipTransfer) *projectGRPCContract.OwnershipTransfer {
+	return &projectGRPCContract.OwnershipTransfer{
+		FromUserEmail: transfer.FromUserEmail,
+		ToUserEmail:   transfer.ToUserEmail,
+		RequestedAt:   mapTimeToGRPC(transfer.RequestedAt),
+		ExpiresAt:     mapTimeToGRPC(transfer.ExpiresAt),
+	}
+}
+
+func mapAuditEventToGRPC(auditEvent models.AuditEvent) *projectGRPCContract.AuditEvent {
+	return &projectGRPCContract.AuditEvent{
+		Action:          projectGRPCContract.AuditAction(auditEvent.Action),
+		UserEmail:       auditEvent.UserEmail,
+		TargetUserEmail: auditEvent.TargetUserEmail,
+		OccurredAt:      mapTimeToGRPC(auditEvent.OccurredAt),
+	}
+}
+
+func mapInvitationToGRPC(invitation models.Invitation) *projectGRPCContract.Invitation {
+	return &projectGRPCContract.Invitation{
+		ProjectID: invitation.ProjectID,
+		Email:     invitation.Email,
+		Role:      projectGRPCContract.MemberRole(invitation.Role),
+		InvitedBy: invitation.InvitedBy,
+		CreatedAt: mapTimeToGRPC(invitation.CreatedAt),
+		ExpiresAt: mapTimeToGRPC(invitation.ExpiresAt),
+	}
+}
+
+func mapProjectsWithCursorToGRPC(projects []models.ProjectWithCursor) []*projectGRPCContract.ProjectWithCursor {
+	return funk.Map(projects, func(project models.ProjectWithCursor) *projectGRPCContract.ProjectWithCursor {
+		return &projectGRPCContract.ProjectWithCursor{
+			ProjectID: project.ProjectID,
+			Project:   mapProjectToGRPC(project.Project),
+			Cursor:    project.Cursor,
+		}
+	}).([]*projectGRPCContract.ProjectWithCursor)
+}
+
+func mapProjectSearchResultsToGRPC(results []models.ProjectSearchResult) []*projectGRPCContract.ProjectSearchResult {
+	return funk.Map(results, func(result models.ProjectSearchResult) *projectGRPCContract.ProjectSearchResult {
+		return &projectGRPCContract.ProjectSearchResult{
+			ProjectID:  result.ProjectID,
+			Project:    mapProjectToGRPC(result.Project),
+			Cursor:     result.Cursor,
+			Score:      result.Score,
+			Highlights: mapHighlightsToGRPC(result.Highlights),
+		}
+	}).([]*projectGRPCContract.ProjectSearchResult)
+}
+
+func mapHighlightsToGRPC(highlights []models.Highlight) []*projectGRPCContract.Highlight {
+	return funk.Map(highlights, func(highlight models.Highlight) *projectGRPCContract.Highlight {
+		return &projectGRPCContract.Highlight{
+			Field: highlight.Field,
+			Ranges: funk.Map(highlight.Ranges, func(textRange models.TextRange) *projectGRPCContract.TextRange {
+				return &projectGRPCContract.TextRange{
+					Start: int32(textRange.Start),
+					End:   int32(textRange.End),
+				}
+			}).([]*projectGRPCContract.TextRange),
+		}
+	}).([]*projectGRPCContract.Highlight)
+}
+
+func mapPaginationFromGRPC(from *projectGRPCContract.Pagination) common.Pagination {
+	pagination := common.Pagination{}
+
+	if from.HasAfter {
+		pagination.After = &from.After
+	}
+
+	if from.HasFirst {
+		first := int(from.First)
+		pagination.First = &first
+	}
+
+	if from.HasBefore {
+		pagination.Before = &from.Before
+	}
+
+	if from.HasLast {
+		last := int(from.Last)
+		pagination.Last = &last
+	}
+
+	return pagination
+}
+
+func mapSortingOptionsFromGRPC(from []*projectGRPCContract.SortingOptionPair) []common.SortingOptionPair {
+	if len(from) == 0 {
+		return []common.SortingOptionPair{}
+	}
+
+	return funk.Map(
+		from,
+		func(sortingOption *projectGRPCContract.SortingOptionPair) common.SortingOptionPair {
+			direction := common.Ascending
+
+			if sortingOption.Direction == projectGRPCContract.SortingDirection_DESCENDING {
+				direction = common.Descending
+			}
+
+			return common.SortingOptionPair{
+				Name:      sortingOption.Name,
+				Direction: direction,
+			}
+		}).([]common.SortingOptionPair)
+}
+
+// mapProjectFilterFromGRPC maps the filter expression received from the users to the business object. The
+// filters without any condition are mapped as is, so they are rejected when the request is validated.
+func mapProjectFilterFromGRPC(from *projectGRPCContract.ProjectFilter) *models.ProjectFilter {
+	if from == nil {
+		return nil
+	}
+
+	filter := models.ProjectFilter{}
+
+	switch condition := from.GetFilter().(type) {
+	case *projectGRPCContract.ProjectFilter_Group:
+		filter.Operator = models.FilterOperator(condition.Group.GetOperator())
+		for _, nestedFilter := range condition.Group.GetFilters() {
+			filter.Filters = append(filter.Filters, *mapProjectFilterFromGRPC(nestedFilter))
+		}
+	case *projectGRPCContract.ProjectFilter_Name:
+		filter.Name = &models.NameCondition{
+			Operator: models.NameOperator(condition.Name.GetOperator()),
+			Value:    condition.Name.GetValue(),
+		}
+	case *projectGRPCContract.ProjectFilter_Label:
+		filter.Label = &models.LabelSelector{
+			Key:      condition.Label.GetKey(),
+			Operator: models.LabelOperator(condition.Label.GetOperator()),
+			Values:   condition.Label.GetValues(),
+		}
+	case *projectGRPCContract.ProjectFilter_CreatedAt:
+		filter.CreatedAt = mapTimeRangeFromGRPC(condition.CreatedAt)
+	case *projectGRPCContract.ProjectFilter_UpdatedAt:
+		filter.UpdatedAt = mapTimeRangeFromGRPC(condition.UpdatedAt)
+	case *projectGRPCContract.ProjectFilter_State:
+		state := models.ProjectState(condition.State)
+		filter.State = &state
+	}
+
+	return &filter
+}
+
+func mapTimeRangeFromGRPC(from *projectGRPCContract.TimeRange) *models.TimeRange {
+	return &models.TimeRange{
+		From: mapTimeFromGRPC(from.GetFrom()),
+		To:   mapTimeFromGRPC(from.GetTo()),
+	}
+}
+
+func mapTimeFromGRPC(value *timestamppb.Timestamp) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	return value.AsTime()
+}
+
+func mapTimeToGRPC(value time.Time) *timestamppb.Timestamp {
+	if value.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(value)
 }
@@ -7,9 +7,11 @@ import (
 	"net"
 
 	projectGRPCContract "github.com/decentralized-cloud/project/contract/grpc/go"
+	"github.com/decentralized-cloud/project/services/business"
 	"github.com/decentralized-cloud/project/services/configuration"
 	"github.com/decentralized-cloud/project/services/endpoint"
 	"github.com/decentralized-cloud/project/services/transport"
+	gokitendpoint "github.com/go-kit/kit/endpoint"
 	gokitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/micro-business/go-core/gokit/middleware"
 	commonErrors "github.com/micro-business/go-core/system/errors"
@@ -18,16 +20,51 @@ import (
 )
 
 type transportService struct {
-	logger                    *zap.Logger
-	configurationService      configuration.ConfigurationContract
-	endpointCreatorService    endpoint.EndpointCreatorContract
-	middlewareProviderService middleware.MiddlewareProviderContract
-	jwksURL                   string
-	createProjectHandler      gokitgrpc.Handler
-	readProjectHandler        gokitgrpc.Handler
-	updateProjectHandler      gokitgrpc.Handler
-	deleteProjectHandler      gokitgrpc.Handler
-	ListProjectsHandler       gokitgrpc.Handler
+	logger                          *zap.Logger
+	configurationService            configuration.ConfigurationContract
+	endpointCreatorService          endpoint.EndpointCreatorContract
+	middlewareProviderService       middleware.MiddlewareProviderContract
+	jwksURL                         string
+	createProjectHandler            gokitgrpc.Handler
+	readProjectHandler              gokitgrpc.Handler
+	updateProjectHandler            gokitgrpc.Handler
+	deleteProjectHandler            gokitgrpc.Handler
+	batchCreateProjectsHandler      gokitgrpc.Handler
+	batchUpdateProjectsHandler      gokitgrpc.Handler
+	batchDeleteProjectsHandler      gokitgrpc.Handler
+	ListProjectsHandler             gokitgrpc.Handler
+	streamProjectsEndpoint          gokitendpoint.Endpoint
+	watchProjectsEndpoint           gokitendpoint.Endpoint
+	listProjectSortFieldsHandler    gokitgrpc.Handler
+	searchProjectsHandler           gokitgrpc.Handler
+	archiveProjectHandler           gokitgrpc.Handler
+	unarchiveProjectHandler         gokitgrpc.Handler
+	restoreProjectHandler           gokitgrpc.Handler
+	listDeletedProjectsHandler      gokitgrpc.Handler
+	addMemberHandler                gokitgrpc.Handler
+	removeMemberHandler             gokitgrpc.Handler
+	updateMemberRoleHandler         gokitgrpc.Handler
+	listMembersHandler              gokitgrpc.Handler
+	transferOwnershipHandler        gokitgrpc.Handler
+	acceptTransferHandler           gokitgrpc.Handler
+	declineTransferHandler          gokitgrpc.Handler
+	listAuditEventsHandler          gokitgrpc.Handler
+	createInvitationHandler         gokitgrpc.Handler
+	listInvitationsHandler          gokitgrpc.Handler
+	acceptInvitationHandler         gokitgrpc.Handler
+	revokeInvitationHandler         gokitgrpc.Handler
+	createOrganizationHandler       gokitgrpc.Handler
+	readOrganizationHandler         gokitgrpc.Handler
+	updateOrganizationHandler       gokitgrpc.Handler
+	deleteOrganizationHandler       gokitgrpc.Handler
+	listOrganizationsHandler        gokitgrpc.Handler
+	addOrganizationMemberHandler    gokitgrpc.Handler
+	removeOrganizationMemberHandler gokitgrpc.Handler
+	listOrganizationMembersHandler  gokitgrpc.Handler
+	createFolderHandler             gokitgrpc.Handler
+	listFoldersHandler              gokitgrpc.Handler
+	deleteFolderHandler             gokitgrpc.Handler
+	moveProjectHandler              gokitgrpc.Handler
 }
 
 var Live bool
@@ -158,6 +195,33 @@ func (service *transportService) setupHandlers() {
 		encodeDeleteProjectResponse,
 	)
 
+	endpoint = service.endpointCreatorService.BatchCreateProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("BatchCreateProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.batchCreateProjectsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeBatchCreateProjectsRequest,
+		encodeBatchCreateProjectsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.BatchUpdateProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("BatchUpdateProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.batchUpdateProjectsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeBatchUpdateProjectsRequest,
+		encodeBatchUpdateProjectsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.BatchDeleteProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("BatchDeleteProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.batchDeleteProjectsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeBatchDeleteProjectsRequest,
+		encodeBatchDeleteProjectsResponse,
+	)
+
 	endpoint = service.endpointCreatorService.ListProjectsEndpoint()
 	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListProjects")(endpoint)
 	endpoint = service.createAuthMiddleware()(endpoint)
@@ -166,6 +230,288 @@ func (service *transportService) setupHandlers() {
 		decodeListProjectsRequest,
 		encodeListProjectsResponse,
 	)
+
+	// go-kit has no server-streaming handler, so StreamProjects calls the endpoint itself and streams the projects
+	endpoint = service.endpointCreatorService.StreamProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("StreamProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.streamProjectsEndpoint = endpoint
+
+	// Like StreamProjects, WatchProjects calls the endpoint itself and streams the changes of the projects
+	endpoint = service.endpointCreatorService.WatchProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("WatchProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.watchProjectsEndpoint = endpoint
+
+	endpoint = service.endpointCreatorService.ListProjectSortFieldsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListProjectSortFields")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listProjectSortFieldsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListProjectSortFieldsRequest,
+		encodeListProjectSortFieldsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.SearchProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("SearchProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.searchProjectsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeSearchProjectsRequest,
+		encodeSearchProjectsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ArchiveProjectEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ArchiveProject")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.archiveProjectHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeArchiveProjectRequest,
+		encodeArchiveProjectResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UnarchiveProjectEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UnarchiveProject")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.unarchiveProjectHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUnarchiveProjectRequest,
+		encodeUnarchiveProjectResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RestoreProjectEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RestoreProject")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.restoreProjectHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRestoreProjectRequest,
+		encodeRestoreProjectResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListDeletedProjectsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListDeletedProjects")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listDeletedProjectsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListDeletedProjectsRequest,
+		encodeListDeletedProjectsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AddMemberEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AddMember")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.addMemberHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAddMemberRequest,
+		encodeAddMemberResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RemoveMemberEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RemoveMember")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.removeMemberHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRemoveMemberRequest,
+		encodeRemoveMemberResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UpdateMemberRoleEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UpdateMemberRole")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.updateMemberRoleHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUpdateMemberRoleRequest,
+		encodeUpdateMemberRoleResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListMembersEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListMembers")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listMembersHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListMembersRequest,
+		encodeListMembersResponse,
+	)
+
+	endpoint = service.endpointCreatorService.TransferProjectOwnershipEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("TransferProjectOwnership")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.transferOwnershipHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeTransferProjectOwnershipRequest,
+		encodeTransferProjectOwnershipResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AcceptProjectOwnershipTransferEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AcceptProjectOwnershipTransfer")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.acceptTransferHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAcceptProjectOwnershipTransferRequest,
+		encodeAcceptProjectOwnershipTransferResponse,
+	)
+
+	endpoint = service.endpointCreatorService.DeclineProjectOwnershipTransferEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("DeclineProjectOwnershipTransfer")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.declineTransferHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeDeclineProjectOwnershipTransferRequest,
+		encodeDeclineProjectOwnershipTransferResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListProjectAuditEventsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListProjectAuditEvents")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listAuditEventsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListProjectAuditEventsRequest,
+		encodeListProjectAuditEventsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.CreateInvitationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("CreateInvitation")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.createInvitationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeCreateInvitationRequest,
+		encodeCreateInvitationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListInvitationsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListInvitations")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listInvitationsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListInvitationsRequest,
+		encodeListInvitationsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AcceptInvitationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AcceptInvitation")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.acceptInvitationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAcceptInvitationRequest,
+		encodeAcceptInvitationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RevokeInvitationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RevokeInvitation")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.revokeInvitationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRevokeInvitationRequest,
+		encodeRevokeInvitationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.CreateOrganizationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("CreateOrganization")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.createOrganizationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeCreateOrganizationRequest,
+		encodeCreateOrganizationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ReadOrganizationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ReadOrganization")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.readOrganizationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeReadOrganizationRequest,
+		encodeReadOrganizationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UpdateOrganizationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UpdateOrganization")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.updateOrganizationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUpdateOrganizationRequest,
+		encodeUpdateOrganizationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.DeleteOrganizationEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("DeleteOrganization")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.deleteOrganizationHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeDeleteOrganizationRequest,
+		encodeDeleteOrganizationResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListOrganizationsEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListOrganizations")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listOrganizationsHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListOrganizationsRequest,
+		encodeListOrganizationsResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AddOrganizationMemberEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AddOrganizationMember")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.addOrganizationMemberHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAddOrganizationMemberRequest,
+		encodeAddOrganizationMemberResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RemoveOrganizationMemberEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RemoveOrganizationMember")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.removeOrganizationMemberHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRemoveOrganizationMemberRequest,
+		encodeRemoveOrganizationMemberResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListOrganizationMembersEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListOrganizationMembers")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listOrganizationMembersHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListOrganizationMembersRequest,
+		encodeListOrganizationMembersResponse,
+	)
+
+	endpoint = service.endpointCreatorService.CreateFolderEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("CreateFolder")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.createFolderHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeCreateFolderRequest,
+		encodeCreateFolderResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListFoldersEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListFolders")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listFoldersHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListFoldersRequest,
+		encodeListFoldersResponse,
+	)
+
+	endpoint = service.endpointCreatorService.DeleteFolderEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("DeleteFolder")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.deleteFolderHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeDeleteFolderRequest,
+		encodeDeleteFolderResponse,
+	)
+
+	endpoint = service.endpointCreatorService.MoveProjectEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("MoveProject")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.moveProjectHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeMoveProjectRequest,
+		encodeMoveProjectResponse,
+	)
 }
 
 // CreateProject creates a new project
@@ -231,6 +577,51 @@ func (service *transportService) DeleteProject(
 
 }
 
+// BatchCreateProjects creates new projects at once
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to create new projects
+// Returns the results of creating the projects
+func (service *transportService) BatchCreateProjects(
+	ctx context.Context,
+	request *projectGRPCContract.BatchCreateProjectsRequest) (*projectGRPCContract.BatchCreateProjectsResponse, error) {
+	_, response, err := service.batchCreateProjectsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.BatchCreateProjectsResponse), nil
+}
+
+// BatchUpdateProjects updates existing projects at once
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to update existing projects
+// Returns the results of updating the projects
+func (service *transportService) BatchUpdateProjects(
+	ctx context.Context,
+	request *projectGRPCContract.BatchUpdateProjectsRequest) (*projectGRPCContract.BatchUpdateProjectsResponse, error) {
+	_, response, err := service.batchUpdateProjectsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.BatchUpdateProjectsResponse), nil
+}
+
+// BatchDeleteProjects deletes existing projects at once
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to delete existing projects
+// Returns the results of deleting the projects
+func (service *transportService) BatchDeleteProjects(
+	ctx context.Context,
+	request *projectGRPCContract.BatchDeleteProjectsRequest) (*projectGRPCContract.BatchDeleteProjectsResponse, error) {
+	_, response, err := service.batchDeleteProjectsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.BatchDeleteProjectsResponse), nil
+}
+
 // ListProjects returns the list  of project that matched the provided criteria
 // context: Mandatory. The reference to the context
 // request: Mandatory. The request contains the filter criteria to look for existing project
@@ -245,3 +636,550 @@ func (service *transportService) ListProjects(
 
 	return response.(*projectGRPCContract.ListProjectsResponse), nil
 }
+
+// StreamProjects streams all the projects that matched the provided criteria. The next project is only read once
+// the previous one is sent, so a slow client holds the reading of the projects back, and the projects stop being
+// read as soon as the client cancels the stream.
+// request: Mandatory. The request contains the filter criteria to look for existing project
+// stream: Mandatory. The stream to send the projects to
+// Returns error if something goes wrong
+func (service *transportService) StreamProjects(
+	request *projectGRPCContract.StreamProjectsRequest,
+	stream projectGRPCContract.Service_StreamProjectsServer) error {
+	ctx := stream.Context()
+
+	decodedRequest, err := decodeStreamProjectsRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	response, err := service.streamProjectsEndpoint(ctx, decodedRequest)
+	if err != nil {
+		return err
+	}
+
+	castedResponse := response.(*business.StreamProjectsResponse)
+	if castedResponse.Err != nil {
+		return stream.Send(encodeStreamProjectsError(castedResponse.Err))
+	}
+
+	projects := castedResponse.Projects
+	defer func() {
+		if err := projects.Close(); err != nil {
+			service.logger.Error("failed to close the project iterator", zap.Error(err))
+		}
+	}()
+
+	for projects.Next(ctx) {
+		if err := stream.Send(encodeStreamProjectsResponse(projects.Project())); err != nil {
+			return err
+		}
+	}
+
+	if err := projects.Err(); err != nil {
+		if ctx.Err() != nil {
+			// The client cancelled the stream, so there is no one to send the error to
+			return ctx.Err()
+		}
+
+		return stream.Send(encodeStreamProjectsError(err))
+	}
+
+	return nil
+}
+
+// WatchProjects streams the changes of the projects the user can see as they are made, until the client cancels
+// the stream or the watcher fails
+// request: Mandatory. The request contains the resource version to resume watching from
+// stream: Mandatory. The stream to send the changes of the projects to
+// Returns error if something goes wrong
+func (service *transportService) WatchProjects(
+	request *projectGRPCContract.WatchProjectsRequest,
+	stream projectGRPCContract.Service_WatchProjectsServer) error {
+	ctx := stream.Context()
+
+	decodedRequest, err := decodeWatchProjectsRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	response, err := service.watchProjectsEndpoint(ctx, decodedRequest)
+	if err != nil {
+		return err
+	}
+
+	castedResponse := response.(*business.WatchProjectsResponse)
+	if castedResponse.Err != nil {
+		return stream.Send(encodeWatchProjectsError(castedResponse.Err))
+	}
+
+	watcher := castedResponse.Watcher
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			service.logger.Error("failed to close the project watcher", zap.Error(err))
+		}
+	}()
+
+	for watcher.Next(ctx) {
+		if err := stream.Send(encodeWatchProjectsResponse(watcher.Event())); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		// The client cancelled the stream, so there is no one to send the error to
+		return ctx.Err()
+	}
+
+	return stream.Send(encodeWatchProjectsError(watcher.Err()))
+}
+
+// ListProjectSortFields returns the fields the projects can be sorted on
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the fields the projects can be sorted on
+// Returns the fields the projects can be sorted on
+func (service *transportService) ListProjectSortFields(
+	ctx context.Context,
+	request *projectGRPCContract.ListProjectSortFieldsRequest) (*projectGRPCContract.ListProjectSortFieldsResponse, error) {
+	_, response, err := service.listProjectSortFieldsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListProjectSortFieldsResponse), nil
+}
+
+// SearchProjects returns the list of projects that matched the full-text query, the most relevant ones first
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request contains the query
+// Returns the list of projects that matched the query
+func (service *transportService) SearchProjects(
+	ctx context.Context,
+	request *projectGRPCContract.SearchProjectsRequest) (*projectGRPCContract.SearchProjectsResponse, error) {
+	_, response, err := service.searchProjectsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.SearchProjectsResponse), nil
+}
+
+// ArchiveProject archives an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to archive an existing project
+// Returns the result of archiving an existing project
+func (service *transportService) ArchiveProject(
+	ctx context.Context,
+	request *projectGRPCContract.ArchiveProjectRequest) (*projectGRPCContract.ArchiveProjectResponse, error) {
+	_, response, err := service.archiveProjectHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ArchiveProjectResponse), nil
+}
+
+// UnarchiveProject unarchives an archived project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to unarchive an archived project
+// Returns the result of unarchiving an archived project
+func (service *transportService) UnarchiveProject(
+	ctx context.Context,
+	request *projectGRPCContract.UnarchiveProjectRequest) (*projectGRPCContract.UnarchiveProjectResponse, error) {
+	_, response, err := service.unarchiveProjectHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.UnarchiveProjectResponse), nil
+}
+
+// RestoreProject restores a deleted project that is not purged yet
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to restore a deleted project
+// Returns the result of restoring a deleted project
+func (service *transportService) RestoreProject(
+	ctx context.Context,
+	request *projectGRPCContract.RestoreProjectRequest) (*projectGRPCContract.RestoreProjectResponse, error) {
+	_, response, err := service.restoreProjectHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.RestoreProjectResponse), nil
+}
+
+// ListDeletedProjects returns the list of deleted projects that are not purged yet and matched the provided criteria
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request contains the filter criteria to look for the deleted projects
+// Returns the list of deleted projects that matched the provided criteria
+func (service *transportService) ListDeletedProjects(
+	ctx context.Context,
+	request *projectGRPCContract.ListDeletedProjectsRequest) (*projectGRPCContract.ListDeletedProjectsResponse, error) {
+	_, response, err := service.listDeletedProjectsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListDeletedProjectsResponse), nil
+}
+
+// AddMember adds a new member to an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to add a new member to an existing project
+// Returns the result of adding a new member
+func (service *transportService) AddMember(
+	ctx context.Context,
+	request *projectGRPCContract.AddMemberRequest) (*projectGRPCContract.AddMemberResponse, error) {
+	_, response, err := service.addMemberHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.AddMemberResponse), nil
+}
+
+// RemoveMember removes an existing member from a project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to remove an existing member from a project
+// Returns the result of removing an existing member
+func (service *transportService) RemoveMember(
+	ctx context.Context,
+	request *projectGRPCContract.RemoveMemberRequest) (*projectGRPCContract.RemoveMemberResponse, error) {
+	_, response, err := service.removeMemberHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.RemoveMemberResponse), nil
+}
+
+// UpdateMemberRole changes the role of an existing member of a project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to change the role of an existing member
+// Returns the result of changing the role of an existing member
+func (service *transportService) UpdateMemberRole(
+	ctx context.Context,
+	request *projectGRPCContract.UpdateMemberRoleRequest) (*projectGRPCContract.UpdateMemberRoleResponse, error) {
+	_, response, err := service.updateMemberRoleHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.UpdateMemberRoleResponse), nil
+}
+
+// ListMembers returns the members of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the members of an existing project
+// Returns the members of the project
+func (service *transportService) ListMembers(
+	ctx context.Context,
+	request *projectGRPCContract.ListMembersRequest) (*projectGRPCContract.ListMembersResponse, error) {
+	_, response, err := service.listMembersHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListMembersResponse), nil
+}
+
+// TransferProjectOwnership transfers the ownership of an existing project to another user
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to transfer the ownership of an existing project
+// Returns the result of transferring the ownership
+func (service *transportService) TransferProjectOwnership(
+	ctx context.Context,
+	request *projectGRPCContract.TransferProjectOwnershipRequest) (*projectGRPCContract.TransferProjectOwnershipResponse, error) {
+	_, response, err := service.transferOwnershipHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.TransferProjectOwnershipResponse), nil
+}
+
+// AcceptProjectOwnershipTransfer accepts the pending ownership transfer of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to accept the pending ownership transfer
+// Returns the result of accepting the pending ownership transfer
+func (service *transportService) AcceptProjectOwnershipTransfer(
+	ctx context.Context,
+	request *projectGRPCContract.AcceptProjectOwnershipTransferRequest) (*projectGRPCContract.AcceptProjectOwnershipTransferResponse, error) {
+	_, response, err := service.acceptTransferHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.AcceptProjectOwnershipTransferResponse), nil
+}
+
+// DeclineProjectOwnershipTransfer declines the pending ownership transfer of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to decline the pending ownership transfer
+// Returns the result of declining the pending ownership transfer
+func (service *transportService) DeclineProjectOwnershipTransfer(
+	ctx context.Context,
+	request *projectGRPCContract.DeclineProjectOwnershipTransferRequest) (*projectGRPCContract.DeclineProjectOwnershipTransferResponse, error) {
+	_, response, err := service.declineTransferHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.DeclineProjectOwnershipTransferResponse), nil
+}
+
+// ListProjectAuditEvents returns the audit trail of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the audit trail of an existing project
+// Returns the audit events of the project
+func (service *transportService) ListProjectAuditEvents(
+	ctx context.Context,
+	request *projectGRPCContract.ListProjectAuditEventsRequest) (*projectGRPCContract.ListProjectAuditEventsResponse, error) {
+	_, response, err := service.listAuditEventsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListProjectAuditEventsResponse), nil
+}
+
+// CreateInvitation invites a user to join an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to invite a user to join an existing project
+// Returns the result of inviting the user
+func (service *transportService) CreateInvitation(
+	ctx context.Context,
+	request *projectGRPCContract.CreateInvitationRequest) (*projectGRPCContract.CreateInvitationResponse, error) {
+	_, response, err := service.createInvitationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.CreateInvitationResponse), nil
+}
+
+// ListInvitations returns the pending invitations of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the pending invitations of an existing project
+// Returns the pending invitations of the project
+func (service *transportService) ListInvitations(
+	ctx context.Context,
+	request *projectGRPCContract.ListInvitationsRequest) (*projectGRPCContract.ListInvitationsResponse, error) {
+	_, response, err := service.listInvitationsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListInvitationsResponse), nil
+}
+
+// AcceptInvitation accepts a pending invitation and adds the invited user to the members of the project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to accept a pending invitation
+// Returns the result of accepting the invitation
+func (service *transportService) AcceptInvitation(
+	ctx context.Context,
+	request *projectGRPCContract.AcceptInvitationRequest) (*projectGRPCContract.AcceptInvitationResponse, error) {
+	_, response, err := service.acceptInvitationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.AcceptInvitationResponse), nil
+}
+
+// RevokeInvitation revokes a pending invitation of an existing project
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to revoke a pending invitation
+// Returns the result of revoking the invitation
+func (service *transportService) RevokeInvitation(
+	ctx context.Context,
+	request *projectGRPCContract.RevokeInvitationRequest) (*projectGRPCContract.RevokeInvitationResponse, error) {
+	_, response, err := service.revokeInvitationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.RevokeInvitationResponse), nil
+}
+
+// CreateOrganization creates a new organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to create a new organization
+// Returns the result of creating new organization
+func (service *transportService) CreateOrganization(
+	ctx context.Context,
+	request *projectGRPCContract.CreateOrganizationRequest) (*projectGRPCContract.CreateOrganizationResponse, error) {
+	_, response, err := service.createOrganizationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.CreateOrganizationResponse), nil
+}
+
+// ReadOrganization read an existing organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to read an existing organization
+// Returns the result of reading an existing organization
+func (service *transportService) ReadOrganization(
+	ctx context.Context,
+	request *projectGRPCContract.ReadOrganizationRequest) (*projectGRPCContract.ReadOrganizationResponse, error) {
+	_, response, err := service.readOrganizationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ReadOrganizationResponse), nil
+}
+
+// UpdateOrganization update an existing organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to update an existing organization
+// Returns the result of updating an existing organization
+func (service *transportService) UpdateOrganization(
+	ctx context.Context,
+	request *projectGRPCContract.UpdateOrganizationRequest) (*projectGRPCContract.UpdateOrganizationResponse, error) {
+	_, response, err := service.updateOrganizationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.UpdateOrganizationResponse), nil
+}
+
+// DeleteOrganization delete an existing organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to delete an existing organization
+// Returns the result of deleting an existing organization
+func (service *transportService) DeleteOrganization(
+	ctx context.Context,
+	request *projectGRPCContract.DeleteOrganizationRequest) (*projectGRPCContract.DeleteOrganizationResponse, error) {
+	_, response, err := service.deleteOrganizationHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.DeleteOrganizationResponse), nil
+}
+
+// ListOrganizations returns the organizations the user is a member of
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the organizations
+// Returns the organizations of the user
+func (service *transportService) ListOrganizations(
+	ctx context.Context,
+	request *projectGRPCContract.ListOrganizationsRequest) (*projectGRPCContract.ListOrganizationsResponse, error) {
+	_, response, err := service.listOrganizationsHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListOrganizationsResponse), nil
+}
+
+// AddOrganizationMember adds a new member to an existing organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to add a new member to an existing organization
+// Returns the result of adding the member
+func (service *transportService) AddOrganizationMember(
+	ctx context.Context,
+	request *projectGRPCContract.AddOrganizationMemberRequest) (*projectGRPCContract.AddOrganizationMemberResponse, error) {
+	_, response, err := service.addOrganizationMemberHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.AddOrganizationMemberResponse), nil
+}
+
+// RemoveOrganizationMember removes an existing member from an organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to remove an existing member from an organization
+// Returns the result of removing the member
+func (service *transportService) RemoveOrganizationMember(
+	ctx context.Context,
+	request *projectGRPCContract.RemoveOrganizationMemberRequest) (*projectGRPCContract.RemoveOrganizationMemberResponse, error) {
+	_, response, err := service.removeOrganizationMemberHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.RemoveOrganizationMemberResponse), nil
+}
+
+// ListOrganizationMembers returns the members of an existing organization
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the members of an existing organization
+// Returns the members of the organization
+func (service *transportService) ListOrganizationMembers(
+	ctx context.Context,
+	request *projectGRPCContract.ListOrganizationMembersRequest) (*projectGRPCContract.ListOrganizationMembersResponse, error) {
+	_, response, err := service.listOrganizationMembersHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListOrganizationMembersResponse), nil
+}
+
+// CreateFolder creates a new folder to organize the projects of the user
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to create a new folder
+// Returns the result of creating the new folder
+func (service *transportService) CreateFolder(
+	ctx context.Context,
+	request *projectGRPCContract.CreateFolderRequest) (*projectGRPCContract.CreateFolderResponse, error) {
+	_, response, err := service.createFolderHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.CreateFolderResponse), nil
+}
+
+// ListFolders returns the folders of the user
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the folders of the user
+// Returns the folders of the user
+func (service *transportService) ListFolders(
+	ctx context.Context,
+	request *projectGRPCContract.ListFoldersRequest) (*projectGRPCContract.ListFoldersResponse, error) {
+	_, response, err := service.listFoldersHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.ListFoldersResponse), nil
+}
+
+// DeleteFolder deletes an existing folder together with its subfolders
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to delete an existing folder
+// Returns the result of deleting the folder
+func (service *transportService) DeleteFolder(
+	ctx context.Context,
+	request *projectGRPCContract.DeleteFolderRequest) (*projectGRPCContract.DeleteFolderResponse, error) {
+	_, response, err := service.deleteFolderHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.DeleteFolderResponse), nil
+}
+
+// MoveProject moves an existing project into a folder or out of its folder
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to move an existing project
+// Returns the result of moving the project
+func (service *transportService) MoveProject(
+	ctx context.Context,
+	request *projectGRPCContract.MoveProjectRequest) (*projectGRPCContract.MoveProjectResponse, error) {
+	_, response, err := service.moveProjectHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*projectGRPCContract.MoveProjectResponse), nil
+}
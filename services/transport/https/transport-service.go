@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/decentralized-cloud/project/services/configuration"
+	"github.com/decentralized-cloud/project/services/repository"
 	"github.com/decentralized-cloud/project/services/transport"
 	"github.com/decentralized-cloud/project/services/transport/grpc"
 	commonErrors "github.com/micro-business/go-core/system/errors"
@@ -17,15 +18,18 @@ import (
 type transportService struct {
 	logger               *zap.Logger
 	configurationService configuration.ConfigurationContract
+	repositoryService    repository.RepositoryContract
 }
 
 // NewTransportService creates new instance of the transportService, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
 // configurationService: Mandatory. Reference to the service that provides required configurations
+// repositoryService: Mandatory. Reference to the repository service, used to back up the data file if the repository service supports it
 // Returns the new service or error if something goes wrong
 func NewTransportService(
 	logger *zap.Logger,
-	configurationService configuration.ConfigurationContract) (transport.TransportContract, error) {
+	configurationService configuration.ConfigurationContract,
+	repositoryService repository.RepositoryContract) (transport.TransportContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -34,9 +38,14 @@ func NewTransportService(
 		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
 	}
 
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
 	return &transportService{
 		logger:               logger,
 		configurationService: configurationService,
+		repositoryService:    repositoryService,
 	}, nil
 }
 
@@ -62,9 +71,27 @@ func (service *transportService) Start() error {
 	server.Path("GET", "/live", service.livenessCheckHandler)
 	server.Path("GET", "/ready", service.readinessCheckHandler)
 	server.NetHTTPPath("GET", "/metrics", promhttp.Handler())
+
+	serverErrors := make(chan error, 2)
+
+	if backupService, ok := service.repositoryService.(repository.BackupContract); ok {
+		adminServer, err := service.newAdminServer(backupService)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			serverErrors <- adminServer.ListenAndServe()
+		}()
+	}
+
 	service.logger.Info("HTTPS service started", zap.String("address", config.Addr))
 
-	return server.ListenAndServe()
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+
+	return <-serverErrors
 }
 
 // Stop stops the GraphQL transport service
@@ -92,3 +119,44 @@ func (service *transportService) readinessCheckHandler(ctx *atreugo.RequestCtx)
 
 	return nil
 }
+
+// newAdminServer creates the server that exposes the administrative endpoints. The backup streams the whole data file,
+// so it is kept off the public HTTP port and listens on the admin host, which only accepts local connections by default.
+func (service *transportService) newAdminServer(backupService repository.BackupContract) (*atreugo.Atreugo, error) {
+	host, err := service.configurationService.GetAdminHost()
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := service.configurationService.GetAdminPort()
+	if err != nil {
+		return nil, err
+	}
+
+	config := atreugo.Config{GracefulShutdown: true, Addr: fmt.Sprintf("%s:%d", host, port)}
+	server := atreugo.New(config)
+	server.NetHTTPPath("GET", "/backup", service.newBackupHandler(backupService))
+
+	service.logger.Info("Admin HTTP service started", zap.String("address", config.Addr))
+
+	return server, nil
+}
+
+func (service *transportService) newBackupHandler(backupService repository.BackupContract) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/octet-stream")
+
+		written, err := backupService.Backup(request.Context(), writer)
+		if err != nil {
+			service.logger.Error("failed to back up the data file", zap.Error(err))
+
+			if written == 0 {
+				writer.WriteHeader(http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		service.logger.Info("data file backed up", zap.Int64("size", written))
+	})
+}